@@ -19,7 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Oracle_Prices_FullMethodName = "/slinky.service.v1.Oracle/Prices"
+	Oracle_Prices_FullMethodName            = "/slinky.service.v1.Oracle/Prices"
+	Oracle_PriceEnvelopes_FullMethodName    = "/slinky.service.v1.Oracle/PriceEnvelopes"
+	Oracle_ProviderHealth_FullMethodName    = "/slinky.service.v1.Oracle/ProviderHealth"
+	Oracle_Config_FullMethodName            = "/slinky.service.v1.Oracle/Config"
+	Oracle_RefreshPrices_FullMethodName     = "/slinky.service.v1.Oracle/RefreshPrices"
+	Oracle_SetProviderKilled_FullMethodName = "/slinky.service.v1.Oracle/SetProviderKilled"
+	Oracle_AggregationAudit_FullMethodName  = "/slinky.service.v1.Oracle/AggregationAudit"
+	Oracle_StreamPrices_FullMethodName      = "/slinky.service.v1.Oracle/StreamPrices"
 )
 
 // OracleClient is the client API for Oracle service.
@@ -28,6 +35,32 @@ const (
 type OracleClient interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// PriceEnvelopes defines a method for fetching the latest prices, each
+	// wrapped in a PriceEnvelope packed into a google.protobuf.Any.
+	PriceEnvelopes(ctx context.Context, in *QueryPriceEnvelopesRequest, opts ...grpc.CallOption) (*QueryPriceEnvelopesResponse, error)
+	// ProviderHealth defines a method for fetching the health of each provider,
+	// including the state of each of its websocket connections.
+	ProviderHealth(ctx context.Context, in *QueryProviderHealthRequest, opts ...grpc.CallOption) (*QueryProviderHealthResponse, error)
+	// Config defines a method for fetching the effective configuration of the
+	// oracle, with secrets such as API keys redacted.
+	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// RefreshPrices defines a method for immediately fetching and aggregating
+	// the prices of the given pairs, rather than waiting for the next update of
+	// the oracle. Refreshes are rate-limited.
+	RefreshPrices(ctx context.Context, in *RefreshPricesRequest, opts ...grpc.CallOption) (*RefreshPricesResponse, error)
+	// SetProviderKilled defines a method for killing or reviving a provider at
+	// runtime. A killed provider is stopped and its prices are excluded from
+	// the aggregation until it is revived.
+	SetProviderKilled(ctx context.Context, in *SetProviderKilledRequest, opts ...grpc.CallOption) (*SetProviderKilledResponse, error)
+	// AggregationAudit defines a method for fetching, for each pair, whether
+	// each of its providers was included in the latest aggregated price and, if
+	// not, why it was excluded. This is only available if the oracle's
+	// aggregation audit mode is enabled.
+	AggregationAudit(ctx context.Context, in *QueryAggregationAuditRequest, opts ...grpc.CallOption) (*QueryAggregationAuditResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A new
+	// response is sent each time the oracle completes an aggregation cycle, such
+	// that consumers do not need to poll the Prices method.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
 }
 
 type oracleClient struct {
@@ -47,12 +80,124 @@ func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts
 	return out, nil
 }
 
+func (c *oracleClient) PriceEnvelopes(ctx context.Context, in *QueryPriceEnvelopesRequest, opts ...grpc.CallOption) (*QueryPriceEnvelopesResponse, error) {
+	out := new(QueryPriceEnvelopesResponse)
+	err := c.cc.Invoke(ctx, Oracle_PriceEnvelopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) ProviderHealth(ctx context.Context, in *QueryProviderHealthRequest, opts ...grpc.CallOption) (*QueryProviderHealthResponse, error) {
+	out := new(QueryProviderHealthResponse)
+	err := c.cc.Invoke(ctx, Oracle_ProviderHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
+	out := new(QueryConfigResponse)
+	err := c.cc.Invoke(ctx, Oracle_Config_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) RefreshPrices(ctx context.Context, in *RefreshPricesRequest, opts ...grpc.CallOption) (*RefreshPricesResponse, error) {
+	out := new(RefreshPricesResponse)
+	err := c.cc.Invoke(ctx, Oracle_RefreshPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) SetProviderKilled(ctx context.Context, in *SetProviderKilledRequest, opts ...grpc.CallOption) (*SetProviderKilledResponse, error) {
+	out := new(SetProviderKilledResponse)
+	err := c.cc.Invoke(ctx, Oracle_SetProviderKilled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) AggregationAudit(ctx context.Context, in *QueryAggregationAuditRequest, opts ...grpc.CallOption) (*QueryAggregationAuditResponse, error) {
+	out := new(QueryAggregationAuditResponse)
+	err := c.cc.Invoke(ctx, Oracle_AggregationAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Oracle_ServiceDesc.Streams[0], Oracle_StreamPrices_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OracleServer is the server API for Oracle service.
 // All implementations must embed UnimplementedOracleServer
 // for forward compatibility
 type OracleServer interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// PriceEnvelopes defines a method for fetching the latest prices, each
+	// wrapped in a PriceEnvelope packed into a google.protobuf.Any.
+	PriceEnvelopes(context.Context, *QueryPriceEnvelopesRequest) (*QueryPriceEnvelopesResponse, error)
+	// ProviderHealth defines a method for fetching the health of each provider,
+	// including the state of each of its websocket connections.
+	ProviderHealth(context.Context, *QueryProviderHealthRequest) (*QueryProviderHealthResponse, error)
+	// Config defines a method for fetching the effective configuration of the
+	// oracle, with secrets such as API keys redacted.
+	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// RefreshPrices defines a method for immediately fetching and aggregating
+	// the prices of the given pairs, rather than waiting for the next update of
+	// the oracle. Refreshes are rate-limited.
+	RefreshPrices(context.Context, *RefreshPricesRequest) (*RefreshPricesResponse, error)
+	// SetProviderKilled defines a method for killing or reviving a provider at
+	// runtime. A killed provider is stopped and its prices are excluded from
+	// the aggregation until it is revived.
+	SetProviderKilled(context.Context, *SetProviderKilledRequest) (*SetProviderKilledResponse, error)
+	// AggregationAudit defines a method for fetching, for each pair, whether
+	// each of its providers was included in the latest aggregated price and, if
+	// not, why it was excluded. This is only available if the oracle's
+	// aggregation audit mode is enabled.
+	AggregationAudit(context.Context, *QueryAggregationAuditRequest) (*QueryAggregationAuditResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A new
+	// response is sent each time the oracle completes an aggregation cycle, such
+	// that consumers do not need to poll the Prices method.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
 	mustEmbedUnimplementedOracleServer()
 }
 
@@ -63,6 +208,27 @@ type UnimplementedOracleServer struct {
 func (UnimplementedOracleServer) Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (UnimplementedOracleServer) PriceEnvelopes(context.Context, *QueryPriceEnvelopesRequest) (*QueryPriceEnvelopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceEnvelopes not implemented")
+}
+func (UnimplementedOracleServer) ProviderHealth(context.Context, *QueryProviderHealthRequest) (*QueryProviderHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderHealth not implemented")
+}
+func (UnimplementedOracleServer) Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (UnimplementedOracleServer) RefreshPrices(context.Context, *RefreshPricesRequest) (*RefreshPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPrices not implemented")
+}
+func (UnimplementedOracleServer) SetProviderKilled(context.Context, *SetProviderKilledRequest) (*SetProviderKilledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderKilled not implemented")
+}
+func (UnimplementedOracleServer) AggregationAudit(context.Context, *QueryAggregationAuditRequest) (*QueryAggregationAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregationAudit not implemented")
+}
+func (UnimplementedOracleServer) StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
 func (UnimplementedOracleServer) mustEmbedUnimplementedOracleServer() {}
 
 // UnsafeOracleServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +260,135 @@ func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Oracle_PriceEnvelopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceEnvelopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).PriceEnvelopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_PriceEnvelopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).PriceEnvelopes(ctx, req.(*QueryPriceEnvelopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_ProviderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_ProviderHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderHealth(ctx, req.(*QueryProviderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_Config_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Config(ctx, req.(*QueryConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_RefreshPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).RefreshPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_RefreshPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).RefreshPrices(ctx, req.(*RefreshPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_SetProviderKilled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProviderKilledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).SetProviderKilled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_SetProviderKilled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).SetProviderKilled(ctx, req.(*SetProviderKilledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_AggregationAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregationAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).AggregationAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Oracle_AggregationAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).AggregationAudit(ctx, req.(*QueryAggregationAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Oracle_ServiceDesc is the grpc.ServiceDesc for Oracle service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,7 +400,37 @@ var Oracle_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
 		},
+		{
+			MethodName: "PriceEnvelopes",
+			Handler:    _Oracle_PriceEnvelopes_Handler,
+		},
+		{
+			MethodName: "ProviderHealth",
+			Handler:    _Oracle_ProviderHealth_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Oracle_Config_Handler,
+		},
+		{
+			MethodName: "RefreshPrices",
+			Handler:    _Oracle_RefreshPrices_Handler,
+		},
+		{
+			MethodName: "SetProviderKilled",
+			Handler:    _Oracle_SetProviderKilled_Handler,
+		},
+		{
+			MethodName: "AggregationAudit",
+			Handler:    _Oracle_AggregationAudit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/service/v1/oracle.proto",
 }
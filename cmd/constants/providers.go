@@ -6,10 +6,12 @@ import (
 	"github.com/skip-mev/slinky/oracle/constants"
 	"github.com/skip-mev/slinky/oracle/types"
 	binanceapi "github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/binancefutures"
 	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
 	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
 	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
 	"github.com/skip-mev/slinky/providers/apis/dydx"
+	"github.com/skip-mev/slinky/providers/apis/fx"
 	krakenapi "github.com/skip-mev/slinky/providers/apis/kraken"
 	"github.com/skip-mev/slinky/providers/apis/marketmap"
 	"github.com/skip-mev/slinky/providers/volatile"
@@ -52,11 +54,21 @@ var (
 			API:  binanceapi.DefaultNonUSAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: binancefutures.Name,
+			API:  binancefutures.DefaultAPIConfig,
+			Type: types.ConfigType,
+		},
 		{
 			Name: krakenapi.Name,
 			API:  krakenapi.DefaultAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: fx.Name,
+			API:  fx.DefaultAPIConfig,
+			Type: types.ConfigType,
+		},
 		{
 			Name: volatile.Name,
 			API:  volatile.DefaultAPIConfig,
@@ -100,6 +100,9 @@ var (
 	ethNodeURLs []string
 	// providerMarkets is the set of providers to output config for. It is used for testing.
 	providerMarkets []string
+	// providerMarketsPath is the path to a JSON file of additional markets for each provider. These
+	// are merged with the default markets of the providers.
+	providerMarketsPath string
 	// ProviderToMarkets defines a map of provider names to their respective market
 	// configurations. This is used to generate the local market config file.
 	ProviderToMarkets = map[string]types.CurrencyPairsToProviderTickers{
@@ -268,6 +271,13 @@ func init() {
 		nil,
 		"The set of providers to add markets for.",
 	)
+	rootCmd.Flags().StringVarP(
+		&providerMarketsPath,
+		"provider-markets-path",
+		"",
+		"",
+		"Path to a JSON file of additional markets for each provider, indexed by provider name and then currency pair, e.g. {\"mexc_ws\": {\"SOL/USD\": {\"offChainTicker\": \"SOLUSDT\"}}}. These are merged with the default markets of the providers, and take precedence over the defaults on conflicts.",
+	)
 }
 
 // main executes a simple script that encodes the local config file to the local
@@ -414,6 +424,16 @@ func createMarketMap() error {
 		ProviderToMarkets = pruned
 	}
 
+	// merge the user-supplied provider markets, if any, with the default provider markets
+	if len(providerMarketsPath) > 0 {
+		merged, err := addProviderMarkets(ProviderToMarkets)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error adding provider markets: %v\n", err)
+			return err
+		}
+		ProviderToMarkets = merged
+	}
+
 	// if raydium is enabled, configure the raydium markets based on the local raydium_pairs fixture
 	if raydiumEnabled {
 		ProviderToMarkets = addRaydiumMarkets(ProviderToMarkets)
@@ -479,6 +499,69 @@ func createMarketMap() error {
 	return nil
 }
 
+// ProviderMarket is the configuration of a user-supplied market of a provider.
+type ProviderMarket struct {
+	// OffChainTicker is the ticker of the market on the provider.
+	OffChainTicker string `json:"offChainTicker"`
+	// JSON is the additional JSON data of the market, if any.
+	JSON string `json:"json"`
+}
+
+// addProviderMarkets merges the provider markets in the file at providerMarketsPath with the given
+// provider markets, such that the user-supplied markets augment rather than replace the defaults.
+// If a user-supplied market conflicts with a default market of the same currency pair, the
+// user-supplied market is kept and a warning is logged.
+func addProviderMarkets(
+	providerToMarkets map[string]types.CurrencyPairsToProviderTickers,
+) (map[string]types.CurrencyPairsToProviderTickers, error) {
+	bz, err := os.ReadFile(providerMarketsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading provider markets file: %w", err)
+	}
+
+	var providerMarkets map[string]map[string]ProviderMarket
+	if err := json.Unmarshal(bz, &providerMarkets); err != nil {
+		return nil, fmt.Errorf("error unmarshalling provider markets file: %w", err)
+	}
+
+	merged := make(map[string]types.CurrencyPairsToProviderTickers, len(providerToMarkets))
+	for provider, markets := range providerToMarkets {
+		merged[provider] = markets
+	}
+
+	for provider, markets := range providerMarkets {
+		override := make(types.CurrencyPairsToProviderTickers, len(markets))
+		for pair, market := range markets {
+			cp, err := slinkytypes.SpotCurrencyPairFromString(pair)
+			if err != nil {
+				return nil, fmt.Errorf("invalid currency pair %s for provider %s: %w", pair, provider, err)
+			}
+
+			if len(market.OffChainTicker) == 0 {
+				return nil, fmt.Errorf("off-chain ticker of %s for provider %s cannot be empty", pair, provider)
+			}
+
+			override[cp] = types.DefaultProviderTicker{
+				OffChainTicker: market.OffChainTicker,
+				JSON:           market.JSON,
+			}
+		}
+
+		var conflicts []slinkytypes.CurrencyPair
+		merged[provider], conflicts = types.MergeMarketConfigs(merged[provider], override)
+		for _, cp := range conflicts {
+			fmt.Fprintf(
+				os.Stderr,
+				"warning: market %s of provider %s overrides the default market of the provider\n",
+				cp,
+				provider,
+			)
+		}
+	}
+
+	return merged, nil
+}
+
 type TickerMetaData struct {
 	Cp             slinkytypes.CurrencyPair `json:"currency_pair"`
 	TickerMetaData raydium.TickerMetadata   `json:"ticker_metadata"`
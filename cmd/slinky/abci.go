@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/log"
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/slinky/cmd/slinky/replay"
+)
+
+var (
+	abciCmd = &cobra.Command{
+		Use:   "abci",
+		Short: "Tooling for debugging the slinky ABCI handlers.",
+	}
+
+	replayCmd = &cobra.Command{
+		Use:   "replay",
+		Short: "Replay recorded extended commits against the proposal handler.",
+		Long: `Replay recorded extended commits through the ProcessProposalHandler and print whether each
+commit would be accepted or rejected. Each --commit file must contain a single base64 encoded extended
+commit, i.e. the first transaction of a proposal as returned by the CometBFT /block RPC.
+
+Vote extension signatures and voting power are not verified, as this requires access to the validator
+set. All other validation performed by the proposal handler is applied.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return replayExtendedCommits(cmd)
+		},
+	}
+
+	replayCommitPaths         []string
+	replayHeight              int64
+	replayMaxNumCP            uint64
+	replayExtendedCommitCodec string
+	replayVoteExtensionCodec  string
+	replayVerbose             bool
+)
+
+func init() {
+	replayCmd.Flags().StringSliceVar(
+		&replayCommitPaths,
+		"commit",
+		nil,
+		"Path to a file containing a base64 encoded extended commit. May be specified multiple times.",
+	)
+	replayCmd.Flags().Int64Var(
+		&replayHeight,
+		"height",
+		2,
+		"Height at which the commits are processed. Must be greater than 1 for vote extensions to be enabled.",
+	)
+	replayCmd.Flags().Uint64Var(
+		&replayMaxNumCP,
+		"max-num-cp",
+		0,
+		"Maximum number of currency pairs expected in each vote extension. A value of 0 disables the check.",
+	)
+	replayCmd.Flags().StringVar(
+		&replayExtendedCommitCodec,
+		"extended-commit-codec",
+		"1",
+		"The codec used to decode the extended commit. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding",
+	)
+	replayCmd.Flags().StringVar(
+		&replayVoteExtensionCodec,
+		"vote-extension-codec",
+		"1",
+		"The codec used to decode the vote extensions. Options are 1: standard encoding (default), 2: z-lib compressed encoding, 3: zstd compressed encoding",
+	)
+	replayCmd.Flags().BoolVar(
+		&replayVerbose,
+		"verbose",
+		false,
+		"Log the output of the proposal handler.",
+	)
+	replayCmd.MarkFlagRequired("commit") //nolint: errcheck
+
+	abciCmd.AddCommand(replayCmd)
+	rootCmd.AddCommand(abciCmd)
+}
+
+// replayExtendedCommits runs each recorded extended commit through the ProcessProposalHandler and
+// prints the resulting decision.
+func replayExtendedCommits(cmd *cobra.Command) error {
+	extCommitCodec, err := replay.ExtendedCommitCodecFromFlag(replayExtendedCommitCodec)
+	if err != nil {
+		return err
+	}
+
+	veCodec, err := replay.VoteExtensionCodecFromFlag(replayVoteExtensionCodec)
+	if err != nil {
+		return err
+	}
+
+	logger := log.NewNopLogger()
+	if replayVerbose {
+		logger = log.NewLogger(cmd.ErrOrStderr())
+	}
+
+	replayer, err := replay.NewReplayer(logger, replayHeight, replayMaxNumCP, extCommitCodec, veCodec)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range replayCommitPaths {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read commit file %s: %w", path, err)
+		}
+
+		extCommitBz, err := replay.DecodeCommit(bz)
+		if err != nil {
+			return fmt.Errorf("failed to decode commit file %s: %w", path, err)
+		}
+
+		status, err := replayer.Replay(extCommitBz)
+		if err != nil {
+			cmd.Printf("%s: %s (%s)\n", path, status, err)
+			continue
+		}
+
+		cmd.Printf("%s: %s\n", path, status)
+	}
+
+	return nil
+}
@@ -297,6 +297,62 @@ func TestReadOracleConfigWithOverrides(t *testing.T) {
 		require.Equal(t, expectedConfig.UpdateInterval, cfg.UpdateInterval)
 		require.Equal(t, expectedConfig.Metrics.PrometheusServerAddress, cfg.Metrics.PrometheusServerAddress)
 	})
+
+	t.Run("config with a leading BOM and surrounding whitespace", func(t *testing.T) {
+		tmpfile, err := os.CreateTemp("", "slinky-config-*.json")
+		require.NoError(t, err)
+
+		defer os.Remove(tmpfile.Name())
+
+		overrides := fmt.Sprintf("\xEF\xBB\xBF\n  {\"updateInterval\": \"%s\"}  \n\n", updateIntervalOverride)
+		tmpfile.Write([]byte(overrides))
+
+		cfg, err := config.ReadOracleConfigWithOverrides(tmpfile.Name(), marketmap.Name)
+		require.NoError(t, err)
+		require.Equal(t, updateIntervalOverride, cfg.UpdateInterval)
+	})
+}
+
+func TestReadOracleConfigFromEnv(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "slinky-config-*.json")
+	require.NoError(t, err)
+	defer os.Remove(tmpfile.Name())
+
+	_, err = tmpfile.WriteString(`{"updateInterval": "1s", "port": "9090"}`)
+	require.NoError(t, err)
+	require.NoError(t, tmpfile.Close())
+
+	t.Run("environment over file over defaults", func(t *testing.T) {
+		t.Setenv(config.SlinkyConfigFileEnvironmentVariable, tmpfile.Name())
+		t.Setenv(config.SlinkyConfigEnvironmentPrefix+"_UPDATEINTERVAL", "5s")
+		t.Setenv(config.SlinkyConfigEnvironmentPrefix+"_HOST", "127.0.0.1")
+		t.Setenv(config.SlinkyConfigEnvironmentPrefix+"_METRICS_ENABLED", "false")
+
+		cfg, err := config.ReadOracleConfigFromEnv(marketmap.Name)
+		require.NoError(t, err)
+
+		require.Equal(t, 5*time.Second, cfg.UpdateInterval)
+		require.Equal(t, "127.0.0.1", cfg.Host)
+		require.False(t, cfg.Metrics.Enabled)
+		require.Equal(t, "9090", cfg.Port)
+		require.Equal(t, time.Duration(config.DefaultMaxPriceAge), cfg.MaxPriceAge)
+		require.Equal(t, config.DefaultPrometheusServerAddress, cfg.Metrics.PrometheusServerAddress)
+	})
+
+	t.Run("invalid environment override", func(t *testing.T) {
+		t.Setenv(config.SlinkyConfigFileEnvironmentVariable, tmpfile.Name())
+		t.Setenv(config.SlinkyConfigEnvironmentPrefix+"_MAXPRICEAGE", "-1s")
+
+		_, err := config.ReadOracleConfigFromEnv(marketmap.Name)
+		require.Error(t, err)
+	})
+
+	t.Run("missing base config file", func(t *testing.T) {
+		t.Setenv(config.SlinkyConfigFileEnvironmentVariable, "does-not-exist.json")
+
+		_, err := config.ReadOracleConfigFromEnv(marketmap.Name)
+		require.Error(t, err)
+	})
 }
 
 func filterMarketMapProvidersFromOracleConfig(cfg config.OracleConfig, mmProvider string) config.OracleConfig {
@@ -311,3 +367,32 @@ func filterMarketMapProvidersFromOracleConfig(cfg config.OracleConfig, mmProvide
 
 	return cfg
 }
+
+func TestCheckMarketConfigAge(t *testing.T) {
+	tmpfile, err := os.CreateTemp("", "slinky-market-config-*.json")
+	require.NoError(t, err)
+	defer os.Remove(tmpfile.Name())
+
+	modTime := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(tmpfile.Name(), modTime, modTime))
+
+	t.Run("disabled check ignores stale file", func(t *testing.T) {
+		require.NoError(t, config.CheckMarketConfigAge(tmpfile.Name(), 0, time.Now()))
+	})
+
+	t.Run("file within max age", func(t *testing.T) {
+		require.NoError(t, config.CheckMarketConfigAge(tmpfile.Name(), 2*time.Hour, time.Now()))
+	})
+
+	t.Run("file older than max age", func(t *testing.T) {
+		require.Error(t, config.CheckMarketConfigAge(tmpfile.Name(), time.Minute, time.Now()))
+	})
+
+	t.Run("negative max age", func(t *testing.T) {
+		require.Error(t, config.CheckMarketConfigAge(tmpfile.Name(), -time.Minute, time.Now()))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		require.Error(t, config.CheckMarketConfigAge("does-not-exist.json", time.Minute, time.Now()))
+	})
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// ParseProviderEndpoints parses the given provider endpoint overwrites, each formatted as name=url, into the
+// urls of each provider in the order in which they are given. A provider may be given several times, in which
+// case all of its urls are returned.
+func ParseProviderEndpoints(overwrites []string) (map[string][]string, error) {
+	endpoints := make(map[string][]string)
+	for _, overwrite := range overwrites {
+		name, url, ok := strings.Cut(overwrite, "=")
+		if !ok || name == "" || url == "" {
+			return nil, fmt.Errorf("invalid provider endpoint %q; expected name=url", overwrite)
+		}
+
+		endpoints[name] = append(endpoints[name], url)
+	}
+
+	return endpoints, nil
+}
+
+// OverwriteProviderEndpoints overwrites the endpoints of the given providers with the given urls, as returned
+// by ParseProviderEndpoints. The websocket endpoint of websocket providers and the API URL of API providers
+// are replaced by a single url. The endpoints of API providers that query a list of endpoints, e.g. the
+// RPC endpoints of the DeFi providers, are replaced by the given urls; the replaced endpoints' authentication
+// is not carried over to the new urls. Every unknown provider is reported in a single error.
+func OverwriteProviderEndpoints(cfg config.OracleConfig, endpoints map[string][]string) (config.OracleConfig, error) {
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var unknown []string
+	for _, name := range names {
+		if providerIndex(cfg, name) < 0 {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return cfg, fmt.Errorf(
+			"unknown providers: %s; valid providers are: %s",
+			strings.Join(unknown, ", "),
+			strings.Join(providerNames(cfg), ", "),
+		)
+	}
+
+	for _, name := range names {
+		urls := endpoints[name]
+
+		i := providerIndex(cfg, name)
+		provider := cfg.Providers[i]
+		switch {
+		case provider.WebSocket.Enabled:
+			if len(urls) > 1 {
+				return cfg, fmt.Errorf("websocket provider %s accepts a single endpoint; got %d", name, len(urls))
+			}
+			provider.WebSocket.WSS = urls[0]
+		case provider.API.Enabled && len(provider.API.Endpoints) > 0:
+			provider.API.Endpoints = make([]config.Endpoint, len(urls))
+			for j, url := range urls {
+				provider.API.Endpoints[j] = config.Endpoint{URL: url}
+			}
+		case provider.API.Enabled:
+			if len(urls) > 1 {
+				return cfg, fmt.Errorf("API provider %s accepts a single endpoint; got %d", name, len(urls))
+			}
+			provider.API.URL = urls[0]
+		default:
+			return cfg, fmt.Errorf("provider %s has neither an API nor a websocket enabled", name)
+		}
+		cfg.Providers[i] = provider
+	}
+
+	return cfg, cfg.ValidateBasic()
+}
+
+// providerIndex returns the index of the provider with the given name in the config, or -1 if it is not found.
+func providerIndex(cfg config.OracleConfig, name string) int {
+	for i, provider := range cfg.Providers {
+		if provider.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// providerNames returns the sorted names of the providers in the config.
+func providerNames(cfg config.OracleConfig) []string {
+	names := make([]string, len(cfg.Providers))
+	for i, provider := range cfg.Providers {
+		names[i] = provider.Name
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,159 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/cmd/slinky/config"
+	oracleconfig "github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/apis/defi/raydium"
+	coinbasews "github.com/skip-mev/slinky/providers/websockets/coinbase"
+)
+
+func TestParseProviderEndpoints(t *testing.T) {
+	tcs := []struct {
+		name       string
+		overwrites []string
+		expected   map[string][]string
+		expectErr  bool
+	}{
+		{
+			name:     "no overwrites",
+			expected: map[string][]string{},
+		},
+		{
+			name:       "single overwrite",
+			overwrites: []string{"coinbase_api=https://localhost:8080"},
+			expected:   map[string][]string{"coinbase_api": {"https://localhost:8080"}},
+		},
+		{
+			name:       "urls may contain =",
+			overwrites: []string{"coinbase_api=https://localhost:8080?key=value"},
+			expected:   map[string][]string{"coinbase_api": {"https://localhost:8080?key=value"}},
+		},
+		{
+			name: "repeated providers keep the order of their urls",
+			overwrites: []string{
+				"raydium_api=https://rpc-1",
+				"coinbase_ws=wss://localhost:8080",
+				"raydium_api=https://rpc-2",
+			},
+			expected: map[string][]string{
+				"raydium_api": {"https://rpc-1", "https://rpc-2"},
+				"coinbase_ws": {"wss://localhost:8080"},
+			},
+		},
+		{
+			name:       "missing separator",
+			overwrites: []string{"coinbase_api"},
+			expectErr:  true,
+		},
+		{
+			name:       "missing name",
+			overwrites: []string{"=https://localhost:8080"},
+			expectErr:  true,
+		},
+		{
+			name:       "missing url",
+			overwrites: []string{"coinbase_api="},
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoints, err := config.ParseProviderEndpoints(tc.overwrites)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, endpoints)
+		})
+	}
+}
+
+func TestOverwriteProviderEndpoints(t *testing.T) {
+	// defaultConfig returns the legacy default oracle config, which includes every provider.
+	defaultConfig := func() oracleconfig.OracleConfig {
+		cfg := config.DefaultOracleConfig()
+		return cfg.ToLegacy()
+	}
+
+	// provider returns the provider with the given name in the config.
+	provider := func(t *testing.T, cfg oracleconfig.OracleConfig, name string) oracleconfig.ProviderConfig {
+		t.Helper()
+
+		for _, provider := range cfg.Providers {
+			if provider.Name == name {
+				return provider
+			}
+		}
+
+		require.FailNow(t, "provider not found", name)
+		return oracleconfig.ProviderConfig{}
+	}
+
+	t.Run("overwrites the websocket endpoint of a websocket provider", func(t *testing.T) {
+		cfg, err := config.OverwriteProviderEndpoints(
+			defaultConfig(),
+			map[string][]string{coinbasews.Name: {"wss://localhost:8080"}},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "wss://localhost:8080", provider(t, cfg, coinbasews.Name).WebSocket.WSS)
+	})
+
+	t.Run("overwrites the url of an API provider", func(t *testing.T) {
+		cfg, err := config.OverwriteProviderEndpoints(
+			defaultConfig(),
+			map[string][]string{coinbase.Name: {"https://localhost:8080"}},
+		)
+		require.NoError(t, err)
+		require.Equal(t, "https://localhost:8080", provider(t, cfg, coinbase.Name).API.URL)
+	})
+
+	t.Run("overwrites the endpoints of an API provider that queries endpoints", func(t *testing.T) {
+		cfg, err := config.OverwriteProviderEndpoints(
+			defaultConfig(),
+			map[string][]string{raydium.Name: {"https://rpc-1", "https://rpc-2"}},
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]oracleconfig.Endpoint{{URL: "https://rpc-1"}, {URL: "https://rpc-2"}},
+			provider(t, cfg, raydium.Name).API.Endpoints,
+		)
+	})
+
+	t.Run("rejects several endpoints for a provider with a single url", func(t *testing.T) {
+		_, err := config.OverwriteProviderEndpoints(
+			defaultConfig(),
+			map[string][]string{coinbase.Name: {"https://localhost:8080", "https://localhost:8081"}},
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects an unknown provider", func(t *testing.T) {
+		_, err := config.OverwriteProviderEndpoints(
+			defaultConfig(),
+			map[string][]string{"unknown": {"https://localhost:8080"}},
+		)
+		require.ErrorContains(t, err, "unknown providers: unknown;")
+		require.ErrorContains(t, err, coinbase.Name)
+	})
+
+	t.Run("reports every unknown provider", func(t *testing.T) {
+		_, err := config.OverwriteProviderEndpoints(
+			defaultConfig(),
+			map[string][]string{
+				"unknown_b":   {"https://localhost:8080"},
+				coinbase.Name: {"https://localhost:8080"},
+				"unknown_a":   {"https://localhost:8081"},
+			},
+		)
+		require.ErrorContains(t, err, "unknown providers: unknown_a, unknown_b;")
+	})
+}
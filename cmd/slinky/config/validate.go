@@ -0,0 +1,144 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	slinkyjson "github.com/skip-mev/slinky/pkg/json"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// ReadMarketConfig reads the market config at the given path, canonicalizing its markets as
+// mmtypes.ReadMarketMapFromFile does, but without validating them, so that every invalid market
+// can be reported by ValidateMarketConfig.
+func ReadMarketConfig(path string) (mmtypes.MarketMap, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return mmtypes.MarketMap{}, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	var marketMap mmtypes.MarketMap
+	if err := json.Unmarshal(slinkyjson.Sanitize(bz), &marketMap); err != nil {
+		return mmtypes.MarketMap{}, fmt.Errorf("error unmarshalling config JSON: %w", err)
+	}
+
+	marketMap, err = marketMap.Canonicalize()
+	if err != nil {
+		return mmtypes.MarketMap{}, fmt.Errorf("error canonicalizing config: %w", err)
+	}
+
+	return marketMap, nil
+}
+
+// ValidateMarketConfig validates every market of the given market config, and returns each problem
+// found, ordered by market. Unlike mmtypes.MarketMap.ValidateBasic, validation continues past the first
+// invalid market. If an oracle config is given, the provider configs of each enabled market are also
+// checked against the providers of the oracle. This includes provider configs of providers that are not
+// configured in the oracle, which the oracle skips at runtime rather than failing on.
+func ValidateMarketConfig(marketMap mmtypes.MarketMap, cfg *config.OracleConfig) []error {
+	tickers := make([]string, 0, len(marketMap.Markets))
+	for ticker := range marketMap.Markets {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	var problems []error
+	for _, ticker := range tickers {
+		market := marketMap.Markets[ticker]
+		for _, err := range validateMarket(marketMap, ticker, market, cfg) {
+			problems = append(problems, fmt.Errorf("market %s: %w", ticker, err))
+		}
+	}
+
+	return problems
+}
+
+// validateMarket returns each problem of the given market of the market config.
+func validateMarket(
+	marketMap mmtypes.MarketMap,
+	ticker string,
+	market mmtypes.Market,
+	cfg *config.OracleConfig,
+) []error {
+	var problems []error
+	if err := market.ValidateBasic(); err != nil {
+		problems = append(problems, err)
+	}
+
+	if ticker != market.Ticker.String() {
+		problems = append(problems, fmt.Errorf("market map key does not match its ticker %s", market.Ticker.String()))
+	}
+
+	var providers map[string]struct{}
+	if cfg != nil {
+		providers = make(map[string]struct{}, len(cfg.Providers))
+		for _, provider := range cfg.Providers {
+			providers[provider.Name] = struct{}{}
+		}
+	}
+
+	supported := false
+	for _, providerCfg := range market.ProviderConfigs {
+		if providerCfg.NormalizeByPair != nil {
+			normalizeMarket, found := marketMap.Markets[providerCfg.NormalizeByPair.String()]
+			switch {
+			case !found:
+				problems = append(problems, fmt.Errorf(
+					"provider %s normalizes by %s, which was not found in the market config",
+					providerCfg.Name, providerCfg.NormalizeByPair.String(),
+				))
+			case !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled:
+				problems = append(problems, fmt.Errorf(
+					"provider %s normalizes by %s, which is disabled",
+					providerCfg.Name, providerCfg.NormalizeByPair.String(),
+				))
+			}
+		}
+
+		if providers == nil || !market.Ticker.Enabled {
+			continue
+		}
+
+		if _, ok := providers[providerCfg.Name]; ok {
+			supported = true
+		} else {
+			problems = append(problems, fmt.Errorf("provider %s is not configured in the oracle config", providerCfg.Name))
+		}
+	}
+
+	if cfg == nil {
+		return problems
+	}
+
+	if market.Ticker.Enabled && !supported && cfg.RejectMarketsWithoutProviders {
+		problems = append(problems, fmt.Errorf("enabled market does not have any provider configured in the oracle config"))
+	}
+
+	for _, required := range cfg.RequiredProviders {
+		if required.CurrencyPair != ticker {
+			continue
+		}
+
+		for _, provider := range required.Providers {
+			if !hasProviderConfig(market, provider) {
+				problems = append(problems, fmt.Errorf("required provider %s is not configured", provider))
+			}
+		}
+	}
+
+	return problems
+}
+
+// hasProviderConfig returns true if the given market is configured with the given provider.
+func hasProviderConfig(market mmtypes.Market, provider string) bool {
+	for _, providerCfg := range market.ProviderConfigs {
+		if providerCfg.Name == provider {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/cmd/slinky/config"
+	oracleconfig "github.com/skip-mev/slinky/oracle/config"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestValidateMarketConfig(t *testing.T) {
+	btcUSD := slinkytypes.NewCurrencyPair("BTC", "USD")
+	usdtUSD := slinkytypes.NewCurrencyPair("USDT", "USD")
+
+	newMarketMap := func() mmtypes.MarketMap {
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcUSD.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     btcUSD,
+						Decimals:         8,
+						MinProviderCount: 1,
+						Enabled:          true,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: "coinbase_api", OffChainTicker: "BTC-USD"},
+						{
+							Name:            "binance_api",
+							OffChainTicker:  "BTCUSDT",
+							NormalizeByPair: &usdtUSD,
+						},
+					},
+				},
+				usdtUSD.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     usdtUSD,
+						Decimals:         6,
+						MinProviderCount: 1,
+						Enabled:          true,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: "coinbase_api", OffChainTicker: "USDT-USD"},
+					},
+				},
+			},
+		}
+	}
+
+	oracleCfg := &oracleconfig.OracleConfig{
+		Providers: []oracleconfig.ProviderConfig{
+			{Name: "coinbase_api"},
+			{Name: "binance_api"},
+		},
+	}
+
+	t.Run("valid market config", func(t *testing.T) {
+		require.Empty(t, config.ValidateMarketConfig(newMarketMap(), oracleCfg))
+		require.Empty(t, config.ValidateMarketConfig(newMarketMap(), nil))
+	})
+
+	t.Run("reports every invalid market", func(t *testing.T) {
+		marketMap := newMarketMap()
+
+		btc := marketMap.Markets[btcUSD.String()]
+		btc.Ticker.Decimals = 0
+		marketMap.Markets[btcUSD.String()] = btc
+
+		usdt := marketMap.Markets[usdtUSD.String()]
+		usdt.Ticker.MinProviderCount = 2
+		marketMap.Markets[usdtUSD.String()] = usdt
+
+		problems := config.ValidateMarketConfig(marketMap, oracleCfg)
+		require.Len(t, problems, 2)
+		require.ErrorContains(t, problems[0], "market BTC/USD: decimals must be between")
+		require.ErrorContains(t, problems[1], "market USDT/USD: this ticker must have at least 2 providers")
+	})
+
+	t.Run("reports disabled normalization markets", func(t *testing.T) {
+		marketMap := newMarketMap()
+
+		usdt := marketMap.Markets[usdtUSD.String()]
+		usdt.Ticker.Enabled = false
+		marketMap.Markets[usdtUSD.String()] = usdt
+
+		problems := config.ValidateMarketConfig(marketMap, nil)
+		require.Len(t, problems, 1)
+		require.ErrorContains(t, problems[0], "market BTC/USD: provider binance_api normalizes by USDT/USD, which is disabled")
+	})
+
+	t.Run("reports providers that are not configured in the oracle", func(t *testing.T) {
+		cfg := &oracleconfig.OracleConfig{
+			Providers: []oracleconfig.ProviderConfig{
+				{Name: "coinbase_api"},
+			},
+		}
+
+		problems := config.ValidateMarketConfig(newMarketMap(), cfg)
+		require.Len(t, problems, 1)
+		require.ErrorContains(t, problems[0], "market BTC/USD: provider binance_api is not configured in the oracle config")
+
+		// disabled markets are not checked against the providers of the oracle
+		marketMap := newMarketMap()
+		btc := marketMap.Markets[btcUSD.String()]
+		btc.Ticker.Enabled = false
+		marketMap.Markets[btcUSD.String()] = btc
+		require.Empty(t, config.ValidateMarketConfig(marketMap, cfg))
+	})
+
+	t.Run("reports markets without providers if rejected by the oracle", func(t *testing.T) {
+		cfg := &oracleconfig.OracleConfig{
+			Providers: []oracleconfig.ProviderConfig{
+				{Name: "binance_api"},
+			},
+			RejectMarketsWithoutProviders: true,
+		}
+
+		problems := config.ValidateMarketConfig(newMarketMap(), cfg)
+		require.Len(t, problems, 3)
+		require.ErrorContains(t, problems[2], "market USDT/USD: enabled market does not have any provider configured")
+	})
+
+	t.Run("reports missing required providers", func(t *testing.T) {
+		cfg := &oracleconfig.OracleConfig{
+			Providers: oracleCfg.Providers,
+			RequiredProviders: []oracleconfig.RequiredProvidersConfig{
+				{CurrencyPair: usdtUSD.String(), Providers: []string{"coinbase_api", "binance_api"}},
+			},
+		}
+
+		problems := config.ValidateMarketConfig(newMarketMap(), cfg)
+		require.Len(t, problems, 1)
+		require.ErrorContains(t, problems[0], "market USDT/USD: required provider binance_api is not configured")
+	})
+}
+
+func TestReadMarketConfig(t *testing.T) {
+	t.Run("reads invalid markets without validating them", func(t *testing.T) {
+		tmpfile, err := os.CreateTemp("", "slinky-market-config-*.json")
+		require.NoError(t, err)
+		defer os.Remove(tmpfile.Name())
+
+		_, err = tmpfile.WriteString(`{"markets": {"btc/usd": {"ticker": {"currency_pair": {"Base": "btc", "Quote": "usd"}, "decimals": 0}}}}`)
+		require.NoError(t, err)
+		require.NoError(t, tmpfile.Close())
+
+		marketMap, err := config.ReadMarketConfig(tmpfile.Name())
+		require.NoError(t, err)
+		require.Contains(t, marketMap.Markets, "BTC/USD")
+		require.NotEmpty(t, config.ValidateMarketConfig(marketMap, nil))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := config.ReadMarketConfig("does-not-exist.json")
+		require.Error(t, err)
+	})
+}
@@ -3,30 +3,37 @@ package main
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/skip-mev/slinky/providers/apis/marketmap"
 
-	_ "net/http/pprof" //nolint: gosec
-
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	cmdconfig "github.com/skip-mev/slinky/cmd/slinky/config"
 	"github.com/skip-mev/slinky/oracle"
 	"github.com/skip-mev/slinky/oracle/config"
 
 	"github.com/skip-mev/slinky/cmd/build"
+	"github.com/skip-mev/slinky/oracle/alerts"
 	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/oracle/orchestrator"
+	"github.com/skip-mev/slinky/oracle/reference"
 	"github.com/skip-mev/slinky/pkg/log"
 	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/pkg/tracing"
 	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
+	mmclient "github.com/skip-mev/slinky/service/clients/marketmap"
 	mmservicetypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
 	oracleserver "github.com/skip-mev/slinky/service/servers/oracle"
+	oracletypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+	pprofserver "github.com/skip-mev/slinky/service/servers/pprof"
 	promserver "github.com/skip-mev/slinky/service/servers/prometheus"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
@@ -52,15 +59,24 @@ var (
 
 	oracleCfgPath       string
 	legacyOracleCfgPath string
+	configMode          string
 	marketCfgPath       string
 	marketMapProvider   string
 	updateMarketCfgPath string
 	runPprof            bool
 	profilePort         string
+	pprofLoopback       bool
+	pprofReadTimeout    time.Duration
+	pprofWriteTimeout   time.Duration
+	pprofIdleTimeout    time.Duration
+	pprofMaxConns       int
 	logLevel            string
 	fileLogLevel        string
 	writeLogsTo         string
 	marketMapEndPoint   string
+	providerEndpoints   []string
+	marketCfgMaxAge     time.Duration
+	marketCfgAgeStrict  bool
 	maxLogSize          int
 	maxBackups          int
 	maxAge              int
@@ -70,6 +86,15 @@ var (
 
 const (
 	DefaultLegacyConfigPath = "./oracle.json"
+
+	// ConfigModeAuto selects the legacy or modern oracle config based on the flags provided and
+	// the presence of a legacy config at DefaultLegacyConfigPath.
+	ConfigModeAuto = "auto"
+	// ConfigModeLegacy forces the use of a legacy oracle config.
+	ConfigModeLegacy = "legacy"
+	// ConfigModeModern forces the use of a modern oracle config i.e. the default config with
+	// overrides, ignoring any legacy config at DefaultLegacyConfigPath.
+	ConfigModeModern = "modern"
 )
 
 func init() {
@@ -94,6 +119,13 @@ func init() {
 		"",
 		"Path to the oracle config file.",
 	)
+	rootCmd.Flags().StringVarP(
+		&configMode,
+		"config-mode",
+		"",
+		ConfigModeAuto,
+		"How the oracle config is selected (auto, legacy, modern). auto uses --oracle-config if set, then --oracle-config-path, then a legacy oracle.json in the working directory. legacy and modern force a legacy or modern config, and fail if the expected config is not present.",
+	)
 	rootCmd.Flags().StringVarP(
 		&marketCfgPath,
 		"market-config-path",
@@ -122,12 +154,47 @@ func init() {
 		"6060",
 		"Port for the pprof server to listen on.",
 	)
+	rootCmd.Flags().BoolVarP(
+		&pprofLoopback,
+		"pprof-loopback-only",
+		"",
+		false,
+		"Bind the pprof server to the loopback interface instead of the oracle host.",
+	)
+	rootCmd.Flags().DurationVarP(
+		&pprofReadTimeout,
+		"pprof-read-timeout",
+		"",
+		pprofserver.DefaultReadTimeout,
+		"Maximum duration for the pprof server to read an entire request.",
+	)
+	rootCmd.Flags().DurationVarP(
+		&pprofWriteTimeout,
+		"pprof-write-timeout",
+		"",
+		pprofserver.DefaultWriteTimeout,
+		"Maximum duration for the pprof server to write a response. Must exceed the duration of the profiles collected.",
+	)
+	rootCmd.Flags().DurationVarP(
+		&pprofIdleTimeout,
+		"pprof-idle-timeout",
+		"",
+		pprofserver.DefaultIdleTimeout,
+		"Maximum duration for the pprof server to wait for the next request on a keep-alive connection.",
+	)
+	rootCmd.Flags().IntVarP(
+		&pprofMaxConns,
+		"pprof-max-connections",
+		"",
+		pprofserver.DefaultMaxConnections,
+		"Maximum number of concurrent connections to the pprof server. A value of 0 disables the limit.",
+	)
 	rootCmd.Flags().StringVarP(
 		&logLevel,
 		"log-std-out-level",
 		"",
 		"info",
-		"Log level (debug, info, warn, error, dpanic, panic, fatal).",
+		"Log level (debug, info, warn, error, dpanic, panic, fatal). Sending SIGUSR1 to the process toggles debug logging at runtime.",
 	)
 	rootCmd.Flags().StringVarP(
 		&fileLogLevel,
@@ -185,6 +252,27 @@ func init() {
 		"",
 		"Use a custom listen-to endpoint for market-map (overwrites what is provided in oracle-config).",
 	)
+	rootCmd.Flags().StringArrayVarP(
+		&providerEndpoints,
+		"provider-endpoint",
+		"",
+		nil,
+		"Use a custom endpoint for a provider, as name=url (overwrites the API URL, API endpoints or websocket endpoint provided in oracle-config). Can be repeated to provide several API endpoints.",
+	)
+	rootCmd.Flags().DurationVarP(
+		&marketCfgMaxAge,
+		"market-config-max-age",
+		"",
+		0,
+		"Maximum age of the market config file, based on its modification time, checked when the file is read on startup. A value of 0 disables the check.",
+	)
+	rootCmd.Flags().BoolVarP(
+		&marketCfgAgeStrict,
+		"market-config-max-age-strict",
+		"",
+		false,
+		"Refuse to start if the market config file is older than --market-config-max-age, instead of logging a warning.",
+	)
 	rootCmd.MarkFlagsMutuallyExclusive("update-market-config-path", "market-config-path")
 	rootCmd.MarkFlagsMutuallyExclusive("market-map-endpoint", "market-config-path")
 
@@ -193,7 +281,9 @@ func init() {
 
 // start the oracle-grpc server + oracle process, cancel on interrupt or terminate.
 func main() {
-	rootCmd.Execute()
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
 }
 
 func runOracle() error {
@@ -219,19 +309,50 @@ func runOracle() error {
 	logCfg.Compress = !disableCompressLogs
 
 	// Build logger.
-	logger := log.NewLogger(logCfg)
+	logger, logLevels := log.NewLoggerWithLevels(logCfg)
 	defer logger.Sync()
 
+	// toggle debug logging on SIGUSR1 so that operators can gather debug logs without a restart
+	levelSigs := make(chan os.Signal, 1)
+	signal.Notify(levelSigs, syscall.SIGUSR1)
+	defer signal.Stop(levelSigs)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-levelSigs:
+				debug := logLevels.ToggleDebug()
+				logger.Info(
+					"received SIGUSR1; toggled debug logging",
+					zap.Bool("debug", debug),
+					zap.String("std_out_level", logLevels.StdOut.String()),
+					zap.String("file_level", logLevels.FileOut.String()),
+				)
+			}
+		}
+	}()
+
 	var cfg config.OracleConfig
 	var err error
 
-	if legacyPath, legacyConfigInUse := useLegacyOracleConfig(logger); legacyConfigInUse {
-		cfg, err = cmdconfig.GetLegacyOracleConfig(legacyPath)
+	cfgPath, legacyConfigInUse, err := selectOracleConfig(logger)
+	if err != nil {
+		return fmt.Errorf("failed to select oracle config: %w", err)
+	}
+
+	if legacyConfigInUse {
+		cfg, err = cmdconfig.GetLegacyOracleConfig(cfgPath)
 		if err != nil {
 			return fmt.Errorf("failed to read legacy oracle config file: %w", err)
 		}
+	} else if cfgPath == "" {
+		cfg, err = cmdconfig.ReadOracleConfigFromEnv(marketMapProvider)
+		if err != nil {
+			return fmt.Errorf("failed to get oracle config from environment: %w", err)
+		}
 	} else {
-		cfg, err = cmdconfig.ReadOracleConfigWithOverrides(oracleCfgPath, marketMapProvider)
+		cfg, err = cmdconfig.ReadOracleConfigWithOverrides(cfgPath, marketMapProvider)
 		if err != nil {
 			return fmt.Errorf("failed to get oracle config: %w", err)
 		}
@@ -245,8 +366,28 @@ func runOracle() error {
 		}
 	}
 
+	if len(providerEndpoints) > 0 {
+		endpoints, err := cmdconfig.ParseProviderEndpoints(providerEndpoints)
+		if err != nil {
+			return fmt.Errorf("failed to parse provider endpoints: %w", err)
+		}
+
+		cfg, err = cmdconfig.OverwriteProviderEndpoints(cfg, endpoints)
+		if err != nil {
+			return fmt.Errorf("failed to overwrite provider endpoints: %w", err)
+		}
+	}
+
 	var marketCfg mmtypes.MarketMap
 	if marketCfgPath != "" {
+		if err := cmdconfig.CheckMarketConfigAge(marketCfgPath, marketCfgMaxAge, time.Now()); err != nil {
+			if marketCfgAgeStrict {
+				return fmt.Errorf("stale market config file: %w", err)
+			}
+
+			logger.Warn("market config file may be stale", zap.Error(err))
+		}
+
 		marketCfg, err = mmtypes.ReadMarketMapFromFile(marketCfgPath)
 		if err != nil {
 			return fmt.Errorf("failed to read market config file: %w", err)
@@ -260,10 +401,41 @@ func runOracle() error {
 	)
 
 	metrics := oraclemetrics.NewMetricsFromConfig(cfg.Metrics)
+	if cfg.Tracing.Enabled {
+		logger.Info("exporting traces", zap.String("endpoint", cfg.Tracing.Endpoint))
+		tracerProvider, err := tracing.NewTracerProvider(cfg.Tracing)
+		if err != nil {
+			return fmt.Errorf("failed to create tracer provider: %w", err)
+		}
+
+		// flush any buffered spans on shut-down
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), tracing.DefaultExportTimeout)
+			defer cancel()
+
+			if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+				logger.Error("failed to shut down tracer provider", zap.Error(err))
+			}
+		}()
+	}
+
 	aggregator, err := oraclemath.NewIndexPriceAggregator(
 		logger,
 		marketCfg,
 		metrics,
+		oraclemath.WithNoDataGracePeriod(cfg.NoDataGracePeriod),
+		oraclemath.WithStablecoinDepegConfig(cfg.StablecoinDepeg),
+		oraclemath.WithLastGoodConfig(cfg.LastGood),
+		oraclemath.WithAggregationFallbackConfig(cfg.AggregationFallback),
+		oraclemath.WithAggregationWorkers(cfg.AggregationWorkers),
+		oraclemath.WithProviderLagConfig(cfg.ProviderLag),
+		oraclemath.WithProviderCollapseConfig(cfg.ProviderCollapse),
+		oraclemath.WithDecimalDisagreementConfig(cfg.DecimalDisagreement),
+		oraclemath.WithProviderSpread(cfg.ProviderSpread),
+		oraclemath.WithAggregationAudit(cfg.AggregationAudit),
+		oraclemath.WithMinSignificantDigits(cfg.MinSignificantDigits),
+		oraclemath.WithProviderPriority(cfg.ProviderPriority),
+		oraclemath.WithProviderQuoteCurrencies(oraclefactory.ProviderQuoteCurrencies()),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create data aggregator: %w", err)
@@ -277,16 +449,63 @@ func runOracle() error {
 		orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory), // Replace with custom websocket query handler factory.
 		orchestrator.WithMarketMapperFactory(oraclefactory.MarketMapProviderFactory),
 		orchestrator.WithAggregator(aggregator),
+		orchestrator.WithMetrics(metrics),
 	}
 	if updateMarketCfgPath != "" {
 		orchestratorOpts = append(orchestratorOpts, orchestrator.WithWriteTo(updateMarketCfgPath))
 	}
+	if streamer, err := marketMapStreamer(logger, cfg); err != nil {
+		return fmt.Errorf("failed to create market map stream client: %w", err)
+	} else if streamer != nil {
+		orchestratorOpts = append(orchestratorOpts, orchestrator.WithMarketMapStreamer(streamer))
+	}
 	oracleOpts := []oracle.Option{
 		oracle.WithLogger(logger),
 		oracle.WithUpdateInterval(cfg.UpdateInterval),
+		oracle.WithAggregationInterval(cfg.AggregationInterval),
 		oracle.WithMetrics(metrics),
 		oracle.WithMaxCacheAge(cfg.MaxPriceAge),
 		oracle.WithPriceAggregator(aggregator),
+		oracle.WithRefreshConfig(cfg.Refresh),
+	}
+	if cfg.PriceSnapshotPath != "" {
+		store, err := oracle.NewFilePriceStore(cfg.PriceSnapshotPath)
+		if err != nil {
+			return fmt.Errorf("failed to create price store: %w", err)
+		}
+
+		oracleOpts = append(oracleOpts, oracle.WithPriceStore(store))
+	}
+	if cfg.PriceExportPath != "" {
+		exporter, err := oracle.NewFilePriceExporter(logger, cfg.PriceExportPath)
+		if err != nil {
+			return fmt.Errorf("failed to create price exporter: %w", err)
+		}
+
+		oracleOpts = append(oracleOpts, oracle.WithPriceExporter(exporter))
+	}
+	if cfg.DeviationAlerts.Enabled {
+		alerter, err := alerts.NewWebhookAlerter(logger, cfg.DeviationAlerts)
+		if err != nil {
+			return fmt.Errorf("failed to create deviation alerter: %w", err)
+		}
+
+		oracleOpts = append(oracleOpts, oracle.WithPriceObserver(alerter))
+	}
+	if cfg.ReferenceOracle.Enabled {
+		conn, err := grpc.NewClient(cfg.ReferenceOracle.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("failed to connect to reference oracle: %w", err)
+		}
+		defer conn.Close()
+
+		checker, err := reference.NewChecker(logger, cfg.ReferenceOracle, oracletypes.NewOracleClient(conn), metrics)
+		if err != nil {
+			return fmt.Errorf("failed to create reference oracle checker: %w", err)
+		}
+
+		go checker.Start(ctx) //nolint: errcheck
+		oracleOpts = append(oracleOpts, oracle.WithPriceGuard(checker))
 	}
 
 	// Create the orchestrator and start the orchestrator.
@@ -303,26 +522,59 @@ func runOracle() error {
 	}
 	defer orch.Stop()
 
-	// Create the oracle and start the oracle server.
-	oracleOpts = append(oracleOpts, oracle.WithProviders(orch.GetPriceProviders()))
+	// Create the oracle and start the oracle server. The broadcaster pushes the prices of each
+	// aggregation cycle to the price streams of the server.
+	broadcaster := oracleserver.NewPriceBroadcaster()
+	oracleOpts = append(oracleOpts,
+		oracle.WithProviders(orch.GetPriceProviders()),
+		oracle.WithPriceObserver(broadcaster),
+	)
 	orc, err := oracle.New(oracleOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to create oracle: %w", err)
 	}
-	srv := oracleserver.NewOracleServer(orc, logger)
+	serverOpts := []oracleserver.Option{
+		oracleserver.WithMaxConnections(cfg.MaxConnections),
+		oracleserver.WithStartupJitter(cfg.StartupJitter),
+		oracleserver.WithMetrics(metrics),
+		oracleserver.WithConfig(cfg),
+		oracleserver.WithPriceBroadcaster(broadcaster),
+	}
+	if cfg.ProviderKillSwitch {
+		serverOpts = append(serverOpts, oracleserver.WithProviderKillSwitch(orch, cfg.ProviderKillSwitchToken))
+	}
+	if cfg.AggregationAudit {
+		serverOpts = append(serverOpts, oracleserver.WithAggregationAuditor(aggregator))
+	}
+	srv := oracleserver.NewOracleServer(orc, logger, serverOpts...)
 
-	// cancel oracle on interrupt or terminate
+	// drain the server, then cancel oracle on interrupt or terminate
 	go func() {
 		<-sigs
-		logger.Info("received interrupt or terminate signal; closing oracle")
+		logger.Info("received interrupt or terminate signal; draining oracle server")
 
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), oracleserver.DefaultGracefulStopTimeout)
+		defer drainCancel()
+		if err := srv.GracefulStop(drainCtx); err != nil {
+			logger.Warn("failed to drain oracle server", zap.Error(err))
+		}
+
+		logger.Info("closing oracle")
 		cancel()
 	}()
 
 	// start prometheus metrics
 	if cfg.Metrics.Enabled {
 		logger.Info("starting prometheus metrics", zap.String("address", cfg.Metrics.PrometheusServerAddress))
-		ps, err := promserver.NewPrometheusServer(cfg.Metrics.PrometheusServerAddress, logger)
+		var promOpts []promserver.Option
+		if cfg.Metrics.TLS.Enabled() {
+			promOpts = append(promOpts, promserver.WithTLS(cfg.Metrics.TLS.CertFile, cfg.Metrics.TLS.KeyFile))
+		}
+		if cfg.Metrics.BasicAuth.Enabled() {
+			promOpts = append(promOpts, promserver.WithBasicAuth(cfg.Metrics.BasicAuth.Username, cfg.Metrics.BasicAuth.Password))
+		}
+
+		ps, err := promserver.NewPrometheusServer(cfg.Metrics.PrometheusServerAddress, logger, promOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to start prometheus metrics: %w", err)
 		}
@@ -338,23 +590,110 @@ func runOracle() error {
 	}
 
 	if runPprof {
-		endpoint := fmt.Sprintf("%s:%s", cfg.Host, profilePort)
-		// Start pprof server
+		ps, err := newPprofServer(logger, cfg.Host)
+		if err != nil {
+			return fmt.Errorf("failed to start pprof server: %w", err)
+		}
+
+		go ps.Start()
+
+		// close server on shut-down
 		go func() {
-			logger.Info("Starting pprof server", zap.String("endpoint", endpoint))
-			if err := http.ListenAndServe(endpoint, nil); err != nil { //nolint: gosec
-				logger.Error("pprof server failed", zap.Error(err))
-			}
+			<-ctx.Done()
+			logger.Info("stopping pprof server")
+			ps.Close()
 		}()
 	}
 
 	// start oracle + server, and wait for either to finish
-	if err := srv.StartServer(ctx, cfg.Host, cfg.Port); err != nil {
+	addrs := append([]string{fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)}, cfg.ListenAddresses...)
+	if err := srv.StartServerOnAddresses(ctx, addrs...); err != nil {
 		logger.Error("stopping server", zap.Error(err))
 	}
 	return nil
 }
 
+// newPprofServer returns the pprof server configured by the pprof flags. The server listens on the
+// given host, unless it is restricted to loopback.
+func newPprofServer(logger *zap.Logger, host string) (*pprofserver.PprofServer, error) {
+	if pprofReadTimeout <= 0 || pprofWriteTimeout <= 0 || pprofIdleTimeout <= 0 {
+		return nil, fmt.Errorf("pprof server timeouts must be positive")
+	}
+
+	if pprofMaxConns < 0 {
+		return nil, fmt.Errorf("pprof server max connections cannot be negative")
+	}
+
+	opts := []pprofserver.Option{
+		pprofserver.WithReadTimeout(pprofReadTimeout),
+		pprofserver.WithWriteTimeout(pprofWriteTimeout),
+		pprofserver.WithIdleTimeout(pprofIdleTimeout),
+		pprofserver.WithMaxConnections(pprofMaxConns),
+	}
+	if pprofLoopback {
+		opts = append(opts, pprofserver.WithLoopbackOnly())
+	}
+
+	return pprofserver.NewPprofServer(net.JoinHostPort(host, profilePort), logger, opts...)
+}
+
+// selectOracleConfig returns the path of the oracle config to use, and whether it is a legacy config,
+// according to the --config-mode flag. In auto mode, the config is selected by useLegacyOracleConfig. In
+// legacy mode, the config at --oracle-config-path, or at DefaultLegacyConfigPath if unset, is used and must
+// exist. In modern mode, the config at --oracle-config is used, and must exist if set, and any legacy config
+// is ignored.
+func selectOracleConfig(logger *zap.Logger) (string, bool, error) {
+	switch configMode {
+	case ConfigModeAuto:
+		path, legacy := useLegacyOracleConfig(logger)
+		return path, legacy, nil
+
+	case ConfigModeLegacy:
+		if oracleCfgPath != "" {
+			return "", false, fmt.Errorf("--oracle-config cannot be used with --config-mode %s", ConfigModeLegacy)
+		}
+
+		path := legacyOracleCfgPath
+		if path == "" {
+			path = DefaultLegacyConfigPath
+		}
+
+		if _, err := os.Stat(path); err != nil {
+			return "", false, fmt.Errorf("legacy oracle config %s is not present: %w", path, err)
+		}
+
+		logger.Info("using legacy oracle config", zap.String("path", path))
+		return path, true, nil
+
+	case ConfigModeModern:
+		if legacyOracleCfgPath != "" {
+			return "", false, fmt.Errorf("--oracle-config-path cannot be used with --config-mode %s", ConfigModeModern)
+		}
+
+		if oracleCfgPath != "" {
+			if _, err := os.Stat(oracleCfgPath); err != nil {
+				return "", false, fmt.Errorf("oracle config %s is not present: %w", oracleCfgPath, err)
+			}
+		}
+
+		if legacyOracleConfigExists() {
+			logger.Info(
+				"ignoring legacy oracle config in the working directory",
+				zap.String("path", DefaultLegacyConfigPath),
+				zap.String("config_mode", configMode),
+			)
+		}
+
+		return oracleCfgPath, false, nil
+
+	default:
+		return "", false, fmt.Errorf(
+			"unsupported config mode %s; expected one of %s, %s, %s",
+			configMode, ConfigModeAuto, ConfigModeLegacy, ConfigModeModern,
+		)
+	}
+}
+
 // useLegacyOracleConfig returns true if a legacy oracle config should be used
 // based on the provided flags.
 func useLegacyOracleConfig(logger *zap.Logger) (string, bool) {
@@ -387,6 +726,19 @@ func legacyOracleConfigExists() bool {
 	return !os.IsNotExist(err)
 }
 
+// marketMapStreamer returns a stream client for the x/marketmap market map provider, if one is
+// configured. Market map updates are streamed from the provider's endpoint, falling back to
+// polling if the endpoint does not support streaming.
+func marketMapStreamer(logger *zap.Logger, cfg config.OracleConfig) (orchestrator.MarketMapStreamer, error) {
+	for _, provider := range cfg.Providers {
+		if provider.Type == mmservicetypes.ConfigType && provider.Name == marketmap.Name {
+			return mmclient.NewStreamClient(logger, provider.API)
+		}
+	}
+
+	return nil, nil
+}
+
 func overwriteMarketMapEndpoint(cfg config.OracleConfig, overwrite string) (config.OracleConfig, error) {
 	for i, provider := range cfg.Providers {
 		if provider.Type == mmservicetypes.ConfigType {
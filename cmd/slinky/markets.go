@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	oracletypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+var (
+	marketsCmd = &cobra.Command{
+		Use:   "markets",
+		Short: "Tooling for inspecting the markets served by the slinky oracle.",
+	}
+
+	monitorCmd = &cobra.Command{
+		Use:   "monitor",
+		Short: "Continuously diff the markets served by the sidecar against the on-chain market map.",
+		Long: `Periodically fetch the on-chain market map from the x/marketmap module of --node and the markets
+served by the sidecar at --oracle, and report any discrepancies. A market is reported as missing if it is
+enabled on-chain but not served by the sidecar, and as unexpected if it is served by the sidecar but not
+enabled on-chain.
+
+The command exits with an error once a drift has persisted for longer than --threshold.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return monitorMarkets(cmd)
+		},
+	}
+
+	monitorNode      string
+	monitorOracle    string
+	monitorInterval  time.Duration
+	monitorThreshold time.Duration
+	monitorTimeout   time.Duration
+)
+
+func init() {
+	monitorCmd.Flags().StringVar(
+		&monitorNode,
+		"node",
+		"",
+		"gRPC address of the chain node to fetch the on-chain market map from.",
+	)
+	monitorCmd.Flags().StringVar(
+		&monitorOracle,
+		"oracle",
+		"localhost:8080",
+		"gRPC address of the sidecar to fetch the served markets from.",
+	)
+	monitorCmd.Flags().DurationVar(
+		&monitorInterval,
+		"interval",
+		30*time.Second,
+		"Interval at which the market maps are compared.",
+	)
+	monitorCmd.Flags().DurationVar(
+		&monitorThreshold,
+		"threshold",
+		5*time.Minute,
+		"Duration a drift may persist before the command exits with an error.",
+	)
+	monitorCmd.Flags().DurationVar(
+		&monitorTimeout,
+		"timeout",
+		5*time.Second,
+		"Timeout of each request to the node and the sidecar.",
+	)
+	monitorCmd.MarkFlagRequired("node") //nolint: errcheck
+
+	marketsCmd.AddCommand(monitorCmd)
+	rootCmd.AddCommand(marketsCmd)
+}
+
+// monitorMarkets periodically compares the on-chain market map with the markets served by the
+// sidecar, and returns an error once a drift persists beyond the configured threshold.
+func monitorMarkets(cmd *cobra.Command) error {
+	if monitorInterval <= 0 {
+		return fmt.Errorf("interval must be positive; got %s", monitorInterval)
+	}
+
+	if monitorThreshold < 0 {
+		return fmt.Errorf("threshold must be non-negative; got %s", monitorThreshold)
+	}
+
+	nodeConn, err := grpc.NewClient(monitorNode, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to node %s: %w", monitorNode, err)
+	}
+	defer nodeConn.Close()
+
+	oracleConn, err := grpc.NewClient(monitorOracle, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to oracle %s: %w", monitorOracle, err)
+	}
+	defer oracleConn.Close()
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var (
+		mmClient     = mmtypes.NewQueryClient(nodeConn)
+		oracleClient = oracletypes.NewOracleClient(oracleConn)
+		ticker       = time.NewTicker(monitorInterval)
+		driftSince   time.Time
+	)
+	defer ticker.Stop()
+
+	for {
+		missing, unexpected, err := diffServedMarkets(ctx, mmClient, oracleClient)
+		switch {
+		case err != nil:
+			cmd.PrintErrf("failed to compare market maps: %s\n", err)
+		case len(missing) == 0 && len(unexpected) == 0:
+			if !driftSince.IsZero() {
+				cmd.Printf("drift resolved after %s\n", time.Since(driftSince).Round(time.Second))
+			}
+			driftSince = time.Time{}
+		default:
+			if driftSince.IsZero() {
+				driftSince = time.Now()
+			}
+
+			drift := time.Since(driftSince)
+			cmd.Printf(
+				"drift detected for %s: missing=%v unexpected=%v\n",
+				drift.Round(time.Second),
+				missing,
+				unexpected,
+			)
+
+			if drift >= monitorThreshold {
+				return fmt.Errorf(
+					"market map drift persisted for %s; missing %d markets, serving %d unexpected markets",
+					drift.Round(time.Second),
+					len(missing),
+					len(unexpected),
+				)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			cmd.Println("received interrupt or terminate signal; exiting")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// diffServedMarkets fetches the on-chain market map and the markets served by the sidecar, and
+// returns the enabled on-chain markets that are not served along with the served markets that are
+// not enabled on-chain.
+func diffServedMarkets(
+	ctx context.Context,
+	mmClient mmtypes.QueryClient,
+	oracleClient oracletypes.OracleClient,
+) ([]string, []string, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, monitorTimeout)
+	defer cancel()
+
+	mmResp, err := mmClient.MarketMap(reqCtx, &mmtypes.MarketMapRequest{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch on-chain market map: %w", err)
+	}
+
+	pricesResp, err := oracleClient.Prices(reqCtx, &oracletypes.QueryPricesRequest{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch served markets: %w", err)
+	}
+
+	missing, unexpected := diffMarkets(mmResp.MarketMap, pricesResp)
+	return missing, unexpected, nil
+}
+
+// diffMarkets compares the enabled markets of the market map with the markets served by the
+// sidecar. A market is served if it has a price, is warming up, or is failing. The returned
+// tickers are sorted.
+func diffMarkets(marketMap mmtypes.MarketMap, resp *oracletypes.QueryPricesResponse) ([]string, []string) {
+	served := make(map[string]struct{}, len(resp.Prices)+len(resp.WarmingUp)+len(resp.Failing))
+	for ticker := range resp.Prices {
+		served[ticker] = struct{}{}
+	}
+	for _, ticker := range resp.WarmingUp {
+		served[ticker] = struct{}{}
+	}
+	for _, ticker := range resp.Failing {
+		served[ticker] = struct{}{}
+	}
+
+	enabled := make(map[string]struct{}, len(marketMap.Markets))
+	for _, market := range marketMap.Markets {
+		if market.Ticker.Enabled {
+			enabled[market.Ticker.String()] = struct{}{}
+		}
+	}
+
+	var missing, unexpected []string
+	for ticker := range enabled {
+		if _, ok := served[ticker]; !ok {
+			missing = append(missing, ticker)
+		}
+	}
+	for ticker := range served {
+		if _, ok := enabled[ticker]; !ok {
+			unexpected = append(unexpected, ticker)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	return missing, unexpected
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestDiffMarkets(t *testing.T) {
+	// marketMap returns a market map with the given markets, each enabled as given.
+	marketMap := func(markets map[string]bool) mmtypes.MarketMap {
+		mm := mmtypes.MarketMap{Markets: make(map[string]mmtypes.Market, len(markets))}
+		for ticker, enabled := range markets {
+			cp, err := slinkytypes.CurrencyPairFromString(ticker)
+			require.NoError(t, err)
+
+			mm.Markets[ticker] = mmtypes.Market{
+				Ticker: mmtypes.Ticker{
+					CurrencyPair: cp,
+					Enabled:      enabled,
+				},
+			}
+		}
+
+		return mm
+	}
+
+	testCases := []struct {
+		name               string
+		marketMap          mmtypes.MarketMap
+		resp               *oracletypes.QueryPricesResponse
+		expectedMissing    []string
+		expectedUnexpected []string
+	}{
+		{
+			name:      "no drift",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true, "ETH/USD": true}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices: map[string]string{"BTC/USD": "1", "ETH/USD": "1"},
+			},
+		},
+		{
+			name:      "market added on-chain is missing",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true, "ETH/USD": true, "SOL/USD": true}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices: map[string]string{"BTC/USD": "1", "ETH/USD": "1"},
+			},
+			expectedMissing: []string{"SOL/USD"},
+		},
+		{
+			name:      "market removed on-chain is unexpected",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices: map[string]string{"BTC/USD": "1", "ETH/USD": "1"},
+			},
+			expectedUnexpected: []string{"ETH/USD"},
+		},
+		{
+			name:      "market disabled on-chain is unexpected",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true, "ETH/USD": false}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices: map[string]string{"BTC/USD": "1", "ETH/USD": "1"},
+			},
+			expectedUnexpected: []string{"ETH/USD"},
+		},
+		{
+			name:      "disabled market that is not served is not a drift",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true, "ETH/USD": false}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices: map[string]string{"BTC/USD": "1"},
+			},
+		},
+		{
+			name:      "warming up and failing markets are served",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true, "ETH/USD": true, "SOL/USD": true}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices:    map[string]string{"BTC/USD": "1"},
+				WarmingUp: []string{"ETH/USD"},
+				Failing:   []string{"SOL/USD"},
+			},
+		},
+		{
+			name:      "added, removed and changed markets are reported sorted",
+			marketMap: marketMap(map[string]bool{"BTC/USD": true, "ETH/USD": false, "SOL/USD": true, "ATOM/USD": true}),
+			resp: &oracletypes.QueryPricesResponse{
+				Prices:  map[string]string{"BTC/USD": "1", "ETH/USD": "1", "DOGE/USD": "1"},
+				Failing: []string{"PEPE/USD"},
+			},
+			expectedMissing:    []string{"ATOM/USD", "SOL/USD"},
+			expectedUnexpected: []string{"DOGE/USD", "ETH/USD", "PEPE/USD"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			missing, unexpected := diffMarkets(tc.marketMap, tc.resp)
+			require.Equal(t, tc.expectedMissing, missing)
+			require.Equal(t, tc.expectedUnexpected, unexpected)
+		})
+	}
+}
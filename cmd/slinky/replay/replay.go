@@ -0,0 +1,162 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/slinky/abci/proposals"
+	"github.com/skip-mev/slinky/abci/strategies/codec"
+	"github.com/skip-mev/slinky/abci/strategies/currencypair"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	servicemetrics "github.com/skip-mev/slinky/service/metrics"
+)
+
+// Replayer replays recorded extended commits against the ProcessProposalHandler. Vote extension
+// signatures and voting power are not verified, as this requires access to the validator set. All
+// other validation performed by the proposal handler is applied.
+type Replayer struct {
+	height  int64
+	ctx     sdk.Context
+	handler sdk.ProcessProposalHandler
+}
+
+// NewReplayer returns a new replayer that processes extended commits at the given height, which
+// must be greater than 1 for vote extensions to be enabled. The extended commits and their vote
+// extensions are decoded with the given codecs. A maxNumCP of 0 disables the check of the number
+// of currency pairs in each vote extension.
+func NewReplayer(
+	logger log.Logger,
+	height int64,
+	maxNumCP uint64,
+	extCommitCodec codec.ExtendedCommitCodec,
+	veCodec codec.VoteExtensionCodec,
+) (*Replayer, error) {
+	if height <= 1 {
+		return nil, fmt.Errorf("height must be greater than 1; got %d", height)
+	}
+
+	handler := proposals.NewProposalHandler(
+		logger,
+		baseapp.NoOpPrepareProposal(),
+		baseapp.NoOpProcessProposal(),
+		func(sdk.Context, cometabci.ExtendedCommitInfo) error { return nil },
+		veCodec,
+		extCommitCodec,
+		CurrencyPairStrategy{MaxNumCP: maxNumCP},
+		servicemetrics.NewNopMetrics(),
+	)
+
+	ctx := sdk.Context{}.
+		WithBlockHeight(height).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Abci: &cmtproto.ABCIParams{
+				VoteExtensionsEnableHeight: 1,
+			},
+		})
+
+	return &Replayer{
+		height:  height,
+		ctx:     ctx,
+		handler: handler.ProcessProposalHandler(),
+	}, nil
+}
+
+// Replay runs the given encoded extended commit through the ProcessProposalHandler and returns
+// its decision, along with the reason the commit was rejected, if any.
+func (r *Replayer) Replay(extCommitBz []byte) (cometabci.ResponseProcessProposal_ProposalStatus, error) {
+	resp, err := r.handler(r.ctx, &cometabci.RequestProcessProposal{
+		Txs:    [][]byte{extCommitBz},
+		Height: r.height,
+	})
+
+	return resp.GetStatus(), err
+}
+
+// DecodeCommit decodes a base64 encoded extended commit, i.e. the first transaction of a proposal
+// as returned by the CometBFT /block RPC. Surrounding whitespace is ignored.
+func DecodeCommit(bz []byte) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(string(bytes.TrimSpace(bz)))
+}
+
+// ExtendedCommitCodecFromFlag returns the extended commit codec for the given selector.
+func ExtendedCommitCodecFromFlag(flag string) (codec.ExtendedCommitCodec, error) {
+	switch flag {
+	case "1":
+		return codec.NewDefaultExtendedCommitCodec(), nil
+	case "2":
+		return codec.NewCompressionExtendedCommitCodec(
+			codec.NewDefaultExtendedCommitCodec(),
+			codec.NewZLibCompressor(),
+		), nil
+	case "3":
+		return codec.NewCompressionExtendedCommitCodec(
+			codec.NewDefaultExtendedCommitCodec(),
+			codec.NewZStdCompressor(),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown extended commit codec %s", flag)
+	}
+}
+
+// VoteExtensionCodecFromFlag returns the vote extension codec for the given selector.
+func VoteExtensionCodecFromFlag(flag string) (codec.VoteExtensionCodec, error) {
+	switch flag {
+	case "1":
+		return codec.NewDefaultVoteExtensionCodec(), nil
+	case "2":
+		return codec.NewCompressionVoteExtensionCodec(
+			codec.NewDefaultVoteExtensionCodec(),
+			codec.NewZLibCompressor(),
+		), nil
+	case "3":
+		return codec.NewCompressionVoteExtensionCodec(
+			codec.NewDefaultVoteExtensionCodec(),
+			codec.NewZStdCompressor(),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown vote extension codec %s", flag)
+	}
+}
+
+var _ currencypair.CurrencyPairStrategy = CurrencyPairStrategy{}
+
+// CurrencyPairStrategy is a stub currency pair strategy used when replaying extended commits
+// offline. Only the maximum number of currency pairs is known, all other lookups require x/oracle
+// state and return an error.
+type CurrencyPairStrategy struct {
+	// MaxNumCP is the maximum number of currency pairs expected in each vote extension. A value of
+	// 0 disables the check.
+	MaxNumCP uint64
+}
+
+func (s CurrencyPairStrategy) ID(sdk.Context, slinkytypes.CurrencyPair) (uint64, error) {
+	return 0, fmt.Errorf("currency pair IDs are not available when replaying commits")
+}
+
+func (s CurrencyPairStrategy) FromID(sdk.Context, uint64) (slinkytypes.CurrencyPair, error) {
+	return slinkytypes.CurrencyPair{}, fmt.Errorf("currency pair IDs are not available when replaying commits")
+}
+
+func (s CurrencyPairStrategy) GetEncodedPrice(sdk.Context, slinkytypes.CurrencyPair, *big.Int) ([]byte, error) {
+	return nil, fmt.Errorf("price encoding is not available when replaying commits")
+}
+
+func (s CurrencyPairStrategy) GetDecodedPrice(sdk.Context, slinkytypes.CurrencyPair, []byte) (*big.Int, error) {
+	return nil, fmt.Errorf("price decoding is not available when replaying commits")
+}
+
+func (s CurrencyPairStrategy) GetMaxNumCP(sdk.Context) (uint64, error) {
+	if s.MaxNumCP == 0 {
+		return ^uint64(0), nil
+	}
+
+	return s.MaxNumCP, nil
+}
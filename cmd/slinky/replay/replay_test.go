@@ -0,0 +1,169 @@
+package replay_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"cosmossdk.io/log"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/abci/strategies/codec"
+	"github.com/skip-mev/slinky/abci/testutils"
+	"github.com/skip-mev/slinky/cmd/slinky/replay"
+)
+
+func TestNewReplayer(t *testing.T) {
+	t.Run("rejects heights at which vote extensions are disabled", func(t *testing.T) {
+		_, err := replay.NewReplayer(
+			log.NewNopLogger(),
+			1,
+			0,
+			codec.NewDefaultExtendedCommitCodec(),
+			codec.NewDefaultVoteExtensionCodec(),
+		)
+		require.Error(t, err)
+	})
+
+	t.Run("accepts heights at which vote extensions are enabled", func(t *testing.T) {
+		_, err := replay.NewReplayer(
+			log.NewNopLogger(),
+			2,
+			0,
+			codec.NewDefaultExtendedCommitCodec(),
+			codec.NewDefaultVoteExtensionCodec(),
+		)
+		require.NoError(t, err)
+	})
+}
+
+func TestReplay(t *testing.T) {
+	// extendedCommit returns an extended commit with a single vote whose vote extension contains
+	// the given number of prices, encoded with the given codecs.
+	extendedCommit := func(
+		t *testing.T,
+		numPrices int,
+		extCommitCodec codec.ExtendedCommitCodec,
+		veCodec codec.VoteExtensionCodec,
+	) []byte {
+		t.Helper()
+
+		prices := make(map[uint64][]byte, numPrices)
+		for i := 0; i < numPrices; i++ {
+			prices[uint64(i)] = []byte("price")
+		}
+
+		vote, err := testutils.CreateExtendedVoteInfo(sdk.ConsAddress("validator"), prices, veCodec)
+		require.NoError(t, err)
+
+		_, bz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{vote}, extCommitCodec)
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	zlibExtCommitCodec, err := replay.ExtendedCommitCodecFromFlag("2")
+	require.NoError(t, err)
+
+	zlibVECodec, err := replay.VoteExtensionCodecFromFlag("2")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		maxNumCP       uint64
+		extCommitCodec codec.ExtendedCommitCodec
+		veCodec        codec.VoteExtensionCodec
+		commit         []byte
+		expectedStatus cometabci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			name:           "valid commit is accepted",
+			extCommitCodec: codec.NewDefaultExtendedCommitCodec(),
+			veCodec:        codec.NewDefaultVoteExtensionCodec(),
+			commit:         extendedCommit(t, 3, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec()),
+			expectedStatus: cometabci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:           "commit with as many currency pairs as the maximum is accepted",
+			maxNumCP:       3,
+			extCommitCodec: codec.NewDefaultExtendedCommitCodec(),
+			veCodec:        codec.NewDefaultVoteExtensionCodec(),
+			commit:         extendedCommit(t, 3, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec()),
+			expectedStatus: cometabci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:           "commit with more currency pairs than the maximum is rejected",
+			maxNumCP:       2,
+			extCommitCodec: codec.NewDefaultExtendedCommitCodec(),
+			veCodec:        codec.NewDefaultVoteExtensionCodec(),
+			commit:         extendedCommit(t, 3, codec.NewDefaultExtendedCommitCodec(), codec.NewDefaultVoteExtensionCodec()),
+			expectedStatus: cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name:           "compressed commit is accepted with the matching codecs",
+			extCommitCodec: zlibExtCommitCodec,
+			veCodec:        zlibVECodec,
+			commit:         extendedCommit(t, 3, zlibExtCommitCodec, zlibVECodec),
+			expectedStatus: cometabci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name:           "compressed commit is rejected with the default codecs",
+			extCommitCodec: codec.NewDefaultExtendedCommitCodec(),
+			veCodec:        codec.NewDefaultVoteExtensionCodec(),
+			commit:         extendedCommit(t, 3, zlibExtCommitCodec, zlibVECodec),
+			expectedStatus: cometabci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name:           "malformed commit is rejected",
+			extCommitCodec: codec.NewDefaultExtendedCommitCodec(),
+			veCodec:        codec.NewDefaultVoteExtensionCodec(),
+			commit:         []byte("not an extended commit"),
+			expectedStatus: cometabci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			replayer, err := replay.NewReplayer(log.NewNopLogger(), 2, tc.maxNumCP, tc.extCommitCodec, tc.veCodec)
+			require.NoError(t, err)
+
+			status, err := replayer.Replay(tc.commit)
+			require.Equal(t, tc.expectedStatus, status)
+			if tc.expectedStatus == cometabci.ResponseProcessProposal_ACCEPT {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodeCommit(t *testing.T) {
+	t.Run("surrounding whitespace is ignored", func(t *testing.T) {
+		bz, err := replay.DecodeCommit([]byte("  " + base64.StdEncoding.EncodeToString([]byte("commit")) + "\n"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("commit"), bz)
+	})
+
+	t.Run("invalid base64 is rejected", func(t *testing.T) {
+		_, err := replay.DecodeCommit([]byte("not base64!"))
+		require.Error(t, err)
+	})
+}
+
+func TestCodecsFromFlags(t *testing.T) {
+	for _, flag := range []string{"1", "2", "3"} {
+		_, err := replay.ExtendedCommitCodecFromFlag(flag)
+		require.NoError(t, err)
+
+		_, err = replay.VoteExtensionCodecFromFlag(flag)
+		require.NoError(t, err)
+	}
+
+	_, err := replay.ExtendedCommitCodecFromFlag("4")
+	require.Error(t, err)
+
+	_, err = replay.VoteExtensionCodecFromFlag("4")
+	require.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	cmdconfig "github.com/skip-mev/slinky/cmd/slinky/config"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/marketmap"
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the oracle and market configs without starting the oracle.",
+	Long: `Load the oracle config (legacy or modern, selected as when starting the oracle) and the market config,
+run all of their validation checks, and print a report of every problem found. The provider configs of each
+market are validated individually, and checked against the providers of the oracle config. No servers are
+started. The command exits with a non-zero status if any problem is found.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		cmd.SilenceUsage = true
+		return validateConfigs(cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	validateCmd.Flags().StringVar(
+		&marketMapProvider,
+		"marketmap-provider",
+		marketmap.Name,
+		"MarketMap provider to use (marketmap_api, dydx_api).",
+	)
+	validateCmd.Flags().StringVar(
+		&legacyOracleCfgPath,
+		"oracle-config-path",
+		"",
+		"Path to the legacy oracle config file.",
+	)
+	validateCmd.Flags().StringVar(
+		&oracleCfgPath,
+		"oracle-config",
+		"",
+		"Path to the oracle config file.",
+	)
+	validateCmd.Flags().StringVar(
+		&configMode,
+		"config-mode",
+		ConfigModeAuto,
+		"How the oracle config is selected (auto, legacy, modern), as when starting the oracle.",
+	)
+	validateCmd.Flags().StringVar(
+		&marketCfgPath,
+		"market-config-path",
+		"",
+		"Path to the market config file. If unset, only the oracle config is validated.",
+	)
+
+	rootCmd.AddCommand(validateCmd)
+}
+
+// validateConfigs validates the oracle and market configs selected by the flags, and writes a report
+// of the problems found to w. An error is returned if any problem is found.
+func validateConfigs(w io.Writer) error {
+	var (
+		problems int
+		cfg      *config.OracleConfig
+	)
+
+	oracleCfg, source, err := loadOracleConfig()
+	if err != nil {
+		problems++
+		fmt.Fprintf(w, "oracle config (%s): FAILED\n  - %s\n", source, err)
+	} else {
+		cfg = &oracleCfg
+		fmt.Fprintf(w, "oracle config (%s): OK (%d providers)\n", source, len(oracleCfg.Providers))
+	}
+
+	if marketCfgPath == "" {
+		fmt.Fprintln(w, "market config: SKIPPED (no --market-config-path)")
+	} else {
+		marketCfg, err := cmdconfig.ReadMarketConfig(marketCfgPath)
+		if err != nil {
+			problems++
+			fmt.Fprintf(w, "market config (%s): FAILED\n  - %s\n", marketCfgPath, err)
+		} else {
+			errs := cmdconfig.ValidateMarketConfig(marketCfg, cfg)
+			problems += len(errs)
+
+			if len(errs) == 0 {
+				fmt.Fprintf(w, "market config (%s): OK (%d markets)\n", marketCfgPath, len(marketCfg.Markets))
+			} else {
+				fmt.Fprintf(w, "market config (%s): FAILED\n", marketCfgPath)
+				for _, err := range errs {
+					fmt.Fprintf(w, "  - %s\n", err)
+				}
+			}
+		}
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("found %d problem(s) in the configs", problems)
+	}
+
+	return nil
+}
+
+// loadOracleConfig loads the oracle config selected by the config flags, and returns it along with a
+// description of where it was loaded from. Loading the config runs its validation checks.
+func loadOracleConfig() (config.OracleConfig, string, error) {
+	path, legacy, err := selectOracleConfig(zap.NewNop())
+	if err != nil {
+		return config.OracleConfig{}, "unknown", err
+	}
+
+	if legacy {
+		source := fmt.Sprintf("legacy, %s", path)
+		cfg, err := cmdconfig.GetLegacyOracleConfig(path)
+		return cfg, source, err
+	}
+
+	if path == "" {
+		source := "defaults with environment overrides"
+		if base := os.Getenv(cmdconfig.SlinkyConfigFileEnvironmentVariable); base != "" {
+			source = fmt.Sprintf("defaults with environment overrides, %s", base)
+		}
+
+		cfg, err := cmdconfig.ReadOracleConfigFromEnv(marketMapProvider)
+		return cfg, source, err
+	}
+
+	source := fmt.Sprintf("defaults with overrides, %s", path)
+	cfg, err := cmdconfig.ReadOracleConfigWithOverrides(path, marketMapProvider)
+	return cfg, source, err
+}
@@ -0,0 +1,68 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/types"
+	mathtestutils "github.com/skip-mev/slinky/pkg/math/testutils"
+)
+
+// countingObserver is a price observer that counts the number of aggregations of the oracle.
+type countingObserver struct {
+	count atomic.Int64
+}
+
+func (o *countingObserver) ObservePrices(_ types.Prices, _ time.Time) {
+	o.count.Add(1)
+}
+
+func (s *OracleTestSuite) TestAggregationInterval() {
+	s.Run("prices are aggregated on the aggregation interval rather than the update interval", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		observer := &countingObserver{}
+		testOracle, err := oracle.New(
+			oracle.WithUpdateInterval(10*time.Millisecond),
+			oracle.WithAggregationInterval(200*time.Millisecond),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+			oracle.WithPriceObserver(observer),
+		)
+		s.Require().NoError(err)
+
+		s.Require().NoError(testOracle.PushPrice("custom", s.currencyPairs[0], big.NewFloat(100), time.Now()))
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = testOracle.Start(ctx)
+		}()
+
+		// The prices fetched on each update are only published on the aggregation interval.
+		s.Require().Eventually(func() bool {
+			return !testOracle.GetLastSyncTime().IsZero()
+		}, 2*time.Second, 10*time.Millisecond)
+		s.Require().Equal(types.Prices{
+			s.currencyPairs[0].String(): big.NewFloat(100),
+		}, testOracle.GetPrices())
+
+		time.Sleep(500 * time.Millisecond)
+		cancel()
+		<-done
+
+		// Over ~700ms, the oracle fetched prices ~70 times but only aggregated them ~3 times.
+		s.Require().GreaterOrEqual(observer.count.Load(), int64(2))
+		s.Require().LessOrEqual(observer.count.Load(), int64(5))
+	})
+
+	s.Run("cannot set a negative aggregation interval", func() {
+		s.Require().Panics(func() {
+			_, _ = oracle.New(oracle.WithAggregationInterval(-time.Second))
+		})
+	})
+}
@@ -0,0 +1,225 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+var _ oracle.PriceObserver = (*WebhookAlerter)(nil)
+
+// Kind is the kind of deviation that triggered an alert.
+type Kind string
+
+const (
+	// KindChange is the kind of alert triggered when the price of a market moves more than the
+	// configured max change between two consecutive updates.
+	KindChange Kind = "change"
+	// KindReference is the kind of alert triggered when the price of a market diverges from the
+	// configured reference price by more than the configured max deviation.
+	KindReference Kind = "reference"
+)
+
+// Alert is the JSON payload that is POSTed to the webhook.
+type Alert struct {
+	// CurrencyPair is the currency pair of the market that triggered the alert.
+	CurrencyPair string `json:"currencyPair"`
+
+	// Kind is the kind of deviation that triggered the alert.
+	Kind Kind `json:"kind"`
+
+	// Price is the aggregated price of the market.
+	Price string `json:"price"`
+
+	// Baseline is the price the aggregated price was compared against i.e. the previous price
+	// for change alerts and the reference price for reference alerts.
+	Baseline string `json:"baseline"`
+
+	// Deviation is the relative deviation of the price from the baseline.
+	Deviation float64 `json:"deviation"`
+
+	// Threshold is the configured relative deviation that was exceeded.
+	Threshold float64 `json:"threshold"`
+
+	// Timestamp is the time at which the oracle updated the price.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// WebhookAlerter is a price observer that POSTs an alert to a webhook whenever the aggregated
+// price of a configured market moves or diverges more than its configured thresholds. Alerts
+// of the same kind for the same market are debounced.
+type WebhookAlerter struct {
+	mtx    sync.Mutex
+	logger *zap.Logger
+	cfg    config.DeviationAlertsConfig
+	client *http.Client
+
+	// markets is the alert config of each market indexed by currency pair.
+	markets map[string]config.MarketAlertConfig
+	// lastPrices is the last observed price of each market.
+	lastPrices map[string]*big.Float
+	// lastAlerts is the time at which the last alert was sent, indexed by market and kind.
+	lastAlerts map[string]time.Time
+}
+
+// NewWebhookAlerter returns a new WebhookAlerter from the given config.
+func NewWebhookAlerter(logger *zap.Logger, cfg config.DeviationAlertsConfig) (*WebhookAlerter, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	markets := make(map[string]config.MarketAlertConfig, len(cfg.Markets))
+	for _, market := range cfg.Markets {
+		cp, err := slinkytypes.CurrencyPairFromString(market.CurrencyPair)
+		if err != nil {
+			return nil, err
+		}
+
+		markets[cp.String()] = market
+	}
+
+	return &WebhookAlerter{
+		logger:     logger.With(zap.String("process", "deviation_alerts")),
+		cfg:        cfg,
+		client:     &http.Client{Timeout: cfg.Timeout},
+		markets:    markets,
+		lastPrices: make(map[string]*big.Float),
+		lastAlerts: make(map[string]time.Time),
+	}, nil
+}
+
+// ObservePrices checks the configured markets for deviations and sends any triggered alerts to
+// the webhook asynchronously.
+func (a *WebhookAlerter) ObservePrices(prices types.Prices, timestamp time.Time) {
+	for _, alert := range a.checkPrices(prices, timestamp) {
+		go a.send(alert)
+	}
+}
+
+// checkPrices returns the alerts triggered by the given prices and records the prices as the
+// last observed prices.
+func (a *WebhookAlerter) checkPrices(prices types.Prices, timestamp time.Time) []Alert {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	var alerts []Alert
+	for cp, market := range a.markets {
+		price, ok := prices[cp]
+		if !ok || price == nil {
+			continue
+		}
+
+		if last, ok := a.lastPrices[cp]; ok && market.MaxChange > 0 {
+			if alert, ok := a.checkDeviation(cp, KindChange, price, last, market.MaxChange, timestamp); ok {
+				alerts = append(alerts, alert)
+			}
+		}
+
+		if market.ReferencePrice > 0 {
+			reference := big.NewFloat(market.ReferencePrice)
+			if alert, ok := a.checkDeviation(cp, KindReference, price, reference, market.MaxReferenceDeviation, timestamp); ok {
+				alerts = append(alerts, alert)
+			}
+		}
+
+		a.lastPrices[cp] = new(big.Float).Copy(price)
+	}
+
+	return alerts
+}
+
+// checkDeviation returns an alert if the price deviates from the baseline by more than the
+// threshold and no alert of the same kind was sent for the market within the debounce window.
+func (a *WebhookAlerter) checkDeviation(
+	cp string,
+	kind Kind,
+	price, baseline *big.Float,
+	threshold float64,
+	timestamp time.Time,
+) (Alert, bool) {
+	if baseline.Sign() == 0 {
+		return Alert{}, false
+	}
+
+	diff := new(big.Float).Sub(price, baseline)
+	diff.Abs(diff)
+	deviation, _ := diff.Quo(diff, new(big.Float).Abs(baseline)).Float64()
+	if deviation <= threshold {
+		return Alert{}, false
+	}
+
+	key := cp + "/" + string(kind)
+	if last, ok := a.lastAlerts[key]; ok && timestamp.Sub(last) < a.cfg.Debounce {
+		a.logger.Debug(
+			"debouncing deviation alert",
+			zap.String("currency_pair", cp),
+			zap.String("kind", string(kind)),
+		)
+		return Alert{}, false
+	}
+	a.lastAlerts[key] = timestamp
+
+	return Alert{
+		CurrencyPair: cp,
+		Kind:         kind,
+		Price:        price.String(),
+		Baseline:     baseline.String(),
+		Deviation:    deviation,
+		Threshold:    threshold,
+		Timestamp:    timestamp,
+	}, true
+}
+
+// send POSTs the alert to the webhook.
+func (a *WebhookAlerter) send(alert Alert) {
+	logger := a.logger.With(
+		zap.String("currency_pair", alert.CurrencyPair),
+		zap.String("kind", string(alert.Kind)),
+	)
+
+	bz, err := json.Marshal(alert)
+	if err != nil {
+		logger.Error("failed to marshal deviation alert", zap.Error(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.cfg.WebhookURL, bytes.NewReader(bz))
+	if err != nil {
+		logger.Error("failed to create deviation alert request", zap.Error(err))
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		logger.Error("failed to send deviation alert", zap.Error(err))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		logger.Error("webhook rejected deviation alert", zap.Int("status_code", resp.StatusCode))
+		return
+	}
+
+	logger.Info("sent deviation alert", zap.Float64("deviation", alert.Deviation))
+}
@@ -0,0 +1,121 @@
+package alerts_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/alerts"
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+func TestWebhookAlerter(t *testing.T) {
+	received := make(chan alerts.Alert, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert alerts.Alert
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		received <- alert
+	}))
+	defer srv.Close()
+
+	alerter, err := alerts.NewWebhookAlerter(zap.NewNop(), config.DeviationAlertsConfig{
+		Enabled:    true,
+		WebhookURL: srv.URL,
+		Timeout:    time.Second,
+		Debounce:   time.Minute,
+		Markets: []config.MarketAlertConfig{
+			{
+				CurrencyPair: "BTC/USD",
+				MaxChange:    0.1,
+			},
+			{
+				CurrencyPair:          "ETH/USD",
+				ReferencePrice:        100,
+				MaxReferenceDeviation: 0.2,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	expectAlert := func() alerts.Alert {
+		select {
+		case alert := <-received:
+			return alert
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected an alert")
+			return alerts.Alert{}
+		}
+	}
+
+	expectNoAlert := func() {
+		select {
+		case alert := <-received:
+			t.Fatalf("unexpected alert: %+v", alert)
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+
+	now := time.Now().UTC()
+
+	// the first observation has nothing to compare changes against
+	alerter.ObservePrices(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+		"ETH/USD": big.NewFloat(110),
+	}, now)
+	expectNoAlert()
+
+	// BTC/USD moves by 20%
+	alerter.ObservePrices(types.Prices{
+		"BTC/USD": big.NewFloat(120),
+		"ETH/USD": big.NewFloat(110),
+	}, now.Add(time.Second))
+	alert := expectAlert()
+	require.Equal(t, "BTC/USD", alert.CurrencyPair)
+	require.Equal(t, alerts.KindChange, alert.Kind)
+	require.Equal(t, "120", alert.Price)
+	require.Equal(t, "100", alert.Baseline)
+	require.InDelta(t, 0.2, alert.Deviation, 1e-9)
+	require.Equal(t, 0.1, alert.Threshold)
+	expectNoAlert()
+
+	// BTC/USD moves again, but the alert is debounced
+	alerter.ObservePrices(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+	}, now.Add(2*time.Second))
+	expectNoAlert()
+
+	// ETH/USD diverges from its reference price by 50%
+	alerter.ObservePrices(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+		"ETH/USD": big.NewFloat(150),
+	}, now.Add(3*time.Second))
+	alert = expectAlert()
+	require.Equal(t, "ETH/USD", alert.CurrencyPair)
+	require.Equal(t, alerts.KindReference, alert.Kind)
+	require.InDelta(t, 0.5, alert.Deviation, 1e-9)
+	expectNoAlert()
+
+	// once the debounce window has passed, alerts are sent again
+	alerter.ObservePrices(types.Prices{
+		"BTC/USD": big.NewFloat(200),
+		"ETH/USD": big.NewFloat(150),
+	}, now.Add(2*time.Minute))
+	first, second := expectAlert(), expectAlert()
+	require.ElementsMatch(t, []string{"BTC/USD", "ETH/USD"}, []string{first.CurrencyPair, second.CurrencyPair})
+	expectNoAlert()
+}
+
+func TestNewWebhookAlerterInvalidConfig(t *testing.T) {
+	_, err := alerts.NewWebhookAlerter(zap.NewNop(), config.DeviationAlertsConfig{
+		Enabled:    true,
+		WebhookURL: "http://localhost:9000",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// AggregationFallback is a fallback used to price a market whose aggregation fails because it
+// does not meet its minimum provider count.
+type AggregationFallback string
+
+const (
+	// AggregationFallbackMedian takes the median of the market's available prices, regardless of
+	// the market's minimum provider count and aggregation strategy. At least one price is required.
+	AggregationFallbackMedian AggregationFallback = "median"
+	// AggregationFallbackLastKnown reuses the market's last successfully aggregated price, as long
+	// as it is not older than the configured max age.
+	AggregationFallbackLastKnown AggregationFallback = "lastKnown"
+)
+
+// AggregationFallbackConfig is the configuration of the fallbacks used to price a market whose
+// aggregation fails. The fallbacks are evaluated in order until one of them resolves a price, so
+// that a market degrades gracefully rather than being dropped outright.
+type AggregationFallbackConfig struct {
+	// Fallbacks is the ordered chain of fallbacks, e.g. ["median", "lastKnown"]. If empty, markets
+	// whose aggregation fails are not priced.
+	Fallbacks []AggregationFallback `json:"fallbacks"`
+
+	// LastKnownMaxAge is the maximum age of a last known price utilized by the lastKnown fallback.
+	// This must be set if the lastKnown fallback is configured.
+	LastKnownMaxAge time.Duration `json:"lastKnownMaxAge"`
+}
+
+// ValidateBasic performs basic validation of the aggregation fallback config.
+func (c *AggregationFallbackConfig) ValidateBasic() error {
+	if c.LastKnownMaxAge < 0 {
+		return fmt.Errorf("aggregation fallback last known max age cannot be negative")
+	}
+
+	seen := make(map[AggregationFallback]struct{}, len(c.Fallbacks))
+	for _, fallback := range c.Fallbacks {
+		switch fallback {
+		case AggregationFallbackMedian:
+		case AggregationFallbackLastKnown:
+			if c.LastKnownMaxAge == 0 {
+				return fmt.Errorf("aggregation fallback last known max age must be set for the %s fallback", fallback)
+			}
+		default:
+			return fmt.Errorf("unknown aggregation fallback %q", fallback)
+		}
+
+		if _, ok := seen[fallback]; ok {
+			return fmt.Errorf("duplicate aggregation fallback %s", fallback)
+		}
+		seen[fallback] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestAggregationFallbackConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.AggregationFallbackConfig
+		expectedErr bool
+	}{
+		{
+			name:        "empty config",
+			config:      config.AggregationFallbackConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.AggregationFallbackConfig{
+				Fallbacks:       []config.AggregationFallback{config.AggregationFallbackMedian, config.AggregationFallbackLastKnown},
+				LastKnownMaxAge: time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "median fallback without a last known max age",
+			config: config.AggregationFallbackConfig{
+				Fallbacks: []config.AggregationFallback{config.AggregationFallbackMedian},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "last known fallback without a last known max age",
+			config: config.AggregationFallbackConfig{
+				Fallbacks: []config.AggregationFallback{config.AggregationFallbackLastKnown},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative last known max age",
+			config: config.AggregationFallbackConfig{
+				LastKnownMaxAge: -time.Minute,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unknown fallback",
+			config: config.AggregationFallbackConfig{
+				Fallbacks: []config.AggregationFallback{"mode"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate fallback",
+			config: config.AggregationFallbackConfig{
+				Fallbacks: []config.AggregationFallback{config.AggregationFallbackMedian, config.AggregationFallbackMedian},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
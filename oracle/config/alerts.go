@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// DeviationAlertsConfig is the configuration used to push alerts to a webhook when the
+// aggregated price of a market moves too far within a single update interval, or diverges
+// too far from a configured reference price.
+type DeviationAlertsConfig struct {
+	// Enabled is a flag that indicates whether deviation alerts are enabled.
+	Enabled bool `json:"enabled"`
+
+	// WebhookURL is the URL that alerts are POSTed to as JSON.
+	WebhookURL string `json:"webhookURL"`
+
+	// Timeout is the timeout of each webhook request.
+	Timeout time.Duration `json:"timeout"`
+
+	// Debounce is the minimum amount of time between two alerts of the same kind for the same
+	// market.
+	Debounce time.Duration `json:"debounce"`
+
+	// Markets is the list of markets to alert on, along with their thresholds.
+	Markets []MarketAlertConfig `json:"markets"`
+}
+
+// MarketAlertConfig defines the alert thresholds of a single market.
+type MarketAlertConfig struct {
+	// CurrencyPair is the currency pair of the market e.g. BTC/USD.
+	CurrencyPair string `json:"currencyPair"`
+
+	// MaxChange is the maximum relative change of the market's price between two consecutive
+	// updates, e.g. 0.05 for 5%. A value of 0 disables the check.
+	MaxChange float64 `json:"maxChange"`
+
+	// ReferencePrice is the expected price of the market, scaled by the market's decimals. A value
+	// of 0 disables the check.
+	ReferencePrice float64 `json:"referencePrice"`
+
+	// MaxReferenceDeviation is the maximum relative deviation of the market's price from the
+	// reference price, e.g. 0.1 for 10%.
+	MaxReferenceDeviation float64 `json:"maxReferenceDeviation"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *DeviationAlertsConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if _, err := url.ParseRequestURI(c.WebhookURL); err != nil {
+		return fmt.Errorf("invalid deviation alerts webhook url %q: %w", c.WebhookURL, err)
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("deviation alerts timeout must be greater than 0")
+	}
+
+	if c.Debounce < 0 {
+		return fmt.Errorf("deviation alerts debounce cannot be negative")
+	}
+
+	if len(c.Markets) == 0 {
+		return fmt.Errorf("deviation alerts must configure at least one market")
+	}
+
+	seen := make(map[string]struct{}, len(c.Markets))
+	for _, market := range c.Markets {
+		if err := market.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := seen[market.CurrencyPair]; ok {
+			return fmt.Errorf("duplicate deviation alert market %s", market.CurrencyPair)
+		}
+		seen[market.CurrencyPair] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the market alert config.
+func (c *MarketAlertConfig) ValidateBasic() error {
+	if _, err := slinkytypes.SpotCurrencyPairFromString(c.CurrencyPair); err != nil {
+		return fmt.Errorf("invalid deviation alert market %s: %w", c.CurrencyPair, err)
+	}
+
+	if c.MaxChange < 0 {
+		return fmt.Errorf("max change for %s cannot be negative", c.CurrencyPair)
+	}
+
+	if c.ReferencePrice < 0 {
+		return fmt.Errorf("reference price for %s cannot be negative", c.CurrencyPair)
+	}
+
+	if c.ReferencePrice > 0 && c.MaxReferenceDeviation <= 0 {
+		return fmt.Errorf("max reference deviation for %s must be greater than 0", c.CurrencyPair)
+	}
+
+	if c.MaxChange == 0 && c.ReferencePrice == 0 {
+		return fmt.Errorf("deviation alert market %s must configure a max change or a reference price", c.CurrencyPair)
+	}
+
+	return nil
+}
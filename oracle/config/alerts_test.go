@@ -0,0 +1,143 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestDeviationAlertsConfig(t *testing.T) {
+	validMarket := config.MarketAlertConfig{
+		CurrencyPair: "BTC/USD",
+		MaxChange:    0.05,
+	}
+
+	testCases := []struct {
+		name        string
+		config      config.DeviationAlertsConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.DeviationAlertsConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+				Debounce:   time.Minute,
+				Markets: []config.MarketAlertConfig{
+					validMarket,
+					{
+						CurrencyPair:          "ETH/USD",
+						ReferencePrice:        3000,
+						MaxReferenceDeviation: 0.1,
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "invalid webhook url",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "not a url",
+				Timeout:    time.Second,
+				Markets:    []config.MarketAlertConfig{validMarket},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no timeout",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Markets:    []config.MarketAlertConfig{validMarket},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative debounce",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+				Debounce:   -time.Second,
+				Markets:    []config.MarketAlertConfig{validMarket},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no markets",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate markets",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+				Markets:    []config.MarketAlertConfig{validMarket, validMarket},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid currency pair",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+				Markets: []config.MarketAlertConfig{
+					{CurrencyPair: "BTC", MaxChange: 0.05},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "market without thresholds",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+				Markets: []config.MarketAlertConfig{
+					{CurrencyPair: "BTC/USD"},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "reference price without max deviation",
+			config: config.DeviationAlertsConfig{
+				Enabled:    true,
+				WebhookURL: "http://localhost:9000/alerts",
+				Timeout:    time.Second,
+				Markets: []config.MarketAlertConfig{
+					{CurrencyPair: "BTC/USD", ReferencePrice: 100},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
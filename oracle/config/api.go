@@ -2,9 +2,34 @@ package config
 
 import (
 	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 )
 
+// DefaultMaxResponseSize is the default maximum size, in bytes, of a response body that an API
+// provider will read.
+const DefaultMaxResponseSize = 10 * 1024 * 1024
+
+// UnrequestedPolicy determines how an API provider handles results returned for IDs that it did
+// not request, e.g. because a batch endpoint returns more pairs than were asked for, or because an
+// off-chain ticker maps to more than one pair.
+type UnrequestedPolicy string
+
+const (
+	// UnrequestedPolicyIgnore passes results for unrequested IDs through silently. This is the
+	// default policy.
+	UnrequestedPolicyIgnore UnrequestedPolicy = "ignore"
+	// UnrequestedPolicyReport passes results for unrequested IDs through, but logs a warning and
+	// records a metric for each of them.
+	UnrequestedPolicyReport UnrequestedPolicy = "report"
+	// UnrequestedPolicyDrop logs a warning and records a metric for each unrequested ID, and drops
+	// its results.
+	UnrequestedPolicyDrop UnrequestedPolicy = "drop"
+)
+
 // APIConfig defines a config for an API based data provider.
 type APIConfig struct {
 	// Enabled is a flag that indicates whether the provider is API based.
@@ -32,6 +57,11 @@ type APIConfig struct {
 	// URL is the URL that is used to fetch data from the API.
 	URL string `json:"url"`
 
+	// BaseURL optionally overrides the scheme and host of every URL created by the provider.
+	// If the base URL includes a path, it is prepended to the path of the created URLs. This
+	// is useful for pointing a provider at a staging endpoint or regional mirror.
+	BaseURL string `json:"baseURL"`
+
 	// Endpoints is a list of endpoints that the provider can query.
 	Endpoints []Endpoint `json:"endpoints"`
 
@@ -41,10 +71,54 @@ type APIConfig struct {
 	// some currency-pairs may not be fetched each interval.
 	BatchSize int `json:"batchSize"`
 
+	// MaxResponseSize is the maximum size, in bytes, of a response body that the provider
+	// will read. Responses that exceed this size are rejected. If set to 0, the
+	// DefaultMaxResponseSize is used.
+	MaxResponseSize int64 `json:"maxResponseSize"`
+
+	// TimeoutEscalation configures the escalation of the timeout of the provider's requests
+	// after consecutive timeouts.
+	TimeoutEscalation TimeoutEscalationConfig `json:"timeoutEscalation"`
+
+	// LocalAddress is the optional local IP address that outbound connections of the provider
+	// are bound to. This is useful on multi-homed hosts where an exchange only accepts requests
+	// from a whitelisted egress IP. If empty, the operating system selects the source address.
+	LocalAddress string `json:"localAddress"`
+
+	// UnrequestedPolicy is the policy applied to results returned for IDs that the provider did
+	// not request. If empty, such results are passed through silently.
+	UnrequestedPolicy UnrequestedPolicy `json:"unrequestedPolicy"`
+
+	// FieldOverrides optionally overrides the JSON field names that the provider's parser expects
+	// in the API's responses. If empty, the hard-coded field names are used.
+	FieldOverrides FieldOverrides `json:"fieldOverrides"`
+
+	// ErrorLogSuppression configures the suppression of repeated error logs of the provider's
+	// requests. If disabled, every failed request is logged.
+	ErrorLogSuppression ErrorLogSuppressionConfig `json:"errorLogSuppression"`
+
 	// Name is the name of the provider that corresponds to this config.
 	Name string `json:"name"`
 }
 
+// TimeoutEscalationConfig defines how the timeout of a provider's requests escalates when the
+// provider repeatedly times out. After each consecutive timeout, the timeout is multiplied by
+// Multiplier, up to MaxTimeout. The timeout is reset to the configured timeout once a request
+// completes without timing out.
+type TimeoutEscalationConfig struct {
+	// Multiplier is the factor that the timeout is multiplied by after each consecutive timeout.
+	// A value of 0 disables escalation.
+	Multiplier float64 `json:"multiplier"`
+
+	// MaxTimeout is the maximum timeout that the escalation can reach.
+	MaxTimeout time.Duration `json:"maxTimeout"`
+}
+
+// Enabled returns true if timeout escalation is enabled.
+func (c TimeoutEscalationConfig) Enabled() bool {
+	return c.Multiplier != 0
+}
+
 // Endpoint holds all data necessary for an API provider to connect to a given endpoint
 // i.e. URL, headers, authentication, etc.
 type Endpoint struct {
@@ -120,6 +194,49 @@ func (c *APIConfig) ValidateBasic() error {
 		return fmt.Errorf("batch size cannot be set for atomic providers")
 	}
 
+	if c.MaxResponseSize < 0 {
+		return fmt.Errorf("api max response size cannot be negative")
+	}
+
+	switch c.UnrequestedPolicy {
+	case "", UnrequestedPolicyIgnore, UnrequestedPolicyReport, UnrequestedPolicyDrop:
+	default:
+		return fmt.Errorf("api unrequested policy %s is not supported", c.UnrequestedPolicy)
+	}
+
+	if c.TimeoutEscalation.Enabled() {
+		if c.TimeoutEscalation.Multiplier <= 1 {
+			return fmt.Errorf("api timeout escalation multiplier must be greater than 1")
+		}
+
+		if c.TimeoutEscalation.MaxTimeout < c.Timeout {
+			return fmt.Errorf("api timeout escalation max timeout cannot be less than the timeout")
+		}
+	}
+
+	if len(c.BaseURL) > 0 {
+		base, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base url: %w", err)
+		}
+
+		if len(base.Scheme) == 0 || len(base.Host) == 0 {
+			return fmt.Errorf("base url %s must include a scheme and host", c.BaseURL)
+		}
+	}
+
+	if err := validateLocalAddress(c.LocalAddress); err != nil {
+		return fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if err := c.FieldOverrides.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if err := c.ErrorLogSuppression.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid api config: %w", err)
+	}
+
 	for _, e := range c.Endpoints {
 		if err := e.ValidateBasic(); err != nil {
 			return err
@@ -128,3 +245,81 @@ func (c *APIConfig) ValidateBasic() error {
 
 	return nil
 }
+
+// validateLocalAddress checks that the given local address, if set, is a valid IP address.
+func validateLocalAddress(address string) error {
+	if len(address) == 0 {
+		return nil
+	}
+
+	if net.ParseIP(address) == nil {
+		return fmt.Errorf("local address %s must be a valid IP address", address)
+	}
+
+	return nil
+}
+
+// GetMaxResponseSize returns the effective maximum size, in bytes, of a response body that the
+// provider will read.
+func (c *APIConfig) GetMaxResponseSize() int64 {
+	if c.MaxResponseSize == 0 {
+		return DefaultMaxResponseSize
+	}
+
+	return c.MaxResponseSize
+}
+
+// GetMaxTimeout returns the maximum timeout of the provider's requests, accounting for timeout
+// escalation.
+func (c *APIConfig) GetMaxTimeout() time.Duration {
+	if c.TimeoutEscalation.Enabled() {
+		return c.TimeoutEscalation.MaxTimeout
+	}
+
+	return c.Timeout
+}
+
+// EscalatedTimeout returns the timeout of the provider's requests after the given number of
+// consecutive timeouts.
+func (c *APIConfig) EscalatedTimeout(consecutiveTimeouts int) time.Duration {
+	if !c.TimeoutEscalation.Enabled() || consecutiveTimeouts <= 0 {
+		return c.Timeout
+	}
+
+	escalated := float64(c.Timeout) * math.Pow(c.TimeoutEscalation.Multiplier, float64(consecutiveTimeouts))
+	if escalated >= float64(c.TimeoutEscalation.MaxTimeout) {
+		return c.TimeoutEscalation.MaxTimeout
+	}
+
+	return time.Duration(escalated)
+}
+
+// ResolveURL returns the given URL with its scheme and host replaced by the configured base
+// URL. If no base URL is configured, the URL is returned unchanged.
+func (c *APIConfig) ResolveURL(rawURL string) (string, error) {
+	if len(c.BaseURL) == 0 {
+		return rawURL, nil
+	}
+
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid base url: %w", err)
+	}
+
+	resolved, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url: %w", err)
+	}
+
+	resolved.Scheme = base.Scheme
+	resolved.Host = base.Host
+	resolved.User = base.User
+	if prefix := strings.TrimSuffix(base.Path, "/"); len(prefix) > 0 {
+		resolved.Path = prefix + resolved.Path
+		if len(resolved.RawPath) > 0 {
+			resolved.RawPath = strings.TrimSuffix(base.EscapedPath(), "/") + resolved.RawPath
+		}
+	}
+
+	return resolved.String(), nil
+}
@@ -229,6 +229,152 @@ func TestAPIConfig(t *testing.T) {
 				BatchSize: 1,
 			},
 		},
+		{
+			name: "good config with base url",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				BaseURL:          "https://staging.test.com",
+			},
+		},
+		{
+			name: "bad config with negative max response size",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				MaxResponseSize:  -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with timeout escalation",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				TimeoutEscalation: config.TimeoutEscalationConfig{
+					Multiplier: 2,
+					MaxTimeout: 5 * time.Second,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with timeout escalation multiplier of 1",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				TimeoutEscalation: config.TimeoutEscalationConfig{
+					Multiplier: 1,
+					MaxTimeout: 5 * time.Second,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with timeout escalation max timeout below the timeout",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				TimeoutEscalation: config.TimeoutEscalationConfig{
+					Multiplier: 2,
+					MaxTimeout: 500 * time.Millisecond,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with base url missing a host",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				BaseURL:          "staging.test.com",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with local address",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				LocalAddress:     "10.0.0.5",
+			},
+		},
+		{
+			name: "bad config with local address that is not an ip address",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				URL:              "http://test.com",
+				LocalAddress:     "eth0",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with unrequested policy",
+			config: config.APIConfig{
+				Enabled:           true,
+				Timeout:           time.Second,
+				Interval:          time.Second,
+				ReconnectTimeout:  time.Second,
+				MaxQueries:        1,
+				Name:              "test",
+				URL:               "http://test.com",
+				UnrequestedPolicy: config.UnrequestedPolicyDrop,
+			},
+		},
+		{
+			name: "bad config with unsupported unrequested policy",
+			config: config.APIConfig{
+				Enabled:           true,
+				Timeout:           time.Second,
+				Interval:          time.Second,
+				ReconnectTimeout:  time.Second,
+				MaxQueries:        1,
+				Name:              "test",
+				URL:               "http://test.com",
+				UnrequestedPolicy: "warn",
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -242,3 +388,61 @@ func TestAPIConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIConfigResolveURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		baseURL  string
+		url      string
+		expected string
+	}{
+		{
+			name:     "no base url",
+			url:      "https://api.test.com/v2/prices/BTC-USD/spot",
+			expected: "https://api.test.com/v2/prices/BTC-USD/spot",
+		},
+		{
+			name:     "base url replaces the scheme and host",
+			baseURL:  "http://localhost:8080",
+			url:      "https://api.test.com/v2/prices/BTC-USD/spot?currency=usd",
+			expected: "http://localhost:8080/v2/prices/BTC-USD/spot?currency=usd",
+		},
+		{
+			name:     "base url path is prepended",
+			baseURL:  "https://mirror.test.com/eu/",
+			url:      "https://api.test.com/v2/prices/BTC-USD/spot",
+			expected: "https://mirror.test.com/eu/v2/prices/BTC-USD/spot",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.APIConfig{BaseURL: tc.baseURL}
+			resolved, err := cfg.ResolveURL(tc.url)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resolved)
+		})
+	}
+}
+
+func TestAPIConfigEscalatedTimeout(t *testing.T) {
+	cfg := config.APIConfig{
+		Timeout: time.Second,
+		TimeoutEscalation: config.TimeoutEscalationConfig{
+			Multiplier: 1.5,
+			MaxTimeout: 3 * time.Second,
+		},
+	}
+
+	require.Equal(t, time.Second, cfg.EscalatedTimeout(0))
+	require.Equal(t, 1500*time.Millisecond, cfg.EscalatedTimeout(1))
+	require.Equal(t, 2250*time.Millisecond, cfg.EscalatedTimeout(2))
+	require.Equal(t, 3*time.Second, cfg.EscalatedTimeout(3))
+	require.Equal(t, 3*time.Second, cfg.EscalatedTimeout(100))
+	require.Equal(t, 3*time.Second, cfg.GetMaxTimeout())
+
+	// Escalation is disabled by default.
+	cfg.TimeoutEscalation = config.TimeoutEscalationConfig{}
+	require.Equal(t, time.Second, cfg.EscalatedTimeout(3))
+	require.Equal(t, time.Second, cfg.GetMaxTimeout())
+}
@@ -38,6 +38,11 @@ client_timeout = "{{ .Oracle.ClientTimeout }}"
 # this enables instrumentation of the oracle client and the interaction between
 # the oracle and the app.
 metrics_enabled = "{{ .Oracle.MetricsEnabled }}"
+
+# EnableCompression determines whether the oracle client compresses its requests, and
+# asks the oracle sidecar to compress its responses, with gzip. This reduces bandwidth
+# when the oracle sidecar runs on a remote machine.
+enable_compression = "{{ .Oracle.EnableCompression }}"
 `
 )
 
@@ -46,6 +51,7 @@ const (
 	flagOracleAddress           = "oracle.oracle_address"
 	flagClientTimeout           = "oracle.client_timeout"
 	flagMetricsEnabled          = "oracle.metrics_enabled"
+	flagEnableCompression       = "oracle.enable_compression"
 	flagPrometheusServerAddress = "oracle.prometheus_server_address"
 )
 
@@ -65,6 +71,10 @@ type AppConfig struct {
 
 	// MetricsEnabled is a flag that determines whether oracle metrics are enabled.
 	MetricsEnabled bool `mapstructure:"metrics_enabled" toml:"metrics_enabled"`
+
+	// EnableCompression is a flag that determines whether requests to and responses from the
+	// oracle are compressed with gzip.
+	EnableCompression bool `mapstructure:"enable_compression" toml:"enable_compression"`
 }
 
 // ValidateBasic performs basic validation of the app config.
@@ -143,6 +153,13 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (AppConfig, error) {
 		}
 	}
 
+	// get the compression flag
+	if v := opts.Get(flagEnableCompression); v != nil {
+		if cfg.EnableCompression, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
 	if err := cfg.ValidateBasic(); err != nil {
 		return cfg, err
 	}
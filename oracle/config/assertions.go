@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ResponseAssertionsConfig is the configuration of the sanity checks that every result reported by
+// a provider must satisfy before it is utilized. Results that fail an assertion are dropped and
+// reported as failed responses, which allows operators to guard against provider specific bugs
+// without patching the provider. By default, no assertions are evaluated.
+type ResponseAssertionsConfig struct {
+	// PositivePrice asserts that every price reported by the provider is positive.
+	PositivePrice bool `json:"positivePrice"`
+
+	// RequestedIDs asserts that every result reported by the provider is for an ID, e.g. a
+	// currency pair, that the provider requested.
+	RequestedIDs bool `json:"requestedIDs"`
+
+	// MaxTimestampSkew asserts that the event timestamp reported by the provider for a result,
+	// i.e. the time at which the exchange produced it, is within the given duration of the time at
+	// which the result was received. Results without an event timestamp are not evaluated. A value
+	// of 0 disables the assertion.
+	MaxTimestampSkew time.Duration `json:"maxTimestampSkew"`
+}
+
+// Enabled returns true if the config declares at least one assertion.
+func (c ResponseAssertionsConfig) Enabled() bool {
+	return c.PositivePrice || c.RequestedIDs || c.MaxTimestampSkew > 0
+}
+
+// ValidateBasic performs basic validation of the response assertions config.
+func (c ResponseAssertionsConfig) ValidateBasic() error {
+	if c.MaxTimestampSkew < 0 {
+		return fmt.Errorf("response assertions max timestamp skew cannot be negative")
+	}
+
+	return nil
+}
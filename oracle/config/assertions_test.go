@@ -0,0 +1,57 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestResponseAssertionsConfig(t *testing.T) {
+	testCases := []struct {
+		name            string
+		config          config.ResponseAssertionsConfig
+		expectedEnabled bool
+		expectedErr     bool
+	}{
+		{
+			name:            "no assertions",
+			config:          config.ResponseAssertionsConfig{},
+			expectedEnabled: false,
+			expectedErr:     false,
+		},
+		{
+			name: "all assertions",
+			config: config.ResponseAssertionsConfig{
+				PositivePrice:    true,
+				RequestedIDs:     true,
+				MaxTimestampSkew: time.Minute,
+			},
+			expectedEnabled: true,
+			expectedErr:     false,
+		},
+		{
+			name: "negative max timestamp skew",
+			config: config.ResponseAssertionsConfig{
+				MaxTimestampSkew: -time.Minute,
+			},
+			expectedEnabled: false,
+			expectedErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedEnabled, tc.config.Enabled())
+
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
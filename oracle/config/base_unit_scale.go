@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MaxBaseUnitScale is the largest magnitude of the base unit scale of a provider.
+const MaxBaseUnitScale = 36
+
+// BaseUnitScale is the base-10 exponent by which the prices reported by a provider are multiplied to
+// normalize them to whole units, for providers that quote prices in a sub-unit of an asset. For
+// example, a provider that reports the price of a satoshi rather than of a whole bitcoin has a scale
+// of 8, whereas a provider that reports prices in wei rather than in whole ether has a scale of -18.
+// A scale of 0 reports prices in whole units.
+type BaseUnitScale int
+
+// Enabled returns true if the scale normalizes prices.
+func (s BaseUnitScale) Enabled() bool {
+	return s != 0
+}
+
+// ValidateBasic performs basic validation of the scale.
+func (s BaseUnitScale) ValidateBasic() error {
+	if s < -MaxBaseUnitScale || s > MaxBaseUnitScale {
+		return fmt.Errorf("base unit scale must be within [%d, %d]; got %d", -MaxBaseUnitScale, MaxBaseUnitScale, s)
+	}
+
+	return nil
+}
+
+// Apply returns the price normalized to whole units.
+func (s BaseUnitScale) Apply(price *big.Float) *big.Float {
+	normalized := new(big.Float).Copy(price)
+	if s == 0 {
+		return normalized
+	}
+
+	exp := int64(s)
+	if exp < 0 {
+		exp = -exp
+	}
+
+	factor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil))
+	if s > 0 {
+		return normalized.Mul(normalized, factor)
+	}
+
+	return normalized.Quo(normalized, factor)
+}
@@ -0,0 +1,97 @@
+package config_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestBaseUnitScale(t *testing.T) {
+	testCases := []struct {
+		name        string
+		scale       config.BaseUnitScale
+		expectedErr bool
+	}{
+		{
+			name:        "disabled scale",
+			scale:       0,
+			expectedErr: false,
+		},
+		{
+			name:        "positive scale",
+			scale:       8,
+			expectedErr: false,
+		},
+		{
+			name:        "negative scale",
+			scale:       -18,
+			expectedErr: false,
+		},
+		{
+			name:        "scale above the maximum",
+			scale:       config.MaxBaseUnitScale + 1,
+			expectedErr: true,
+		},
+		{
+			name:        "scale below the minimum",
+			scale:       -config.MaxBaseUnitScale - 1,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scale.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBaseUnitScaleApply(t *testing.T) {
+	testCases := []struct {
+		name     string
+		scale    config.BaseUnitScale
+		price    float64
+		expected float64
+	}{
+		{
+			name:     "no scale",
+			scale:    0,
+			price:    100,
+			expected: 100,
+		},
+		{
+			name:     "price of a satoshi",
+			scale:    8,
+			price:    0.0007,
+			expected: 70_000,
+		},
+		{
+			name:     "price in wei",
+			scale:    -18,
+			price:    3_500e18,
+			expected: 3_500,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price := big.NewFloat(tc.price)
+			normalized := tc.scale.Apply(price)
+
+			actual, _ := normalized.Float64()
+			require.InDelta(t, tc.expected, actual, 1e-9)
+
+			// The given price is never modified.
+			original, _ := price.Float64()
+			require.Equal(t, tc.price, original)
+		})
+	}
+}
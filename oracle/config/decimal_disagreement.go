@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"math"
+)
+
+// DecimalDisagreementConfig is the configuration used to guard against providers of the same
+// market reporting prices at different implied decimals. A market's providers disagree on
+// decimals if their converted prices split into clusters that are at least MinRatio apart, e.g.
+// one cluster around 60000 and another around 0.6. Rather than aggregating such prices into a
+// meaningless price, the market's price is withheld.
+type DecimalDisagreementConfig struct {
+	// Enabled is a flag that indicates whether the guard is enabled.
+	Enabled bool `json:"enabled"`
+
+	// MinRatio is the minimum ratio between two consecutive converted prices of a market, ordered
+	// by price, at which the prices are considered to be reported at different decimals, e.g. 100
+	// for a difference of two orders of magnitude.
+	MinRatio float64 `json:"minRatio"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *DecimalDisagreementConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MinRatio <= 1 || math.IsNaN(c.MinRatio) || math.IsInf(c.MinRatio, 0) {
+		return fmt.Errorf("decimal disagreement min ratio must be a finite number greater than 1")
+	}
+
+	return nil
+}
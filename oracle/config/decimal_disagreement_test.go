@@ -0,0 +1,67 @@
+package config_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestDecimalDisagreementConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.DecimalDisagreementConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.DecimalDisagreementConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.DecimalDisagreementConfig{
+				Enabled:  true,
+				MinRatio: 100,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "min ratio of 1",
+			config: config.DecimalDisagreementConfig{
+				Enabled:  true,
+				MinRatio: 1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "infinite min ratio",
+			config: config.DecimalDisagreementConfig{
+				Enabled:  true,
+				MinRatio: math.Inf(1),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "nan min ratio",
+			config: config.DecimalDisagreementConfig{
+				Enabled:  true,
+				MinRatio: math.NaN(),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
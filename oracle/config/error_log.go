@@ -0,0 +1,32 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ErrorLogSuppressionConfig is the configuration used to suppress repeated error logs of a
+// persistently failing provider. The first occurrence of a distinct error is logged at full
+// detail, and identical repeats are suppressed until the error changes or clears, with a summary
+// of the number of occurrences logged every SummaryInterval.
+type ErrorLogSuppressionConfig struct {
+	// Enabled is a flag that indicates whether repeated error logs are suppressed.
+	Enabled bool `json:"enabled"`
+
+	// SummaryInterval is the interval at which a summary of a suppressed error is logged while
+	// the error persists.
+	SummaryInterval time.Duration `json:"summaryInterval"`
+}
+
+// ValidateBasic performs basic validation of the error log suppression config.
+func (c *ErrorLogSuppressionConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.SummaryInterval <= 0 {
+		return fmt.Errorf("error log suppression summary interval must be greater than 0")
+	}
+
+	return nil
+}
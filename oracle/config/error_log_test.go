@@ -0,0 +1,50 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestErrorLogSuppressionConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.ErrorLogSuppressionConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.ErrorLogSuppressionConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.ErrorLogSuppressionConfig{
+				Enabled:         true,
+				SummaryInterval: time.Minute,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "summary interval not set",
+			config: config.ErrorLogSuppressionConfig{
+				Enabled: true,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+)
+
+// FieldOverrides maps the JSON field names expected by a provider's parser to the field names
+// actually returned by the provider, e.g. {"lastPrice": "last"} if an exchange renamed its
+// lastPrice field to last. The fields of every JSON object in the provider's responses are renamed
+// accordingly before they are parsed, which allows a provider to be adapted to a minor change of
+// its API via config. Fields that are not overridden keep their hard-coded names.
+type FieldOverrides map[string]string
+
+// ValidateBasic performs basic validation of the field overrides.
+func (o FieldOverrides) ValidateBasic() error {
+	actual := make(map[string]string, len(o))
+	for expected, field := range o {
+		if len(expected) == 0 || len(field) == 0 {
+			return fmt.Errorf("field overrides cannot contain empty field names")
+		}
+
+		if expected == field {
+			return fmt.Errorf("field %s cannot be overridden with itself", expected)
+		}
+
+		if other, ok := actual[field]; ok {
+			return fmt.Errorf("field %s cannot override both %s and %s", field, other, expected)
+		}
+		actual[field] = expected
+	}
+
+	return nil
+}
+
+// Renames returns the mapping of the field names returned by the provider to the field names
+// expected by the provider's parser.
+func (o FieldOverrides) Renames() map[string]string {
+	renames := make(map[string]string, len(o))
+	for expected, field := range o {
+		renames[field] = expected
+	}
+
+	return renames
+}
@@ -0,0 +1,91 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestFieldOverrides(t *testing.T) {
+	testCases := []struct {
+		name        string
+		overrides   config.FieldOverrides
+		expectedErr bool
+	}{
+		{
+			name:        "no overrides",
+			overrides:   nil,
+			expectedErr: false,
+		},
+		{
+			name: "good overrides",
+			overrides: config.FieldOverrides{
+				"lastPrice": "last",
+				"symbol":    "s",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "swapped fields",
+			overrides: config.FieldOverrides{
+				"bid": "ask",
+				"ask": "bid",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "empty expected field",
+			overrides: config.FieldOverrides{
+				"": "last",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty actual field",
+			overrides: config.FieldOverrides{
+				"lastPrice": "",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "field overridden with itself",
+			overrides: config.FieldOverrides{
+				"lastPrice": "lastPrice",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "actual field overrides several fields",
+			overrides: config.FieldOverrides{
+				"lastPrice": "last",
+				"price":     "last",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.overrides.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFieldOverridesRenames(t *testing.T) {
+	overrides := config.FieldOverrides{
+		"lastPrice": "last",
+		"symbol":    "s",
+	}
+
+	require.Equal(t, map[string]string{
+		"last": "lastPrice",
+		"s":    "symbol",
+	}, overrides.Renames())
+}
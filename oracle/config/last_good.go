@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// LastGoodConfig is the configuration used to smooth over brief provider outages. When a
+// provider's price for a market goes stale, its last good price continues to contribute to the
+// market's median with a reduced weight until the grace period has elapsed, after which the
+// provider is dropped as usual.
+type LastGoodConfig struct {
+	// GracePeriod is the amount of time after a provider's price was last seen fresh during
+	// which its last good price continues to be utilized. A value of 0 disables the mode.
+	GracePeriod time.Duration `json:"gracePeriod"`
+
+	// Weight is the weight of a last good price relative to a fresh price when calculating
+	// the median, e.g. 0.5 to count a last good price half as much as a fresh one. This must
+	// be in the range (0, 1] if the grace period is set.
+	Weight float64 `json:"weight"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *LastGoodConfig) ValidateBasic() error {
+	if c.GracePeriod < 0 {
+		return fmt.Errorf("last good grace period cannot be negative")
+	}
+
+	if c.GracePeriod == 0 {
+		return nil
+	}
+
+	if c.Weight <= 0 || c.Weight > 1 {
+		return fmt.Errorf("last good weight must be in the range (0, 1]; got %f", c.Weight)
+	}
+
+	return nil
+}
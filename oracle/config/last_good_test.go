@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestLastGoodConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.LastGoodConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.LastGoodConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.LastGoodConfig{
+				GracePeriod: 30 * time.Second,
+				Weight:      0.5,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative grace period",
+			config: config.LastGoodConfig{
+				GracePeriod: -time.Second,
+				Weight:      0.5,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero weight",
+			config: config.LastGoodConfig{
+				GracePeriod: 30 * time.Second,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "weight greater than 1",
+			config: config.LastGoodConfig{
+				GracePeriod: 30 * time.Second,
+				Weight:      1.5,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
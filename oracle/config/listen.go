@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// UnixListenAddressPrefix is the prefix of listen addresses that are unix socket paths.
+	UnixListenAddressPrefix = "unix://"
+
+	// TCPListenAddressPrefix is the optional prefix of listen addresses that are TCP addresses.
+	TCPListenAddressPrefix = "tcp://"
+)
+
+// ParseListenAddress returns the network and address of the given listen address. A listen address
+// is either a unix socket path prefixed with unix:// (e.g. unix:///var/run/slinky.sock), or a TCP
+// host and port optionally prefixed with tcp:// (e.g. 0.0.0.0:8080).
+func ParseListenAddress(addr string) (network, address string, err error) {
+	if path, ok := strings.CutPrefix(addr, UnixListenAddressPrefix); ok {
+		if len(path) == 0 {
+			return "", "", fmt.Errorf("unix listen address %q must specify a socket path", addr)
+		}
+
+		return "unix", path, nil
+	}
+
+	hostPort := strings.TrimPrefix(addr, TCPListenAddressPrefix)
+	if _, port, err := net.SplitHostPort(hostPort); err != nil || len(port) == 0 {
+		return "", "", fmt.Errorf("invalid tcp listen address %q: must be of the form host:port", addr)
+	}
+
+	return "tcp", hostPort, nil
+}
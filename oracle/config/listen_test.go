@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestParseListenAddress(t *testing.T) {
+	testCases := []struct {
+		name            string
+		addr            string
+		expectedNetwork string
+		expectedAddress string
+		expectedErr     bool
+	}{
+		{
+			name:            "tcp address",
+			addr:            "0.0.0.0:8080",
+			expectedNetwork: "tcp",
+			expectedAddress: "0.0.0.0:8080",
+		},
+		{
+			name:            "tcp address with a prefix",
+			addr:            "tcp://localhost:8080",
+			expectedNetwork: "tcp",
+			expectedAddress: "localhost:8080",
+		},
+		{
+			name:            "tcp address without a host",
+			addr:            ":8080",
+			expectedNetwork: "tcp",
+			expectedAddress: ":8080",
+		},
+		{
+			name:            "unix socket",
+			addr:            "unix:///var/run/slinky.sock",
+			expectedNetwork: "unix",
+			expectedAddress: "/var/run/slinky.sock",
+		},
+		{
+			name:        "unix socket without a path",
+			addr:        "unix://",
+			expectedErr: true,
+		},
+		{
+			name:        "tcp address without a port",
+			addr:        "localhost",
+			expectedErr: true,
+		},
+		{
+			name:        "empty address",
+			addr:        "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			network, address, err := config.ParseListenAddress(tc.addr)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNetwork, network)
+			require.Equal(t, tc.expectedAddress, address)
+		})
+	}
+}
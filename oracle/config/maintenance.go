@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaintenanceWindowTimeLayout is the layout of the start time of a maintenance window.
+const MaintenanceWindowTimeLayout = "15:04"
+
+// MaintenanceWindowConfig is the configuration of a recurring maintenance window of a provider, e.g.
+// the daily maintenance of an exchange. Exchanges typically keep publishing their last price during
+// maintenance, so prices reported by the provider are not utilized while a window is active.
+type MaintenanceWindowConfig struct {
+	// Start is the UTC time of day at which the window begins, formatted as HH:MM.
+	Start string `json:"start"`
+
+	// Duration is the length of the window. The window may extend into the next day, but cannot
+	// exceed 24 hours.
+	Duration time.Duration `json:"duration"`
+
+	// Weekdays is the optional set of days (e.g. Monday) on which the window begins. If empty, the
+	// window recurs every day.
+	Weekdays []string `json:"weekdays"`
+}
+
+// ValidateBasic performs basic validation of the maintenance window config.
+func (c MaintenanceWindowConfig) ValidateBasic() error {
+	if _, err := time.Parse(MaintenanceWindowTimeLayout, c.Start); err != nil {
+		return fmt.Errorf("maintenance window start %q must be formatted as HH:MM: %w", c.Start, err)
+	}
+
+	if c.Duration <= 0 || c.Duration > 24*time.Hour {
+		return fmt.Errorf("maintenance window duration must be within (0, 24h]; got %s", c.Duration)
+	}
+
+	for _, day := range c.Weekdays {
+		if _, ok := parseWeekday(day); !ok {
+			return fmt.Errorf("invalid maintenance window weekday %s", day)
+		}
+	}
+
+	return nil
+}
+
+// Contains returns true if the given time falls within an occurrence of the maintenance window.
+func (c MaintenanceWindowConfig) Contains(t time.Time) bool {
+	start, err := time.Parse(MaintenanceWindowTimeLayout, c.Start)
+	if err != nil {
+		return false
+	}
+
+	t = t.UTC()
+	today := time.Date(t.Year(), t.Month(), t.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC)
+
+	// The window may have begun on the previous day and extend past midnight.
+	for _, begin := range []time.Time{today, today.AddDate(0, 0, -1)} {
+		if !c.recursOn(begin.Weekday()) {
+			continue
+		}
+
+		if !t.Before(begin) && t.Before(begin.Add(c.Duration)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recursOn returns true if the window begins on the given day.
+func (c MaintenanceWindowConfig) recursOn(day time.Weekday) bool {
+	if len(c.Weekdays) == 0 {
+		return true
+	}
+
+	for _, d := range c.Weekdays {
+		if weekday, ok := parseWeekday(d); ok && weekday == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InMaintenance returns true if the given time falls within any of the maintenance windows.
+func InMaintenance(windows []MaintenanceWindowConfig, t time.Time) bool {
+	for _, window := range windows {
+		if window.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseWeekday parses a case-insensitive weekday name e.g. Monday.
+func parseWeekday(day string) (time.Weekday, bool) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), day) {
+			return weekday, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,123 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestMaintenanceWindowConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.MaintenanceWindowConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config",
+			config: config.MaintenanceWindowConfig{
+				Start:    "23:30",
+				Duration: time.Hour,
+				Weekdays: []string{"Monday", "thursday"},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "malformed start",
+			config: config.MaintenanceWindowConfig{
+				Start:    "11pm",
+				Duration: time.Hour,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero duration",
+			config: config.MaintenanceWindowConfig{
+				Start: "23:30",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duration longer than a day",
+			config: config.MaintenanceWindowConfig{
+				Start:    "23:30",
+				Duration: 25 * time.Hour,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid weekday",
+			config: config.MaintenanceWindowConfig{
+				Start:    "23:30",
+				Duration: time.Hour,
+				Weekdays: []string{"Funday"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowConfigContains(t *testing.T) {
+	// 2024-01-01 is a Monday.
+	window := config.MaintenanceWindowConfig{
+		Start:    "23:30",
+		Duration: time.Hour,
+		Weekdays: []string{"Monday"},
+	}
+
+	testCases := []struct {
+		name     string
+		time     time.Time
+		expected bool
+	}{
+		{
+			name:     "before the window",
+			time:     time.Date(2024, 1, 1, 23, 29, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "start of the window",
+			time:     time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name:     "window extends past midnight",
+			time:     time.Date(2024, 1, 2, 0, 15, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name:     "end of the window",
+			time:     time.Date(2024, 1, 2, 0, 30, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "window does not recur on other days",
+			time:     time.Date(2024, 1, 2, 23, 45, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "time in another location is converted to UTC",
+			time:     time.Date(2024, 1, 1, 18, 45, 0, 0, time.FixedZone("EST", -5*60*60)),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, window.Contains(tc.time))
+		})
+	}
+}
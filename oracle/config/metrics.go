@@ -14,6 +14,62 @@ type MetricsConfig struct {
 
 	// Enabled indicates whether metrics should be enabled.
 	Enabled bool `json:"enabled"`
+
+	// TLS is the optional TLS configuration of the prometheus server. If unset, metrics are
+	// served over plaintext HTTP.
+	TLS MetricsTLSConfig `json:"tls"`
+
+	// BasicAuth is the optional basic authentication configuration of the prometheus server. If
+	// unset, metrics are served without authentication.
+	BasicAuth MetricsBasicAuthConfig `json:"basicAuth"`
+}
+
+// MetricsTLSConfig is the TLS configuration of the prometheus server.
+type MetricsTLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate served by the prometheus server.
+	CertFile string `json:"certFile"`
+
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string `json:"keyFile"`
+}
+
+// Enabled returns true if TLS is configured.
+func (c MetricsTLSConfig) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// ValidateBasic performs basic validation of the config. The cert and key files must be set
+// atomically.
+func (c MetricsTLSConfig) ValidateBasic() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("prometheus tls cert file and key file must both be set or both be empty")
+	}
+
+	return nil
+}
+
+// MetricsBasicAuthConfig is the basic authentication configuration of the prometheus server.
+type MetricsBasicAuthConfig struct {
+	// Username is the username required to scrape metrics.
+	Username string `json:"username"`
+
+	// Password is the password required to scrape metrics.
+	Password string `json:"password"`
+}
+
+// Enabled returns true if basic authentication is configured.
+func (c MetricsBasicAuthConfig) Enabled() bool {
+	return c.Username != "" && c.Password != ""
+}
+
+// ValidateBasic performs basic validation of the config. The username and password must be set
+// atomically.
+func (c MetricsBasicAuthConfig) ValidateBasic() error {
+	if (c.Username == "") != (c.Password == "") {
+		return fmt.Errorf("prometheus basic auth username and password must both be set or both be empty")
+	}
+
+	return nil
 }
 
 // ValidateBasic performs basic validation of the config.
@@ -26,5 +82,9 @@ func (c *MetricsConfig) ValidateBasic() error {
 		return fmt.Errorf("must supply a non-empty prometheus server address if metrics are enabled")
 	}
 
-	return nil
+	if err := c.TLS.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return c.BasicAuth.ValidateBasic()
 }
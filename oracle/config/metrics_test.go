@@ -30,6 +30,44 @@ func TestMetricsConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with tls and basic auth",
+			config: config.MetricsConfig{
+				Enabled:                 true,
+				PrometheusServerAddress: "localhost:9090",
+				TLS: config.MetricsTLSConfig{
+					CertFile: "cert.pem",
+					KeyFile:  "key.pem",
+				},
+				BasicAuth: config.MetricsBasicAuthConfig{
+					Username: "prometheus",
+					Password: "secret",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with tls cert but no key",
+			config: config.MetricsConfig{
+				Enabled:                 true,
+				PrometheusServerAddress: "localhost:9090",
+				TLS: config.MetricsTLSConfig{
+					CertFile: "cert.pem",
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with basic auth username but no password",
+			config: config.MetricsConfig{
+				Enabled:                 true,
+				PrometheusServerAddress: "localhost:9090",
+				BasicAuth: config.MetricsBasicAuthConfig{
+					Username: "prometheus",
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name: "no metrics enabled",
 			config: config.MetricsConfig{
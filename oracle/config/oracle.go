@@ -7,6 +7,9 @@ import (
 	"github.com/spf13/viper"
 )
 
+// MaxStartupJitter is the maximum startup jitter that the oracle can be configured with.
+const MaxStartupJitter = 5 * time.Minute
+
 // OracleConfig is the over-arching config for the oracle sidecar and instrumentation. The
 // oracle is configured via a set of data providers (i.e. coinbase, binance, etc.) and a set
 // of currency pairs (i.e. BTC/USD, ETH/USD, etc.). The oracle will fetch prices from the
@@ -15,11 +18,119 @@ type OracleConfig struct {
 	// UpdateInterval is the interval at which the oracle will fetch prices from providers.
 	UpdateInterval time.Duration `json:"updateInterval"`
 
+	// AggregationInterval is the interval at which the oracle will recompute the index prices from
+	// the latest prices fetched from providers. This decouples the rate at which prices are
+	// published from the rate at which they are fetched. A value of 0 recomputes the index prices
+	// every update interval.
+	AggregationInterval time.Duration `json:"aggregationInterval"`
+
 	// MaxPriceAge is the maximum age of a price that the oracle will consider valid. If a
 	// price is older than this, the oracle will not consider it valid and will not return it in /prices
 	// requests.
 	MaxPriceAge time.Duration `json:"maxPriceAge"`
 
+	// NoDataGracePeriod is the amount of time a currency pair may go without a price after it
+	// is added to the oracle (i.e. on startup or on a market map update) before it is reported
+	// as failing rather than warming up. A value of 0 disables the grace period.
+	NoDataGracePeriod time.Duration `json:"noDataGracePeriod"`
+
+	// StablecoinDepeg is the configuration used to halt price derivations through stablecoins
+	// whose index price has deviated too far from 1.0.
+	StablecoinDepeg StablecoinDepegConfig `json:"stablecoinDepeg"`
+
+	// LastGood is the configuration used to keep utilizing a provider's last good price, with
+	// a reduced weight, for a grace period after the provider's price goes stale.
+	LastGood LastGoodConfig `json:"lastGood"`
+
+	// AggregationFallback is the configuration of the fallbacks used to price a market whose
+	// aggregation fails because it does not meet its minimum provider count.
+	AggregationFallback AggregationFallbackConfig `json:"aggregationFallback"`
+
+	// ProviderLag is the configuration used to warn about providers whose prices systematically
+	// lag the index price.
+	ProviderLag ProviderLagConfig `json:"providerLag"`
+
+	// ProviderCollapse is the configuration used to flag or withhold the prices of markets whose
+	// number of contributing providers drops sharply between two aggregations.
+	ProviderCollapse ProviderCollapseConfig `json:"providerCollapse"`
+
+	// DecimalDisagreement is the configuration used to withhold the prices of markets whose
+	// providers report prices at different implied decimals.
+	DecimalDisagreement DecimalDisagreementConfig `json:"decimalDisagreement"`
+
+	// ProviderSpread determines whether the spread between the highest and lowest provider prices
+	// that contribute to the price of each market is computed, served, and exposed via metrics.
+	ProviderSpread bool `json:"providerSpread"`
+
+	// AggregationAudit determines whether the aggregator records, for each market, whether each
+	// provider was included in its latest price and why excluded providers were excluded. The audit
+	// is served via the AggregationAudit endpoint.
+	AggregationAudit bool `json:"aggregationAudit"`
+
+	// MinSignificantDigits is the minimum number of significant digits of the scaled price of
+	// derived markets, i.e. markets priced through a conversion, below which a warning is logged.
+	// Markets may override this in their ticker metadata. A value of 0 disables the check.
+	MinSignificantDigits int `json:"minSignificantDigits"`
+
+	// AggregationWorkers is the number of workers used to aggregate prices across markets
+	// concurrently. A value of 0 or 1 aggregates markets sequentially.
+	AggregationWorkers int `json:"aggregationWorkers"`
+
+	// RejectMarketsWithoutProviders determines whether market maps containing enabled markets
+	// that are not supported by any enabled provider are rejected. If false, a warning is logged
+	// for such markets instead.
+	RejectMarketsWithoutProviders bool `json:"rejectMarketsWithoutProviders"`
+
+	// FailOnEmptyMarketMap determines whether the oracle fails to start if the market map
+	// resolved on startup, either from the market config or from the market map provider,
+	// contains no markets.
+	FailOnEmptyMarketMap bool `json:"failOnEmptyMarketMap"`
+
+	// RequiredProviders is the list of markets that must be configured with a specific set of
+	// providers. Market maps in which any of these markets lacks a required provider are rejected.
+	RequiredProviders []RequiredProvidersConfig `json:"requiredProviders"`
+
+	// ProviderPriority is the ordered list of providers, from most to least trusted, used to
+	// deterministically attribute an aggregated price to a single provider when several
+	// providers reported the same price. Providers that are not listed are ranked after the
+	// listed providers, by name.
+	ProviderPriority []string `json:"providerPriority"`
+
+	// PriceSnapshotPath is the path of the file used to persist the last known prices across
+	// restarts. Prices are saved on shutdown and restored on startup. If empty, prices are
+	// not persisted.
+	PriceSnapshotPath string `json:"priceSnapshotPath"`
+
+	// PriceExportPath is the path of the file to which the aggregated prices are written after
+	// every update, for consumers that read a file rather than query the oracle. The file is
+	// replaced atomically. If empty, prices are not exported.
+	PriceExportPath string `json:"priceExportPath"`
+
+	// DeviationAlerts is the configuration used to push alerts to a webhook when the price of a
+	// market moves or diverges too far.
+	DeviationAlerts DeviationAlertsConfig `json:"deviationAlerts"`
+
+	// ReferenceOracle is the configuration used to compare the aggregated prices against the
+	// prices published by a reference oracle.
+	ReferenceOracle ReferenceOracleConfig `json:"referenceOracle"`
+
+	// Refresh is the configuration of the on-demand price refresh, which fetches and aggregates
+	// the prices of a set of markets immediately rather than on the next update.
+	Refresh RefreshConfig `json:"refresh"`
+
+	// RateLimitGroups is the list of groups of providers that share a single rate limit, e.g.
+	// providers that query the same exchange from the same IP. The combined request rate of the
+	// providers in each group is governed by a single token bucket.
+	RateLimitGroups []RateLimitGroupConfig `json:"rateLimitGroups"`
+
+	// ProviderKillSwitch determines whether providers can be disabled and re-enabled at runtime via
+	// the oracle server's SetProviderKilled endpoint, e.g. during an exchange security incident.
+	ProviderKillSwitch bool `json:"providerKillSwitch"`
+
+	// ProviderKillSwitchToken is the bearer token that requests to the SetProviderKilled endpoint
+	// must present in their authorization header. It is required if the kill switch is enabled.
+	ProviderKillSwitchToken string `json:"providerKillSwitchToken"`
+
 	// Providers is the list of providers that the oracle will fetch prices from.
 	Providers []ProviderConfig `json:"providers"`
 
@@ -32,11 +143,29 @@ type OracleConfig struct {
 	// Metrics is the metrics configurations for the oracle.
 	Metrics MetricsConfig `json:"metrics"`
 
+	// Tracing is the configuration used to export OpenTelemetry traces of the oracle.
+	Tracing TracingConfig `json:"tracing"`
+
 	// Host is the host that the oracle will listen on.
 	Host string `json:"host"`
 
 	// Port is the port that the oracle will listen on.
 	Port string `json:"port"`
+
+	// ListenAddresses is the list of additional addresses that the oracle will listen on, e.g. a
+	// unix socket for local consumers. Each address is either a unix socket path prefixed with
+	// unix:// or a TCP host:port. The same oracle is served on Host:Port and on each of these.
+	ListenAddresses []string `json:"listenAddresses"`
+
+	// MaxConnections is the maximum number of concurrent client connections that the oracle
+	// server will serve. Requests on connections past the limit are rejected. A value of 0
+	// disables the limit.
+	MaxConnections int `json:"maxConnections"`
+
+	// StartupJitter is the maximum random delay before the oracle server begins accepting
+	// requests after startup. It staggers fleets of side-cars that are restarted at the same
+	// time. A value of 0 disables the delay.
+	StartupJitter time.Duration `json:"startupJitter"`
 }
 
 // ValidateBasic performs basic validation on the oracle config.
@@ -45,14 +174,100 @@ func (c *OracleConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle update interval must be greater than 0")
 	}
 
+	if c.AggregationInterval < 0 {
+		return fmt.Errorf("oracle aggregation interval cannot be negative")
+	}
+
 	if c.MaxPriceAge <= 0 {
 		return fmt.Errorf("oracle max price age must be greater than 0")
 	}
 
+	if c.NoDataGracePeriod < 0 {
+		return fmt.Errorf("oracle no data grace period cannot be negative")
+	}
+
+	if err := c.StablecoinDepeg.ValidateBasic(); err != nil {
+		return fmt.Errorf("stablecoin depeg config is not formatted correctly: %w", err)
+	}
+
+	if err := c.LastGood.ValidateBasic(); err != nil {
+		return fmt.Errorf("last good config is not formatted correctly: %w", err)
+	}
+
+	if err := c.AggregationFallback.ValidateBasic(); err != nil {
+		return fmt.Errorf("aggregation fallback config is not formatted correctly: %w", err)
+	}
+
+	if err := c.ProviderLag.ValidateBasic(); err != nil {
+		return fmt.Errorf("provider lag config is not formatted correctly: %w", err)
+	}
+
+	if err := c.ProviderCollapse.ValidateBasic(); err != nil {
+		return fmt.Errorf("provider collapse config is not formatted correctly: %w", err)
+	}
+
+	if err := c.DecimalDisagreement.ValidateBasic(); err != nil {
+		return fmt.Errorf("decimal disagreement config is not formatted correctly: %w", err)
+	}
+
+	if c.AggregationWorkers < 0 {
+		return fmt.Errorf("oracle aggregation workers cannot be negative")
+	}
+
+	if c.MinSignificantDigits < 0 {
+		return fmt.Errorf("oracle min significant digits cannot be negative")
+	}
+
+	seenRequired := make(map[string]struct{}, len(c.RequiredProviders))
+	for _, required := range c.RequiredProviders {
+		if err := required.ValidateBasic(); err != nil {
+			return fmt.Errorf("required providers config is not formatted correctly: %w", err)
+		}
+
+		if _, ok := seenRequired[required.CurrencyPair]; ok {
+			return fmt.Errorf("duplicate required providers market %s", required.CurrencyPair)
+		}
+		seenRequired[required.CurrencyPair] = struct{}{}
+	}
+
+	seenPriority := make(map[string]struct{}, len(c.ProviderPriority))
+	for _, provider := range c.ProviderPriority {
+		if len(provider) == 0 {
+			return fmt.Errorf("provider priority cannot contain an empty provider name")
+		}
+
+		if _, ok := seenPriority[provider]; ok {
+			return fmt.Errorf("duplicate provider priority provider %s", provider)
+		}
+		seenPriority[provider] = struct{}{}
+	}
+
+	if err := c.DeviationAlerts.ValidateBasic(); err != nil {
+		return fmt.Errorf("deviation alerts config is not formatted correctly: %w", err)
+	}
+
+	if err := c.ReferenceOracle.ValidateBasic(); err != nil {
+		return fmt.Errorf("reference oracle config is not formatted correctly: %w", err)
+	}
+
+	if err := c.Refresh.ValidateBasic(); err != nil {
+		return fmt.Errorf("refresh config is not formatted correctly: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(c.Providers))
 	for _, p := range c.Providers {
 		if err := p.ValidateBasic(); err != nil {
 			return fmt.Errorf("provider is not formatted correctly: %w", err)
 		}
+
+		if _, ok := seen[p.Name]; ok {
+			return fmt.Errorf("duplicate provider name %s", p.Name)
+		}
+		seen[p.Name] = struct{}{}
+	}
+
+	if err := ValidateRateLimitGroups(c.RateLimitGroups, c.Providers); err != nil {
+		return err
 	}
 
 	if len(c.Host) == 0 {
@@ -63,6 +278,34 @@ func (c *OracleConfig) ValidateBasic() error {
 		return fmt.Errorf("oracle port cannot be empty")
 	}
 
+	seenAddresses := make(map[string]struct{}, len(c.ListenAddresses))
+	for _, addr := range c.ListenAddresses {
+		if _, _, err := ParseListenAddress(addr); err != nil {
+			return err
+		}
+
+		if _, ok := seenAddresses[addr]; ok {
+			return fmt.Errorf("duplicate listen address %s", addr)
+		}
+		seenAddresses[addr] = struct{}{}
+	}
+
+	if c.ProviderKillSwitch && len(c.ProviderKillSwitchToken) == 0 {
+		return fmt.Errorf("provider kill switch token cannot be empty if the kill switch is enabled")
+	}
+
+	if c.MaxConnections < 0 {
+		return fmt.Errorf("oracle max connections cannot be negative")
+	}
+
+	if c.StartupJitter < 0 || c.StartupJitter > MaxStartupJitter {
+		return fmt.Errorf("oracle startup jitter must be between 0 and %s", MaxStartupJitter)
+	}
+
+	if err := c.Tracing.ValidateBasic(); err != nil {
+		return fmt.Errorf("tracing config is not formatted correctly: %w", err)
+	}
+
 	return c.Metrics.ValidateBasic()
 }
 
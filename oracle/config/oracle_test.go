@@ -77,6 +77,28 @@ func TestOracleConfig(t *testing.T) {
 			config:      config.OracleConfig{},
 			expectedErr: true,
 		},
+		{
+			name: "bad config with negative aggregation interval",
+			config: config.OracleConfig{
+				UpdateInterval:      time.Second,
+				AggregationInterval: -time.Second,
+				MaxPriceAge:         time.Minute,
+				Host:                "localhost",
+				Port:                "8080",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative min significant digits",
+			config: config.OracleConfig{
+				UpdateInterval:       time.Second,
+				MaxPriceAge:          time.Minute,
+				MinSignificantDigits: -1,
+				Host:                 "localhost",
+				Port:                 "8080",
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config with bad metrics",
 			config: config.OracleConfig{
@@ -165,6 +187,63 @@ func TestOracleConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "bad config with duplicate provider names",
+			config: config.OracleConfig{
+				UpdateInterval: time.Second,
+				MaxPriceAge:    time.Minute,
+				Providers: []config.ProviderConfig{
+					{
+						Name: "test",
+						WebSocket: config.WebSocketConfig{
+							Enabled:             true,
+							MaxBufferSize:       1,
+							ReconnectionTimeout: time.Second,
+							WSS:                 "wss://test.com",
+							Name:                "test",
+							ReadBufferSize:      config.DefaultReadBufferSize,
+							WriteBufferSize:     config.DefaultWriteBufferSize,
+							HandshakeTimeout:    config.DefaultHandshakeTimeout,
+							EnableCompression:   config.DefaultEnableCompression,
+							ReadTimeout:         config.DefaultReadTimeout,
+							WriteTimeout:        config.DefaultWriteTimeout,
+						},
+						Type: "price_provider",
+					},
+					{
+						Name: "test",
+						WebSocket: config.WebSocketConfig{
+							Enabled:             true,
+							MaxBufferSize:       1,
+							ReconnectionTimeout: time.Second,
+							WSS:                 "wss://test.com",
+							Name:                "test",
+							ReadBufferSize:      config.DefaultReadBufferSize,
+							WriteBufferSize:     config.DefaultWriteBufferSize,
+							HandshakeTimeout:    config.DefaultHandshakeTimeout,
+							EnableCompression:   config.DefaultEnableCompression,
+							ReadTimeout:         config.DefaultReadTimeout,
+							WriteTimeout:        config.DefaultWriteTimeout,
+						},
+						Type: "price_provider",
+					},
+				},
+				Host: "localhost",
+				Port: "8080",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with duplicate provider priority",
+			config: config.OracleConfig{
+				UpdateInterval:   time.Second,
+				MaxPriceAge:      time.Minute,
+				ProviderPriority: []string{"coinbase", "kraken", "coinbase"},
+				Host:             "localhost",
+				Port:             "8080",
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
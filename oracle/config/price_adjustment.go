@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	// MinPriceAdjustmentMultiplier is the smallest multiplier that can be applied to a provider's prices.
+	MinPriceAdjustmentMultiplier = 0.9
+	// MaxPriceAdjustmentMultiplier is the largest multiplier that can be applied to a provider's prices.
+	MaxPriceAdjustmentMultiplier = 1.1
+)
+
+// PriceAdjustmentConfig is the configuration used to correct a known systematic bias in the prices
+// of a provider, e.g. a venue that quotes fee-inclusive prices. Each price reported by the provider
+// is adjusted to price * multiplier + offset before it is aggregated.
+type PriceAdjustmentConfig struct {
+	// Multiplier is the multiplicative adjustment applied to each price. It must be within
+	// [MinPriceAdjustmentMultiplier, MaxPriceAdjustmentMultiplier]. A value of 0 disables the
+	// multiplicative adjustment.
+	Multiplier float64 `json:"multiplier"`
+
+	// Offset is the additive adjustment applied to each price after the multiplier. Since the
+	// offset is denominated in the quote currency of every market of the provider, it should only
+	// be utilized for providers that quote markets with similar prices. A value of 0 disables the
+	// additive adjustment.
+	Offset float64 `json:"offset"`
+}
+
+// Enabled returns true if the config adjusts prices.
+func (c PriceAdjustmentConfig) Enabled() bool {
+	return c.Multiplier != 0 || c.Offset != 0
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c PriceAdjustmentConfig) ValidateBasic() error {
+	if c.Multiplier != 0 && (c.Multiplier < MinPriceAdjustmentMultiplier || c.Multiplier > MaxPriceAdjustmentMultiplier) {
+		return fmt.Errorf(
+			"price adjustment multiplier must be within [%v, %v]; got %v",
+			MinPriceAdjustmentMultiplier,
+			MaxPriceAdjustmentMultiplier,
+			c.Multiplier,
+		)
+	}
+
+	if math.IsNaN(c.Offset) || math.IsInf(c.Offset, 0) {
+		return fmt.Errorf("price adjustment offset must be finite; got %v", c.Offset)
+	}
+
+	return nil
+}
+
+// Apply returns the adjusted price. An error is returned if the adjusted price is not positive, in
+// which case the price should not be utilized.
+func (c PriceAdjustmentConfig) Apply(price *big.Float) (*big.Float, error) {
+	adjusted := new(big.Float).Copy(price)
+	if c.Multiplier != 0 {
+		adjusted.Mul(adjusted, big.NewFloat(c.Multiplier))
+	}
+
+	if c.Offset != 0 {
+		adjusted.Add(adjusted, big.NewFloat(c.Offset))
+	}
+
+	if adjusted.Sign() <= 0 {
+		return nil, fmt.Errorf("adjusted price %s is not positive", adjusted.String())
+	}
+
+	return adjusted, nil
+}
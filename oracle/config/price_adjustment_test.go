@@ -0,0 +1,119 @@
+package config_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestPriceAdjustmentConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.PriceAdjustmentConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.PriceAdjustmentConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.PriceAdjustmentConfig{
+				Multiplier: 0.999,
+				Offset:     -0.5,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "multiplier below the minimum",
+			config: config.PriceAdjustmentConfig{
+				Multiplier: 0.5,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "multiplier above the maximum",
+			config: config.PriceAdjustmentConfig{
+				Multiplier: 2,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "non-finite offset",
+			config: config.PriceAdjustmentConfig{
+				Offset: math.Inf(1),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPriceAdjustmentConfigApply(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.PriceAdjustmentConfig
+		price       float64
+		expected    float64
+		expectedErr bool
+	}{
+		{
+			name:     "no adjustment",
+			config:   config.PriceAdjustmentConfig{},
+			price:    100,
+			expected: 100,
+		},
+		{
+			name:     "multiplier",
+			config:   config.PriceAdjustmentConfig{Multiplier: 1.01},
+			price:    100,
+			expected: 101,
+		},
+		{
+			name:     "multiplier and offset",
+			config:   config.PriceAdjustmentConfig{Multiplier: 0.99, Offset: 2},
+			price:    100,
+			expected: 101,
+		},
+		{
+			name:        "non-positive adjusted price",
+			config:      config.PriceAdjustmentConfig{Offset: -100},
+			price:       100,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price := big.NewFloat(tc.price)
+			adjusted, err := tc.config.Apply(price)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			actual, _ := adjusted.Float64()
+			require.InDelta(t, tc.expected, actual, 1e-9)
+
+			// The given price is never modified.
+			original, _ := price.Float64()
+			require.Equal(t, tc.price, original)
+		})
+	}
+}
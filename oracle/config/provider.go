@@ -21,6 +21,28 @@ type ProviderConfig struct {
 	// Type is the type of the provider (i.e. price, market map, other). This is used
 	// to determine how to construct the provider.
 	Type string `json:"type"`
+
+	// MinVolume is the minimum reported volume required for a price from this provider
+	// to be utilized. Prices that are not reported with a volume are always utilized. A
+	// value of 0 disables the check.
+	MinVolume float64 `json:"minVolume"`
+
+	// BaseUnitScale is the base-10 exponent by which every price reported by the provider is
+	// multiplied to normalize it to whole units before aggregation, for providers that quote prices
+	// in a sub-unit of an asset e.g. satoshis or wei. A value of 0 disables the normalization.
+	BaseUnitScale BaseUnitScale `json:"baseUnitScale"`
+
+	// PriceAdjustment is an optional adjustment applied to every price reported by the provider
+	// before aggregation. This can be utilized to correct a known systematic bias of the provider.
+	PriceAdjustment PriceAdjustmentConfig `json:"priceAdjustment"`
+
+	// MaintenanceWindows is the optional set of scheduled maintenance windows of the provider.
+	// Prices reported by the provider are not utilized while a window is active.
+	MaintenanceWindows []MaintenanceWindowConfig `json:"maintenanceWindows"`
+
+	// Assertions is the optional set of sanity checks that every result reported by the provider
+	// must satisfy before it is utilized.
+	Assertions ResponseAssertionsConfig `json:"assertions"`
 }
 
 func (c *ProviderConfig) ValidateBasic() error {
@@ -60,5 +82,27 @@ func (c *ProviderConfig) ValidateBasic() error {
 		return fmt.Errorf("type cannot be empty")
 	}
 
+	if c.MinVolume < 0 {
+		return fmt.Errorf("provider %s min volume cannot be negative", c.Name)
+	}
+
+	if err := c.BaseUnitScale.ValidateBasic(); err != nil {
+		return fmt.Errorf("base unit scale for %s is not formatted correctly: %w", c.Name, err)
+	}
+
+	if err := c.PriceAdjustment.ValidateBasic(); err != nil {
+		return fmt.Errorf("price adjustment for %s is not formatted correctly: %w", c.Name, err)
+	}
+
+	for _, window := range c.MaintenanceWindows {
+		if err := window.ValidateBasic(); err != nil {
+			return fmt.Errorf("maintenance window for %s is not formatted correctly: %w", c.Name, err)
+		}
+	}
+
+	if err := c.Assertions.ValidateBasic(); err != nil {
+		return fmt.Errorf("response assertions for %s are not formatted correctly: %w", c.Name, err)
+	}
+
 	return nil
 }
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+)
+
+// ProviderCollapseAction is the action taken on a market whose number of contributing providers
+// collapsed.
+type ProviderCollapseAction string
+
+const (
+	// ProviderCollapseActionWithhold withholds the price of a collapsed market until its number of
+	// contributing providers stabilizes. The market is reported as failing in the meantime.
+	ProviderCollapseActionWithhold ProviderCollapseAction = "withhold"
+	// ProviderCollapseActionFlag continues to publish the price of a collapsed market, but logs a
+	// warning and flags the market in the metrics until its number of contributing providers
+	// stabilizes.
+	ProviderCollapseActionFlag ProviderCollapseAction = "flag"
+)
+
+// ProviderCollapseConfig is the configuration used to guard against a sudden collapse of the
+// number of providers contributing to a market's price, e.g. due to a correlated outage of
+// several feeds. A market collapses if its number of contributing providers drops by more than
+// MaxDrop between two consecutive aggregations. A collapsed market is released once its number of
+// contributing providers recovers, or once it has not dropped further for StabilizationCycles
+// consecutive aggregations.
+type ProviderCollapseConfig struct {
+	// Enabled is a flag that indicates whether the guard is enabled.
+	Enabled bool `json:"enabled"`
+
+	// MaxDrop is the maximum number of contributing providers a market may lose between two
+	// consecutive aggregations before it is considered collapsed. A value of 0 considers any drop
+	// a collapse.
+	MaxDrop int `json:"maxDrop"`
+
+	// StabilizationCycles is the number of consecutive aggregations in which the number of
+	// contributing providers of a collapsed market must not drop further for it to be released.
+	StabilizationCycles int `json:"stabilizationCycles"`
+
+	// Action is the action taken on a collapsed market. This must be one of withhold or flag.
+	Action ProviderCollapseAction `json:"action"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *ProviderCollapseConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MaxDrop < 0 {
+		return fmt.Errorf("provider collapse max drop cannot be negative")
+	}
+
+	if c.StabilizationCycles <= 0 {
+		return fmt.Errorf("provider collapse stabilization cycles must be greater than 0")
+	}
+
+	switch c.Action {
+	case ProviderCollapseActionWithhold, ProviderCollapseActionFlag:
+	default:
+		return fmt.Errorf("unknown provider collapse action %q", c.Action)
+	}
+
+	return nil
+}
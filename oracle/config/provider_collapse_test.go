@@ -0,0 +1,91 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestProviderCollapseConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.ProviderCollapseConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.ProviderCollapseConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good withhold config",
+			config: config.ProviderCollapseConfig{
+				Enabled:             true,
+				MaxDrop:             2,
+				StabilizationCycles: 5,
+				Action:              config.ProviderCollapseActionWithhold,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good flag config with any drop",
+			config: config.ProviderCollapseConfig{
+				Enabled:             true,
+				StabilizationCycles: 1,
+				Action:              config.ProviderCollapseActionFlag,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative max drop",
+			config: config.ProviderCollapseConfig{
+				Enabled:             true,
+				MaxDrop:             -1,
+				StabilizationCycles: 5,
+				Action:              config.ProviderCollapseActionWithhold,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "stabilization cycles not set",
+			config: config.ProviderCollapseConfig{
+				Enabled: true,
+				MaxDrop: 2,
+				Action:  config.ProviderCollapseActionWithhold,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "action not set",
+			config: config.ProviderCollapseConfig{
+				Enabled:             true,
+				MaxDrop:             2,
+				StabilizationCycles: 5,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unknown action",
+			config: config.ProviderCollapseConfig{
+				Enabled:             true,
+				MaxDrop:             2,
+				StabilizationCycles: 5,
+				Action:              "halt",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
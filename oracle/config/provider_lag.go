@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+)
+
+// ProviderLagConfig is the configuration used to detect providers whose prices systematically
+// lag the index price. Each time the index price of a market moves, a provider's price for the
+// market is considered to lag if it is closer to the previous index price than to the current
+// one. A provider is reported as lagging if it lagged in at least Threshold of the last Window
+// moves of the index price.
+type ProviderLagConfig struct {
+	// Window is the number of moves of a market's index price over which each provider's lag is
+	// evaluated. A value of 0 disables the detection.
+	Window int `json:"window"`
+
+	// Threshold is the minimum fraction of the moves in the window in which a provider lagged
+	// for it to be reported as lagging, e.g. 0.8 for 80%. This must be in the range (0.5, 1] if
+	// the window is set, since a provider whose price is merely noisy lags about half the time.
+	Threshold float64 `json:"threshold"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *ProviderLagConfig) ValidateBasic() error {
+	if c.Window < 0 {
+		return fmt.Errorf("provider lag window cannot be negative")
+	}
+
+	if c.Window == 0 {
+		return nil
+	}
+
+	if c.Threshold <= 0.5 || c.Threshold > 1 {
+		return fmt.Errorf("provider lag threshold must be in the range (0.5, 1]; got %f", c.Threshold)
+	}
+
+	return nil
+}
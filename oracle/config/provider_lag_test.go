@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestProviderLagConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.ProviderLagConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.ProviderLagConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.ProviderLagConfig{
+				Window:    20,
+				Threshold: 0.8,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative window",
+			config: config.ProviderLagConfig{
+				Window:    -1,
+				Threshold: 0.8,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "threshold not set",
+			config: config.ProviderLagConfig{
+				Window: 20,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "threshold of a noisy provider",
+			config: config.ProviderLagConfig{
+				Window:    20,
+				Threshold: 0.5,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "threshold greater than 1",
+			config: config.ProviderLagConfig{
+				Window:    20,
+				Threshold: 1.5,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -165,6 +165,91 @@ func TestProviderConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "negative min volume",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					URL:              "http://test.com",
+				},
+				Name:      "test",
+				Type:      "price_provider",
+				MinVolume: -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid price adjustment",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					URL:              "http://test.com",
+				},
+				Name: "test",
+				Type: "price_provider",
+				PriceAdjustment: config.PriceAdjustmentConfig{
+					Multiplier: 1.5,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad maintenance window",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					URL:              "http://test.com",
+				},
+				Name: "test",
+				Type: "price_provider",
+				MaintenanceWindows: []config.MaintenanceWindowConfig{
+					{
+						Start:    "25:00",
+						Duration: time.Hour,
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad response assertions",
+			config: config.ProviderConfig{
+				API: config.APIConfig{
+					Enabled:          true,
+					Timeout:          time.Second,
+					Interval:         time.Second,
+					ReconnectTimeout: time.Second,
+					MaxQueries:       1,
+					Name:             "test",
+					Atomic:           true,
+					URL:              "http://test.com",
+				},
+				Name: "test",
+				Type: "price_provider",
+				Assertions: config.ResponseAssertionsConfig{
+					MaxTimestampSkew: -time.Second,
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name: "no type",
 			config: config.ProviderConfig{
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"math"
+)
+
+// RateLimitGroupConfig declares a set of providers that share a single rate limit, e.g. several
+// providers that query the same exchange from the same IP. The combined request rate of the
+// providers in the group is governed by a single token bucket.
+type RateLimitGroupConfig struct {
+	// Name is the name of the rate limit group.
+	Name string `json:"name"`
+
+	// Providers is the list of provider names that share the rate limit.
+	Providers []string `json:"providers"`
+
+	// RequestsPerSecond is the combined number of requests per second that the providers in the
+	// group are allowed to make.
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+
+	// Burst is the maximum number of requests that the providers in the group are allowed to
+	// make at once.
+	Burst int `json:"burst"`
+}
+
+// ValidateBasic performs basic validation of the rate limit group config.
+func (c *RateLimitGroupConfig) ValidateBasic() error {
+	if len(c.Name) == 0 {
+		return fmt.Errorf("rate limit group name cannot be empty")
+	}
+
+	if len(c.Providers) == 0 {
+		return fmt.Errorf("rate limit group %s providers cannot be empty", c.Name)
+	}
+
+	seen := make(map[string]struct{}, len(c.Providers))
+	for _, provider := range c.Providers {
+		if len(provider) == 0 {
+			return fmt.Errorf("rate limit group %s provider name cannot be empty", c.Name)
+		}
+
+		if _, ok := seen[provider]; ok {
+			return fmt.Errorf("duplicate provider %s in rate limit group %s", provider, c.Name)
+		}
+		seen[provider] = struct{}{}
+	}
+
+	if c.RequestsPerSecond <= 0 || math.IsNaN(c.RequestsPerSecond) || math.IsInf(c.RequestsPerSecond, 0) {
+		return fmt.Errorf("rate limit group %s requests per second must be a finite number greater than 0", c.Name)
+	}
+
+	if c.Burst < 1 {
+		return fmt.Errorf("rate limit group %s burst must be at least 1", c.Name)
+	}
+
+	return nil
+}
+
+// ValidateRateLimitGroups validates the given rate limit groups against the configured
+// providers. Group names must be unique, and each provider may belong to at most one group and
+// must be a configured provider that makes API requests.
+func ValidateRateLimitGroups(groups []RateLimitGroupConfig, providers []ProviderConfig) error {
+	apiProviders := make(map[string]bool, len(providers))
+	for _, p := range providers {
+		apiProviders[p.Name] = p.API.Enabled
+	}
+
+	seenGroups := make(map[string]struct{}, len(groups))
+	grouped := make(map[string]string)
+	for _, group := range groups {
+		if err := group.ValidateBasic(); err != nil {
+			return fmt.Errorf("rate limit group config is not formatted correctly: %w", err)
+		}
+
+		if _, ok := seenGroups[group.Name]; ok {
+			return fmt.Errorf("duplicate rate limit group %s", group.Name)
+		}
+		seenGroups[group.Name] = struct{}{}
+
+		for _, provider := range group.Providers {
+			apiEnabled, ok := apiProviders[provider]
+			if !ok {
+				return fmt.Errorf("rate limit group %s references unknown provider %s", group.Name, provider)
+			}
+
+			if !apiEnabled {
+				return fmt.Errorf("rate limit group %s provider %s is not an api provider", group.Name, provider)
+			}
+
+			if other, ok := grouped[provider]; ok {
+				return fmt.Errorf("provider %s belongs to rate limit groups %s and %s", provider, other, group.Name)
+			}
+			grouped[provider] = group.Name
+		}
+	}
+
+	return nil
+}
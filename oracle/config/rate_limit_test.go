@@ -0,0 +1,180 @@
+package config_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestRateLimitGroupConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.RateLimitGroupConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config",
+			config: config.RateLimitGroupConfig{
+				Name:              "exchange",
+				Providers:         []string{"provider1", "provider2"},
+				RequestsPerSecond: 0.5,
+				Burst:             1,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "empty name",
+			config: config.RateLimitGroupConfig{
+				Providers:         []string{"provider1"},
+				RequestsPerSecond: 1,
+				Burst:             1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no providers",
+			config: config.RateLimitGroupConfig{
+				Name:              "exchange",
+				RequestsPerSecond: 1,
+				Burst:             1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty provider name",
+			config: config.RateLimitGroupConfig{
+				Name:              "exchange",
+				Providers:         []string{""},
+				RequestsPerSecond: 1,
+				Burst:             1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate providers",
+			config: config.RateLimitGroupConfig{
+				Name:              "exchange",
+				Providers:         []string{"provider1", "provider1"},
+				RequestsPerSecond: 1,
+				Burst:             1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero requests per second",
+			config: config.RateLimitGroupConfig{
+				Name:      "exchange",
+				Providers: []string{"provider1"},
+				Burst:     1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "infinite requests per second",
+			config: config.RateLimitGroupConfig{
+				Name:              "exchange",
+				Providers:         []string{"provider1"},
+				RequestsPerSecond: math.Inf(1),
+				Burst:             1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero burst",
+			config: config.RateLimitGroupConfig{
+				Name:              "exchange",
+				Providers:         []string{"provider1"},
+				RequestsPerSecond: 1,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRateLimitGroups(t *testing.T) {
+	providers := []config.ProviderConfig{
+		{Name: "api1", API: config.APIConfig{Enabled: true}},
+		{Name: "api2", API: config.APIConfig{Enabled: true}},
+		{Name: "ws1", WebSocket: config.WebSocketConfig{Enabled: true}},
+	}
+
+	group := func(name string, providers ...string) config.RateLimitGroupConfig {
+		return config.RateLimitGroupConfig{
+			Name:              name,
+			Providers:         providers,
+			RequestsPerSecond: 1,
+			Burst:             1,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		groups      []config.RateLimitGroupConfig
+		expectedErr bool
+	}{
+		{
+			name:        "no groups",
+			expectedErr: false,
+		},
+		{
+			name:        "providers share a group",
+			groups:      []config.RateLimitGroupConfig{group("exchange", "api1", "api2")},
+			expectedErr: false,
+		},
+		{
+			name:        "providers in separate groups",
+			groups:      []config.RateLimitGroupConfig{group("exchange1", "api1"), group("exchange2", "api2")},
+			expectedErr: false,
+		},
+		{
+			name:        "invalid group",
+			groups:      []config.RateLimitGroupConfig{{Name: "exchange", Providers: []string{"api1"}}},
+			expectedErr: true,
+		},
+		{
+			name:        "duplicate group names",
+			groups:      []config.RateLimitGroupConfig{group("exchange", "api1"), group("exchange", "api2")},
+			expectedErr: true,
+		},
+		{
+			name:        "unknown provider",
+			groups:      []config.RateLimitGroupConfig{group("exchange", "api3")},
+			expectedErr: true,
+		},
+		{
+			name:        "websocket provider",
+			groups:      []config.RateLimitGroupConfig{group("exchange", "ws1")},
+			expectedErr: true,
+		},
+		{
+			name:        "provider in several groups",
+			groups:      []config.RateLimitGroupConfig{group("exchange1", "api1"), group("exchange2", "api1", "api2")},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := config.ValidateRateLimitGroups(tc.groups, providers)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"net/url"
+	"slices"
+)
+
+// RedactedValue is the value that secrets are replaced with in a redacted config.
+const RedactedValue = "[REDACTED]"
+
+// Redacted returns a copy of the config in which secrets are redacted, so that the config can be
+// safely exported e.g. for debugging. The API keys of the providers' endpoints and the password of
+// the metrics server are replaced with RedactedValue, as are the provider kill switch token and the
+// deviation alerts webhook URL, since webhook URLs commonly embed a token. Passwords embedded in the providers' URLs and in the tracing
+// endpoint are redacted as well. The config itself is not modified.
+func (c OracleConfig) Redacted() OracleConfig {
+	redacted := c
+
+	redacted.Providers = slices.Clone(c.Providers)
+	for i := range redacted.Providers {
+		redacted.Providers[i] = redacted.Providers[i].Redacted()
+	}
+
+	redacted.Metrics.BasicAuth.Password = redactSecret(c.Metrics.BasicAuth.Password)
+	redacted.ProviderKillSwitchToken = redactSecret(c.ProviderKillSwitchToken)
+	redacted.DeviationAlerts.WebhookURL = redactSecret(c.DeviationAlerts.WebhookURL)
+	redacted.Tracing.Endpoint = redactURL(c.Tracing.Endpoint)
+
+	return redacted
+}
+
+// Redacted returns a copy of the provider config in which the API keys of the provider's endpoints
+// and any passwords embedded in the provider's URLs are redacted.
+func (c ProviderConfig) Redacted() ProviderConfig {
+	redacted := c
+
+	redacted.API.URL = redactURL(c.API.URL)
+	redacted.API.BaseURL = redactURL(c.API.BaseURL)
+	redacted.API.Endpoints = slices.Clone(c.API.Endpoints)
+	for i, endpoint := range redacted.API.Endpoints {
+		redacted.API.Endpoints[i].URL = redactURL(endpoint.URL)
+		redacted.API.Endpoints[i].Authentication.APIKey = redactSecret(endpoint.Authentication.APIKey)
+	}
+
+	redacted.WebSocket.WSS = redactURL(c.WebSocket.WSS)
+
+	return redacted
+}
+
+// redactSecret returns RedactedValue if the secret is set, and an empty string otherwise, so that
+// a redacted config still shows whether the secret is configured.
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	return RedactedValue
+}
+
+// redactURL returns the URL with the password of its user info, if any, redacted. URLs that
+// cannot be parsed are returned as is.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+
+	if _, ok := u.User.Password(); !ok {
+		return rawURL
+	}
+
+	u.User = url.UserPassword(u.User.Username(), RedactedValue)
+	return u.String()
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReferenceOracleConfig is the configuration used to compare the aggregated prices of the oracle
+// against the prices published by a reference oracle, i.e. another slinky side-car that is
+// configured with an independent set of providers. This guards against systemic errors that
+// affect every provider of the oracle, which cross-checks between providers cannot detect.
+type ReferenceOracleConfig struct {
+	// Enabled is a flag that indicates whether the reference oracle check is enabled.
+	Enabled bool `json:"enabled"`
+
+	// Address is the gRPC address of the reference oracle e.g. localhost:8080.
+	Address string `json:"address"`
+
+	// Interval is the interval at which prices are fetched from the reference oracle.
+	Interval time.Duration `json:"interval"`
+
+	// Timeout is the timeout of each request to the reference oracle.
+	Timeout time.Duration `json:"timeout"`
+
+	// MaxPriceAge is the maximum age of the reference prices. Markets are not compared once the
+	// last successfully fetched reference prices are older than this.
+	MaxPriceAge time.Duration `json:"maxPriceAge"`
+
+	// MaxDeviation is the maximum relative deviation of an aggregated price from the reference
+	// price, e.g. 0.05 for 5%, before the market is considered divergent.
+	MaxDeviation float64 `json:"maxDeviation"`
+
+	// Withhold determines whether divergent markets are withheld from the oracle's prices. If
+	// false, divergence is only reported.
+	Withhold bool `json:"withhold"`
+}
+
+// ValidateBasic performs basic validation of the reference oracle config.
+func (c *ReferenceOracleConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if len(c.Address) == 0 {
+		return fmt.Errorf("reference oracle address cannot be empty")
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("reference oracle interval must be greater than 0")
+	}
+
+	if c.Timeout <= 0 || c.Timeout > c.Interval {
+		return fmt.Errorf("reference oracle timeout must be greater than 0 and at most the interval")
+	}
+
+	if c.MaxPriceAge < c.Interval {
+		return fmt.Errorf("reference oracle max price age must be at least the interval")
+	}
+
+	if c.MaxDeviation <= 0 {
+		return fmt.Errorf("reference oracle max deviation must be greater than 0")
+	}
+
+	return nil
+}
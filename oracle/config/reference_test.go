@@ -0,0 +1,100 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestReferenceOracleConfig(t *testing.T) {
+	validConfig := func() config.ReferenceOracleConfig {
+		return config.ReferenceOracleConfig{
+			Enabled:      true,
+			Address:      "localhost:8080",
+			Interval:     time.Second,
+			Timeout:      500 * time.Millisecond,
+			MaxPriceAge:  time.Minute,
+			MaxDeviation: 0.05,
+			Withhold:     true,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		config      func() config.ReferenceOracleConfig
+		expectedErr bool
+	}{
+		{
+			name: "disabled config",
+			config: func() config.ReferenceOracleConfig {
+				return config.ReferenceOracleConfig{}
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "good config",
+			config:      validConfig,
+			expectedErr: false,
+		},
+		{
+			name: "empty address",
+			config: func() config.ReferenceOracleConfig {
+				cfg := validConfig()
+				cfg.Address = ""
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero interval",
+			config: func() config.ReferenceOracleConfig {
+				cfg := validConfig()
+				cfg.Interval = 0
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "timeout longer than the interval",
+			config: func() config.ReferenceOracleConfig {
+				cfg := validConfig()
+				cfg.Timeout = 2 * time.Second
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "max price age shorter than the interval",
+			config: func() config.ReferenceOracleConfig {
+				cfg := validConfig()
+				cfg.MaxPriceAge = 500 * time.Millisecond
+				return cfg
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero max deviation",
+			config: func() config.ReferenceOracleConfig {
+				cfg := validConfig()
+				cfg.MaxDeviation = 0
+				return cfg
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := tc.config()
+			err := cfg.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
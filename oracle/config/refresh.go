@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// RefreshConfig is the configuration of the on-demand price refresh, which allows clients to
+// trigger an immediate fetch and aggregation of the prices of a set of markets between two
+// updates of the oracle. Refreshes are rate-limited to protect the providers' APIs.
+type RefreshConfig struct {
+	// Enabled is a flag that indicates whether on-demand refreshes are enabled.
+	Enabled bool `json:"enabled"`
+
+	// MinInterval is the minimum amount of time between two refreshes. Refreshes requested
+	// sooner are rejected.
+	MinInterval time.Duration `json:"minInterval"`
+
+	// Timeout is the maximum amount of time spent fetching prices from the providers during a
+	// refresh.
+	Timeout time.Duration `json:"timeout"`
+}
+
+// ValidateBasic performs basic validation of the refresh config.
+func (c *RefreshConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MinInterval <= 0 {
+		return fmt.Errorf("refresh min interval must be greater than 0")
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("refresh timeout must be greater than 0")
+	}
+
+	return nil
+}
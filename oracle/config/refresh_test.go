@@ -0,0 +1,60 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestRefreshConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.RefreshConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.RefreshConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.RefreshConfig{
+				Enabled:     true,
+				MinInterval: time.Second,
+				Timeout:     500 * time.Millisecond,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "min interval not set",
+			config: config.RefreshConfig{
+				Enabled: true,
+				Timeout: 500 * time.Millisecond,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "timeout not set",
+			config: config.RefreshConfig{
+				Enabled:     true,
+				MinInterval: time.Second,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
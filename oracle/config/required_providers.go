@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// RequiredProvidersConfig declares the providers that a market must be configured with in the
+// market map, e.g. a regulated venue that must always contribute to the market's price. Market
+// maps in which the market is missing any of its required providers are rejected.
+type RequiredProvidersConfig struct {
+	// CurrencyPair is the currency pair of the market e.g. BTC/USD.
+	CurrencyPair string `json:"currencyPair"`
+
+	// Providers is the list of provider names that the market must be configured with.
+	Providers []string `json:"providers"`
+}
+
+// ValidateBasic performs basic validation of the required providers config.
+func (c *RequiredProvidersConfig) ValidateBasic() error {
+	if _, err := slinkytypes.SpotCurrencyPairFromString(c.CurrencyPair); err != nil {
+		return fmt.Errorf("invalid required providers market %s: %w", c.CurrencyPair, err)
+	}
+
+	if len(c.Providers) == 0 {
+		return fmt.Errorf("required providers for %s cannot be empty", c.CurrencyPair)
+	}
+
+	seen := make(map[string]struct{}, len(c.Providers))
+	for _, provider := range c.Providers {
+		if len(provider) == 0 {
+			return fmt.Errorf("required provider name for %s cannot be empty", c.CurrencyPair)
+		}
+
+		if _, ok := seen[provider]; ok {
+			return fmt.Errorf("duplicate required provider %s for %s", provider, c.CurrencyPair)
+		}
+		seen[provider] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestRequiredProvidersConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.RequiredProvidersConfig
+		expectedErr bool
+	}{
+		{
+			name: "good config",
+			config: config.RequiredProvidersConfig{
+				CurrencyPair: "BTC/USD",
+				Providers:    []string{"coinbase_api", "kraken_api"},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "invalid currency pair",
+			config: config.RequiredProvidersConfig{
+				CurrencyPair: "BTCUSD",
+				Providers:    []string{"coinbase_api"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "no providers",
+			config: config.RequiredProvidersConfig{
+				CurrencyPair: "BTC/USD",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty provider name",
+			config: config.RequiredProvidersConfig{
+				CurrencyPair: "BTC/USD",
+				Providers:    []string{""},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate providers",
+			config: config.RequiredProvidersConfig{
+				CurrencyPair: "BTC/USD",
+				Providers:    []string{"coinbase_api", "coinbase_api"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// StablecoinDepegConfig is the configuration used to guard against stablecoin depegs when
+// deriving prices. When a price is derived through a stablecoin bridge (e.g. BTC/USDT * USDT/USD),
+// the index price of the stablecoin must be within MaxDeviation of 1.0 for the derivation to be
+// utilized.
+type StablecoinDepegConfig struct {
+	// MaxDeviation is the maximum relative deviation of a stablecoin's index price from 1.0
+	// before derivations through that stablecoin are halted, e.g. 0.02 for 2%. A value of 0
+	// disables the check.
+	MaxDeviation float64 `json:"maxDeviation"`
+
+	// Stablecoins is the list of stablecoin currency pairs (e.g. USDT/USD) that the check
+	// applies to.
+	Stablecoins []string `json:"stablecoins"`
+}
+
+// ValidateBasic performs basic validation of the config.
+func (c *StablecoinDepegConfig) ValidateBasic() error {
+	if c.MaxDeviation < 0 || c.MaxDeviation >= 1 {
+		return fmt.Errorf("stablecoin max deviation must be in the range [0, 1); got %f", c.MaxDeviation)
+	}
+
+	for _, stablecoin := range c.Stablecoins {
+		if _, err := slinkytypes.SpotCurrencyPairFromString(stablecoin); err != nil {
+			return fmt.Errorf("invalid stablecoin %s: %w", stablecoin, err)
+		}
+	}
+
+	return nil
+}
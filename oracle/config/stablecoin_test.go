@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestStablecoinDepegConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.StablecoinDepegConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.StablecoinDepegConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.StablecoinDepegConfig{
+				MaxDeviation: 0.02,
+				Stablecoins:  []string{"USDT/USD", "USDC/USD"},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "negative max deviation",
+			config: config.StablecoinDepegConfig{
+				MaxDeviation: -0.02,
+				Stablecoins:  []string{"USDT/USD"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "max deviation of 1",
+			config: config.StablecoinDepegConfig{
+				MaxDeviation: 1,
+				Stablecoins:  []string{"USDT/USD"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid stablecoin",
+			config: config.StablecoinDepegConfig{
+				MaxDeviation: 0.02,
+				Stablecoins:  []string{"USDT"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// TracingConfig is the configuration used to export OpenTelemetry traces of the oracle to an
+// OTLP collector. Spans are emitted for provider fetches, price aggregation, and the oracle
+// server's price requests, and carry the provider and currency pair that they pertain to.
+type TracingConfig struct {
+	// Enabled is a flag that indicates whether traces are exported.
+	Enabled bool `json:"enabled"`
+
+	// Endpoint is the base URL of the OTLP/HTTP collector e.g. http://localhost:4318. Spans
+	// are exported to the /v1/traces path of the endpoint.
+	Endpoint string `json:"endpoint"`
+
+	// ServiceName is the service name that exported spans are attributed to. If empty, the
+	// spans are attributed to slinky.
+	ServiceName string `json:"serviceName"`
+
+	// SampleRatio is the fraction of traces that are sampled, e.g. 0.1 for 10%. A value of 0
+	// samples every trace.
+	SampleRatio float64 `json:"sampleRatio"`
+}
+
+// ValidateBasic performs basic validation of the tracing config.
+func (c *TracingConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid tracing endpoint %s: %w", c.Endpoint, err)
+	}
+
+	if (endpoint.Scheme != "http" && endpoint.Scheme != "https") || len(endpoint.Host) == 0 {
+		return fmt.Errorf("tracing endpoint %s must be an http or https url", c.Endpoint)
+	}
+
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+func TestTracingConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      config.TracingConfig
+		expectedErr bool
+	}{
+		{
+			name:        "disabled config",
+			config:      config.TracingConfig{},
+			expectedErr: false,
+		},
+		{
+			name: "good config",
+			config: config.TracingConfig{
+				Enabled:     true,
+				Endpoint:    "http://localhost:4318",
+				ServiceName: "slinky",
+				SampleRatio: 0.5,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "good config with https endpoint and default sample ratio",
+			config: config.TracingConfig{
+				Enabled:  true,
+				Endpoint: "https://otel.example.com",
+			},
+			expectedErr: false,
+		},
+		{
+			name: "empty endpoint",
+			config: config.TracingConfig{
+				Enabled: true,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "endpoint without a scheme",
+			config: config.TracingConfig{
+				Enabled:  true,
+				Endpoint: "localhost:4318",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative sample ratio",
+			config: config.TracingConfig{
+				Enabled:     true,
+				Endpoint:    "http://localhost:4318",
+				SampleRatio: -0.1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "sample ratio above 1",
+			config: config.TracingConfig{
+				Enabled:     true,
+				Endpoint:    "http://localhost:4318",
+				SampleRatio: 1.5,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.ValidateBasic()
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
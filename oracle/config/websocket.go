@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -56,16 +57,41 @@ const (
 	DefaultMaxSubscriptionsPerConnection = 0
 )
 
+// BufferPolicy determines how a websocket provider handles new messages when its message
+// buffer is full.
+type BufferPolicy string
+
+const (
+	// BufferPolicyBlock blocks receiving messages until the buffer has capacity. If a buffer
+	// block timeout is configured, the new message is dropped once the timeout elapses. This
+	// is the default policy.
+	BufferPolicyBlock BufferPolicy = "block"
+	// BufferPolicyDropNewest drops the new message if the buffer is full.
+	BufferPolicyDropNewest BufferPolicy = "dropNewest"
+	// BufferPolicyDropOldest drops the oldest buffered message to make room for the new
+	// message if the buffer is full.
+	BufferPolicyDropOldest BufferPolicy = "dropOldest"
+)
+
 // WebSocketConfig defines a config for a websocket based data provider.
 type WebSocketConfig struct {
 	// Enabled is a flag that indicates whether the provider is websocket based.
 	Enabled bool `json:"enabled"`
 
 	// MaxBufferSize is the maximum number of messages that the provider will buffer
-	// at any given time. If the provider receives more messages than this, it will
-	// block receiving messages until the buffer is cleared.
+	// at any given time. If the provider receives more messages than this, the buffer
+	// policy determines how the new message is handled.
 	MaxBufferSize int `json:"maxBufferSize"`
 
+	// BufferPolicy is the policy applied to new messages when the buffer is full. If
+	// empty, the provider blocks receiving messages until the buffer is cleared.
+	BufferPolicy BufferPolicy `json:"bufferPolicy"`
+
+	// BufferBlockTimeout is the maximum amount of time the provider will block on a full
+	// buffer before dropping the new message when the buffer policy is block. A value of
+	// 0 blocks until the buffer is cleared.
+	BufferBlockTimeout time.Duration `json:"bufferBlockTimeout"`
+
 	// ReconnectionTimeout is the timeout for the provider to attempt to reconnect
 	// to the websocket endpoint.
 	ReconnectionTimeout time.Duration `json:"reconnectionTimeout"`
@@ -116,6 +142,51 @@ type WebSocketConfig struct {
 	// can be assigned to a single connection for this provider.  The null value (0),
 	// indicates that there is no limit per connection.
 	MaxSubscriptionsPerConnection int `json:"maxSubscriptionsPerConnection"`
+
+	// FailedConnectionTimeout is the maximum amount of time a connection may go without
+	// successfully handling a message before it is considered failed, at which point it is
+	// torn down and rebuilt. A value of 0 disables the check.
+	FailedConnectionTimeout time.Duration `json:"failedConnectionTimeout"`
+
+	// MaxReconnectAttempts is the maximum number of consecutive attempts to establish a
+	// connection that may fail before the connection is disabled. A value of 0 retries
+	// indefinitely.
+	MaxReconnectAttempts int `json:"maxReconnectAttempts"`
+
+	// ReconnectCooldown is the amount of time a disabled connection waits before making another
+	// attempt to connect. A value of 0 keeps the connection disabled until the provider is
+	// restarted.
+	ReconnectCooldown time.Duration `json:"reconnectCooldown"`
+
+	// MaxReconnectInterval is the maximum delay between consecutive attempts to reconnect when
+	// the reconnect backoff is enabled. The delay starts at the reconnection timeout, and is
+	// multiplied by the reconnect backoff base after every reconnect, up to this value, with
+	// random jitter added on top. The delay is reset once a connection stays up for this long.
+	// A value of 0 disables the backoff, such that the reconnection timeout is always used.
+	MaxReconnectInterval time.Duration `json:"maxReconnectInterval"`
+
+	// ReconnectBackoffBase is the factor by which the delay between consecutive attempts to
+	// reconnect is multiplied when the reconnect backoff is enabled. This must be at least 1 if
+	// the max reconnect interval is set.
+	ReconnectBackoffBase float64 `json:"reconnectBackoffBase"`
+
+	// DedupeWindow is the amount of time after a value is processed for an ID during which
+	// identical values for the same ID are dropped, e.g. ticks that are replayed when a
+	// connection is re-established. A value of 0 disables deduplication.
+	DedupeWindow time.Duration `json:"dedupeWindow"`
+
+	// LocalAddress is the optional local IP address that the provider's connections are bound
+	// to. If empty, the operating system selects the source address.
+	LocalAddress string `json:"localAddress"`
+
+	// FieldOverrides optionally overrides the JSON field names that the provider's parser expects
+	// in the messages received from the data provider. If empty, the hard-coded field names are
+	// used.
+	FieldOverrides FieldOverrides `json:"fieldOverrides"`
+
+	// ErrorLogSuppression configures the suppression of repeated error logs of the provider's
+	// connections. If disabled, every connection failure and read error is logged.
+	ErrorLogSuppression ErrorLogSuppressionConfig `json:"errorLogSuppression"`
 }
 
 // ValidateBasic performs basic validation of the websocket config.
@@ -128,6 +199,16 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket max buffer size must be greater than 0")
 	}
 
+	switch c.BufferPolicy {
+	case "", BufferPolicyBlock, BufferPolicyDropNewest, BufferPolicyDropOldest:
+	default:
+		return fmt.Errorf("websocket buffer policy %s is not supported", c.BufferPolicy)
+	}
+
+	if c.BufferBlockTimeout < 0 {
+		return fmt.Errorf("websocket buffer block timeout cannot be negative")
+	}
+
 	if c.ReconnectionTimeout <= 0 {
 		return fmt.Errorf("websocket reconnection timeout must be greater than 0")
 	}
@@ -172,5 +253,51 @@ func (c *WebSocketConfig) ValidateBasic() error {
 		return fmt.Errorf("websocket max subscriptions per connection cannot be negative")
 	}
 
+	if c.FailedConnectionTimeout < 0 {
+		return fmt.Errorf("websocket failed connection timeout cannot be negative")
+	}
+
+	if c.MaxReconnectAttempts < 0 {
+		return fmt.Errorf("websocket max reconnect attempts cannot be negative")
+	}
+
+	if c.ReconnectCooldown < 0 {
+		return fmt.Errorf("websocket reconnect cooldown cannot be negative")
+	}
+
+	if c.MaxReconnectInterval < 0 {
+		return fmt.Errorf("websocket max reconnect interval cannot be negative")
+	}
+
+	if c.MaxReconnectInterval > 0 {
+		if c.MaxReconnectInterval < c.ReconnectionTimeout {
+			return fmt.Errorf(
+				"websocket max reconnect interval %s cannot be less than the reconnection timeout %s",
+				c.MaxReconnectInterval,
+				c.ReconnectionTimeout,
+			)
+		}
+
+		if math.IsNaN(c.ReconnectBackoffBase) || math.IsInf(c.ReconnectBackoffBase, 0) || c.ReconnectBackoffBase < 1 {
+			return fmt.Errorf("websocket reconnect backoff base must be at least 1; got %v", c.ReconnectBackoffBase)
+		}
+	}
+
+	if c.DedupeWindow < 0 {
+		return fmt.Errorf("websocket dedupe window cannot be negative")
+	}
+
+	if err := validateLocalAddress(c.LocalAddress); err != nil {
+		return fmt.Errorf("invalid websocket config: %w", err)
+	}
+
+	if err := c.FieldOverrides.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid websocket config: %w", err)
+	}
+
+	if err := c.ErrorLogSuppression.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid websocket config: %w", err)
+	}
+
 	return nil
 }
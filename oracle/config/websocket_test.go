@@ -275,6 +275,169 @@ func TestWebSocketConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "bad config with negative failed connection timeout",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				FailedConnectionTimeout:       -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative max reconnect attempts",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxReconnectAttempts:          -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with negative reconnect cooldown",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				ReconnectCooldown:             -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "good config with reconnect backoff",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxReconnectInterval:          time.Minute,
+				ReconnectBackoffBase:          2,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with negative max reconnect interval",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxReconnectInterval:          -1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with max reconnect interval less than the reconnection timeout",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxReconnectInterval:          time.Second,
+				ReconnectBackoffBase:          2,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with reconnect backoff base less than 1",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				MaxReconnectInterval:          time.Minute,
+				ReconnectBackoffBase:          0.5,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with local address that is not an ip address",
+			config: config.WebSocketConfig{
+				Enabled:                       true,
+				MaxBufferSize:                 1,
+				ReconnectionTimeout:           config.DefaultReconnectionTimeout,
+				Name:                          "test",
+				WSS:                           "wss://test.com",
+				ReadBufferSize:                config.DefaultReadBufferSize,
+				WriteBufferSize:               config.DefaultWriteBufferSize,
+				HandshakeTimeout:              config.DefaultHandshakeTimeout,
+				EnableCompression:             config.DefaultEnableCompression,
+				ReadTimeout:                   config.DefaultReadTimeout,
+				WriteTimeout:                  config.DefaultWriteTimeout,
+				MaxReadErrorCount:             config.DefaultMaxReadErrorCount,
+				MaxSubscriptionsPerConnection: config.DefaultMaxSubscriptionsPerConnection,
+				LocalAddress:                  "10.0.0",
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
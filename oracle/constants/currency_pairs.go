@@ -136,6 +136,11 @@ var (
 	WORLD_USDT     = pkgtypes.NewCurrencyPair("WLD", "USDT")
 	WTAO_USDT      = pkgtypes.NewCurrencyPair("WTAO", "USDT")
 
+	// Fiat tickers.
+	EUR_USD = pkgtypes.NewCurrencyPair("EUR", "USD")
+	GBP_USD = pkgtypes.NewCurrencyPair("GBP", "USD")
+	JPY_USD = pkgtypes.NewCurrencyPair("JPY", "USD")
+
 	// BTC denominated tickers.
 	ETHEREUM_BITCOIN = pkgtypes.NewCurrencyPair("ETH", "BTC")
 
@@ -0,0 +1,139 @@
+package oracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// PriceExport is the JSON document written by the FilePriceExporter after every oracle update.
+type PriceExport struct {
+	// Timestamp is the time at which the prices were updated by the oracle.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Prices is the set of aggregated prices indexed by currency pair.
+	Prices map[string]ExportedPrice `json:"prices"`
+}
+
+// ExportedPrice is an aggregated price along with its metadata.
+type ExportedPrice struct {
+	// Price is the aggregated price as an integer scaled by Decimals.
+	Price string `json:"price"`
+
+	// Decimals is the number of decimals the price is scaled by.
+	Decimals uint64 `json:"decimals"`
+
+	// Providers is the set of providers whose prices contributed to the price.
+	Providers []string `json:"providers"`
+
+	// Source is the provider that the price is attributed to. This is empty if the price was not
+	// selected from a single provider.
+	Source string `json:"source,omitempty"`
+}
+
+// NewPriceExport returns the export of the given aggregated prices and their metadata.
+func NewPriceExport(prices types.Prices, info map[string]types.PriceInfo, timestamp time.Time) PriceExport {
+	exported := make(map[string]ExportedPrice, len(prices))
+	for cp, price := range prices {
+		intPrice, _ := price.Int(nil)
+		exported[cp] = ExportedPrice{
+			Price:     intPrice.String(),
+			Decimals:  info[cp].Decimals,
+			Providers: info[cp].Providers,
+			Source:    info[cp].Source,
+		}
+	}
+
+	return PriceExport{
+		Timestamp: timestamp,
+		Prices:    exported,
+	}
+}
+
+var _ PriceExporter = (*FilePriceExporter)(nil)
+
+// FilePriceExporter is a PriceExporter that writes the aggregated prices as JSON to a file on disk
+// after every update. Each export replaces the file atomically. Exports are written in the
+// background so that a slow disk does not block the oracle; if an export is still being written
+// when the next update occurs, only the latest pending export is written afterwards.
+type FilePriceExporter struct {
+	logger *zap.Logger
+	path   string
+
+	mtx sync.Mutex
+	// pending is the latest export that has not been written yet.
+	pending *PriceExport
+	// writing is true while an export is being written in the background.
+	writing bool
+}
+
+// NewFilePriceExporter returns a new FilePriceExporter that writes exports to the given path.
+func NewFilePriceExporter(logger *zap.Logger, path string) (*FilePriceExporter, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if len(path) == 0 {
+		return nil, fmt.Errorf("price export path cannot be empty")
+	}
+
+	return &FilePriceExporter{
+		logger: logger.With(zap.String("price_export_path", path)),
+		path:   path,
+	}, nil
+}
+
+// ExportPrices schedules the given prices to be written to the export file.
+func (e *FilePriceExporter) ExportPrices(prices types.Prices, info map[string]types.PriceInfo, timestamp time.Time) {
+	export := NewPriceExport(prices, info, timestamp)
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	e.pending = &export
+	if e.writing {
+		return
+	}
+
+	e.writing = true
+	go e.flush()
+}
+
+// flush writes pending exports until there are none left.
+func (e *FilePriceExporter) flush() {
+	for {
+		e.mtx.Lock()
+		export := e.pending
+		e.pending = nil
+		if export == nil {
+			e.writing = false
+			e.mtx.Unlock()
+			return
+		}
+		e.mtx.Unlock()
+
+		if err := e.Write(*export); err != nil {
+			e.logger.Error("failed to export prices", zap.Error(err))
+		}
+	}
+}
+
+// Write writes the given export to a temporary file and renames it over the configured path so
+// that readers never observe a partially written export.
+func (e *FilePriceExporter) Write(export PriceExport) error {
+	bz, err := json.Marshal(export)
+	if err != nil {
+		return fmt.Errorf("failed to marshal price export: %w", err)
+	}
+
+	if err := writeFileAtomic(e.path, bz, 0o644); err != nil {
+		return fmt.Errorf("failed to write price export: %w", err)
+	}
+
+	return nil
+}
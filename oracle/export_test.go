@@ -0,0 +1,140 @@
+package oracle_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/types"
+	mathtestutils "github.com/skip-mev/slinky/pkg/math/testutils"
+)
+
+func (s *OracleTestSuite) TestPriceExporter() {
+	readExport := func(path string) (oracle.PriceExport, error) {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return oracle.PriceExport{}, err
+		}
+
+		var export oracle.PriceExport
+		return export, json.Unmarshal(bz, &export)
+	}
+
+	s.Run("file price exporter cannot be created with an empty path", func() {
+		_, err := oracle.NewFilePriceExporter(s.logger, "")
+		s.Require().Error(err)
+	})
+
+	s.Run("file price exporter cannot be created without a logger", func() {
+		_, err := oracle.NewFilePriceExporter(nil, filepath.Join(s.T().TempDir(), "prices.json"))
+		s.Require().Error(err)
+	})
+
+	s.Run("exports prices with their metadata", func() {
+		dir := s.T().TempDir()
+		path := filepath.Join(dir, "prices.json")
+		exporter, err := oracle.NewFilePriceExporter(s.logger, path)
+		s.Require().NoError(err)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		s.Require().NoError(exporter.Write(oracle.NewPriceExport(
+			types.Prices{
+				"BTC/USD": big.NewFloat(4_200_000_000),
+				"ETH/USD": big.NewFloat(300_000),
+			},
+			map[string]types.PriceInfo{
+				"BTC/USD": {
+					Decimals:  5,
+					Providers: []string{"coinbase_api", "kraken_api"},
+					Source:    "kraken_api",
+				},
+				"ETH/USD": {
+					Decimals:  2,
+					Providers: []string{"coinbase_api", "kraken_api"},
+				},
+			},
+			now,
+		)))
+
+		export, err := readExport(path)
+		s.Require().NoError(err)
+		s.Require().True(now.Equal(export.Timestamp))
+		s.Require().Equal(map[string]oracle.ExportedPrice{
+			"BTC/USD": {
+				Price:     "4200000000",
+				Decimals:  5,
+				Providers: []string{"coinbase_api", "kraken_api"},
+				Source:    "kraken_api",
+			},
+			"ETH/USD": {
+				Price:     "300000",
+				Decimals:  2,
+				Providers: []string{"coinbase_api", "kraken_api"},
+			},
+		}, export.Prices)
+
+		// No temporary files are left behind.
+		entries, err := os.ReadDir(dir)
+		s.Require().NoError(err)
+		s.Require().Len(entries, 1)
+	})
+
+	s.Run("writes the latest export in the background", func() {
+		path := filepath.Join(s.T().TempDir(), "prices.json")
+		exporter, err := oracle.NewFilePriceExporter(s.logger, path)
+		s.Require().NoError(err)
+
+		now := time.Now().UTC()
+		for i := int64(1); i <= 10; i++ {
+			exporter.ExportPrices(
+				types.Prices{"BTC/USD": big.NewFloat(float64(i))},
+				nil,
+				now.Add(time.Duration(i)*time.Second),
+			)
+		}
+
+		s.Require().Eventually(func() bool {
+			export, err := readExport(path)
+			return err == nil && export.Prices["BTC/USD"].Price == "10"
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	s.Run("exports prices after every oracle update", func() {
+		path := filepath.Join(s.T().TempDir(), "prices.json")
+		exporter, err := oracle.NewFilePriceExporter(s.logger, path)
+		s.Require().NoError(err)
+
+		updateInterval := 500 * time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), 4*updateInterval)
+		defer cancel()
+
+		testOracle, err := oracle.New(
+			oracle.WithUpdateInterval(updateInterval),
+			oracle.WithMaxCacheAge(time.Minute),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+			oracle.WithPriceExporter(exporter),
+		)
+		s.Require().NoError(err)
+
+		s.Require().NoError(testOracle.PushPrice("custom", s.currencyPairs[0], big.NewFloat(100), time.Now()))
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			s.Require().NoError(testOracle.Start(ctx))
+		}()
+
+		s.Require().Eventually(func() bool {
+			export, err := readExport(path)
+			return err == nil && export.Prices[s.currencyPairs[0].String()].Price == "100"
+		}, 3*updateInterval, 10*time.Millisecond)
+
+		testOracle.Stop()
+		<-done
+	})
+}
@@ -0,0 +1,67 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/types"
+	mathtestutils "github.com/skip-mev/slinky/pkg/math/testutils"
+)
+
+// withholdGuard is a price guard that withholds every price above a fixed threshold.
+type withholdGuard struct {
+	threshold *big.Float
+}
+
+func (g withholdGuard) GuardPrices(prices types.Prices) []string {
+	var withheld []string
+	for cp, price := range prices {
+		if price.Cmp(g.threshold) > 0 {
+			withheld = append(withheld, cp)
+		}
+	}
+
+	return withheld
+}
+
+func (s *OracleTestSuite) TestPriceGuard() {
+	s.Run("withheld prices are not served and are reported as failing", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		testOracle, err := oracle.New(
+			oracle.WithUpdateInterval(100*time.Millisecond),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+			oracle.WithPriceGuard(withholdGuard{threshold: big.NewFloat(1000)}),
+		)
+		s.Require().NoError(err)
+
+		s.Require().NoError(testOracle.PushPrice("custom", s.currencyPairs[0], big.NewFloat(100), time.Now()))
+		s.Require().NoError(testOracle.PushPrice("custom", s.currencyPairs[1], big.NewFloat(2000), time.Now()))
+
+		go func() {
+			s.Require().NoError(testOracle.Start(ctx))
+		}()
+		defer testOracle.Stop()
+
+		s.Require().Eventually(func() bool {
+			return !testOracle.GetLastSyncTime().IsZero()
+		}, 2*time.Second, 50*time.Millisecond)
+
+		s.Require().Equal(types.Prices{
+			s.currencyPairs[0].String(): big.NewFloat(100),
+		}, testOracle.GetPrices())
+
+		_, failing := testOracle.GetMissingPrices()
+		s.Require().Equal([]string{s.currencyPairs[1].String()}, failing)
+	})
+
+	s.Run("cannot set a nil price guard", func() {
+		s.Require().Panics(func() {
+			_, _ = oracle.New(oracle.WithPriceGuard(nil))
+		})
+	})
+}
@@ -1,13 +1,63 @@
 package oracle
 
-import "github.com/skip-mev/slinky/oracle/types"
+import (
+	"context"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
 
 // PriceAggregator is an interface for aggregating prices from multiple providers.
 //
 //go:generate mockery --name PriceAggregator
 type PriceAggregator interface {
 	SetProviderPrices(provider string, prices types.Prices)
-	AggregatePrices()
+	AggregatePrices(ctx context.Context)
 	GetPrices() types.Prices
+	GetMissingPrices() (warmingUp []string, failing []string)
+	GetPriceInfo() map[string]types.PriceInfo
+	GetProviderTickers(pairs []string) map[string][]string
 	Reset()
 }
+
+// AuditAggregator is an optional interface of a PriceAggregator that records why each provider was
+// included in or excluded from the aggregated prices. If the oracle's aggregator implements it, the
+// reasons that the oracle dropped each provider's prices before aggregation are forwarded to the
+// aggregator along with the prices.
+type AuditAggregator interface {
+	SetProviderExclusions(provider string, exclusions map[string]types.ExclusionReason)
+}
+
+// VolumeAggregator is an optional interface of a PriceAggregator that consumes the volume reported
+// alongside each provider's prices. If the oracle's aggregator implements it, the volumes of each
+// provider's prices are forwarded to the aggregator along with the prices.
+type VolumeAggregator interface {
+	SetProviderVolumes(provider string, volumes types.Prices)
+}
+
+// TimestampAggregator is an optional interface of a PriceAggregator that consumes the time at which
+// each provider's prices were produced. If the oracle's aggregator implements it, the timestamps of
+// each provider's prices are forwarded to the aggregator along with the prices.
+type TimestampAggregator interface {
+	SetProviderTimestamps(provider string, timestamps map[string]time.Time)
+}
+
+// PriceObserver is an interface for observing the aggregated prices of the oracle. Observers
+// are notified after every oracle update, must not block, and must not modify the prices.
+type PriceObserver interface {
+	ObservePrices(prices types.Prices, timestamp time.Time)
+}
+
+// PriceExporter is an interface for exporting the aggregated prices of the oracle along with the
+// metadata of each price. Exporters are notified after every oracle update, must not block, and
+// must not modify the prices or their metadata.
+type PriceExporter interface {
+	ExportPrices(prices types.Prices, info map[string]types.PriceInfo, timestamp time.Time)
+}
+
+// PriceGuard is an interface for sanity checking the aggregated prices of the oracle. Guards are
+// consulted after every oracle update, must not block, and must not modify the prices. The pairs
+// returned by a guard are withheld from the oracle's prices until the next update.
+type PriceGuard interface {
+	GuardPrices(prices types.Prices) (withheld []string)
+}
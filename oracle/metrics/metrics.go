@@ -52,19 +52,79 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(market string, count int)
 
+	// AddStablecoinDepeg increments the number of times a price derivation was halted because
+	// the given stablecoin's index price deviated too far from 1.0.
+	AddStablecoinDepeg(stablecoin string)
+
+	// AddInsufficientProviders increments the number of aggregations in which the price of the
+	// given pairID was suppressed because fewer than its minimum number of providers reported.
+	AddInsufficientProviders(pairID string)
+
+	// UpdateReferenceDeviation updates the relative deviation of the aggregated price of the
+	// given pairID from the price published by the reference oracle.
+	UpdateReferenceDeviation(pairID string, deviation float64)
+
+	// AddReferenceDivergence increments the number of updates in which the aggregated price of
+	// the given pairID diverged too far from the price published by the reference oracle.
+	AddReferenceDivergence(pairID string)
+
+	// UpdateProviderLag updates the fraction of the recent moves of the index price of the given
+	// pairID that the given provider's price lagged.
+	UpdateProviderLag(providerName, pairID string, score float64)
+
+	// UpdateProviderCollapse updates whether the number of providers contributing to the price
+	// of the given pairID is currently collapsed.
+	UpdateProviderCollapse(pairID string, collapsed bool)
+
+	// UpdateDecimalDisagreement updates whether the providers of the given pairID currently
+	// report prices at different implied decimals.
+	UpdateDecimalDisagreement(pairID string, disagreement bool)
+
+	// UpdatePriceSignificantDigits updates the number of significant digits of the scaled
+	// aggregated price of the given pairID.
+	UpdatePriceSignificantDigits(pairID string, digits int)
+
+	// UpdateProviderSpread updates the spread between the highest and lowest provider prices
+	// that contributed to the price of the given pairID, relative to the price.
+	UpdateProviderSpread(pairID string, spread float64)
+
+	// UpdateUnknownMarketProvider updates the number of enabled markets in the market map that
+	// reference the given provider although it is not configured in the oracle.
+	UpdateUnknownMarketProvider(providerName string, markets int)
+
+	// UpdateProviderKilled updates whether the given provider is currently killed via the
+	// provider kill switch.
+	UpdateProviderKilled(providerName string, killed bool)
+
 	// SetSlinkyBuildInfo sets the build information for the Slinky binary.
 	SetSlinkyBuildInfo()
+
+	// SetServerConnections sets the number of client connections currently open to the
+	// oracle server.
+	SetServerConnections(count int)
 }
 
 // OracleMetricsImpl is a Metrics implementation that does nothing.
 type OracleMetricsImpl struct {
-	ticks           prometheus.Counter
-	tickerTicks     *prometheus.CounterVec
-	prices          *prometheus.GaugeVec
-	aggregatePrices *prometheus.GaugeVec
-	providerTick    *prometheus.CounterVec
-	providerCount   *prometheus.GaugeVec
-	slinkyBuildInfo *prometheus.GaugeVec
+	ticks            prometheus.Counter
+	tickerTicks      *prometheus.CounterVec
+	prices           *prometheus.GaugeVec
+	aggregatePrices  *prometheus.GaugeVec
+	providerTick     *prometheus.CounterVec
+	providerCount    *prometheus.GaugeVec
+	stablecoinDepeg  *prometheus.CounterVec
+	insufficientProv *prometheus.CounterVec
+	refDeviation     *prometheus.GaugeVec
+	refDivergence    *prometheus.CounterVec
+	providerLag      *prometheus.GaugeVec
+	providerCollapse *prometheus.GaugeVec
+	decimalDisagree  *prometheus.GaugeVec
+	priceDigits      *prometheus.GaugeVec
+	providerSpread   *prometheus.GaugeVec
+	unknownProviders *prometheus.GaugeVec
+	providerKilled   *prometheus.GaugeVec
+	slinkyBuildInfo  *prometheus.GaugeVec
+	serverConns      prometheus.Gauge
 }
 
 // NewMetricsFromConfig returns an oracle Metrics implementation based on the provided
@@ -109,11 +169,71 @@ func NewMetrics() Metrics {
 			Name:      "health_check_market_providers",
 			Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 		}, []string{PairIDLabel}),
+		stablecoinDepeg: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "stablecoin_depeg_total",
+			Help:      "Number of price derivations halted because the stablecoin used as a bridge was depegged.",
+		}, []string{PairIDLabel}),
+		insufficientProv: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "insufficient_providers_total",
+			Help:      "Number of aggregations in which the price of a given currency pair was suppressed because too few providers reported.",
+		}, []string{PairIDLabel}),
+		refDeviation: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "reference_price_deviation",
+			Help:      "Relative deviation of the aggregated price of a given currency pair from the price published by the reference oracle.",
+		}, []string{PairIDLabel}),
+		refDivergence: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "reference_price_divergence_total",
+			Help:      "Number of updates in which the aggregated price of a given currency pair diverged too far from the price published by the reference oracle.",
+		}, []string{PairIDLabel}),
+		providerLag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_lag_score",
+			Help:      "Fraction of the recent moves of the index price of a given currency pair that the price of a provider lagged.",
+		}, []string{ProviderLabel, PairIDLabel}),
+		providerCollapse: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_collapse",
+			Help:      "Whether the number of providers contributing to the price of a given currency pair is currently collapsed (1) or not (0).",
+		}, []string{PairIDLabel}),
+		decimalDisagree: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "decimal_disagreement",
+			Help:      "Whether the providers of a given currency pair currently report prices at different implied decimals (1) or not (0).",
+		}, []string{PairIDLabel}),
+		priceDigits: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "price_significant_digits",
+			Help:      "Number of significant digits of the scaled aggregated price of a given derived currency pair.",
+		}, []string{PairIDLabel}),
+		providerSpread: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_price_spread",
+			Help:      "Spread between the highest and lowest provider prices that contributed to the price of a given currency pair, relative to the price.",
+		}, []string{PairIDLabel}),
+		unknownProviders: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "unknown_market_providers",
+			Help:      "Number of enabled markets in the market map that reference a provider that is not configured in the oracle.",
+		}, []string{ProviderLabel}),
+		providerKilled: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_killed",
+			Help:      "Whether a given provider is currently killed via the provider kill switch (1) or not (0).",
+		}, []string{ProviderLabel}),
 		slinkyBuildInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: OracleSubsystem,
 			Name:      "slinky_build_info",
 			Help:      "Information about the slinky build",
 		}, []string{Version}),
+		serverConns: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: OracleSubsystem,
+			Name:      "server_connections",
+			Help:      "Number of client connections currently open to the oracle server.",
+		}),
 	}
 
 	// register the above metrics
@@ -123,7 +243,19 @@ func NewMetrics() Metrics {
 	prometheus.MustRegister(m.aggregatePrices)
 	prometheus.MustRegister(m.providerTick)
 	prometheus.MustRegister(m.providerCount)
+	prometheus.MustRegister(m.stablecoinDepeg)
+	prometheus.MustRegister(m.insufficientProv)
+	prometheus.MustRegister(m.refDeviation)
+	prometheus.MustRegister(m.refDivergence)
+	prometheus.MustRegister(m.providerLag)
+	prometheus.MustRegister(m.providerCollapse)
+	prometheus.MustRegister(m.decimalDisagree)
+	prometheus.MustRegister(m.priceDigits)
+	prometheus.MustRegister(m.providerSpread)
+	prometheus.MustRegister(m.unknownProviders)
+	prometheus.MustRegister(m.providerKilled)
 	prometheus.MustRegister(m.slinkyBuildInfo)
+	prometheus.MustRegister(m.serverConns)
 
 	return m
 }
@@ -163,9 +295,68 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {
 }
 
+// AddStablecoinDepeg increments the number of times a price derivation was halted because
+// the given stablecoin's index price deviated too far from 1.0.
+func (m *noOpOracleMetrics) AddStablecoinDepeg(string) {
+}
+
+// AddInsufficientProviders increments the number of aggregations in which the price of the
+// given pairID was suppressed because fewer than its minimum number of providers reported.
+func (m *noOpOracleMetrics) AddInsufficientProviders(string) {
+}
+
+// UpdateReferenceDeviation updates the relative deviation of the aggregated price of the
+// given pairID from the price published by the reference oracle.
+func (m *noOpOracleMetrics) UpdateReferenceDeviation(string, float64) {
+}
+
+// AddReferenceDivergence increments the number of updates in which the aggregated price of
+// the given pairID diverged too far from the price published by the reference oracle.
+func (m *noOpOracleMetrics) AddReferenceDivergence(string) {
+}
+
+// UpdateProviderLag updates the fraction of the recent moves of the index price of the given
+// pairID that the given provider's price lagged.
+func (m *noOpOracleMetrics) UpdateProviderLag(string, string, float64) {
+}
+
+// UpdateProviderCollapse updates whether the number of providers contributing to the price
+// of the given pairID is currently collapsed.
+func (m *noOpOracleMetrics) UpdateProviderCollapse(string, bool) {
+}
+
+// UpdateDecimalDisagreement updates whether the providers of the given pairID currently report
+// prices at different implied decimals.
+func (m *noOpOracleMetrics) UpdateDecimalDisagreement(string, bool) {
+}
+
+// UpdatePriceSignificantDigits updates the number of significant digits of the scaled
+// aggregated price of the given pairID.
+func (m *noOpOracleMetrics) UpdatePriceSignificantDigits(string, int) {
+}
+
+// UpdateProviderSpread updates the spread between the highest and lowest provider prices that
+// contributed to the price of the given pairID, relative to the price.
+func (m *noOpOracleMetrics) UpdateProviderSpread(string, float64) {
+}
+
+// UpdateUnknownMarketProvider updates the number of enabled markets in the market map that
+// reference the given provider although it is not configured in the oracle.
+func (m *noOpOracleMetrics) UpdateUnknownMarketProvider(string, int) {
+}
+
+// UpdateProviderKilled updates whether the given provider is currently killed via the provider
+// kill switch.
+func (m *noOpOracleMetrics) UpdateProviderKilled(string, bool) {
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary.
 func (m *noOpOracleMetrics) SetSlinkyBuildInfo() {}
 
+// SetServerConnections sets the number of client connections currently open to the
+// oracle server.
+func (m *noOpOracleMetrics) SetServerConnections(int) {}
+
 // AddTick increments the total number of ticks that have been processed by the oracle.
 func (m *OracleMetricsImpl) AddTick() {
 	m.ticks.Add(1)
@@ -228,6 +419,121 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	).Set(float64(count))
 }
 
+// AddStablecoinDepeg increments the number of times a price derivation was halted because
+// the given stablecoin's index price deviated too far from 1.0.
+func (m *OracleMetricsImpl) AddStablecoinDepeg(stablecoin string) {
+	m.stablecoinDepeg.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(stablecoin),
+	},
+	).Add(1)
+}
+
+// AddInsufficientProviders increments the number of aggregations in which the price of the
+// given pairID was suppressed because fewer than its minimum number of providers reported.
+func (m *OracleMetricsImpl) AddInsufficientProviders(pairID string) {
+	m.insufficientProv.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Add(1)
+}
+
+// UpdateReferenceDeviation updates the relative deviation of the aggregated price of the
+// given pairID from the price published by the reference oracle.
+func (m *OracleMetricsImpl) UpdateReferenceDeviation(pairID string, deviation float64) {
+	m.refDeviation.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(deviation)
+}
+
+// AddReferenceDivergence increments the number of updates in which the aggregated price of
+// the given pairID diverged too far from the price published by the reference oracle.
+func (m *OracleMetricsImpl) AddReferenceDivergence(pairID string) {
+	m.refDivergence.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Add(1)
+}
+
+// UpdateProviderLag updates the fraction of the recent moves of the index price of the given
+// pairID that the given provider's price lagged.
+func (m *OracleMetricsImpl) UpdateProviderLag(providerName, pairID string, score float64) {
+	m.providerLag.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+	},
+	).Set(score)
+}
+
+// UpdateProviderCollapse updates whether the number of providers contributing to the price
+// of the given pairID is currently collapsed.
+func (m *OracleMetricsImpl) UpdateProviderCollapse(pairID string, collapsed bool) {
+	var value float64
+	if collapsed {
+		value = 1
+	}
+
+	m.providerCollapse.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(value)
+}
+
+// UpdateDecimalDisagreement updates whether the providers of the given pairID currently report
+// prices at different implied decimals.
+func (m *OracleMetricsImpl) UpdateDecimalDisagreement(pairID string, disagreement bool) {
+	var value float64
+	if disagreement {
+		value = 1
+	}
+
+	m.decimalDisagree.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(value)
+}
+
+// UpdatePriceSignificantDigits updates the number of significant digits of the scaled
+// aggregated price of the given pairID.
+func (m *OracleMetricsImpl) UpdatePriceSignificantDigits(pairID string, digits int) {
+	m.priceDigits.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(float64(digits))
+}
+
+// UpdateProviderSpread updates the spread between the highest and lowest provider prices that
+// contributed to the price of the given pairID, relative to the price.
+func (m *OracleMetricsImpl) UpdateProviderSpread(pairID string, spread float64) {
+	m.providerSpread.With(prometheus.Labels{
+		PairIDLabel: strings.ToLower(pairID),
+	},
+	).Set(spread)
+}
+
+// UpdateUnknownMarketProvider updates the number of enabled markets in the market map that
+// reference the given provider although it is not configured in the oracle.
+func (m *OracleMetricsImpl) UpdateUnknownMarketProvider(providerName string, markets int) {
+	m.unknownProviders.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+	},
+	).Set(float64(markets))
+}
+
+// UpdateProviderKilled updates whether the given provider is currently killed via the provider
+// kill switch.
+func (m *OracleMetricsImpl) UpdateProviderKilled(providerName string, killed bool) {
+	var value float64
+	if killed {
+		value = 1
+	}
+
+	m.providerKilled.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+	},
+	).Set(value)
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary. The version exported
 // is determined by the build time version in accordance with the build pkg.
 func (m *OracleMetricsImpl) SetSlinkyBuildInfo() {
@@ -235,3 +541,9 @@ func (m *OracleMetricsImpl) SetSlinkyBuildInfo() {
 		Version: build.Build,
 	}).Set(1)
 }
+
+// SetServerConnections sets the number of client connections currently open to the
+// oracle server.
+func (m *OracleMetricsImpl) SetServerConnections(count int) {
+	m.serverConns.Set(float64(count))
+}
@@ -9,6 +9,11 @@ type Metrics struct {
 	mock.Mock
 }
 
+// AddInsufficientProviders provides a mock function with given fields: pairID
+func (_m *Metrics) AddInsufficientProviders(pairID string) {
+	_m.Called(pairID)
+}
+
 // AddProviderCountForMarket provides a mock function with given fields: market, count
 func (_m *Metrics) AddProviderCountForMarket(market string, count int) {
 	_m.Called(market, count)
@@ -19,6 +24,16 @@ func (_m *Metrics) AddProviderTick(providerName string, pairID string, success b
 	_m.Called(providerName, pairID, success)
 }
 
+// AddReferenceDivergence provides a mock function with given fields: pairID
+func (_m *Metrics) AddReferenceDivergence(pairID string) {
+	_m.Called(pairID)
+}
+
+// AddStablecoinDepeg provides a mock function with given fields: stablecoin
+func (_m *Metrics) AddStablecoinDepeg(stablecoin string) {
+	_m.Called(stablecoin)
+}
+
 // AddTick provides a mock function with given fields:
 func (_m *Metrics) AddTick() {
 	_m.Called()
@@ -29,6 +44,11 @@ func (_m *Metrics) AddTickerTick(ticker string) {
 	_m.Called(ticker)
 }
 
+// SetServerConnections provides a mock function with given fields: count
+func (_m *Metrics) SetServerConnections(count int) {
+	_m.Called(count)
+}
+
 // SetSlinkyBuildInfo provides a mock function with given fields:
 func (_m *Metrics) SetSlinkyBuildInfo() {
 	_m.Called()
@@ -39,6 +59,46 @@ func (_m *Metrics) UpdateAggregatePrice(pairID string, decimals uint64, price fl
 	_m.Called(pairID, decimals, price)
 }
 
+// UpdateReferenceDeviation provides a mock function with given fields: pairID, deviation
+func (_m *Metrics) UpdateReferenceDeviation(pairID string, deviation float64) {
+	_m.Called(pairID, deviation)
+}
+
+// UpdateProviderCollapse provides a mock function with given fields: pairID, collapsed
+func (_m *Metrics) UpdateProviderCollapse(pairID string, collapsed bool) {
+	_m.Called(pairID, collapsed)
+}
+
+// UpdateDecimalDisagreement provides a mock function with given fields: pairID, disagreement
+func (_m *Metrics) UpdateDecimalDisagreement(pairID string, disagreement bool) {
+	_m.Called(pairID, disagreement)
+}
+
+// UpdatePriceSignificantDigits provides a mock function with given fields: pairID, digits
+func (_m *Metrics) UpdatePriceSignificantDigits(pairID string, digits int) {
+	_m.Called(pairID, digits)
+}
+
+// UpdateProviderKilled provides a mock function with given fields: providerName, killed
+func (_m *Metrics) UpdateProviderKilled(providerName string, killed bool) {
+	_m.Called(providerName, killed)
+}
+
+// UpdateProviderSpread provides a mock function with given fields: pairID, spread
+func (_m *Metrics) UpdateProviderSpread(pairID string, spread float64) {
+	_m.Called(pairID, spread)
+}
+
+// UpdateUnknownMarketProvider provides a mock function with given fields: providerName, markets
+func (_m *Metrics) UpdateUnknownMarketProvider(providerName string, markets int) {
+	_m.Called(providerName, markets)
+}
+
+// UpdateProviderLag provides a mock function with given fields: providerName, pairID, score
+func (_m *Metrics) UpdateProviderLag(providerName string, pairID string, score float64) {
+	_m.Called(providerName, pairID, score)
+}
+
 // UpdatePrice provides a mock function with given fields: name, pairID, decimals, price
 func (_m *Metrics) UpdatePrice(name string, pairID string, decimals uint64, price float64) {
 	_m.Called(name, pairID, decimals, price)
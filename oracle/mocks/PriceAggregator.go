@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	context "context"
 	big "math/big"
 
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/skip-mev/slinky/oracle/types"
 )
 
 // PriceAggregator is an autogenerated mock type for the PriceAggregator type
@@ -13,9 +16,81 @@ type PriceAggregator struct {
 	mock.Mock
 }
 
-// AggregatePrices provides a mock function with given fields:
-func (_m *PriceAggregator) AggregatePrices() {
-	_m.Called()
+// AggregatePrices provides a mock function with given fields: ctx
+func (_m *PriceAggregator) AggregatePrices(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// GetMissingPrices provides a mock function with given fields:
+func (_m *PriceAggregator) GetMissingPrices() ([]string, []string) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMissingPrices")
+	}
+
+	var r0 []string
+	var r1 []string
+	if rf, ok := ret.Get(0).(func() ([]string, []string)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() []string); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPriceInfo provides a mock function with given fields:
+func (_m *PriceAggregator) GetPriceInfo() map[string]types.PriceInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceInfo")
+	}
+
+	var r0 map[string]types.PriceInfo
+	if rf, ok := ret.Get(0).(func() map[string]types.PriceInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.PriceInfo)
+		}
+	}
+
+	return r0
+}
+
+// GetProviderTickers provides a mock function with given fields: pairs
+func (_m *PriceAggregator) GetProviderTickers(pairs []string) map[string][]string {
+	ret := _m.Called(pairs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderTickers")
+	}
+
+	var r0 map[string][]string
+	if rf, ok := ret.Get(0).(func([]string) map[string][]string); ok {
+		r0 = rf(pairs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+
+	return r0
 }
 
 // GetPrices provides a mock function with given fields:
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	types "github.com/skip-mev/slinky/oracle/types"
+
 	time "time"
 )
 
@@ -34,6 +36,78 @@ func (_m *Oracle) GetLastSyncTime() time.Time {
 	return r0
 }
 
+// GetMissingPrices provides a mock function with given fields:
+func (_m *Oracle) GetMissingPrices() ([]string, []string) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMissingPrices")
+	}
+
+	var r0 []string
+	var r1 []string
+	if rf, ok := ret.Get(0).(func() ([]string, []string)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() []string); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPriceInfo provides a mock function with given fields:
+func (_m *Oracle) GetPriceInfo() map[string]types.PriceInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceInfo")
+	}
+
+	var r0 map[string]types.PriceInfo
+	if rf, ok := ret.Get(0).(func() map[string]types.PriceInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.PriceInfo)
+		}
+	}
+
+	return r0
+}
+
+// GetProviderHealth provides a mock function with given fields:
+func (_m *Oracle) GetProviderHealth() []types.ProviderHealth {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProviderHealth")
+	}
+
+	var r0 []types.ProviderHealth
+	if rf, ok := ret.Get(0).(func() []types.ProviderHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ProviderHealth)
+		}
+	}
+
+	return r0
+}
+
 // GetPrices provides a mock function with given fields:
 func (_m *Oracle) GetPrices() map[string]*big.Float {
 	ret := _m.Called()
@@ -72,6 +146,36 @@ func (_m *Oracle) IsRunning() bool {
 	return r0
 }
 
+// RefreshPrices provides a mock function with given fields: ctx, pairs
+func (_m *Oracle) RefreshPrices(ctx context.Context, pairs []string) (map[string]*big.Float, error) {
+	ret := _m.Called(ctx, pairs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPrices")
+	}
+
+	var r0 map[string]*big.Float
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*big.Float, error)); ok {
+		return rf(ctx, pairs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*big.Float); ok {
+		r0 = rf(ctx, pairs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*big.Float)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pairs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Oracle) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
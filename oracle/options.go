@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
@@ -24,6 +25,19 @@ func WithUpdateInterval(updateInterval time.Duration) Option {
 	}
 }
 
+// WithAggregationInterval sets the interval at which the Oracle recomputes the aggregated prices,
+// independently of the update interval at which it fetches prices from its providers. An interval
+// of 0 aggregates the prices on every update.
+func WithAggregationInterval(aggregationInterval time.Duration) Option {
+	return func(o *OracleImpl) {
+		if aggregationInterval < 0 {
+			panic("aggregation interval cannot be negative")
+		}
+
+		o.aggregationInterval = aggregationInterval
+	}
+}
+
 // WithMaxCacheAge sets the max cache age on the Oracle.
 func WithMaxCacheAge(maxCacheAge time.Duration) Option {
 	return func(o *OracleImpl) {
@@ -81,3 +95,62 @@ func WithProviders(providers []*types.PriceProvider) Option {
 		o.providers = providers
 	}
 }
+
+// WithPriceStore sets the price store used to persist the last known prices of the Oracle
+// across restarts.
+func WithPriceStore(store PriceStore) Option {
+	return func(o *OracleImpl) {
+		if store == nil {
+			panic("cannot set nil price store")
+		}
+
+		o.priceStore = store
+	}
+}
+
+// WithPriceObserver adds an observer that is notified of the aggregated prices of the Oracle
+// after every update.
+func WithPriceObserver(observer PriceObserver) Option {
+	return func(o *OracleImpl) {
+		if observer == nil {
+			panic("cannot set nil price observer")
+		}
+
+		o.observers = append(o.observers, observer)
+	}
+}
+
+// WithPriceExporter adds an exporter that is notified of the aggregated prices of the Oracle and
+// their metadata after every update.
+func WithPriceExporter(exporter PriceExporter) Option {
+	return func(o *OracleImpl) {
+		if exporter == nil {
+			panic("cannot set nil price exporter")
+		}
+
+		o.exporters = append(o.exporters, exporter)
+	}
+}
+
+// WithPriceGuard adds a guard that is consulted after every update of the Oracle, and whose
+// withheld pairs are not served until the next update.
+func WithPriceGuard(guard PriceGuard) Option {
+	return func(o *OracleImpl) {
+		if guard == nil {
+			panic("cannot set nil price guard")
+		}
+
+		o.guards = append(o.guards, guard)
+	}
+}
+
+// WithRefreshConfig enables on-demand price refreshes on the Oracle with the given config.
+func WithRefreshConfig(cfg config.RefreshConfig) Option {
+	return func(o *OracleImpl) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid refresh config: %s", err))
+		}
+
+		o.refresh = cfg
+	}
+}
@@ -3,15 +3,22 @@ package oracle
 import (
 	"context"
 	"fmt"
+	"maps"
+	"math/big"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/skip-mev/slinky/oracle/config"
 	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/oracle/types"
 	ssync "github.com/skip-mev/slinky/pkg/sync"
+	"github.com/skip-mev/slinky/pkg/tracing"
 )
 
 var _ Oracle = (*OracleImpl)(nil)
@@ -23,6 +30,10 @@ type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
 	GetPrices() types.Prices
+	GetMissingPrices() (warmingUp []string, failing []string)
+	GetPriceInfo() map[string]types.PriceInfo
+	GetProviderHealth() []types.ProviderHealth
+	RefreshPrices(ctx context.Context, pairs []string) (types.Prices, error)
 	Start(ctx context.Context) error
 	Stop()
 }
@@ -60,8 +71,49 @@ type OracleImpl struct { //nolint
 	// each provider.
 	updateInterval time.Duration
 
+	// aggregationInterval is the interval at which the oracle will recompute the aggregated
+	// prices. If 0, the prices are aggregated every update interval.
+	aggregationInterval time.Duration
+
 	// maxCacheAge is the longest amount of time a price will stay in our cache
 	maxCacheAge time.Duration
+
+	// pushedPrices is the set of prices that have been pushed into the oracle via PushPrice.
+	// These are indexed by provider -> ticker -> price.
+	pushedPrices map[string]types.ResolvedPrices
+
+	// priceStore is an optional store used to persist the last known prices across restarts.
+	priceStore PriceStore
+
+	// observers are notified of the aggregated prices after every update.
+	observers []PriceObserver
+
+	// exporters are notified of the aggregated prices and their metadata after every update.
+	exporters []PriceExporter
+
+	// guards sanity check the aggregated prices after every update.
+	guards []PriceGuard
+
+	// withheld is the set of currency pairs that were withheld by the guards in the last update.
+	withheld map[string]struct{}
+
+	// restoredPrices is the set of prices loaded from the price store on startup. These are
+	// served for currency pairs that have not yet been resolved by the aggregator until they
+	// are older than the max cache age.
+	restoredPrices PriceSnapshot
+
+	// updateMtx serializes fetching and aggregating prices, which happen both on the oracle's
+	// update ticks and on on-demand refreshes.
+	updateMtx sync.Mutex
+
+	// refresh is the configuration of on-demand price refreshes.
+	refresh config.RefreshConfig
+
+	// refreshMtx guards lastRefresh.
+	refreshMtx sync.Mutex
+
+	// lastRefresh is the time of the last on-demand price refresh.
+	lastRefresh time.Time
 }
 
 // New returns a new instance of an Oracle. The oracle inputs providers that are
@@ -79,6 +131,7 @@ func New(opts ...Option) (*OracleImpl, error) {
 		metrics:        oraclemetrics.NewNopMetrics(),
 		updateInterval: 1 * time.Second,
 		maxCacheAge:    time.Minute, // default max cache age is 1 minute
+		pushedPrices:   make(map[string]types.ResolvedPrices),
 	}
 
 	for _, opt := range opts {
@@ -107,22 +160,43 @@ func (o *OracleImpl) Start(ctx context.Context) error {
 	ticker := time.NewTicker(o.updateInterval)
 	defer ticker.Stop()
 
+	// If an aggregation interval is configured, the prices fetched on each update are only
+	// aggregated on the aggregation ticker.
+	var aggregationC <-chan time.Time
+	if o.aggregationInterval > 0 {
+		aggregationTicker := time.NewTicker(o.aggregationInterval)
+		defer aggregationTicker.Stop()
+
+		aggregationC = aggregationTicker.C
+	}
+
 	// set the slinky build info on startup
 	o.metrics.SetSlinkyBuildInfo()
 
+	o.loadPrices()
+
 	for {
 		select {
 		case <-ctx.Done():
 			o.Stop()
 			o.logger.Info("oracle stopped via context")
+			o.savePrices()
 			return ctx.Err()
 
 		case <-o.closer.Done():
 			o.logger.Info("oracle stopped via closer")
+			o.savePrices()
 			return nil
 
 		case <-ticker.C:
-			o.tick()
+			if o.aggregationInterval > 0 {
+				o.fetch(ctx)
+			} else {
+				o.tick(ctx)
+			}
+
+		case <-aggregationC:
+			o.aggregate(ctx)
 		}
 	}
 }
@@ -137,38 +211,117 @@ func (o *OracleImpl) Stop() {
 
 // tick executes a single oracle tick. It fetches prices from each provider's
 // cache and computes the aggregated price for each currency pair.
-func (o *OracleImpl) tick() {
+func (o *OracleImpl) tick(ctx context.Context) {
+	ctx, span := tracing.Tracer().Start(ctx, "Oracle.tick")
+	defer span.End()
+
 	o.logger.Debug("starting oracle tick")
 
+	o.fetch(ctx)
+	o.aggregate(ctx)
+}
+
+// fetch replaces the prices of the aggregator with the latest prices from each provider's
+// cache and the prices pushed into the oracle.
+func (o *OracleImpl) fetch(ctx context.Context) {
+	ctx, span := tracing.Tracer().Start(ctx, "Oracle.fetch")
+	defer span.End()
+
 	defer func() {
 		if r := recover(); r != nil {
-			o.logger.Error("oracle tick panicked", zap.Error(fmt.Errorf("%v", r)))
+			o.logger.Error("oracle fetch panicked", zap.Error(fmt.Errorf("%v", r)))
 		}
 	}()
 
+	o.updateMtx.Lock()
+	defer o.updateMtx.Unlock()
+
 	// Reset the provider prices before fetching new prices.
 	o.priceAggregator.Reset()
 
 	// Retrieve the latest prices from each provider.
 	for _, priceProvider := range o.providers {
-		o.fetchPrices(priceProvider)
+		o.fetchPrices(ctx, priceProvider)
 	}
+	o.fetchPushedPrices()
 
 	o.logger.Debug("oracle fetched prices from providers")
+}
+
+// aggregate computes the aggregated price for each currency pair from the latest fetched
+// prices, applies the oracle's guards, and notifies the oracle's observers.
+func (o *OracleImpl) aggregate(ctx context.Context) {
+	ctx, span := tracing.Tracer().Start(ctx, "Oracle.aggregate")
+	defer span.End()
+
+	defer func() {
+		if r := recover(); r != nil {
+			o.logger.Error("oracle aggregation panicked", zap.Error(fmt.Errorf("%v", r)))
+		}
+	}()
+
+	o.updateMtx.Lock()
+	defer o.updateMtx.Unlock()
 
 	// Compute aggregated prices and update the oracle.
-	o.priceAggregator.AggregatePrices()
+	o.priceAggregator.AggregatePrices(ctx)
+	o.guardPrices()
 	o.setLastSyncTime(time.Now().UTC())
 
 	// update the last sync time
 	o.metrics.AddTick()
 
-	o.logger.Info("oracle updated prices", zap.Time("last_sync", o.GetLastSyncTime()), zap.Int("num_prices", len(o.GetPrices())))
+	prices := o.GetPrices()
+	for _, observer := range o.observers {
+		observer.ObservePrices(prices, o.GetLastSyncTime())
+	}
+
+	if len(o.exporters) > 0 {
+		info := o.GetPriceInfo()
+		for _, exporter := range o.exporters {
+			exporter.ExportPrices(prices, info, o.GetLastSyncTime())
+		}
+	}
+
+	span.SetAttributes(attribute.Int("slinky.num_prices", len(prices)))
+	o.logger.Info("oracle updated prices", zap.Time("last_sync", o.GetLastSyncTime()), zap.Int("num_prices", len(prices)))
+}
+
+// guardPrices consults each of the oracle's guards with the latest aggregated prices and
+// updates the set of currency pairs that are withheld.
+func (o *OracleImpl) guardPrices() {
+	if len(o.guards) == 0 {
+		return
+	}
+
+	prices := o.priceAggregator.GetPrices()
+	withheld := make(map[string]struct{})
+	for _, guard := range o.guards {
+		for _, cp := range guard.GuardPrices(prices) {
+			withheld[cp] = struct{}{}
+		}
+	}
+
+	for cp := range withheld {
+		o.logger.Debug("withholding price that failed a sanity check", zap.String("pair", cp))
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.withheld = withheld
 }
 
 // fetchPrices retrieves the latest prices from a given provider and updates the aggregator
 // iff the price age is less than the update interval.
-func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
+func (o *OracleImpl) fetchPrices(ctx context.Context, provider *types.PriceProvider) {
+	_, span := tracing.Tracer().Start(
+		ctx,
+		"Oracle.fetchPrices",
+		trace.WithAttributes(tracing.ProviderKey.String(provider.Name())),
+	)
+	defer span.End()
+
 	defer func() {
 		if r := recover(); r != nil {
 			o.logger.Error("provider panicked", zap.Error(fmt.Errorf("%v", r)))
@@ -184,6 +337,15 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		return
 	}
 
+	if provider.InMaintenance(time.Now().UTC()) {
+		o.logger.Debug(
+			"provider is in a scheduled maintenance window; skipping prices",
+			zap.String("provider", provider.Name()),
+		)
+
+		return
+	}
+
 	o.logger.Debug(
 		"retrieving prices",
 		zap.String("provider", provider.Name()),
@@ -203,10 +365,18 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		return
 	}
 
+	minVolume := provider.GetMinVolume()
+	baseUnitScale := provider.GetBaseUnitScale()
+	adjustment := provider.GetPriceAdjustment()
 	timeFilteredPrices := make(types.Prices)
+	exclusions := make(map[string]types.ExclusionReason)
+	volumes := make(types.Prices)
+	timestamps := make(map[string]time.Time)
 	for pair, result := range prices {
-		// If the price is older than the maxCacheAge, skip it.
-		diff := time.Now().UTC().Sub(result.Timestamp)
+		// If the price is older than the maxCacheAge, skip it. The age is measured from the time
+		// at which the exchange produced the price if the provider reports it, so that a backlogged
+		// feed is not considered fresh.
+		diff := time.Now().UTC().Sub(result.DataTimestamp())
 		if diff > o.maxCacheAge {
 			o.logger.Debug(
 				"skipping price",
@@ -216,18 +386,61 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 				zap.Duration("diff", diff),
 			)
 
+			exclusions[pair.GetOffChainTicker()] = types.ExclusionStale
 			continue
 		}
 
+		// If the price was reported with a volume below the provider's min volume, skip it.
+		if minVolume != nil && result.Volume != nil && result.Volume.Cmp(minVolume) < 0 {
+			o.logger.Debug(
+				"skipping price with insufficient volume",
+				zap.String("provider", provider.Name()),
+				zap.String("data handler type", string(provider.Type())),
+				zap.String("pair", pair.String()),
+				zap.String("volume", result.Volume.String()),
+				zap.String("min_volume", minVolume.String()),
+			)
+
+			exclusions[pair.GetOffChainTicker()] = types.ExclusionBelowMinVolume
+			continue
+		}
+
+		price := result.Value
+		if baseUnitScale.Enabled() {
+			price = baseUnitScale.Apply(price)
+		}
+
+		if adjustment.Enabled() {
+			adjusted, err := adjustment.Apply(price)
+			if err != nil {
+				o.logger.Debug(
+					"skipping price that failed to adjust",
+					zap.String("provider", provider.Name()),
+					zap.String("pair", pair.String()),
+					zap.String("price", price.String()),
+					zap.Error(err),
+				)
+
+				exclusions[pair.GetOffChainTicker()] = types.ExclusionAdjustmentFailed
+				continue
+			}
+
+			price = adjusted
+		}
+
 		o.logger.Debug(
 			"adding price",
 			zap.String("provider", provider.Name()),
 			zap.String("data handler type", string(provider.Type())),
 			zap.String("pair", pair.String()),
-			zap.String("price", result.Value.String()),
+			zap.String("price", price.String()),
 			zap.Duration("diff", diff),
 		)
-		timeFilteredPrices[pair.GetOffChainTicker()] = result.Value
+		timeFilteredPrices[pair.GetOffChainTicker()] = price
+		if result.Volume != nil {
+			volumes[pair.GetOffChainTicker()] = result.Volume
+		}
+		timestamps[pair.GetOffChainTicker()] = result.DataTimestamp()
 	}
 
 	o.logger.Debug("provider returned prices",
@@ -235,9 +448,96 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.String("data handler type", string(provider.Type())),
 		zap.Int("prices", len(prices)),
 	)
+	span.SetAttributes(attribute.Int("slinky.num_prices", len(timeFilteredPrices)))
+	if aggregator, ok := o.priceAggregator.(AuditAggregator); ok {
+		aggregator.SetProviderExclusions(provider.Name(), exclusions)
+	}
+	if aggregator, ok := o.priceAggregator.(VolumeAggregator); ok {
+		aggregator.SetProviderVolumes(provider.Name(), volumes)
+	}
+	if aggregator, ok := o.priceAggregator.(TimestampAggregator); ok {
+		aggregator.SetProviderTimestamps(provider.Name(), timestamps)
+	}
+
 	o.priceAggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
 }
 
+// PushPrice pushes a price for the given ticker into the oracle on behalf of the given provider.
+// This allows embedders to feed prices from custom in-process sources without implementing a
+// full provider. Pushed prices are aggregated alongside the prices of the oracle's providers
+// and are subject to the same max cache age, as determined by the given timestamp. The provider
+// name must not collide with any of the providers configured on the oracle and must be referenced
+// by the market map for the price to be utilized.
+func (o *OracleImpl) PushPrice(provider string, ticker types.ProviderTicker, price *big.Float, timestamp time.Time) error {
+	if len(provider) == 0 {
+		return fmt.Errorf("provider name cannot be empty")
+	}
+
+	if ticker == nil {
+		return fmt.Errorf("ticker cannot be nil")
+	}
+
+	if price == nil {
+		return fmt.Errorf("price cannot be nil")
+	}
+
+	for _, p := range o.providers {
+		if p.Name() == provider {
+			return fmt.Errorf("provider %s is already configured on the oracle", provider)
+		}
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	if _, ok := o.pushedPrices[provider]; !ok {
+		o.pushedPrices[provider] = make(types.ResolvedPrices)
+	}
+
+	o.pushedPrices[provider][ticker] = types.NewPriceResult(price, timestamp.UTC())
+	return nil
+}
+
+// fetchPushedPrices updates the aggregator with the prices that have been pushed into the oracle
+// via PushPrice. Prices that are older than the max cache age are removed.
+func (o *OracleImpl) fetchPushedPrices() {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	now := time.Now().UTC()
+	for provider, prices := range o.pushedPrices {
+		timeFilteredPrices := make(types.Prices)
+		timestamps := make(map[string]time.Time)
+		for ticker, result := range prices {
+			diff := now.Sub(result.Timestamp)
+			if diff > o.maxCacheAge {
+				o.logger.Debug(
+					"removing stale pushed price",
+					zap.String("provider", provider),
+					zap.String("pair", ticker.String()),
+					zap.Duration("diff", diff),
+				)
+
+				delete(prices, ticker)
+				continue
+			}
+
+			timeFilteredPrices[ticker.GetOffChainTicker()] = result.Value
+			timestamps[ticker.GetOffChainTicker()] = result.Timestamp
+		}
+
+		if len(prices) == 0 {
+			delete(o.pushedPrices, provider)
+		}
+
+		if aggregator, ok := o.priceAggregator.(TimestampAggregator); ok {
+			aggregator.SetProviderTimestamps(provider, timestamps)
+		}
+
+		o.priceAggregator.SetProviderPrices(provider, timeFilteredPrices)
+	}
+}
+
 // GetLastSyncTime returns the last time the oracle successfully updated prices.
 func (o *OracleImpl) GetLastSyncTime() time.Time {
 	o.mtx.RLock()
@@ -254,8 +554,146 @@ func (o *OracleImpl) setLastSyncTime(t time.Time) {
 	o.lastPriceSync = t
 }
 
-// GetPrices returns the aggregate prices from the oracle.
+// GetPrices returns the aggregate prices from the oracle. If prices were restored from the
+// price store on startup, the restored prices are returned for any currency pair that has not
+// yet been resolved, until the restored prices are older than the max cache age. Restored
+// prices continue to be reported by GetMissingPrices until the pair is resolved. Prices that
+// were withheld by the oracle's guards are not returned.
 func (o *OracleImpl) GetPrices() types.Prices {
 	prices := o.priceAggregator.GetPrices()
-	return prices
+
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if len(o.restoredPrices.Prices) > 0 && time.Since(o.restoredPrices.Timestamp) <= o.maxCacheAge {
+		merged := make(types.Prices, len(o.restoredPrices.Prices))
+		maps.Copy(merged, o.restoredPrices.Prices)
+		maps.Copy(merged, prices)
+		prices = merged
+	}
+
+	if len(o.withheld) == 0 {
+		return prices
+	}
+
+	guarded := make(types.Prices, len(prices))
+	for cp, price := range prices {
+		if _, ok := o.withheld[cp]; !ok {
+			guarded[cp] = price
+		}
+	}
+
+	return guarded
+}
+
+// loadPrices loads the last known prices from the price store, if one is configured.
+func (o *OracleImpl) loadPrices() {
+	if o.priceStore == nil {
+		return
+	}
+
+	snapshot, err := o.priceStore.Load()
+	if err != nil {
+		o.logger.Error("failed to load prices from price store", zap.Error(err))
+		return
+	}
+
+	if len(snapshot.Prices) == 0 {
+		o.logger.Info("no prices found in price store")
+		return
+	}
+
+	if age := time.Since(snapshot.Timestamp); age > o.maxCacheAge {
+		o.logger.Info(
+			"ignoring stale prices from price store",
+			zap.Time("snapshot_time", snapshot.Timestamp),
+			zap.Duration("age", age),
+		)
+		return
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	o.restoredPrices = snapshot
+	o.logger.Info(
+		"restored prices from price store",
+		zap.Time("snapshot_time", snapshot.Timestamp),
+		zap.Int("num_prices", len(snapshot.Prices)),
+	)
+}
+
+// savePrices persists the current aggregated prices to the price store, if one is configured.
+// Nothing is saved if the oracle has not resolved any prices, so that a previously saved
+// snapshot is not overwritten with an empty one.
+func (o *OracleImpl) savePrices() {
+	if o.priceStore == nil {
+		return
+	}
+
+	prices := o.priceAggregator.GetPrices()
+	if len(prices) == 0 {
+		o.logger.Info("no prices to save to price store")
+		return
+	}
+
+	snapshot := PriceSnapshot{
+		Timestamp: o.GetLastSyncTime(),
+		Prices:    prices,
+	}
+	if err := o.priceStore.Save(snapshot); err != nil {
+		o.logger.Error("failed to save prices to price store", zap.Error(err))
+		return
+	}
+
+	o.logger.Info("saved prices to price store", zap.Int("num_prices", len(prices)))
+}
+
+// GetMissingPrices returns the currency pairs that the oracle failed to resolve a price for,
+// split by whether they are still within the configured no data grace period (warming up)
+// or not (failing). Currency pairs whose prices were withheld by the oracle's guards are reported
+// as failing.
+func (o *OracleImpl) GetMissingPrices() ([]string, []string) {
+	warmingUp, failing := o.priceAggregator.GetMissingPrices()
+
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+
+	if len(o.withheld) == 0 {
+		return warmingUp, failing
+	}
+
+	for cp := range o.withheld {
+		failing = append(failing, cp)
+	}
+	sort.Strings(failing)
+
+	return warmingUp, failing
+}
+
+// GetPriceInfo returns the metadata of each price aggregated in the last update i.e. the
+// decimals of the price and the providers that contributed to it.
+func (o *OracleImpl) GetPriceInfo() map[string]types.PriceInfo {
+	return o.priceAggregator.GetPriceInfo()
+}
+
+// GetProviderHealth returns the health of each provider, sorted by name.
+func (o *OracleImpl) GetProviderHealth() []types.ProviderHealth {
+	health := make([]types.ProviderHealth, 0, len(o.providers))
+	for _, provider := range o.providers {
+		lastErr, lastErrTime := provider.GetLastError()
+		health = append(health, types.ProviderHealth{
+			Name:          provider.Name(),
+			Running:       provider.IsRunning(),
+			Connections:   provider.GetWebSocketConnectionStates(),
+			LastError:     lastErr,
+			LastErrorTime: lastErrTime,
+		})
+	}
+
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Name < health[j].Name
+	})
+
+	return health
 }
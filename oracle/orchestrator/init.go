@@ -6,10 +6,12 @@ import (
 	"math/big"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/providers/base"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	mmclienttypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
 )
 
@@ -18,6 +20,17 @@ func (o *ProviderOrchestrator) Init(ctx context.Context) error {
 	o.mut.Lock()
 	defer o.mut.Unlock()
 
+	o.rateLimiters = newRateLimiters(o.cfg.RateLimitGroups)
+	for _, cfg := range o.cfg.RateLimitGroups {
+		o.logger.Info(
+			"configured rate limit group",
+			zap.String("group", cfg.Name),
+			zap.Strings("providers", cfg.Providers),
+			zap.Float64("requests_per_second", cfg.RequestsPerSecond),
+			zap.Int("burst", cfg.Burst),
+		)
+	}
+
 	for _, cfg := range o.cfg.Providers {
 		// Initialize the provider.
 		var err error
@@ -41,6 +54,30 @@ func (o *ProviderOrchestrator) Init(ctx context.Context) error {
 		}
 	}
 
+	// If a market map provider is configured, the market map is checked once it has been
+	// resolved from the provider on startup.
+	if o.cfg.FailOnEmptyMarketMap && o.mmProvider == nil && len(o.marketMap.Markets) == 0 {
+		return fmt.Errorf(
+			"%w: no market config was provided and no market map provider is configured; "+
+				"provide a market config or configure a %s provider",
+			ErrEmptyMarketMap,
+			mmclienttypes.ConfigType,
+		)
+	}
+
+	if err := o.checkMarketProviders(o.marketMap); err != nil {
+		return err
+	}
+
+	if err := o.checkRequiredProviders(o.marketMap); err != nil {
+		return err
+	}
+
+	aggregatedMarketMap := o.skipUnknownProviders(o.marketMap)
+	if o.aggregator != nil {
+		o.aggregator.UpdateMarketMap(aggregatedMarketMap)
+	}
+
 	return nil
 }
 
@@ -62,6 +99,10 @@ func (o *ProviderOrchestrator) createPriceProvider(ctx context.Context, cfg conf
 			return fmt.Errorf("failed to create %s's api query handler: %w", cfg.Name, err)
 		}
 
+		if err := o.setRateLimiter(cfg.Name, queryHandler); err != nil {
+			return err
+		}
+
 		provider, err = types.NewPriceProvider(
 			base.WithName[types.ProviderTicker, *big.Float](cfg.Name),
 			base.WithLogger[types.ProviderTicker, *big.Float](o.logger),
@@ -69,6 +110,11 @@ func (o *ProviderOrchestrator) createPriceProvider(ctx context.Context, cfg conf
 			base.WithAPIConfig[types.ProviderTicker, *big.Float](cfg.API),
 			base.WithIDs[types.ProviderTicker, *big.Float](tickers),
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
+			base.WithMinVolume[types.ProviderTicker, *big.Float](cfg.MinVolume),
+			base.WithBaseUnitScale[types.ProviderTicker, *big.Float](cfg.BaseUnitScale),
+			base.WithPriceAdjustment[types.ProviderTicker, *big.Float](cfg.PriceAdjustment),
+			base.WithMaintenanceWindows[types.ProviderTicker, *big.Float](cfg.MaintenanceWindows),
+			base.WithResponseAssertions[types.ProviderTicker, *big.Float](cfg.Assertions),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
@@ -86,6 +132,11 @@ func (o *ProviderOrchestrator) createPriceProvider(ctx context.Context, cfg conf
 			base.WithWebSocketConfig[types.ProviderTicker, *big.Float](cfg.WebSocket),
 			base.WithIDs[types.ProviderTicker, *big.Float](tickers),
 			base.WithMetrics[types.ProviderTicker, *big.Float](o.providerMetrics),
+			base.WithMinVolume[types.ProviderTicker, *big.Float](cfg.MinVolume),
+			base.WithBaseUnitScale[types.ProviderTicker, *big.Float](cfg.BaseUnitScale),
+			base.WithPriceAdjustment[types.ProviderTicker, *big.Float](cfg.PriceAdjustment),
+			base.WithMaintenanceWindows[types.ProviderTicker, *big.Float](cfg.MaintenanceWindows),
+			base.WithResponseAssertions[types.ProviderTicker, *big.Float](cfg.Assertions),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create %s's provider: %w", cfg.Name, err)
@@ -94,6 +145,33 @@ func (o *ProviderOrchestrator) createPriceProvider(ctx context.Context, cfg conf
 		return fmt.Errorf("provider %s has no enabled query handlers", cfg.Name)
 	}
 
+	if cfg.BaseUnitScale.Enabled() {
+		o.logger.Info(
+			"normalizing provider prices to whole units",
+			zap.String("provider", cfg.Name),
+			zap.Int("base_unit_scale", int(cfg.BaseUnitScale)),
+		)
+	}
+
+	if cfg.PriceAdjustment.Enabled() {
+		o.logger.Info(
+			"adjusting provider prices",
+			zap.String("provider", cfg.Name),
+			zap.Float64("multiplier", cfg.PriceAdjustment.Multiplier),
+			zap.Float64("offset", cfg.PriceAdjustment.Offset),
+		)
+	}
+
+	for _, window := range cfg.MaintenanceWindows {
+		o.logger.Info(
+			"configured provider maintenance window",
+			zap.String("provider", cfg.Name),
+			zap.String("start", window.Start),
+			zap.Duration("duration", window.Duration),
+			zap.Strings("weekdays", window.Weekdays),
+		)
+	}
+
 	state := ProviderState{
 		Provider: provider,
 		Cfg:      cfg,
@@ -150,6 +228,10 @@ func (o *ProviderOrchestrator) createMarketMapProvider(cfg config.ProviderConfig
 		return fmt.Errorf("failed to create market map provider (%s): %w", cfg.Name, err)
 	}
 
+	if err := o.setRateLimiter(cfg.Name, mapper.GetAPIHandler()); err != nil {
+		return err
+	}
+
 	o.mmProvider = mapper
 	o.logger.Info(
 		"created market map provider",
@@ -157,3 +239,35 @@ func (o *ProviderOrchestrator) createMarketMapProvider(cfg config.ProviderConfig
 	)
 	return nil
 }
+
+// newRateLimiters creates a single rate limiter for each rate limit group, and returns the rate
+// limiter of each provider in the group indexed by provider name.
+func newRateLimiters(groups []config.RateLimitGroupConfig) map[string]*rate.Limiter {
+	limiters := make(map[string]*rate.Limiter)
+	for _, group := range groups {
+		limiter := rate.NewLimiter(rate.Limit(group.RequestsPerSecond), group.Burst)
+		for _, provider := range group.Providers {
+			limiters[provider] = limiter
+		}
+	}
+
+	return limiters
+}
+
+// setRateLimiter sets the shared rate limiter of the given provider's API query handler, if the
+// provider belongs to a rate limit group. This returns an error if the query handler does not
+// support rate limiting.
+func (o *ProviderOrchestrator) setRateLimiter(provider string, handler any) error {
+	limiter, ok := o.rateLimiters[provider]
+	if !ok {
+		return nil
+	}
+
+	rateLimited, ok := handler.(apihandlers.APIRateLimiter)
+	if !ok {
+		return fmt.Errorf("provider %s belongs to a rate limit group but its api query handler does not support rate limiting", provider)
+	}
+
+	rateLimited.SetRateLimiter(limiter)
+	return nil
+}
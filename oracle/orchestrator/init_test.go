@@ -104,6 +104,38 @@ func TestInit(t *testing.T) {
 		)
 	})
 
+	t.Run("errors when markets have no enabled providers and rejection is configured", func(t *testing.T) {
+		cfg := oracleCfg
+		cfg.Providers = oracleCfg.Providers[:1] // binance only
+		cfg.RejectMarketsWithoutProviders = true
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			cfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithMarketMap(marketMap),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+
+		require.Error(t, o.Init(context.TODO()))
+	})
+
+	t.Run("errors when the market map is empty and no market map provider is configured", func(t *testing.T) {
+		cfg := oracleCfg
+		cfg.FailOnEmptyMarketMap = true
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			cfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, o.Init(context.TODO()), orchestrator.ErrEmptyMarketMap)
+	})
+
 	t.Run("errors when the API query handler factory is not set", func(t *testing.T) {
 		o, err := orchestrator.NewProviderOrchestrator(
 			oracleCfg,
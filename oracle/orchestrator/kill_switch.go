@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// SetProviderKilled kills or revives the provider with the given name at runtime. A killed provider
+// is stopped immediately, so that it no longer queries its data source, and its cached and last good
+// prices are cleared from the aggregator, so that its prices are excluded from the oracle's
+// aggregation right away. It is not restarted by market map updates until it is revived. Reviving
+// a provider restarts it if the market map configures any tickers for it. Kills are not persisted
+// across restarts.
+func (o *ProviderOrchestrator) SetProviderKilled(name string, killed bool) error {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	state, ok := o.providers[name]
+	if !ok {
+		return fmt.Errorf("provider %s is not configured", name)
+	}
+
+	if killed {
+		o.killed[name] = struct{}{}
+		state.Provider.Stop()
+		if o.aggregator != nil {
+			o.aggregator.ResetProvider(name)
+		}
+		o.logger.Warn("killed provider", zap.String("provider", name))
+	} else {
+		delete(o.killed, name)
+
+		providerTickers, err := types.ProviderTickersFromMarketMap(name, o.marketMap)
+		if err != nil {
+			o.logger.Error("failed to create provider market map", zap.String("provider", name), zap.Error(err))
+			return err
+		}
+
+		if _, err := o.UpdateProviderState(providerTickers, state); err != nil {
+			o.logger.Error("failed to update provider state", zap.String("provider", name), zap.Error(err))
+			return err
+		}
+
+		o.logger.Warn("revived provider", zap.String("provider", name))
+	}
+
+	o.metrics.UpdateProviderKilled(name, killed)
+	return nil
+}
+
+// IsProviderKilled returns true if the provider with the given name is killed via the provider
+// kill switch.
+func (o *ProviderOrchestrator) IsProviderKilled(name string) bool {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	_, ok := o.killed[name]
+	return ok
+}
+
+// GetKilledProviders returns the names of the providers that are killed via the provider kill
+// switch, sorted by name.
+func (o *ProviderOrchestrator) GetKilledProviders() []string {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+
+	killed := make([]string, 0, len(o.killed))
+	for name := range o.killed {
+		killed = append(killed, name)
+	}
+	sort.Strings(killed)
+
+	return killed
+}
@@ -0,0 +1,75 @@
+package orchestrator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
+	"github.com/skip-mev/slinky/oracle/orchestrator"
+	"github.com/skip-mev/slinky/oracle/types"
+	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
+	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestSetProviderKilled(t *testing.T) {
+	metrics := metricmocks.NewMetrics(t)
+	metrics.On("UpdateProviderKilled", coinbase.Name, true).Return().Once()
+	metrics.On("UpdateProviderKilled", coinbase.Name, false).Return().Once()
+
+	aggregator, err := oraclemath.NewIndexPriceAggregator(logger, mmtypes.MarketMap{}, oraclemetrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	o, err := orchestrator.NewProviderOrchestrator(
+		oracleCfg,
+		orchestrator.WithLogger(logger),
+		orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+		orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		orchestrator.WithMarketMap(marketMap),
+		orchestrator.WithMetrics(metrics),
+		orchestrator.WithAggregator(aggregator),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, o.Start(ctx))
+	defer o.Stop()
+
+	provider := o.GetProviderState()[coinbase.Name].Provider
+	require.Eventually(t, provider.IsRunning, 5*time.Second, 10*time.Millisecond)
+
+	// Unknown providers cannot be killed.
+	require.Error(t, o.SetProviderKilled("unknown", true))
+	require.Empty(t, o.GetKilledProviders())
+
+	// Killing a provider stops it and clears its prices from the aggregator.
+	cfg := mmtypes.ProviderConfig{Name: coinbase.Name, OffChainTicker: "BTC-USD"}
+	aggregator.SetProviderPrices(coinbase.Name, types.Prices{cfg.OffChainTicker: big.NewFloat(70_000)})
+	_, err = aggregator.GetProviderPrice(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, o.SetProviderKilled(coinbase.Name, true))
+	require.True(t, o.IsProviderKilled(coinbase.Name))
+	require.Equal(t, []string{coinbase.Name}, o.GetKilledProviders())
+	require.False(t, provider.IsRunning())
+	_, err = aggregator.GetProviderPrice(cfg)
+	require.Error(t, err)
+
+	// Market map updates do not restart a killed provider.
+	require.NoError(t, o.UpdateWithMarketMap(marketMap))
+	time.Sleep(100 * time.Millisecond)
+	require.False(t, provider.IsRunning())
+
+	// Reviving a provider restarts it.
+	require.NoError(t, o.SetProviderKilled(coinbase.Name, false))
+	require.False(t, o.IsProviderKilled(coinbase.Name))
+	require.Empty(t, o.GetKilledProviders())
+	require.Eventually(t, provider.IsRunning, 5*time.Second, 10*time.Millisecond)
+}
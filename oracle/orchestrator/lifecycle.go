@@ -3,12 +3,17 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/types"
 )
 
+// providerRestartDelay is the amount of time to wait before restarting a provider that panicked.
+const providerRestartDelay = time.Second
+
 // generalProvider is an interface for a provider that implements the base provider.
 type generalProvider interface {
 	// Start starts the provider.
@@ -55,6 +60,16 @@ func (o *ProviderOrchestrator) Start(ctx context.Context) error {
 			o.execProviderFn(ctx, o.mmProvider)
 		}()
 
+		// Block until the market map provider has resolved a market map, so that an empty
+		// market map fails startup rather than silently serving no prices.
+		if o.cfg.FailOnEmptyMarketMap && len(o.GetMarketMap().Markets) == 0 {
+			if err := o.awaitMarketMap(ctx); err != nil {
+				o.logger.Error("failed to resolve market map on startup", zap.Error(err))
+				o.Stop()
+				return err
+			}
+		}
+
 		o.wg.Add(1)
 		go func() {
 			defer o.wg.Done()
@@ -77,24 +92,50 @@ func (o *ProviderOrchestrator) Stop() {
 	o.logger.Info("provider orchestrator exited successfully")
 }
 
-// execProviderFn starts a provider and recovers from any panics that occur.
+// execProviderFn starts a provider and recovers from any panics that occur. If the provider
+// panics, the panic is recorded and the provider is restarted after a short delay, unless the
+// context has been cancelled.
 func (o *ProviderOrchestrator) execProviderFn(
 	ctx context.Context,
 	p generalProvider,
 ) {
-	defer func() {
-		if r := recover(); r != nil {
-			o.logger.Error("recovered from panic", zap.Error(fmt.Errorf("%v", r)))
-		}
-	}()
-
 	if ctx == nil {
 		o.logger.Error("main context is nil; cannot start provider", zap.String("provider", p.Name()))
 		return
 	}
 
+	for {
+		if !o.runProvider(ctx, p) || o.IsProviderKilled(p.Name()) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(providerRestartDelay):
+			o.logger.Info("restarting provider after panic", zap.String("provider", p.Name()))
+		}
+	}
+}
+
+// runProvider runs the provider until it exits. It returns true if the provider panicked.
+func (o *ProviderOrchestrator) runProvider(ctx context.Context, p generalProvider) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			o.logger.Error(
+				"recovered from panic",
+				zap.String("provider", p.Name()),
+				zap.Error(fmt.Errorf("%v", r)),
+				zap.ByteString("stack", debug.Stack()),
+			)
+			o.providerMetrics.AddProviderPanic(p.Name())
+			panicked = true
+		}
+	}()
+
 	err := p.Start(ctx)
 	o.logger.Error("provider exited", zap.String("provider", p.Name()), zap.Error(err))
+	return false
 }
 
 // setMainCtx sets the main context for the provider orchestrator.
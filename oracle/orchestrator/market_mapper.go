@@ -3,14 +3,83 @@ package orchestrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"time"
 
 	"go.uber.org/zap"
+
+	mmclient "github.com/skip-mev/slinky/service/clients/marketmap"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+const (
+	// DefaultMarketMapStartupTimeout is the default amount of time that the orchestrator waits
+	// for the market map provider to resolve a market map on startup.
+	DefaultMarketMapStartupTimeout = time.Minute
+
+	// marketMapStartupPollInterval is the interval at which the market map provider is polled
+	// while waiting for it to resolve a market map on startup.
+	marketMapStartupPollInterval = 100 * time.Millisecond
 )
 
+// ErrEmptyMarketMap is returned on startup if the resolved market map contains no markets and
+// the oracle is configured to fail on an empty market map.
+var ErrEmptyMarketMap = errors.New("market map is empty")
+
+// awaitMarketMap waits for the market map provider to resolve a market map and updates the
+// orchestrator with it. An error is returned if the resolved market map is empty, or if no
+// market map is resolved within the market map startup timeout.
+func (o *ProviderOrchestrator) awaitMarketMap(ctx context.Context) error {
+	mmProvider := o.GetMarketMapProvider()
+	ids := mmProvider.GetIDs()
+	if len(ids) != 1 {
+		return fmt.Errorf("market map provider can only be responsible for one chain; got %v", ids)
+	}
+	chain := ids[0]
+
+	ctx, cancel := context.WithTimeout(ctx, o.mmStartupTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(marketMapStartupPollInterval)
+	defer ticker.Stop()
+
+	o.logger.Info("waiting for market map provider to resolve a market map", zap.String("chain", chain.String()))
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf(
+				"market map provider %s did not resolve a market map within %s; check its endpoint in the oracle config: %w",
+				mmProvider.Name(),
+				o.mmStartupTimeout,
+				ctx.Err(),
+			)
+		case <-ticker.C:
+			result, ok := mmProvider.GetData()[chain]
+			if !ok {
+				continue
+			}
+
+			if len(result.Value.MarketMap.Markets) == 0 {
+				return fmt.Errorf(
+					"%w: market map provider %s resolved an empty market map for %s; check its endpoint and chain in the oracle config",
+					ErrEmptyMarketMap,
+					mmProvider.Name(),
+					chain.String(),
+				)
+			}
+
+			o.applyMarketMapUpdate(result.Value.MarketMap)
+			return nil
+		}
+	}
+}
+
 // listenForMarketMapUpdates is a goroutine that listens for market map updates and
-// updates the orchestrated providers with the new market map.
+// updates the orchestrated providers with the new market map. If a market map streamer
+// is configured, updates are applied as soon as they are streamed. Otherwise, or while
+// the stream is unavailable, the market map provider is polled on its configured interval.
 func (o *ProviderOrchestrator) listenForMarketMapUpdates(ctx context.Context) {
 	mmProvider := o.GetMarketMapProvider()
 	ids := mmProvider.GetIDs()
@@ -21,12 +90,57 @@ func (o *ProviderOrchestrator) listenForMarketMapUpdates(ctx context.Context) {
 
 	apiCfg := mmProvider.GetAPIConfig()
 	ticker := time.NewTicker(apiCfg.Interval)
+	defer ticker.Stop()
+
+	// streamUpdates receives market maps from the streamer, streamDone receives the error that
+	// terminated the stream, and retryStream fires when the stream should be re-established.
+	streamUpdates := make(chan mmtypes.MarketMap)
+	streamDone := make(chan error, 1)
+	var retryStream <-chan time.Time
+	startStream := func() {
+		ticker.Stop()
+		go func() {
+			streamDone <- o.mmStreamer.StreamMarketMap(ctx, func(mm mmtypes.MarketMap) {
+				select {
+				case streamUpdates <- mm:
+				case <-ctx.Done():
+				}
+			})
+		}()
+	}
+	if o.mmStreamer != nil {
+		startStream()
+	}
+
 	chain := ids[0]
 	o.logger.Info("listening for market map updates", zap.String("chain", chain.String()))
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case updated := <-streamUpdates:
+			o.applyMarketMapUpdate(updated)
+		case err := <-streamDone:
+			if ctx.Err() != nil {
+				return
+			}
+
+			// Fall back to polling the market map provider. The stream is only retried if the
+			// source supports streaming.
+			ticker.Reset(apiCfg.Interval)
+			if errors.Is(err, mmclient.ErrStreamingUnsupported) {
+				o.logger.Info("market map source does not support streaming; polling for updates")
+				continue
+			}
+
+			o.logger.Error(
+				"market map stream failed; polling for updates until the stream is re-established",
+				zap.Error(err),
+			)
+			retryStream = time.After(apiCfg.ReconnectTimeout)
+		case <-retryStream:
+			retryStream = nil
+			startStream()
 		case <-ticker.C:
 			// Fetch the latest market map.
 			response := mmProvider.GetData()
@@ -41,27 +155,31 @@ func (o *ProviderOrchestrator) listenForMarketMapUpdates(ctx context.Context) {
 				continue
 			}
 
-			// Update the orchestrator with the latest market map iff the market map has changed.
-			updated := result.Value.MarketMap
-			if o.marketMap.Equal(updated) {
-				o.logger.Debug("market map has not changed")
-				continue
-			}
+			o.applyMarketMapUpdate(result.Value.MarketMap)
+		}
+	}
+}
 
-			o.logger.Info("updating orchestrator with new market map")
-			if err := o.UpdateWithMarketMap(updated); err != nil {
-				o.logger.Error("failed to update orchestrator with new market map", zap.Error(err))
-				continue
-			}
+// applyMarketMapUpdate updates the orchestrator with the given market map iff the market map
+// has changed, and writes the market map to the configured path.
+func (o *ProviderOrchestrator) applyMarketMapUpdate(updated mmtypes.MarketMap) {
+	if o.marketMap.Equal(updated) {
+		o.logger.Debug("market map has not changed")
+		return
+	}
 
-			// Write the market map to the configured path.
-			if err := o.WriteMarketMap(); err != nil {
-				o.logger.Error("failed to write market map", zap.Error(err))
-			}
+	o.logger.Info("updating orchestrator with new market map")
+	if err := o.UpdateWithMarketMap(updated); err != nil {
+		o.logger.Error("failed to update orchestrator with new market map", zap.Error(err))
+		return
+	}
 
-			o.logger.Info("updated orchestrator with new market map", zap.Any("market_map", updated))
-		}
+	// Write the market map to the configured path.
+	if err := o.WriteMarketMap(); err != nil {
+		o.logger.Error("failed to write market map", zap.Error(err))
 	}
+
+	o.logger.Info("updated orchestrator with new market map", zap.Any("market_map", updated))
 }
 
 // WriteMarketMap writes the orchestrator's market map to the configured path.
@@ -12,10 +12,85 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/orchestrator"
 	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
+	mmclient "github.com/skip-mev/slinky/service/clients/marketmap"
 	mmclienttypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
 
+func TestAwaitMarketMapOnStartup(t *testing.T) {
+	cfg := oracleCfgWithOnlyMockMapper
+	cfg.FailOnEmptyMarketMap = true
+
+	t.Run("startup fails if the mapper resolves an empty market map", func(t *testing.T) {
+		chains := []mmclienttypes.Chain{{ChainID: "dYdX"}}
+		handler, factory := marketMapperFactory(t, chains)
+		handler.On("CreateURL", mock.Anything).Return("", nil).Maybe()
+
+		resolved := make(mmclienttypes.ResolvedMarketMap)
+		resp := mmtypes.MarketMapResponse{
+			MarketMap: mmtypes.MarketMap{Markets: map[string]mmtypes.Market{}},
+		}
+		resolved[chains[0]] = mmclienttypes.NewMarketMapResult(&resp, time.Now())
+		handler.On("ParseResponse", mock.Anything, mock.Anything).Return(mmclienttypes.NewMarketMapResponse(resolved, nil)).Maybe()
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			cfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithMarketMapperFactory(factory),
+		)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, o.Start(context.Background()), orchestrator.ErrEmptyMarketMap)
+	})
+
+	t.Run("startup fails if the mapper does not resolve a market map in time", func(t *testing.T) {
+		chains := []mmclienttypes.Chain{{ChainID: "dYdX"}}
+		handler, factory := marketMapperFactory(t, chains)
+		handler.On("CreateURL", mock.Anything).Return("", fmt.Errorf("failed to create url")).Maybe()
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			cfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithMarketMapperFactory(factory),
+			orchestrator.WithMarketMapStartupTimeout(500*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		err = o.Start(context.Background())
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.NotErrorIs(t, err, orchestrator.ErrEmptyMarketMap)
+	})
+
+	t.Run("startup waits for the mapper to resolve a non-empty market map", func(t *testing.T) {
+		chains := []mmclienttypes.Chain{{ChainID: "dYdX"}}
+		handler, factory := marketMapperFactory(t, chains)
+		handler.On("CreateURL", mock.Anything).Return("", nil).Maybe()
+
+		resolved := make(mmclienttypes.ResolvedMarketMap)
+		resp := mmtypes.MarketMapResponse{
+			MarketMap: marketMap,
+		}
+		resolved[chains[0]] = mmclienttypes.NewMarketMapResult(&resp, time.Now())
+		handler.On("ParseResponse", mock.Anything, mock.Anything).Return(mmclienttypes.NewMarketMapResponse(resolved, nil)).Maybe()
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			cfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithMarketMapperFactory(factory),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		require.NoError(t, o.Start(ctx))
+		require.Equal(t, marketMap, o.GetMarketMap())
+
+		cancel()
+		o.Stop()
+	})
+}
+
 func TestListenForMarketMapUpdates(t *testing.T) {
 	t.Run("mapper has no chain IDs to fetch should not update the orchestrator", func(t *testing.T) {
 		handler, factory := marketMapperFactory(t, nil)
@@ -267,4 +342,94 @@ func TestListenForMarketMapUpdates(t *testing.T) {
 		// Clean up the file.
 		require.NoError(t, os.Remove(path))
 	})
+
+	t.Run("can update providers with a streamed market map", func(t *testing.T) {
+		handler, factory := marketMapperFactory(t, []mmclienttypes.Chain{{ChainID: "dYdX"}})
+		handler.On("CreateURL", mock.Anything).Return("", fmt.Errorf("polling disabled")).Maybe()
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			oracleCfgWithMockMapper,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithMarketMapperFactory(factory),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			orchestrator.WithMarketMapStreamer(&marketMapStreamer{maps: []mmtypes.MarketMap{marketMap}}),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			require.NoError(t, o.Start(ctx))
+		}()
+
+		// The orchestrator should have been updated.
+		require.Eventually(t, func() bool {
+			return marketMap.Equal(o.GetMarketMap())
+		}, 5*time.Second, 100*time.Millisecond)
+
+		// Stop the orchestrator.
+		cancel()
+		o.Stop()
+	})
+
+	t.Run("falls back to polling if streaming is not supported", func(t *testing.T) {
+		chains := []mmclienttypes.Chain{{ChainID: "dYdX"}}
+		handler, factory := marketMapperFactory(t, chains)
+		handler.On("CreateURL", mock.Anything).Return("", nil).Maybe()
+
+		resolved := make(mmclienttypes.ResolvedMarketMap)
+		resp := mmtypes.MarketMapResponse{
+			MarketMap: marketMap,
+		}
+		resolved[chains[0]] = mmclienttypes.NewMarketMapResult(&resp, time.Now())
+		handler.On("ParseResponse", mock.Anything, mock.Anything).Return(mmclienttypes.NewMarketMapResponse(resolved, nil)).Maybe()
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			oracleCfgWithMockMapper,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithMarketMapperFactory(factory),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			orchestrator.WithMarketMapStreamer(&marketMapStreamer{err: mmclient.ErrStreamingUnsupported}),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			require.NoError(t, o.Start(ctx))
+		}()
+
+		// The orchestrator should have been updated.
+		require.Eventually(t, func() bool {
+			return marketMap.Equal(o.GetMarketMap())
+		}, 5*time.Second, 100*time.Millisecond)
+
+		// Stop the orchestrator.
+		cancel()
+		o.Stop()
+	})
+}
+
+// marketMapStreamer streams the configured market maps and then blocks until the context is
+// cancelled, or returns the configured error immediately.
+type marketMapStreamer struct {
+	maps []mmtypes.MarketMap
+	err  error
+}
+
+func (s *marketMapStreamer) StreamMarketMap(ctx context.Context, onUpdate func(mmtypes.MarketMap)) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	for _, mm := range s.maps {
+		onUpdate(mm)
+	}
+
+	<-ctx.Done()
+	return ctx.Err()
 }
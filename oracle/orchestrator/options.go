@@ -1,8 +1,11 @@
 package orchestrator
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/pkg/math/oracle"
 	mmclienttypes "github.com/skip-mev/slinky/service/clients/marketmap/types"
@@ -26,6 +29,11 @@ func WithLogger(logger *zap.Logger) Option {
 // WithMarketMap sets the market map for the provider orchestrator.
 func WithMarketMap(marketMap mmtypes.MarketMap) Option {
 	return func(m *ProviderOrchestrator) {
+		marketMap, err := marketMap.Canonicalize()
+		if err != nil {
+			panic(err)
+		}
+
 		if err := marketMap.ValidateBasic(); err != nil {
 			panic(err)
 		}
@@ -70,6 +78,32 @@ func WithMarketMapperFactory(factory mmclienttypes.MarketMapFactory) Option {
 	}
 }
 
+// WithMarketMapStreamer sets the market map streamer for the provider orchestrator. When set, market
+// map updates are applied as soon as they are streamed rather than on the market map provider's
+// polling interval. Note that this is optional.
+func WithMarketMapStreamer(streamer MarketMapStreamer) Option {
+	return func(m *ProviderOrchestrator) {
+		if streamer == nil {
+			panic("market map streamer cannot be nil")
+		}
+
+		m.mmStreamer = streamer
+	}
+}
+
+// WithMarketMapStartupTimeout sets the amount of time that the provider orchestrator waits for the
+// market map provider to resolve a market map on startup, if the oracle is configured to fail on an
+// empty market map.
+func WithMarketMapStartupTimeout(timeout time.Duration) Option {
+	return func(m *ProviderOrchestrator) {
+		if timeout <= 0 {
+			panic("market map startup timeout must be positive")
+		}
+
+		m.mmStartupTimeout = timeout
+	}
+}
+
 // WithWriteTo sets the file path to which market map updates will be written to. Note that this is optional.
 func WithWriteTo(filePath string) Option {
 	return func(m *ProviderOrchestrator) {
@@ -87,3 +121,15 @@ func WithAggregator(fn *oracle.IndexPriceAggregator) Option {
 		m.aggregator = fn
 	}
 }
+
+// WithMetrics sets the oracle metrics for the provider orchestrator. These are utilized to record
+// providers that the market map references although they are not configured in the oracle.
+func WithMetrics(metrics oraclemetrics.Metrics) Option {
+	return func(m *ProviderOrchestrator) {
+		if metrics == nil {
+			panic("metrics cannot be nil")
+		}
+
+		m.metrics = metrics
+	}
+}
@@ -3,10 +3,13 @@ package orchestrator
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/pkg/math/oracle"
 	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
@@ -40,8 +43,24 @@ type ProviderOrchestrator struct {
 	// mmProvider is the market map provider. Specifically this provider is responsible
 	// for making requests for the latest market map data.
 	mmProvider *mmclienttypes.MarketMapProvider
+	// mmStreamer is an optional market map streamer. If set, market map updates are applied as
+	// soon as they are streamed, falling back to polling the market map provider if streaming
+	// is unavailable.
+	mmStreamer MarketMapStreamer
+	// mmStartupTimeout is the amount of time to wait for the market map provider to resolve a
+	// market map on startup, if the oracle is configured to fail on an empty market map.
+	mmStartupTimeout time.Duration
 	// aggregator is the price aggregator.
 	aggregator *oracle.IndexPriceAggregator
+	// unknownProviders is the set of providers that the market map references although they are
+	// not configured in the oracle.
+	unknownProviders map[string]struct{}
+	// rateLimiters is the shared rate limiter of each provider that belongs to a rate limit group,
+	// indexed by provider name. Providers in the same group share the same rate limiter.
+	rateLimiters map[string]*rate.Limiter
+	// killed is the set of providers that are killed via the provider kill switch. Killed
+	// providers are stopped and are not restarted until they are revived.
+	killed map[string]struct{}
 
 	// -------------------Oracle Configuration Fields-------------------//
 	//
@@ -69,6 +88,16 @@ type ProviderOrchestrator struct {
 	apiMetrics apimetrics.APIMetrics
 	// providerMetrics is the provider metrics.
 	providerMetrics providermetrics.ProviderMetrics
+	// metrics is the oracle metrics.
+	metrics oraclemetrics.Metrics
+}
+
+// MarketMapStreamer subscribes to market map updates from a market map source that supports
+// streaming.
+type MarketMapStreamer interface {
+	// StreamMarketMap invokes onUpdate with every market map received from the source. This
+	// blocks until the context is cancelled or the stream fails.
+	StreamMarketMap(ctx context.Context, onUpdate func(mmtypes.MarketMap)) error
 }
 
 // ProviderState is the state of a provider. This includes the provider implementation,
@@ -92,12 +121,15 @@ func NewProviderOrchestrator(
 	}
 
 	orchestrator := &ProviderOrchestrator{
-		cfg:             cfg,
-		providers:       make(map[string]ProviderState),
-		logger:          zap.NewNop(),
-		wsMetrics:       wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
-		apiMetrics:      apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
-		providerMetrics: providermetrics.NewProviderMetricsFromConfig(cfg.Metrics),
+		cfg:              cfg,
+		providers:        make(map[string]ProviderState),
+		killed:           make(map[string]struct{}),
+		mmStartupTimeout: DefaultMarketMapStartupTimeout,
+		logger:           zap.NewNop(),
+		wsMetrics:        wsmetrics.NewWebSocketMetricsFromConfig(cfg.Metrics),
+		apiMetrics:       apimetrics.NewAPIMetricsFromConfig(cfg.Metrics),
+		providerMetrics:  providermetrics.NewProviderMetricsFromConfig(cfg.Metrics),
+		metrics:          oraclemetrics.NewNopMetrics(),
 	}
 
 	for _, opt := range opts {
@@ -1,7 +1,9 @@
 package orchestrator
 
 import (
+	"fmt"
 	"math/big"
+	"sort"
 
 	"go.uber.org/zap"
 
@@ -17,11 +19,25 @@ func (o *ProviderOrchestrator) UpdateWithMarketMap(marketMap mmtypes.MarketMap)
 	o.mut.Lock()
 	defer o.mut.Unlock()
 
+	marketMap, err := marketMap.Canonicalize()
+	if err != nil {
+		o.logger.Error("failed to canonicalize market map", zap.Error(err))
+		return err
+	}
+
 	if err := marketMap.ValidateBasic(); err != nil {
 		o.logger.Error("failed to validate market map", zap.Error(err))
 		return err
 	}
 
+	if err := o.checkMarketProviders(marketMap); err != nil {
+		return err
+	}
+
+	if err := o.checkRequiredProviders(marketMap); err != nil {
+		return err
+	}
+
 	// Iterate over all existing providers and update their market maps.
 	for name, state := range o.providers {
 		providerTickers, err := types.ProviderTickersFromMarketMap(name, marketMap)
@@ -41,23 +57,26 @@ func (o *ProviderOrchestrator) UpdateWithMarketMap(marketMap mmtypes.MarketMap)
 	}
 
 	o.marketMap = marketMap
+	aggregatedMarketMap := o.skipUnknownProviders(o.marketMap)
 	if o.aggregator != nil {
-		o.aggregator.UpdateMarketMap(o.marketMap)
+		o.aggregator.UpdateMarketMap(aggregatedMarketMap)
 	}
 
 	return nil
 }
 
 // UpdateProviderState updates the provider's state based on the market map. Specifically,
-// this will update the provider's query handler and the provider's market map.
+// this will update the provider's query handler and the provider's market map. Providers that
+// are killed via the provider kill switch are stopped regardless of their market map.
 func (o *ProviderOrchestrator) UpdateProviderState(providerTickers []types.ProviderTicker, state ProviderState) (ProviderState, error) {
 	provider := state.Provider
 
 	o.logger.Info("updating provider state", zap.String("provider_state", provider.Name()))
 	provider.Update(base.WithNewIDs[types.ProviderTicker, *big.Float](providerTickers))
 
+	_, killed := o.killed[provider.Name()]
 	switch {
-	case len(providerTickers) == 0:
+	case len(providerTickers) == 0 || killed:
 		provider.Stop()
 	case len(providerTickers) > 0 && !provider.IsRunning():
 		o.wg.Add(1)
@@ -71,3 +90,140 @@ func (o *ProviderOrchestrator) UpdateProviderState(providerTickers []types.Provi
 	o.logger.Info("updated provider state", zap.String("provider_state", provider.Name()))
 	return state, nil
 }
+
+// checkMarketProviders flags the enabled markets in the market map that are not supported by any
+// of the oracle's enabled providers. Such markets will never resolve a price. A warning is logged
+// for these markets, and if the oracle is configured to reject them, an error is returned.
+func (o *ProviderOrchestrator) checkMarketProviders(marketMap mmtypes.MarketMap) error {
+	var unsupported []string
+	for ticker, market := range marketMap.Markets {
+		if !market.Ticker.Enabled {
+			continue
+		}
+
+		supported := false
+		for _, providerCfg := range market.ProviderConfigs {
+			if _, ok := o.providers[providerCfg.Name]; ok {
+				supported = true
+				break
+			}
+		}
+
+		if !supported {
+			unsupported = append(unsupported, ticker)
+		}
+	}
+
+	if len(unsupported) == 0 {
+		return nil
+	}
+
+	sort.Strings(unsupported)
+	o.logger.Warn(
+		"market map contains enabled markets without any enabled providers; these markets will never resolve a price",
+		zap.Strings("markets", unsupported),
+		zap.Bool("rejected", o.cfg.RejectMarketsWithoutProviders),
+	)
+
+	if o.cfg.RejectMarketsWithoutProviders {
+		return fmt.Errorf("markets %v do not have any enabled providers", unsupported)
+	}
+
+	return nil
+}
+
+// checkRequiredProviders ensures that every market in the market map that is covered by the
+// oracle's required providers configuration is configured with each of its required providers.
+// An error naming the offending markets and their missing providers is returned otherwise.
+func (o *ProviderOrchestrator) checkRequiredProviders(marketMap mmtypes.MarketMap) error {
+	var missing []string
+	for _, required := range o.cfg.RequiredProviders {
+		market, ok := marketMap.Markets[required.CurrencyPair]
+		if !ok {
+			continue
+		}
+
+		configured := make(map[string]struct{}, len(market.ProviderConfigs))
+		for _, providerCfg := range market.ProviderConfigs {
+			configured[providerCfg.Name] = struct{}{}
+		}
+
+		for _, provider := range required.Providers {
+			if _, ok := configured[provider]; !ok {
+				missing = append(missing, fmt.Sprintf("%s (%s)", required.CurrencyPair, provider))
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	o.logger.Error(
+		"market map contains markets without their required providers",
+		zap.Strings("markets", missing),
+	)
+
+	return fmt.Errorf("markets are missing required providers: %v", missing)
+}
+
+// skipUnknownProviders flags the provider configs of the enabled markets in the market map that
+// reference providers that are not configured in the oracle, e.g. because of a typo or a version
+// mismatch between the market map and the oracle. A warning naming each unknown provider and the
+// markets that reference it is logged, and the number of such markets is recorded per provider.
+//
+// The returned market map, which is utilized by the aggregator, skips the provider configs of
+// unknown providers so that markets continue with their known providers. Markets without any
+// known providers are left as is, so that their prices are withheld (see checkMarketProviders).
+func (o *ProviderOrchestrator) skipUnknownProviders(marketMap mmtypes.MarketMap) mmtypes.MarketMap {
+	unknown := make(map[string][]string)
+	markets := make(map[string]mmtypes.Market, len(marketMap.Markets))
+	for ticker, market := range marketMap.Markets {
+		markets[ticker] = market
+		if !market.Ticker.Enabled {
+			continue
+		}
+
+		known := make([]mmtypes.ProviderConfig, 0, len(market.ProviderConfigs))
+		for _, providerCfg := range market.ProviderConfigs {
+			if _, ok := o.providers[providerCfg.Name]; !ok {
+				unknown[providerCfg.Name] = append(unknown[providerCfg.Name], ticker)
+				continue
+			}
+
+			known = append(known, providerCfg)
+		}
+
+		if len(known) > 0 && len(known) < len(market.ProviderConfigs) {
+			market.ProviderConfigs = known
+			markets[ticker] = market
+		}
+	}
+
+	// Reset the metric of providers that are no longer referenced by the market map.
+	for provider := range o.unknownProviders {
+		if _, ok := unknown[provider]; !ok {
+			o.metrics.UpdateUnknownMarketProvider(provider, 0)
+		}
+	}
+
+	o.unknownProviders = make(map[string]struct{}, len(unknown))
+	for provider, tickers := range unknown {
+		sort.Strings(tickers)
+		o.logger.Warn(
+			"market map references a provider that is not configured in the oracle; skipping its provider configs",
+			zap.String("provider", provider),
+			zap.Strings("markets", tickers),
+		)
+
+		o.metrics.UpdateUnknownMarketProvider(provider, len(tickers))
+		o.unknownProviders[provider] = struct{}{}
+	}
+
+	if len(unknown) == 0 {
+		return marketMap
+	}
+
+	return mmtypes.MarketMap{Markets: markets}
+}
@@ -7,8 +7,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/constants"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
 	"github.com/skip-mev/slinky/oracle/orchestrator"
 	"github.com/skip-mev/slinky/oracle/types"
+	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
 	"github.com/skip-mev/slinky/providers/apis/binance"
 	"github.com/skip-mev/slinky/providers/apis/coinbase"
 	oraclefactory "github.com/skip-mev/slinky/providers/factories/oracle"
@@ -38,6 +43,161 @@ func TestUpdateWithMarketMap(t *testing.T) {
 		o.Stop()
 	})
 
+	t.Run("markets without enabled providers are rejected if configured", func(t *testing.T) {
+		unsupported := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				constants.SOLANA_USD.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     constants.SOLANA_USD,
+						MinProviderCount: 1,
+						Decimals:         8,
+						Enabled:          true,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{
+							Name:           "removed_provider",
+							OffChainTicker: "SOL-USD",
+						},
+					},
+				},
+			},
+		}
+
+		for _, reject := range []bool{false, true} {
+			cfg := oracleCfg
+			cfg.RejectMarketsWithoutProviders = reject
+
+			o, err := orchestrator.NewProviderOrchestrator(
+				cfg,
+				orchestrator.WithLogger(logger),
+				orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+				orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			)
+			require.NoError(t, err)
+			require.NoError(t, o.Init(context.TODO()))
+
+			err = o.UpdateWithMarketMap(unsupported)
+			if reject {
+				require.Error(t, err)
+				require.Empty(t, o.GetMarketMap().Markets)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, unsupported, o.GetMarketMap())
+			}
+
+			o.Stop()
+		}
+	})
+
+	t.Run("unknown providers are skipped", func(t *testing.T) {
+		// BTC/USD references an unknown provider next to its known providers, and SOL/USD only
+		// references the unknown provider.
+		btcusd := marketMap.Markets[constants.BITCOIN_USD.String()]
+		btcusd.ProviderConfigs = append([]mmtypes.ProviderConfig{
+			{
+				Name:           "coinbase_apii",
+				OffChainTicker: "BTC-USD",
+			},
+		}, btcusd.ProviderConfigs...)
+		unknown := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				constants.BITCOIN_USD.String(): btcusd,
+				constants.SOLANA_USD.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     constants.SOLANA_USD,
+						MinProviderCount: 1,
+						Decimals:         8,
+						Enabled:          true,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{
+							Name:           "coinbase_apii",
+							OffChainTicker: "SOL-USD",
+						},
+					},
+				},
+			},
+		}
+
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("UpdateUnknownMarketProvider", "coinbase_apii", 2).Return().Once()
+		metrics.On("UpdateUnknownMarketProvider", "coinbase_apii", 0).Return().Once()
+
+		aggregator, err := oraclemath.NewIndexPriceAggregator(logger, mmtypes.MarketMap{}, oraclemetrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			oracleCfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			orchestrator.WithAggregator(aggregator),
+			orchestrator.WithMetrics(metrics),
+		)
+		require.NoError(t, err)
+		require.NoError(t, o.Init(context.TODO()))
+
+		require.NoError(t, o.UpdateWithMarketMap(unknown))
+		require.Equal(t, unknown, o.GetMarketMap())
+
+		// The aggregator continues with the known providers of BTC/USD, whereas SOL/USD has no
+		// known providers and is left as is so that its price is withheld.
+		aggregated := aggregator.GetMarketMap()
+		require.Equal(t, marketMap.Markets[constants.BITCOIN_USD.String()], aggregated.Markets[constants.BITCOIN_USD.String()])
+		require.Equal(t, unknown.Markets[constants.SOLANA_USD.String()], aggregated.Markets[constants.SOLANA_USD.String()])
+
+		// The metric is reset once the market map no longer references the unknown provider.
+		require.NoError(t, o.UpdateWithMarketMap(marketMap))
+		require.Equal(t, marketMap, *aggregator.GetMarketMap())
+
+		o.Stop()
+	})
+
+	t.Run("markets without their required providers are rejected", func(t *testing.T) {
+		cfg := oracleCfg
+		cfg.RequiredProviders = []config.RequiredProvidersConfig{
+			{
+				CurrencyPair: constants.BITCOIN_USD.String(),
+				Providers:    []string{coinbase.Name, okx.Name},
+			},
+		}
+
+		o, err := orchestrator.NewProviderOrchestrator(
+			cfg,
+			orchestrator.WithLogger(logger),
+			orchestrator.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			orchestrator.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+		)
+		require.NoError(t, err)
+		require.NoError(t, o.Init(context.TODO()))
+
+		// The market map configures both required providers for BTC/USD.
+		require.NoError(t, o.UpdateWithMarketMap(marketMap))
+
+		// Drop OKX from BTC/USD.
+		btcusd := marketMap.Markets[constants.BITCOIN_USD.String()]
+		btcusd.ProviderConfigs = btcusd.ProviderConfigs[:1]
+		missingOKX := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				constants.BITCOIN_USD.String(): btcusd,
+			},
+		}
+
+		err = o.UpdateWithMarketMap(missingOKX)
+		require.ErrorContains(t, err, okx.Name)
+		require.Equal(t, marketMap, o.GetMarketMap())
+
+		// Markets that are not in the market map are not required.
+		ethusd := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				constants.ETHEREUM_USD.String(): marketMap.Markets[constants.ETHEREUM_USD.String()],
+			},
+		}
+		require.NoError(t, o.UpdateWithMarketMap(ethusd))
+
+		o.Stop()
+	})
+
 	t.Run("can update the orchestrator's market map and update the providers' market maps with no running providers", func(t *testing.T) {
 		o, err := orchestrator.NewProviderOrchestrator(
 			oracleCfg,
@@ -107,6 +107,154 @@ func (s *OracleTestSuite) TestProviders() {
 				s.currencyPairs[0].String(): big.NewFloat(150),
 			},
 		},
+		{
+			name: "1 provider with prices below the min volume",
+			factory: func() []*types.PriceProvider {
+				resolved := types.ResolvedPrices{
+					s.currencyPairs[0]: types.NewPriceResultWithVolume(
+						big.NewFloat(100),
+						time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+						big.NewFloat(5),
+					),
+					s.currencyPairs[1]: types.NewPriceResultWithVolume(
+						big.NewFloat(200),
+						time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+						big.NewFloat(20),
+					),
+					s.currencyPairs[2]: types.NewPriceResult(
+						big.NewFloat(300),
+						time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+					),
+				}
+				response := providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil)
+				responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{response}
+
+				cfg := providerCfg1
+				cfg.MinVolume = 10
+				provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+					s.T(),
+					s.logger,
+					cfg,
+					s.currencyPairs,
+					responses,
+					200*time.Millisecond,
+				)
+
+				providers := []*types.PriceProvider{provider}
+				return providers
+			},
+			expectedPrices: types.Prices{
+				s.currencyPairs[1].String(): big.NewFloat(200),
+				s.currencyPairs[2].String(): big.NewFloat(300),
+			},
+		},
+		{
+			name: "1 provider with adjusted prices",
+			factory: func() []*types.PriceProvider {
+				resolved := types.ResolvedPrices{
+					s.currencyPairs[0]: {
+						Value:     big.NewFloat(100),
+						Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					s.currencyPairs[1]: {
+						Value:     big.NewFloat(1),
+						Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				}
+				response := providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil)
+				responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{response}
+
+				cfg := providerCfg1
+				cfg.PriceAdjustment = config.PriceAdjustmentConfig{
+					Multiplier: 1.0625,
+					Offset:     -6,
+				}
+				provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+					s.T(),
+					s.logger,
+					cfg,
+					s.currencyPairs,
+					responses,
+					200*time.Millisecond,
+				)
+
+				providers := []*types.PriceProvider{provider}
+				return providers
+			},
+			// The second price is adjusted to a negative price, so it is skipped.
+			expectedPrices: types.Prices{
+				s.currencyPairs[0].String(): big.NewFloat(100.25),
+			},
+		},
+		{
+			name: "1 provider quoting in a sub-unit",
+			factory: func() []*types.PriceProvider {
+				resolved := types.ResolvedPrices{
+					s.currencyPairs[0]: {
+						Value:     big.NewFloat(1.5),
+						Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+					s.currencyPairs[1]: {
+						Value:     big.NewFloat(2.25),
+						Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				}
+				response := providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil)
+				responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{response}
+
+				cfg := providerCfg1
+				cfg.BaseUnitScale = 2
+				provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+					s.T(),
+					s.logger,
+					cfg,
+					s.currencyPairs,
+					responses,
+					200*time.Millisecond,
+				)
+
+				providers := []*types.PriceProvider{provider}
+				return providers
+			},
+			expectedPrices: types.Prices{
+				s.currencyPairs[0].String(): big.NewFloat(150),
+				s.currencyPairs[1].String(): big.NewFloat(225),
+			},
+		},
+		{
+			name: "1 provider in a maintenance window",
+			factory: func() []*types.PriceProvider {
+				resolved := types.ResolvedPrices{
+					s.currencyPairs[0]: {
+						Value:     big.NewFloat(100),
+						Timestamp: time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				}
+				response := providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil)
+				responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{response}
+
+				// The window spans the entire day, so it is always active.
+				cfg := providerCfg1
+				cfg.MaintenanceWindows = []config.MaintenanceWindowConfig{
+					{
+						Start:    "00:00",
+						Duration: 24 * time.Hour,
+					},
+				}
+				provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+					s.T(),
+					s.logger,
+					cfg,
+					s.currencyPairs,
+					responses,
+					200*time.Millisecond,
+				)
+
+				providers := []*types.PriceProvider{provider}
+				return providers
+			},
+			expectedPrices: types.Prices{},
+		},
 		{
 			name: "1 provider with stale prices",
 			factory: func() []*types.PriceProvider {
@@ -132,6 +280,32 @@ func (s *OracleTestSuite) TestProviders() {
 			},
 			expectedPrices: types.Prices{},
 		},
+		{
+			name: "1 provider with prices received recently but with stale event timestamps",
+			factory: func() []*types.PriceProvider {
+				resolved := types.ResolvedPrices{
+					s.currencyPairs[0]: {
+						Value:          big.NewFloat(100),
+						Timestamp:      time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+						EventTimestamp: time.Date(1738, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				}
+				response := providertypes.NewGetResponse[types.ProviderTicker, *big.Float](resolved, nil)
+				responses := []providertypes.GetResponse[types.ProviderTicker, *big.Float]{response}
+				provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+					s.T(),
+					s.logger,
+					providerCfg1,
+					s.currencyPairs,
+					responses,
+					200*time.Millisecond,
+				)
+
+				providers := []*types.PriceProvider{provider}
+				return providers
+			},
+			expectedPrices: types.Prices{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -191,3 +365,72 @@ func (s *OracleTestSuite) TestProviders() {
 		})
 	}
 }
+
+func (s *OracleTestSuite) TestPushPrice() {
+	s.Run("invalid pushed prices are rejected", func() {
+		testOracle, err := oracle.New(
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+		)
+		s.Require().NoError(err)
+
+		s.Require().Error(testOracle.PushPrice("", s.currencyPairs[0], big.NewFloat(100), time.Now()))
+		s.Require().Error(testOracle.PushPrice("custom", nil, big.NewFloat(100), time.Now()))
+		s.Require().Error(testOracle.PushPrice("custom", s.currencyPairs[0], nil, time.Now()))
+	})
+
+	s.Run("pushed price cannot use the name of a configured provider", func() {
+		provider := testutils.CreateAPIProviderWithGetResponses[types.ProviderTicker, *big.Float](
+			s.T(),
+			s.logger,
+			providerCfg1,
+			s.currencyPairs,
+			nil,
+			200*time.Millisecond,
+		)
+
+		testOracle, err := oracle.New(
+			oracle.WithLogger(s.logger),
+			oracle.WithProviders([]*types.PriceProvider{provider}),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+		)
+		s.Require().NoError(err)
+
+		s.Require().Error(testOracle.PushPrice(providerCfg1.Name, s.currencyPairs[0], big.NewFloat(100), time.Now()))
+	})
+
+	s.Run("pushed prices are aggregated and stale prices are skipped", func() {
+		cfg := config.OracleConfig{
+			UpdateInterval: 1 * time.Second,
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 4*cfg.UpdateInterval)
+		defer cancel()
+
+		testOracle, err := oracle.New(
+			oracle.WithUpdateInterval(cfg.UpdateInterval),
+			oracle.WithMaxCacheAge(time.Minute),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+		)
+		s.Require().NoError(err)
+
+		s.Require().NoError(testOracle.PushPrice("custom1", s.currencyPairs[0], big.NewFloat(100), time.Now()))
+		s.Require().NoError(testOracle.PushPrice("custom2", s.currencyPairs[0], big.NewFloat(200), time.Now()))
+		s.Require().NoError(testOracle.PushPrice("custom1", s.currencyPairs[1], big.NewFloat(300), time.Now().Add(-time.Hour)))
+
+		go func() {
+			s.Require().NoError(testOracle.Start(ctx))
+		}()
+
+		// Wait for the oracle to start and update.
+		time.Sleep(2 * cfg.UpdateInterval)
+
+		prices := testOracle.GetPrices()
+		s.Require().Equal(types.Prices{
+			s.currencyPairs[0].String(): big.NewFloat(150),
+		}, prices)
+
+		testOracle.Stop()
+	})
+}
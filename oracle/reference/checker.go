@@ -0,0 +1,164 @@
+package reference
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+	"github.com/skip-mev/slinky/oracle/types"
+	servicetypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+var _ oracle.PriceGuard = (*Checker)(nil)
+
+// Checker is a price guard that compares the aggregated prices of the oracle against the prices
+// published by a reference oracle. Markets whose aggregated price diverges from the reference
+// price by more than the configured max deviation are reported, and optionally withheld. The
+// reference prices are polled in the background so that the check never blocks the oracle.
+type Checker struct {
+	mtx     sync.Mutex
+	logger  *zap.Logger
+	cfg     config.ReferenceOracleConfig
+	client  servicetypes.OracleClient
+	metrics oraclemetrics.Metrics
+
+	// prices is the latest set of prices published by the reference oracle.
+	prices map[string]*big.Float
+	// lastFetch is the time at which the reference prices were last fetched successfully.
+	lastFetch time.Time
+}
+
+// NewChecker returns a new Checker that fetches reference prices using the given client.
+func NewChecker(
+	logger *zap.Logger,
+	cfg config.ReferenceOracleConfig,
+	client servicetypes.OracleClient,
+	metrics oraclemetrics.Metrics,
+) (*Checker, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("reference oracle client cannot be nil")
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics cannot be nil")
+	}
+
+	return &Checker{
+		logger:  logger.With(zap.String("process", "reference_oracle"), zap.String("address", cfg.Address)),
+		cfg:     cfg,
+		client:  client,
+		metrics: metrics,
+		prices:  make(map[string]*big.Float),
+	}, nil
+}
+
+// Start polls the reference oracle at the configured interval until the context is cancelled.
+func (c *Checker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.fetch(ctx); err != nil {
+			c.logger.Error("failed to fetch reference prices", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetch retrieves the latest prices from the reference oracle.
+func (c *Checker) fetch(ctx context.Context) error {
+	reqCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	resp, err := c.client.Prices(reqCtx, &servicetypes.QueryPricesRequest{})
+	if err != nil {
+		return err
+	}
+
+	prices := make(map[string]*big.Float, len(resp.Prices))
+	for cp, value := range resp.Prices {
+		price, ok := new(big.Float).SetString(value)
+		if !ok {
+			c.logger.Debug("skipping malformed reference price", zap.String("pair", cp), zap.String("price", value))
+			continue
+		}
+
+		prices[cp] = price
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.prices = prices
+	c.lastFetch = time.Now()
+
+	c.logger.Debug("fetched reference prices", zap.Int("num_prices", len(prices)))
+	return nil
+}
+
+// GuardPrices compares each of the given prices against its reference price. Divergent markets
+// are returned if the checker is configured to withhold them. Nothing is compared if the reference
+// prices are stale.
+func (c *Checker) GuardPrices(prices types.Prices) []string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if age := time.Since(c.lastFetch); age > c.cfg.MaxPriceAge {
+		c.logger.Debug("skipping reference check with stale reference prices", zap.Duration("age", age))
+		return nil
+	}
+
+	var withheld []string
+	for cp, price := range prices {
+		reference, ok := c.prices[cp]
+		if !ok || reference.Sign() <= 0 || price == nil {
+			continue
+		}
+
+		diff := new(big.Float).Sub(price, reference)
+		diff.Abs(diff)
+		deviation, _ := diff.Quo(diff, reference).Float64()
+		c.metrics.UpdateReferenceDeviation(cp, deviation)
+
+		if deviation <= c.cfg.MaxDeviation {
+			continue
+		}
+
+		c.metrics.AddReferenceDivergence(cp)
+		c.logger.Warn(
+			"aggregated price diverges from the reference price",
+			zap.String("pair", cp),
+			zap.String("price", price.String()),
+			zap.String("reference", reference.String()),
+			zap.Float64("deviation", deviation),
+			zap.Float64("max_deviation", c.cfg.MaxDeviation),
+			zap.Bool("withheld", c.cfg.Withhold),
+		)
+
+		if c.cfg.Withhold {
+			withheld = append(withheld, cp)
+		}
+	}
+
+	return withheld
+}
@@ -0,0 +1,140 @@
+package reference_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
+	"github.com/skip-mev/slinky/oracle/reference"
+	"github.com/skip-mev/slinky/oracle/types"
+	servicetypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+// fakeClient is an oracle client that serves a fixed set of prices.
+type fakeClient struct {
+	servicetypes.OracleClient
+
+	prices map[string]string
+	err    error
+}
+
+func (c *fakeClient) Prices(context.Context, *servicetypes.QueryPricesRequest, ...grpc.CallOption) (*servicetypes.QueryPricesResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &servicetypes.QueryPricesResponse{Prices: c.prices}, nil
+}
+
+func TestChecker(t *testing.T) {
+	cfg := config.ReferenceOracleConfig{
+		Enabled:      true,
+		Address:      "localhost:8080",
+		Interval:     50 * time.Millisecond,
+		Timeout:      50 * time.Millisecond,
+		MaxPriceAge:  time.Minute,
+		MaxDeviation: 0.05,
+	}
+	client := &fakeClient{
+		prices: map[string]string{
+			"BTC/USD": "1000",
+			"ETH/USD": "100",
+		},
+	}
+	prices := types.Prices{
+		"BTC/USD": big.NewFloat(1020),
+		"ETH/USD": big.NewFloat(120),
+		"SOL/USD": big.NewFloat(10),
+	}
+
+	startChecker := func(t *testing.T, cfg config.ReferenceOracleConfig) *reference.Checker {
+		t.Helper()
+
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("UpdateReferenceDeviation", mock.Anything, mock.Anything).Maybe()
+		metrics.On("AddReferenceDivergence", mock.Anything).Maybe()
+
+		checker, err := reference.NewChecker(zap.NewNop(), cfg, client, metrics)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		go checker.Start(ctx) //nolint: errcheck
+
+		return checker
+	}
+
+	t.Run("does not withhold prices before the reference prices are fetched", func(t *testing.T) {
+		metrics := metricmocks.NewMetrics(t)
+		checker, err := reference.NewChecker(zap.NewNop(), cfg, client, metrics)
+		require.NoError(t, err)
+		require.Empty(t, checker.GuardPrices(prices))
+	})
+
+	t.Run("reports divergent prices without withholding them", func(t *testing.T) {
+		checker := startChecker(t, cfg)
+		require.Never(t, func() bool {
+			return len(checker.GuardPrices(prices)) > 0
+		}, 200*time.Millisecond, 10*time.Millisecond)
+	})
+
+	t.Run("withholds divergent prices", func(t *testing.T) {
+		withholdCfg := cfg
+		withholdCfg.Withhold = true
+
+		checker := startChecker(t, withholdCfg)
+		require.Eventually(t, func() bool {
+			withheld := checker.GuardPrices(prices)
+			return len(withheld) == 1 && withheld[0] == "ETH/USD"
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("records the deviation and divergence of each market", func(t *testing.T) {
+		metrics := metricmocks.NewMetrics(t)
+		metrics.On("UpdateReferenceDeviation", "BTC/USD", 0.02).Once()
+		metrics.On("UpdateReferenceDeviation", "ETH/USD", 0.2).Once()
+		metrics.On("AddReferenceDivergence", "ETH/USD").Once()
+
+		checker, err := reference.NewChecker(zap.NewNop(), cfg, client, metrics)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, checker.Start(ctx), context.DeadlineExceeded)
+
+		require.Empty(t, checker.GuardPrices(prices))
+	})
+
+	t.Run("fails to create a checker with an invalid config", func(t *testing.T) {
+		invalidCfg := cfg
+		invalidCfg.MaxDeviation = 0
+
+		_, err := reference.NewChecker(zap.NewNop(), invalidCfg, client, metricmocks.NewMetrics(t))
+		require.Error(t, err)
+	})
+
+	t.Run("does not withhold prices if the reference oracle is unavailable", func(t *testing.T) {
+		withholdCfg := cfg
+		withholdCfg.Withhold = true
+		failingClient := &fakeClient{err: fmt.Errorf("unavailable")}
+
+		metrics := metricmocks.NewMetrics(t)
+		checker, err := reference.NewChecker(zap.NewNop(), withholdCfg, failingClient, metrics)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, checker.Start(ctx), context.DeadlineExceeded)
+
+		require.Empty(t, checker.GuardPrices(prices))
+	})
+}
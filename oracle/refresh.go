@@ -0,0 +1,125 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/tracing"
+)
+
+var (
+	// ErrRefreshDisabled is returned when a refresh is requested from an oracle on which
+	// on-demand refreshes are not enabled.
+	ErrRefreshDisabled = errors.New("price refresh is not enabled")
+	// ErrRefreshRateLimited is returned when a refresh is requested sooner than the configured
+	// min interval after the previous refresh.
+	ErrRefreshRateLimited = errors.New("price refresh is rate limited")
+)
+
+// RefreshPrices immediately fetches the prices of the given currency pairs from the oracle's
+// providers, bypassing their caches, and re-aggregates the oracle's prices. The refreshed prices
+// of the given currency pairs are returned. Only API providers are refreshed, as the prices of
+// websocket providers are already streamed as they change. Refreshes are rate-limited to at
+// most one per the configured min interval, and requests made sooner are rejected with
+// ErrRefreshRateLimited.
+func (o *OracleImpl) RefreshPrices(ctx context.Context, pairs []string) (types.Prices, error) {
+	if !o.refresh.Enabled {
+		return nil, ErrRefreshDisabled
+	}
+
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("no currency pairs to refresh")
+	}
+
+	if err := o.reserveRefresh(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, "Oracle.RefreshPrices")
+	defer span.End()
+
+	o.logger.Debug("refreshing prices", zap.Strings("pairs", pairs))
+
+	refreshCtx, cancel := context.WithTimeout(ctx, o.refresh.Timeout)
+	o.refreshProviders(refreshCtx, pairs)
+	cancel()
+
+	o.fetch(ctx)
+	o.aggregate(ctx)
+
+	prices := o.GetPrices()
+	refreshed := make(types.Prices, len(pairs))
+	for _, pair := range pairs {
+		if price, ok := prices[pair]; ok {
+			refreshed[pair] = price
+		}
+	}
+
+	return refreshed, nil
+}
+
+// reserveRefresh records a refresh at the given time, or returns ErrRefreshRateLimited if the
+// previous refresh was less than the min interval ago.
+func (o *OracleImpl) reserveRefresh(now time.Time) error {
+	o.refreshMtx.Lock()
+	defer o.refreshMtx.Unlock()
+
+	if !o.lastRefresh.IsZero() && now.Sub(o.lastRefresh) < o.refresh.MinInterval {
+		return ErrRefreshRateLimited
+	}
+
+	o.lastRefresh = now
+	return nil
+}
+
+// refreshProviders concurrently refreshes the tickers of each provider that contribute to the
+// given currency pairs.
+func (o *OracleImpl) refreshProviders(ctx context.Context, pairs []string) {
+	tickers := o.priceAggregator.GetProviderTickers(pairs)
+
+	var wg sync.WaitGroup
+	for _, provider := range o.providers {
+		offChainTickers, ok := tickers[provider.Name()]
+		if !ok || !provider.IsRunning() || provider.InMaintenance(time.Now().UTC()) {
+			continue
+		}
+
+		requested := make(map[string]struct{}, len(offChainTickers))
+		for _, ticker := range offChainTickers {
+			requested[ticker] = struct{}{}
+		}
+
+		ids := make([]types.ProviderTicker, 0, len(offChainTickers))
+		for _, id := range provider.GetIDs() {
+			if _, ok := requested[id.GetOffChainTicker()]; ok {
+				ids = append(ids, id)
+			}
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(provider *types.PriceProvider) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					o.logger.Error("provider refresh panicked", zap.String("provider", provider.Name()), zap.Error(fmt.Errorf("%v", r)))
+				}
+			}()
+
+			if err := provider.Refresh(ctx, ids); err != nil {
+				o.logger.Debug("skipping provider refresh", zap.String("provider", provider.Name()), zap.Error(err))
+			}
+		}(provider)
+	}
+
+	wg.Wait()
+}
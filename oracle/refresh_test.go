@@ -0,0 +1,133 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+	"github.com/skip-mev/slinky/oracle/types"
+	oraclemath "github.com/skip-mev/slinky/pkg/math/oracle"
+	pkgtypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/providers/base"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	handlermocks "github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	apimetrics "github.com/skip-mev/slinky/providers/base/api/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func (s *OracleTestSuite) TestRefreshPrices() {
+	refreshCfg := config.RefreshConfig{
+		Enabled:     true,
+		MinInterval: time.Hour,
+		Timeout:     time.Second,
+	}
+
+	// newOracle returns an oracle with a single API provider that reports a BTC/USD price that
+	// increases by 100 on every fetch. The provider only queries its API once on startup.
+	newOracle := func(opts ...oracle.Option) (*oracle.OracleImpl, *base.Provider[types.ProviderTicker, *big.Float]) {
+		ticker := s.currencyPairs[0]
+
+		var fetches atomic.Int64
+		fetcher := handlermocks.NewAPIFetcher[types.ProviderTicker, *big.Float](s.T())
+		fetcher.On("Fetch", mock.Anything, []types.ProviderTicker{ticker}).Return(
+			func(context.Context, []types.ProviderTicker) providertypes.GetResponse[types.ProviderTicker, *big.Float] {
+				price := big.NewFloat(float64(100 * fetches.Add(1)))
+				return providertypes.NewGetResponse(types.ResolvedPrices{
+					ticker: types.NewPriceResult(price, time.Now().UTC()),
+				}, nil)
+			},
+		).Maybe()
+
+		apiCfg := providerCfg1.API
+		apiCfg.Interval = time.Hour
+		handler, err := handlers.NewAPIQueryHandlerWithFetcher(s.logger, apiCfg, fetcher, apimetrics.NewNopAPIMetrics())
+		s.Require().NoError(err)
+
+		provider, err := base.NewProvider[types.ProviderTicker, *big.Float](
+			base.WithName[types.ProviderTicker, *big.Float](providerCfg1.Name),
+			base.WithAPIQueryHandler[types.ProviderTicker, *big.Float](handler),
+			base.WithAPIConfig[types.ProviderTicker, *big.Float](apiCfg),
+			base.WithLogger[types.ProviderTicker, *big.Float](s.logger),
+			base.WithIDs[types.ProviderTicker, *big.Float]([]types.ProviderTicker{ticker}),
+		)
+		s.Require().NoError(err)
+
+		btcusd := mmtypes.Ticker{
+			CurrencyPair:     pkgtypes.NewCurrencyPair("BTC", "USD"),
+			Decimals:         0,
+			MinProviderCount: 1,
+			Enabled:          true,
+		}
+		aggregator, err := oraclemath.NewIndexPriceAggregator(s.logger, mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				btcusd.String(): {
+					Ticker: btcusd,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: providerCfg1.Name, OffChainTicker: ticker.GetOffChainTicker()},
+					},
+				},
+			},
+		}, oraclemetrics.NewNopMetrics())
+		s.Require().NoError(err)
+
+		testOracle, err := oracle.New(append([]oracle.Option{
+			oracle.WithUpdateInterval(time.Hour),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(aggregator),
+			oracle.WithProviders([]*types.PriceProvider{provider}),
+		}, opts...)...)
+		s.Require().NoError(err)
+
+		return testOracle, provider
+	}
+
+	s.Run("refreshes the prices of the requested pairs", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testOracle, provider := newOracle(oracle.WithRefreshConfig(refreshCfg))
+		go provider.Start(ctx) //nolint: errcheck
+
+		// Wait for the provider's initial query on startup.
+		s.Require().Eventually(func() bool {
+			return len(provider.GetData()) == 1
+		}, 2*time.Second, 10*time.Millisecond)
+
+		prices, err := testOracle.RefreshPrices(ctx, []string{"BTC/USD"})
+		s.Require().NoError(err)
+		s.Require().Equal(0, prices["BTC/USD"].Cmp(big.NewFloat(200)))
+		s.Require().Equal(0, testOracle.GetPrices()["BTC/USD"].Cmp(big.NewFloat(200)))
+
+		// Refreshes within the min interval are rejected.
+		_, err = testOracle.RefreshPrices(ctx, []string{"BTC/USD"})
+		s.Require().ErrorIs(err, oracle.ErrRefreshRateLimited)
+	})
+
+	s.Run("pairs that are not in the market map are not returned", func() {
+		testOracle, _ := newOracle(oracle.WithRefreshConfig(refreshCfg))
+
+		prices, err := testOracle.RefreshPrices(context.Background(), []string{"MOG/USD"})
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+
+	s.Run("refreshes are rejected if not enabled", func() {
+		testOracle, _ := newOracle()
+
+		_, err := testOracle.RefreshPrices(context.Background(), []string{"BTC/USD"})
+		s.Require().ErrorIs(err, oracle.ErrRefreshDisabled)
+	})
+
+	s.Run("cannot set an invalid refresh config", func() {
+		s.Require().Panics(func() {
+			_, _ = oracle.New(oracle.WithRefreshConfig(config.RefreshConfig{Enabled: true}))
+		})
+	})
+}
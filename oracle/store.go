@@ -0,0 +1,110 @@
+package oracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// PriceSnapshot is a point-in-time snapshot of the aggregated prices of the oracle.
+type PriceSnapshot struct {
+	// Timestamp is the time at which the prices were last updated by the oracle.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Prices is the set of aggregated prices indexed by currency pair.
+	Prices types.Prices `json:"prices"`
+}
+
+// PriceStore is an interface for persisting the last known aggregated prices of the oracle
+// across restarts. The oracle saves a snapshot on shutdown and loads it on startup to bridge
+// the gap while providers are warming up.
+type PriceStore interface {
+	// Save persists the given snapshot, replacing any previously saved snapshot.
+	Save(snapshot PriceSnapshot) error
+
+	// Load returns the last saved snapshot. An empty snapshot is returned if no snapshot
+	// has been saved.
+	Load() (PriceSnapshot, error)
+}
+
+var _ PriceStore = (*FilePriceStore)(nil)
+
+// FilePriceStore is a PriceStore that persists snapshots as JSON to a file on disk.
+type FilePriceStore struct {
+	path string
+}
+
+// NewFilePriceStore returns a new FilePriceStore that reads and writes snapshots to the
+// given path.
+func NewFilePriceStore(path string) (*FilePriceStore, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("price snapshot path cannot be empty")
+	}
+
+	return &FilePriceStore{path: path}, nil
+}
+
+// Save writes the snapshot to a temporary file and renames it over the configured path so
+// that a partially written snapshot is never loaded.
+func (s *FilePriceStore) Save(snapshot PriceSnapshot) error {
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal price snapshot: %w", err)
+	}
+
+	if err := writeFileAtomic(s.path, bz, 0o600); err != nil {
+		return fmt.Errorf("failed to persist price snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// Load reads the snapshot from the configured path. If the file does not exist, an empty
+// snapshot is returned.
+func (s *FilePriceStore) Load() (PriceSnapshot, error) {
+	bz, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return PriceSnapshot{}, nil
+	}
+	if err != nil {
+		return PriceSnapshot{}, fmt.Errorf("failed to read price snapshot: %w", err)
+	}
+
+	var snapshot PriceSnapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return PriceSnapshot{}, fmt.Errorf("failed to unmarshal price snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// writeFileAtomic writes the given data to a temporary file in the directory of the given path and
+// renames it over the path, so that readers never observe a partially written file.
+func writeFileAtomic(path string, bz []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set permissions of temporary file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,137 @@
+package oracle_test
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/types"
+	mathtestutils "github.com/skip-mev/slinky/pkg/math/testutils"
+)
+
+func (s *OracleTestSuite) TestPriceStore() {
+	s.Run("file price store returns an empty snapshot if nothing has been saved", func() {
+		store, err := oracle.NewFilePriceStore(filepath.Join(s.T().TempDir(), "prices.json"))
+		s.Require().NoError(err)
+
+		snapshot, err := store.Load()
+		s.Require().NoError(err)
+		s.Require().Empty(snapshot.Prices)
+	})
+
+	s.Run("file price store cannot be created with an empty path", func() {
+		_, err := oracle.NewFilePriceStore("")
+		s.Require().Error(err)
+	})
+
+	s.Run("file price store round trips a snapshot", func() {
+		store, err := oracle.NewFilePriceStore(filepath.Join(s.T().TempDir(), "prices.json"))
+		s.Require().NoError(err)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		s.Require().NoError(store.Save(oracle.PriceSnapshot{
+			Timestamp: now,
+			Prices: types.Prices{
+				"BTC/USD": big.NewFloat(100),
+				"ETH/USD": big.NewFloat(200.5),
+			},
+		}))
+
+		snapshot, err := store.Load()
+		s.Require().NoError(err)
+		s.Require().True(now.Equal(snapshot.Timestamp))
+		s.Require().Len(snapshot.Prices, 2)
+		s.Require().Equal(0, snapshot.Prices["BTC/USD"].Cmp(big.NewFloat(100)))
+		s.Require().Equal(0, snapshot.Prices["ETH/USD"].Cmp(big.NewFloat(200.5)))
+	})
+
+	s.Run("restores prices on startup and saves prices on shutdown", func() {
+		store, err := oracle.NewFilePriceStore(filepath.Join(s.T().TempDir(), "prices.json"))
+		s.Require().NoError(err)
+
+		s.Require().NoError(store.Save(oracle.PriceSnapshot{
+			Timestamp: time.Now().UTC(),
+			Prices: types.Prices{
+				s.currencyPairs[0].String(): big.NewFloat(100),
+				s.currencyPairs[1].String(): big.NewFloat(200),
+			},
+		}))
+
+		updateInterval := 500 * time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), 4*updateInterval)
+		defer cancel()
+
+		testOracle, err := oracle.New(
+			oracle.WithUpdateInterval(updateInterval),
+			oracle.WithMaxCacheAge(time.Minute),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+			oracle.WithPriceStore(store),
+		)
+		s.Require().NoError(err)
+
+		// Only ETH/USD resolves a fresh price.
+		s.Require().NoError(testOracle.PushPrice("custom", s.currencyPairs[1], big.NewFloat(300), time.Now()))
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			s.Require().NoError(testOracle.Start(ctx))
+		}()
+
+		time.Sleep(2 * updateInterval)
+
+		prices := testOracle.GetPrices()
+		s.Require().Len(prices, 2)
+		s.Require().Equal(0, prices[s.currencyPairs[0].String()].Cmp(big.NewFloat(100)))
+		s.Require().Equal(0, prices[s.currencyPairs[1].String()].Cmp(big.NewFloat(300)))
+
+		testOracle.Stop()
+		<-done
+
+		// Only the freshly resolved prices are persisted.
+		snapshot, err := store.Load()
+		s.Require().NoError(err)
+		s.Require().Len(snapshot.Prices, 1)
+		s.Require().Equal(0, snapshot.Prices[s.currencyPairs[1].String()].Cmp(big.NewFloat(300)))
+	})
+
+	s.Run("ignores restored prices older than the max cache age", func() {
+		store, err := oracle.NewFilePriceStore(filepath.Join(s.T().TempDir(), "prices.json"))
+		s.Require().NoError(err)
+
+		s.Require().NoError(store.Save(oracle.PriceSnapshot{
+			Timestamp: time.Now().UTC().Add(-time.Hour),
+			Prices: types.Prices{
+				s.currencyPairs[0].String(): big.NewFloat(100),
+			},
+		}))
+
+		updateInterval := 500 * time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), 4*updateInterval)
+		defer cancel()
+
+		testOracle, err := oracle.New(
+			oracle.WithUpdateInterval(updateInterval),
+			oracle.WithMaxCacheAge(time.Minute),
+			oracle.WithLogger(s.logger),
+			oracle.WithPriceAggregator(mathtestutils.NewMedianAggregator()),
+			oracle.WithPriceStore(store),
+		)
+		s.Require().NoError(err)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			s.Require().NoError(testOracle.Start(ctx))
+		}()
+
+		time.Sleep(2 * updateInterval)
+		s.Require().Empty(testOracle.GetPrices())
+
+		testOracle.Stop()
+		<-done
+	})
+}
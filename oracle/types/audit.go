@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+)
+
+// ExclusionReason is the reason that a provider's price was excluded from the aggregated price of
+// a pair.
+type ExclusionReason string
+
+const (
+	// ExclusionStale indicates that the provider's price was older than the oracle's max cache age.
+	ExclusionStale ExclusionReason = "stale"
+	// ExclusionBelowMinVolume indicates that the provider's price was reported with a volume below
+	// the provider's min volume.
+	ExclusionBelowMinVolume ExclusionReason = "below_min_volume"
+	// ExclusionAdjustmentFailed indicates that the provider's price adjustment could not be applied
+	// to the price.
+	ExclusionAdjustmentFailed ExclusionReason = "adjustment_failed"
+	// ExclusionNoPrice indicates that the provider did not report a price, e.g. because the request
+	// failed or the result failed one of the provider's response assertions.
+	ExclusionNoPrice ExclusionReason = "no_price"
+	// ExclusionConversionFailed indicates that the provider's price could not be converted to the
+	// pair, e.g. because the index price of the normalizing pair is missing or depegged.
+	ExclusionConversionFailed ExclusionReason = "conversion_failed"
+	// ExclusionZeroWeight indicates that the provider has a weight of 0 for the pair.
+	ExclusionZeroWeight ExclusionReason = "zero_weight"
+	// ExclusionDecimalDisagreement indicates that the pair's price was withheld because its
+	// providers reported prices at different implied decimals.
+	ExclusionDecimalDisagreement ExclusionReason = "decimal_disagreement"
+)
+
+// ProviderAudit records whether the price of a single provider config of a pair's market was
+// included in the aggregated price of the pair.
+type ProviderAudit struct {
+	// Provider is the name of the provider.
+	Provider string
+
+	// OffChainTicker is the off-chain ticker of the provider config.
+	OffChainTicker string
+
+	// Included is true if the provider's converted price was included in the aggregation.
+	Included bool
+
+	// LastGood is true if the included price is the provider's last good price rather than a
+	// fresh price.
+	LastGood bool
+
+	// Reason is the reason the price was excluded. This is empty if the price was included.
+	Reason ExclusionReason
+
+	// Detail is a human readable description of why the price was excluded, if available.
+	Detail string
+}
+
+// AggregationAudit records which providers were included in the latest aggregated price of a pair
+// and why the remaining providers were excluded.
+type AggregationAudit struct {
+	// Timestamp is the time of the aggregation.
+	Timestamp time.Time
+
+	// Providers is the audit of each provider config of the pair's market, in the order of the
+	// market's provider configs.
+	Providers []ProviderAudit
+
+	// WithheldReason is the reason the price of the pair was withheld in the aggregation. This is
+	// empty if the pair was priced.
+	WithheldReason string
+}
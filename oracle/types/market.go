@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	pkgtypes "github.com/skip-mev/slinky/pkg/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
@@ -85,3 +86,50 @@ func (tpt CurrencyPairsToProviderTickers) MustGetProviderConfig(name string, cp
 		Metadata_JSON:  providerTicker.GetJSON(),
 	}
 }
+
+// QuoteCurrencies returns the sorted set of quote currencies of the currency pairs in the map. Since
+// a provider's default market config declares the direct pairs that the provider can supply, these
+// are the quote currencies that the provider supports for the automatic routing of conversions.
+func (tpt CurrencyPairsToProviderTickers) QuoteCurrencies() []string {
+	seen := make(map[string]struct{})
+	quotes := make([]string, 0)
+	for cp := range tpt {
+		if _, ok := seen[cp.Quote]; ok {
+			continue
+		}
+
+		seen[cp.Quote] = struct{}{}
+		quotes = append(quotes, cp.Quote)
+	}
+
+	sort.Strings(quotes)
+	return quotes
+}
+
+// MergeMarketConfigs returns the union of the given market configs, such that the pairs of the
+// override augment the pairs of the base rather than replace them. If both configs contain the same
+// currency pair with different provider tickers, the ticker of the override is kept, and the pair is
+// returned among the sorted conflicts so that the caller can warn about it. Neither input is modified.
+func MergeMarketConfigs(
+	base, override CurrencyPairsToProviderTickers,
+) (CurrencyPairsToProviderTickers, []pkgtypes.CurrencyPair) {
+	merged := make(CurrencyPairsToProviderTickers, len(base)+len(override))
+	for cp, ticker := range base {
+		merged[cp] = ticker
+	}
+
+	conflicts := make([]pkgtypes.CurrencyPair, 0)
+	for cp, ticker := range override {
+		if existing, ok := merged[cp]; ok && existing != ticker {
+			conflicts = append(conflicts, cp)
+		}
+
+		merged[cp] = ticker
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].String() < conflicts[j].String()
+	})
+
+	return merged, conflicts
+}
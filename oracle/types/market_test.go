@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -156,3 +157,91 @@ func TestProviderTickersFromMarketMap(t *testing.T) {
 		})
 	}
 }
+
+func TestQuoteCurrencies(t *testing.T) {
+	require.Empty(t, types.CurrencyPairsToProviderTickers{}.QuoteCurrencies())
+
+	tickers := types.CurrencyPairsToProviderTickers{
+		pkgtypes.NewCurrencyPair("BTC", "USDT"): {OffChainTicker: "BTCUSDT"},
+		pkgtypes.NewCurrencyPair("ETH", "USDT"): {OffChainTicker: "ETHUSDT"},
+		pkgtypes.NewCurrencyPair("BTC", "USD"):  {OffChainTicker: "BTCUSD"},
+		pkgtypes.NewCurrencyPair("ETH", "BTC"):  {OffChainTicker: "ETHBTC"},
+	}
+	require.Equal(t, []string{"BTC", "USD", "USDT"}, tickers.QuoteCurrencies())
+}
+
+func TestMergeMarketConfigs(t *testing.T) {
+	btcusd := pkgtypes.NewCurrencyPair("BTC", "USD")
+	ethusd := pkgtypes.NewCurrencyPair("ETH", "USD")
+	solusd := pkgtypes.NewCurrencyPair("SOL", "USD")
+
+	testCases := []struct {
+		name              string
+		base              types.CurrencyPairsToProviderTickers
+		override          types.CurrencyPairsToProviderTickers
+		expected          types.CurrencyPairsToProviderTickers
+		expectedConflicts []pkgtypes.CurrencyPair
+	}{
+		{
+			name:              "empty configs",
+			expected:          types.CurrencyPairsToProviderTickers{},
+			expectedConflicts: []pkgtypes.CurrencyPair{},
+		},
+		{
+			name: "override pairs augment the base pairs",
+			base: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT"},
+				ethusd: {OffChainTicker: "ETHUSDT"},
+			},
+			override: types.CurrencyPairsToProviderTickers{
+				solusd: {OffChainTicker: "SOLUSDT"},
+			},
+			expected: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT"},
+				ethusd: {OffChainTicker: "ETHUSDT"},
+				solusd: {OffChainTicker: "SOLUSDT"},
+			},
+			expectedConflicts: []pkgtypes.CurrencyPair{},
+		},
+		{
+			name: "identical pairs are not conflicts",
+			base: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT"},
+			},
+			override: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT"},
+			},
+			expected: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT"},
+			},
+			expectedConflicts: []pkgtypes.CurrencyPair{},
+		},
+		{
+			name: "conflicting pairs prefer the override",
+			base: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT"},
+				ethusd: {OffChainTicker: "ETHUSDT"},
+			},
+			override: types.CurrencyPairsToProviderTickers{
+				ethusd: {OffChainTicker: "ETHUSDC"},
+				btcusd: {OffChainTicker: "BTCUSDT", JSON: `{"invert":true}`},
+			},
+			expected: types.CurrencyPairsToProviderTickers{
+				btcusd: {OffChainTicker: "BTCUSDT", JSON: `{"invert":true}`},
+				ethusd: {OffChainTicker: "ETHUSDC"},
+			},
+			expectedConflicts: []pkgtypes.CurrencyPair{btcusd, ethusd},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			base := maps.Clone(tc.base)
+
+			merged, conflicts := types.MergeMarketConfigs(tc.base, tc.override)
+			require.Equal(t, tc.expected, merged)
+			require.Equal(t, tc.expectedConflicts, conflicts)
+			require.Equal(t, base, tc.base)
+		})
+	}
+}
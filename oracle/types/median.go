@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/holiman/uint256"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// MedianVariant determines how the median of an even number of prices is calculated.
+type MedianVariant string
+
+const (
+	// MedianAverage averages the two middle prices. The resulting median may not be a price
+	// that was actually observed. This is the default variant.
+	MedianAverage MedianVariant = "average"
+	// MedianLower selects the lower of the two middle prices.
+	MedianLower MedianVariant = "lower"
+	// MedianUpper selects the upper of the two middle prices.
+	MedianUpper MedianVariant = "upper"
+)
+
+// ValidateBasic returns an error if the median variant is not supported.
+func (v MedianVariant) ValidateBasic() error {
+	switch v {
+	case MedianAverage, MedianLower, MedianUpper:
+		return nil
+	default:
+		return fmt.Errorf("unknown median variant %q", v)
+	}
+}
+
+// CalculateMedian calculates the median of the given prices using the variant.
+func (v MedianVariant) CalculateMedian(prices []*big.Float) *big.Float {
+	switch v {
+	case MedianLower:
+		return math.CalculateLowerMedian(prices)
+	case MedianUpper:
+		return math.CalculateUpperMedian(prices)
+	default:
+		return math.CalculateMedian(prices)
+	}
+}
+
+// CalculateWeightedMedian calculates the weighted median of the given prices using the variant.
+func (v MedianVariant) CalculateWeightedMedian(prices, weights []*big.Float) *big.Float {
+	switch v {
+	case MedianLower:
+		return math.CalculateWeightedLowerMedian(prices, weights)
+	case MedianUpper:
+		return math.CalculateWeightedUpperMedian(prices, weights)
+	default:
+		return math.CalculateWeightedMedian(prices, weights)
+	}
+}
+
+// CalculateVolumeWeightedMedian calculates the volume-weighted median of the given prices using the
+// variant. The weights are integers, such that the median is reproducible across machines.
+func (v MedianVariant) CalculateVolumeWeightedMedian(prices []*big.Float, weights []*uint256.Int) *big.Float {
+	switch v {
+	case MedianLower:
+		return math.CalculateVolumeWeightedLowerMedian(prices, weights)
+	case MedianUpper:
+		return math.CalculateVolumeWeightedUpperMedian(prices, weights)
+	default:
+		return math.CalculateVolumeWeightedMedian(prices, weights)
+	}
+}
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+func TestMedianVariant(t *testing.T) {
+	prices := func() []*big.Float {
+		return []*big.Float{
+			big.NewFloat(101),
+			big.NewFloat(100),
+			big.NewFloat(104),
+			big.NewFloat(103),
+		}
+	}
+
+	cases := []struct {
+		name     string
+		variant  types.MedianVariant
+		expected string
+		err      bool
+	}{
+		{
+			name:     "average",
+			variant:  types.MedianAverage,
+			expected: "102",
+		},
+		{
+			name:     "lower",
+			variant:  types.MedianLower,
+			expected: "101",
+		},
+		{
+			name:     "upper",
+			variant:  types.MedianUpper,
+			expected: "103",
+		},
+		{
+			name:    "unknown",
+			variant: "mode",
+			err:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err {
+				require.Error(t, tc.variant.ValidateBasic())
+				return
+			}
+
+			require.NoError(t, tc.variant.ValidateBasic())
+			require.Equal(t, tc.expected, tc.variant.CalculateMedian(prices()).String())
+
+			weights := []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(1), big.NewFloat(1)}
+			require.Equal(t, tc.expected, tc.variant.CalculateWeightedMedian(prices(), weights).String())
+		})
+	}
+}
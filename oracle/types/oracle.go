@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -82,6 +83,50 @@ type (
 	Prices = map[string]*big.Float
 )
 
+// PriceInfo contains the metadata of an aggregated price.
+type PriceInfo struct {
+	// Decimals is the number of decimals the aggregated price is scaled by.
+	Decimals uint64
+
+	// Providers is the set of providers whose prices contributed to the aggregated price.
+	Providers []string
+
+	// Source is the provider that the aggregated price is attributed to i.e. the provider whose
+	// converted price was selected as the aggregated price. Ties between providers that reported
+	// the same price are broken by the oracle's provider priority. This is empty if the aggregated
+	// price was not selected from a single provider e.g. the mean of the prices.
+	Source string
+
+	// TWAP is the time-weighted average of the aggregated price over the market's configured
+	// window, scaled by Decimals. This is nil if the market does not configure a TWAP.
+	TWAP *big.Float
+
+	// Spread is the difference between the highest and lowest converted provider prices that
+	// contributed to the aggregated price, scaled by Decimals. This is nil if the spread is not
+	// computed.
+	Spread *big.Float
+}
+
+// ProviderHealth contains the health of a provider.
+type ProviderHealth struct {
+	// Name is the name of the provider.
+	Name string
+
+	// Running is true if the provider is running.
+	Running bool
+
+	// Connections is the state of each of the provider's websocket connections. This is empty
+	// for API providers.
+	Connections []wshandlers.ConnectionState
+
+	// LastError is the most recent error that the provider encountered. This is empty if the
+	// provider has not encountered an error.
+	LastError string
+
+	// LastErrorTime is the time at which the provider encountered its most recent error.
+	LastErrorTime time.Time
+}
+
 var (
 	// NewPriceResult is a function alias for the new price result.
 	NewPriceResult = providertypes.NewResult[*big.Float]
@@ -89,6 +134,12 @@ var (
 	// NewPriceResultWithCode is a function alias for the new price result with code.
 	NewPriceResultWithCode = providertypes.NewResultWithCode[*big.Float]
 
+	// NewPriceResultWithVolume is a function alias for the new price result with volume.
+	NewPriceResultWithVolume = providertypes.NewResultWithVolume[*big.Float]
+
+	// NewPriceResultWithEventTimestamp is a function alias for the new price result with an event timestamp.
+	NewPriceResultWithEventTimestamp = providertypes.NewResultWithEventTimestamp[*big.Float]
+
 	// NewPriceResponse is a function alias for the new price response.
 	NewPriceResponse = providertypes.NewGetResponse[ProviderTicker, *big.Float]
 
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"context"
+	"net"
+)
+
+// DialContextFunc is the function used by transports and websocket dialers to open connections.
+type DialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// NewDialContextWithLocalAddress returns a DialContextFunc that binds outbound connections to the
+// given local IP address. This returns nil if the address is empty, in which case callers fall back
+// to their default dialer.
+func NewDialContextWithLocalAddress(localAddress string) DialContextFunc {
+	if len(localAddress) == 0 {
+		return nil
+	}
+
+	dialer := &net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: net.ParseIP(localAddress)},
+	}
+
+	return dialer.DialContext
+}
@@ -0,0 +1,32 @@
+package http_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	slinkyhttp "github.com/skip-mev/slinky/pkg/http"
+)
+
+func TestNewDialContextWithLocalAddress(t *testing.T) {
+	t.Run("returns nil without a local address", func(t *testing.T) {
+		require.Nil(t, slinkyhttp.NewDialContextWithLocalAddress(""))
+	})
+
+	t.Run("binds connections to the local address", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		dial := slinkyhttp.NewDialContextWithLocalAddress("127.0.0.1")
+		require.NotNil(t, dial)
+
+		conn, err := dial(context.Background(), "tcp", listener.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.Equal(t, "127.0.0.1", conn.LocalAddr().(*net.TCPAddr).IP.String())
+	})
+}
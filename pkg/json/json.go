@@ -1,10 +1,14 @@
 package json
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 )
 
+// utf8BOM is the UTF-8 byte order mark that some editors and tools prepend to files.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 // IsValid checks if the given byte array is valid JSON.
 // If the byte array is 0 length, this is a valid empty JSON object.
 func IsValid(jsonBz []byte) error {
@@ -19,3 +23,60 @@ func IsValid(jsonBz []byte) error {
 
 	return nil
 }
+
+// Sanitize strips a leading UTF-8 byte order mark and any leading or trailing whitespace
+// from the given byte array so that it can be parsed as JSON.
+func Sanitize(jsonBz []byte) []byte {
+	jsonBz = bytes.TrimSpace(jsonBz)
+	jsonBz = bytes.TrimPrefix(jsonBz, utf8BOM)
+	return bytes.TrimSpace(jsonBz)
+}
+
+// RenameFields renames the fields of every JSON object in the given byte array, at any depth,
+// according to the given mapping of current to new field names. If an object contains both the
+// current and the new name of a field, the renamed field takes precedence. Numbers are preserved
+// as is, however the order of the fields and any insignificant whitespace are not.
+func RenameFields(jsonBz []byte, renames map[string]string) ([]byte, error) {
+	if len(renames) == 0 {
+		return jsonBz, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBz))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal json: %w", err)
+	}
+
+	return json.Marshal(renameFields(value, renames))
+}
+
+// renameFields recursively renames the fields of the objects in the given decoded JSON value.
+func renameFields(value interface{}, renames map[string]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		renamed := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			if _, ok := renames[key]; !ok {
+				renamed[key] = renameFields(field, renames)
+			}
+		}
+
+		for key, field := range v {
+			if newKey, ok := renames[key]; ok {
+				renamed[newKey] = renameFields(field, renames)
+			}
+		}
+
+		return renamed
+	case []interface{}:
+		for i := range v {
+			v[i] = renameFields(v[i], renames)
+		}
+
+		return v
+	default:
+		return value
+	}
+}
@@ -81,3 +81,111 @@ func TestIsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestSanitize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		bz       []byte
+		expected []byte
+	}{
+		{
+			name:     "empty",
+			bz:       []byte{},
+			expected: []byte{},
+		},
+		{
+			name:     "no changes",
+			bz:       []byte(`{"key": "value"}`),
+			expected: []byte(`{"key": "value"}`),
+		},
+		{
+			name:     "surrounding whitespace",
+			bz:       []byte(" \n\t{\"key\": \"value\"}\r\n "),
+			expected: []byte(`{"key": "value"}`),
+		},
+		{
+			name:     "leading BOM",
+			bz:       append([]byte{0xEF, 0xBB, 0xBF}, []byte(`{"key": "value"}`)...),
+			expected: []byte(`{"key": "value"}`),
+		},
+		{
+			name:     "leading BOM and surrounding whitespace",
+			bz:       append([]byte{0xEF, 0xBB, 0xBF}, []byte("\n{\"key\": \"value\"}\n")...),
+			expected: []byte(`{"key": "value"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sanitized := json.Sanitize(tc.bz)
+			require.Equal(t, string(tc.expected), string(sanitized))
+			require.NoError(t, json.IsValid(sanitized))
+		})
+	}
+}
+
+func TestRenameFields(t *testing.T) {
+	testCases := []struct {
+		name      string
+		bz        []byte
+		renames   map[string]string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "no renames",
+			bz:       []byte(`{"last": "1.0"}`),
+			renames:  nil,
+			expected: `{"last": "1.0"}`,
+		},
+		{
+			name:     "top level field",
+			bz:       []byte(`{"last": "1.0", "symbol": "BTCUSDT"}`),
+			renames:  map[string]string{"last": "lastPrice"},
+			expected: `{"lastPrice": "1.0", "symbol": "BTCUSDT"}`,
+		},
+		{
+			name:     "nested fields in arrays",
+			bz:       []byte(`{"data": [{"s": "BTCUSDT", "p": 70000.123456789012345}, {"s": "ETHUSDT", "p": 3500}]}`),
+			renames:  map[string]string{"s": "symbol", "p": "price"},
+			expected: `{"data": [{"symbol": "BTCUSDT", "price": 70000.123456789012345}, {"symbol": "ETHUSDT", "price": 3500}]}`,
+		},
+		{
+			name:     "renamed field takes precedence",
+			bz:       []byte(`{"last": "2.0", "lastPrice": "1.0"}`),
+			renames:  map[string]string{"last": "lastPrice"},
+			expected: `{"lastPrice": "2.0"}`,
+		},
+		{
+			name:     "fields are swapped",
+			bz:       []byte(`{"a": 1, "b": 2}`),
+			renames:  map[string]string{"a": "b", "b": "a"},
+			expected: `{"a": 2, "b": 1}`,
+		},
+		{
+			name:      "invalid json",
+			bz:        []byte(`pong`),
+			renames:   map[string]string{"last": "lastPrice"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			renamed, err := json.RenameFields(tc.bz, tc.renames)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tc.expected, string(renamed))
+		})
+	}
+}
+
+func TestRenameFieldsPreservesNumbers(t *testing.T) {
+	renamed, err := json.RenameFields([]byte(`{"p": 70000.123456789012345678}`), map[string]string{"p": "price"})
+	require.NoError(t, err)
+	require.Equal(t, `{"price":70000.123456789012345678}`, string(renamed))
+}
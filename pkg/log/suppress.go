@@ -0,0 +1,120 @@
+package log
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrorSuppressor logs errors such that a persistently failing source does not flood the logs.
+// The first occurrence of a distinct error of a source is logged at full detail. Identical
+// repeats are suppressed until the error of the source changes or clears, and a summary of the
+// number of occurrences is logged at most once per summary interval in the meantime. If the
+// suppressor is disabled, every error is logged.
+type ErrorSuppressor struct {
+	logger          *zap.Logger
+	enabled         bool
+	summaryInterval time.Duration
+
+	mtx sync.Mutex
+	// errors is the current error of each source.
+	errors map[string]*suppressedError
+}
+
+// suppressedError is the current error of a source.
+type suppressedError struct {
+	// msg is the log message of the error.
+	msg string
+	// err is the error.
+	err string
+	// first is the time of the first occurrence of the error.
+	first time.Time
+	// occurrences is the total number of occurrences of the error.
+	occurrences int
+	// suppressed is the number of occurrences since the error was last logged.
+	suppressed int
+	// lastLogged is the time at which the error was last logged.
+	lastLogged time.Time
+}
+
+// NewErrorSuppressor returns a new ErrorSuppressor that logs to the given logger.
+func NewErrorSuppressor(logger *zap.Logger, enabled bool, summaryInterval time.Duration) *ErrorSuppressor {
+	return &ErrorSuppressor{
+		logger:          logger,
+		enabled:         enabled,
+		summaryInterval: summaryInterval,
+		errors:          make(map[string]*suppressedError),
+	}
+}
+
+// Error logs the given error of the given source at the error level, unless it is an identical
+// repeat of the current error of the source.
+func (s *ErrorSuppressor) Error(source, msg string, err error, fields ...zap.Field) {
+	fields = append(fields, zap.Error(err))
+	if !s.enabled {
+		s.logger.Error(msg, fields...)
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := time.Now()
+	current, ok := s.errors[source]
+	if !ok || current.msg != msg || current.err != err.Error() {
+		s.errors[source] = &suppressedError{
+			msg:         msg,
+			err:         err.Error(),
+			first:       now,
+			occurrences: 1,
+			lastLogged:  now,
+		}
+		s.logger.Error(msg, fields...)
+		return
+	}
+
+	current.occurrences++
+	current.suppressed++
+	if now.Sub(current.lastLogged) < s.summaryInterval {
+		return
+	}
+
+	s.logger.Error(
+		msg+"; still failing",
+		append(
+			fields,
+			zap.Int("occurrences", current.occurrences),
+			zap.Int("suppressed", current.suppressed),
+			zap.Duration("failing_for", now.Sub(current.first)),
+		)...,
+	)
+	current.suppressed = 0
+	current.lastLogged = now
+}
+
+// Clear clears the current error of the given source, e.g. after the source succeeds, so that
+// its next error is logged at full detail.
+func (s *ErrorSuppressor) Clear(source string) {
+	if !s.enabled {
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	current, ok := s.errors[source]
+	if !ok {
+		return
+	}
+
+	delete(s.errors, source)
+	if current.occurrences > 1 {
+		s.logger.Info(
+			current.msg+"; error cleared",
+			zap.String("error", current.err),
+			zap.Int("occurrences", current.occurrences),
+			zap.Duration("failing_for", time.Since(current.first)),
+		)
+	}
+}
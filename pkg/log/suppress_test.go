@@ -0,0 +1,119 @@
+package log_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/skip-mev/slinky/pkg/log"
+)
+
+func TestErrorSuppressor(t *testing.T) {
+	errTimeout := fmt.Errorf("timeout")
+	errRefused := fmt.Errorf("connection refused")
+
+	t.Run("disabled suppressor logs every error", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), false, time.Hour)
+
+		for i := 0; i < 3; i++ {
+			s.Error("binance", "failed to make request", errTimeout)
+		}
+		s.Clear("binance")
+
+		require.Equal(t, 3, logs.Len())
+		for _, entry := range logs.All() {
+			require.Equal(t, "failed to make request", entry.Message)
+		}
+	})
+
+	t.Run("identical repeats are suppressed", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), true, time.Hour)
+
+		for i := 0; i < 3; i++ {
+			s.Error("binance", "failed to make request", errTimeout, zap.String("url", "url"))
+		}
+
+		require.Equal(t, 1, logs.Len())
+		entry := logs.All()[0]
+		require.Equal(t, zapcore.ErrorLevel, entry.Level)
+		require.Equal(t, "failed to make request", entry.Message)
+		require.Equal(t, "url", entry.ContextMap()["url"])
+		require.Equal(t, "timeout", entry.ContextMap()["error"])
+	})
+
+	t.Run("sources are suppressed independently", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), true, time.Hour)
+
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Error("coinbase", "failed to make request", errTimeout)
+		s.Error("binance", "failed to make request", errTimeout)
+
+		require.Equal(t, 2, logs.Len())
+	})
+
+	t.Run("changed error is logged", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), true, time.Hour)
+
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Error("binance", "failed to make request", errRefused)
+		s.Error("binance", "failed to make request", errRefused)
+
+		require.Equal(t, 2, logs.Len())
+		require.Equal(t, "connection refused", logs.All()[1].ContextMap()["error"])
+	})
+
+	t.Run("summary is logged once the summary interval elapses", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), true, 50*time.Millisecond)
+
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Error("binance", "failed to make request", errTimeout)
+		time.Sleep(60 * time.Millisecond)
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Error("binance", "failed to make request", errTimeout)
+
+		require.Equal(t, 2, logs.Len())
+		summary := logs.All()[1]
+		require.Equal(t, zapcore.ErrorLevel, summary.Level)
+		require.Equal(t, "failed to make request; still failing", summary.Message)
+		require.Equal(t, int64(3), summary.ContextMap()["occurrences"])
+		require.Equal(t, int64(2), summary.ContextMap()["suppressed"])
+	})
+
+	t.Run("cleared error is logged again", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), true, time.Hour)
+
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Clear("binance")
+		s.Error("binance", "failed to make request", errTimeout)
+
+		require.Equal(t, 3, logs.Len())
+		cleared := logs.All()[1]
+		require.Equal(t, zapcore.InfoLevel, cleared.Level)
+		require.Equal(t, "failed to make request; error cleared", cleared.Message)
+		require.Equal(t, int64(2), cleared.ContextMap()["occurrences"])
+		require.Equal(t, "failed to make request", logs.All()[2].Message)
+	})
+
+	t.Run("clearing a single occurrence is not logged", func(t *testing.T) {
+		core, logs := observer.New(zapcore.DebugLevel)
+		s := log.NewErrorSuppressor(zap.New(core), true, time.Hour)
+
+		s.Error("binance", "failed to make request", errTimeout)
+		s.Clear("binance")
+		s.Clear("coinbase")
+
+		require.Equal(t, 1, logs.Len())
+	})
+}
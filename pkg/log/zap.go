@@ -43,10 +43,50 @@ func NewDefaultConfig() Config {
 	}
 }
 
+// Levels are the log levels of a logger, which can be changed at runtime.
+type Levels struct {
+	// StdOut is the level of the standard out logger.
+	StdOut zap.AtomicLevel
+	// FileOut is the level of the file logger.
+	FileOut zap.AtomicLevel
+
+	stdOutDefault  zapcore.Level
+	fileOutDefault zapcore.Level
+}
+
+// ToggleDebug sets both levels to debug, or restores the configured levels if debug logging was
+// already enabled. It returns whether debug logging is enabled.
+func (l *Levels) ToggleDebug() bool {
+	if l.StdOut.Level() == zapcore.DebugLevel && l.FileOut.Level() == zapcore.DebugLevel {
+		l.StdOut.SetLevel(l.stdOutDefault)
+		l.FileOut.SetLevel(l.fileOutDefault)
+		return l.stdOutDefault == zapcore.DebugLevel && l.fileOutDefault == zapcore.DebugLevel
+	}
+
+	l.StdOut.SetLevel(zapcore.DebugLevel)
+	l.FileOut.SetLevel(zapcore.DebugLevel)
+	return true
+}
+
+// NewLogger returns a new logger built from the given config.
 func NewLogger(config Config) *zap.Logger {
+	logger, _ := NewLoggerWithLevels(config)
+	return logger
+}
+
+// NewLoggerWithLevels returns a new logger built from the given config along with its levels,
+// which can be utilized to change the verbosity of the logger at runtime.
+func NewLoggerWithLevels(config Config) (*zap.Logger, *Levels) {
 	encoderCfg := zap.NewProductionEncoderConfig()
 	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
 
+	levels := &Levels{
+		StdOut:         zap.NewAtomicLevel(),
+		FileOut:        zap.NewAtomicLevel(),
+		stdOutDefault:  zapcore.InfoLevel,
+		fileOutDefault: zapcore.InfoLevel,
+	}
+
 	var fileCore zapcore.Core
 	if config.WriteTo != "" && !config.DisableRotating {
 		// Configure lumberjack for logging to a file
@@ -65,10 +105,12 @@ func NewLogger(config Config) *zap.Logger {
 			logLevel = zapcore.InfoLevel // Fallback to info if setting fails
 		}
 
+		levels.fileOutDefault = logLevel
+		levels.FileOut.SetLevel(logLevel)
 		fileCore = zapcore.NewCore(
 			zapcore.NewJSONEncoder(encoderCfg),
 			fileSyncer,
-			logLevel,
+			levels.FileOut,
 		)
 	}
 
@@ -79,11 +121,14 @@ func NewLogger(config Config) *zap.Logger {
 		logLevel = zapcore.InfoLevel // Fallback to info if setting fails
 	}
 
+	levels.stdOutDefault = logLevel
+	levels.StdOut.SetLevel(logLevel)
+
 	// Setup the primary output to always include os.Stderr
 	stdCore := zapcore.NewCore(
 		zapcore.NewJSONEncoder(encoderCfg),
 		zapcore.Lock(os.Stderr),
-		logLevel,
+		levels.StdOut,
 	)
 
 	// Use zapcore.NewTee to write to both stderr and the file (if configured)
@@ -94,9 +139,11 @@ func NewLogger(config Config) *zap.Logger {
 		core = stdCore
 	}
 
-	return zap.New(
+	logger := zap.New(
 		core,
 		zap.AddCaller(),
 		zap.Fields(zapcore.Field{Key: "pid", Type: zapcore.Int64Type, Integer: int64(os.Getpid())}),
 	)
+
+	return logger, levels
 }
@@ -0,0 +1,34 @@
+package log_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/skip-mev/slinky/pkg/log"
+)
+
+func TestLevelsToggleDebug(t *testing.T) {
+	cfg := log.NewDefaultConfig()
+	cfg.StdOutLogLevel = "warn"
+	cfg.WriteTo = filepath.Join(t.TempDir(), "sidecar.log")
+
+	logger, levels := log.NewLoggerWithLevels(cfg)
+	require.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+	require.Equal(t, zapcore.WarnLevel, levels.StdOut.Level())
+	require.Equal(t, zapcore.InfoLevel, levels.FileOut.Level())
+
+	// Toggling enables debug logging on both outputs.
+	require.True(t, levels.ToggleDebug())
+	require.True(t, logger.Core().Enabled(zapcore.DebugLevel))
+	require.Equal(t, zapcore.DebugLevel, levels.StdOut.Level())
+	require.Equal(t, zapcore.DebugLevel, levels.FileOut.Level())
+
+	// Toggling again restores the configured levels.
+	require.False(t, levels.ToggleDebug())
+	require.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+	require.Equal(t, zapcore.WarnLevel, levels.StdOut.Level())
+	require.Equal(t, zapcore.InfoLevel, levels.FileOut.Level())
+}
@@ -98,7 +98,7 @@ func BenchmarkFloat64ToBigInt(b *testing.B) {
 	for _, tc := range testCases {
 		b.Run(tc.name, func(b *testing.B) {
 			for n := 0; n < b.N; n++ {
-				_ = math.Float64ToBigInt(tc.input, tc.base)
+				_, _ = math.Float64ToBigInt(tc.input, tc.base)
 			}
 		})
 	}
@@ -147,7 +147,7 @@ func BenchmarkBigFloatToBigInt(b *testing.B) {
 	for _, tc := range testCases {
 		b.Run(tc.name, func(b *testing.B) {
 			for n := 0; n < b.N; n++ {
-				_ = math.BigFloatToBigInt(tc.input, tc.base)
+				_, _ = math.BigFloatToBigInt(tc.input, tc.base)
 			}
 		})
 	}
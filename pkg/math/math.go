@@ -1,10 +1,14 @@
 package math
 
 import (
+	"errors"
 	"fmt"
+	gomath "math"
 	"math/big"
 	"sort"
+	"strings"
 
+	"github.com/holiman/uint256"
 	"golang.org/x/exp/constraints"
 )
 
@@ -46,27 +50,51 @@ func Max[V int | int64 | uint64 | int32 | uint32](vals ...V) V {
 	return maximum
 }
 
+// MaxIntBits is the maximum bit length of an integer produced by the float-to-integer
+// conversions in this package. Prices are stored on chain as 256-bit integers, so any larger
+// value would silently wrap.
+const MaxIntBits = 256
+
+var (
+	// ErrNaN is returned when a value that is not a number is converted.
+	ErrNaN = errors.New("value is NaN")
+	// ErrInf is returned when an infinite value is converted.
+	ErrInf = errors.New("value is infinite")
+	// ErrOverflow is returned when a value does not fit in MaxIntBits bits once converted
+	// to an integer.
+	ErrOverflow = fmt.Errorf("value overflows %d bits", MaxIntBits)
+)
+
 // Float64StringToBigInt converts a float64 string to a big.Int.
 func Float64StringToBigInt(s string, decimals uint64) (*big.Int, error) {
-	bigFloat := new(big.Float)
-	_, _, err := bigFloat.Parse(s, 10)
+	bigFloat, err := Float64StringToBigFloat(s)
 	if err != nil {
 		return nil, err
 	}
 
-	return BigFloatToBigInt(bigFloat, decimals), nil
+	return BigFloatToBigInt(bigFloat, decimals)
 }
 
-// Float64ToBigInt converts a float64 to a big.Int.
-func Float64ToBigInt(val float64, decimals uint64) *big.Int {
+// Float64ToBigInt converts a float64 to a big.Int. An error is returned if the value is NaN,
+// infinite, or overflows MaxIntBits bits.
+func Float64ToBigInt(val float64, decimals uint64) (*big.Int, error) {
+	if gomath.IsNaN(val) {
+		return nil, ErrNaN
+	}
+
 	bigVal := new(big.Float)
 	bigVal.SetFloat64(val)
 
 	return BigFloatToBigInt(bigVal, decimals)
 }
 
-// BigFloatToBigInt converts a big.Float to a big.Int.
-func BigFloatToBigInt(f *big.Float, decimals uint64) *big.Int {
+// BigFloatToBigInt converts a big.Float to a big.Int. An error is returned if the value is
+// infinite or overflows MaxIntBits bits.
+func BigFloatToBigInt(f *big.Float, decimals uint64) (*big.Int, error) {
+	if f.IsInf() {
+		return nil, ErrInf
+	}
+
 	bigFloat := new(big.Float)
 	factor := big.NewInt(1).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
 	bigFloat.SetInt(factor)
@@ -76,16 +104,29 @@ func BigFloatToBigInt(f *big.Float, decimals uint64) *big.Int {
 	result := new(big.Int)
 	f.Int(result) // store converted number in result
 
-	return result
+	if result.BitLen() > MaxIntBits {
+		return nil, fmt.Errorf("%w: %s", ErrOverflow, f.Text('g', 10))
+	}
+
+	return result, nil
 }
 
-// Float64StringToBigFloat converts a float64 string to a big.Float.
+// Float64StringToBigFloat converts a float64 string to a big.Float. Strings that represent
+// NaN or infinite values are rejected with ErrNaN and ErrInf respectively.
 func Float64StringToBigFloat(s string) (*big.Float, error) {
+	switch strings.ToLower(strings.TrimLeft(s, "+-")) {
+	case "nan":
+		return nil, fmt.Errorf("%w: %s", ErrNaN, s)
+	case "inf", "infinity":
+		return nil, fmt.Errorf("%w: %s", ErrInf, s)
+	}
+
 	bigFloat := new(big.Float)
 	_, ok := bigFloat.SetString(s)
 	if !ok {
 		return nil, fmt.Errorf("failed to set big.Float from string: %s", s)
 	}
+
 	return bigFloat, nil
 }
 
@@ -118,6 +159,40 @@ func SortBigFloats(values []*big.Float) {
 // CalculateMedian calculates the median from a list of big.Float. Returns an
 // average if the number of values is even.
 func CalculateMedian(values []*big.Float) *big.Float {
+	return calculateMedian(values, averageOf)
+}
+
+// CalculateLowerMedian calculates the median from a list of big.Float. Returns the
+// lower of the two middle values if the number of values is even, such that the median
+// is always one of the given values.
+func CalculateLowerMedian(values []*big.Float) *big.Float {
+	return calculateMedian(values, lowerOf)
+}
+
+// CalculateUpperMedian calculates the median from a list of big.Float. Returns the
+// upper of the two middle values if the number of values is even, such that the median
+// is always one of the given values.
+func CalculateUpperMedian(values []*big.Float) *big.Float {
+	return calculateMedian(values, upperOf)
+}
+
+// tieBreakFn selects the median from the two middle values of an even number of values.
+type tieBreakFn func(lower, upper *big.Float) *big.Float
+
+func averageOf(lower, upper *big.Float) *big.Float {
+	median := new(big.Float).Add(lower, upper)
+	return median.Quo(median, new(big.Float).SetUint64(2))
+}
+
+func lowerOf(lower, _ *big.Float) *big.Float {
+	return lower
+}
+
+func upperOf(_, upper *big.Float) *big.Float {
+	return upper
+}
+
+func calculateMedian(values []*big.Float, tieBreak tieBreakFn) *big.Float {
 	if len(values) == 0 {
 		return nil
 	}
@@ -129,8 +204,7 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	numValues := len(values)
 	var median *big.Float
 	if numValues%2 == 0 { // even
-		median = new(big.Float).Add(values[middleIndex-1], values[middleIndex])
-		median = median.Quo(median, new(big.Float).SetUint64(2))
+		median = tieBreak(values[middleIndex-1], values[middleIndex])
 	} else { // odd
 		median = values[middleIndex]
 	}
@@ -138,6 +212,340 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	return median
 }
 
+// CalculateMean calculates the arithmetic mean from a list of big.Float. Returns nil if the
+// list is empty.
+func CalculateMean(values []*big.Float) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sum := new(big.Float)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(len(values))))
+}
+
+// DefaultHuberK is the default tuning constant of CalculateHuberMean, which retains 95% of the
+// efficiency of the mean for normally distributed values.
+const DefaultHuberK = 1.345
+
+const (
+	// madScale scales the median absolute deviation into a consistent estimator of the standard
+	// deviation of normally distributed values.
+	madScale = 1.4826
+	// huberMaxIterations is the maximum number of iterations of CalculateHuberMean.
+	huberMaxIterations = 50
+	// huberTolerance is the change of the estimate, relative to the median absolute deviation,
+	// below which CalculateHuberMean converges.
+	huberTolerance = 1e-9
+)
+
+// CalculateHuberMean calculates the Huber M-estimate of the location of a list of big.Float. Values
+// within k times the scaled median absolute deviation (MAD) of the estimate are weighted fully,
+// while values further away are down-weighted in proportion to their distance, such that outliers
+// are continuously discounted rather than trimmed. A larger k approaches the mean, a smaller k the
+// median. The estimate is computed by iteratively reweighting, starting from the median. If more
+// than half of the values are equal, i.e. the MAD is zero, the median is returned. Returns nil if
+// the list is empty.
+func CalculateHuberMean(values []*big.Float, k float64) *big.Float {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return calculateHuberMean(values, nil, k)
+}
+
+// CalculateWeightedHuberMean calculates the Huber M-estimate like CalculateHuberMean, except that
+// each value is additionally weighted by its corresponding weight, and the median and MAD are
+// weighted medians. Values with a non-positive weight do not contribute to the estimate. Returns nil
+// if the inputs are empty, mismatched, or if the total weight is not positive.
+func CalculateWeightedHuberMean(values []*big.Float, weights []*big.Float, k float64) *big.Float {
+	values, weights = positivelyWeighted(values, weights)
+	if len(values) == 0 {
+		return nil
+	}
+
+	return calculateHuberMean(values, weights, k)
+}
+
+// calculateHuberMean calculates the Huber M-estimate of the given non-empty values. If weights is
+// nil, all values are weighted equally.
+func calculateHuberMean(values []*big.Float, weights []*big.Float, k float64) *big.Float {
+	median := func(values []*big.Float) *big.Float {
+		if weights == nil {
+			// The median is calculated over a copy of the values, since it sorts them in place.
+			return CalculateMedian(append([]*big.Float(nil), values...))
+		}
+
+		return CalculateWeightedMedian(values, weights)
+	}
+
+	estimate := median(values)
+	deviations := make([]*big.Float, len(values))
+	for i, value := range values {
+		deviations[i] = new(big.Float).Sub(value, estimate)
+		deviations[i].Abs(deviations[i])
+	}
+
+	mad := median(deviations)
+	if mad.Sign() == 0 {
+		return estimate
+	}
+
+	threshold := new(big.Float).Mul(mad, big.NewFloat(madScale*k))
+	tolerance := new(big.Float).Mul(mad, big.NewFloat(huberTolerance))
+	for i := 0; i < huberMaxIterations; i++ {
+		sum := new(big.Float)
+		totalWeight := new(big.Float)
+		for j, value := range values {
+			weight := big.NewFloat(1)
+			if weights != nil {
+				weight.Set(weights[j])
+			}
+
+			residual := new(big.Float).Sub(value, estimate)
+			if residual.Abs(residual).Cmp(threshold) > 0 {
+				weight.Mul(weight, residual.Quo(threshold, residual))
+			}
+
+			sum.Add(sum, new(big.Float).Mul(weight, value))
+			totalWeight.Add(totalWeight, weight)
+		}
+
+		next := sum.Quo(sum, totalWeight)
+		change := new(big.Float).Sub(next, estimate)
+		estimate = next
+		if change.Abs(change).Cmp(tolerance) <= 0 {
+			break
+		}
+	}
+
+	return estimate
+}
+
+// CalculateWeightedMean calculates the weighted arithmetic mean from a list of big.Float and their
+// corresponding weights. Values with a non-positive weight do not contribute to the mean. Returns
+// nil if the inputs are empty, mismatched, or if the total weight is not positive.
+func CalculateWeightedMean(values []*big.Float, weights []*big.Float) *big.Float {
+	values, weights = positivelyWeighted(values, weights)
+	if len(values) == 0 {
+		return nil
+	}
+
+	sum := new(big.Float)
+	totalWeight := new(big.Float)
+	for i, value := range values {
+		sum.Add(sum, new(big.Float).Mul(weights[i], value))
+		totalWeight.Add(totalWeight, weights[i])
+	}
+
+	return sum.Quo(sum, totalWeight)
+}
+
+// positivelyWeighted returns the values with a positive weight and their weights. Nothing is
+// returned if the inputs are mismatched.
+func positivelyWeighted(values []*big.Float, weights []*big.Float) ([]*big.Float, []*big.Float) {
+	if len(values) != len(weights) {
+		return nil, nil
+	}
+
+	filteredValues := make([]*big.Float, 0, len(values))
+	filteredWeights := make([]*big.Float, 0, len(weights))
+	for i, value := range values {
+		if weights[i] == nil || weights[i].Sign() <= 0 {
+			continue
+		}
+
+		filteredValues = append(filteredValues, value)
+		filteredWeights = append(filteredWeights, weights[i])
+	}
+
+	return filteredValues, filteredWeights
+}
+
+// CalculateWeightedMedian calculates the weighted median from a list of big.Float and their
+// corresponding weights. Values with a non-positive weight do not contribute to the median. If
+// the cumulative weight lands exactly on half of the total weight, the average of the two middle
+// values is returned, such that equal weights yield the same result as CalculateMedian. Returns
+// nil if the inputs are empty, mismatched, or if the total weight is not positive.
+func CalculateWeightedMedian(values []*big.Float, weights []*big.Float) *big.Float {
+	return calculateWeightedMedian(values, weights, averageOf)
+}
+
+// CalculateWeightedLowerMedian calculates the weighted median like CalculateWeightedMedian,
+// except that the lower of the two middle values is returned if the cumulative weight lands
+// exactly on half of the total weight.
+func CalculateWeightedLowerMedian(values []*big.Float, weights []*big.Float) *big.Float {
+	return calculateWeightedMedian(values, weights, lowerOf)
+}
+
+// CalculateWeightedUpperMedian calculates the weighted median like CalculateWeightedMedian,
+// except that the upper of the two middle values is returned if the cumulative weight lands
+// exactly on half of the total weight.
+func CalculateWeightedUpperMedian(values []*big.Float, weights []*big.Float) *big.Float {
+	return calculateWeightedMedian(values, weights, upperOf)
+}
+
+func calculateWeightedMedian(values []*big.Float, weights []*big.Float, tieBreak tieBreakFn) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	type weightedValue struct {
+		value  *big.Float
+		weight *big.Float
+	}
+
+	// Filter out any values that do not have a positive weight.
+	totalWeight := new(big.Float)
+	weighted := make([]weightedValue, 0, len(values))
+	for i, value := range values {
+		if weights[i] == nil || weights[i].Sign() <= 0 {
+			continue
+		}
+
+		weighted = append(weighted, weightedValue{value: value, weight: weights[i]})
+		totalWeight.Add(totalWeight, weights[i])
+	}
+
+	if len(weighted) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].value.Cmp(weighted[j].value) < 0
+	})
+
+	half := new(big.Float).Quo(totalWeight, new(big.Float).SetUint64(2))
+	cumulativeWeight := new(big.Float)
+	for i, wv := range weighted {
+		cumulativeWeight.Add(cumulativeWeight, wv.weight)
+
+		switch cumulativeWeight.Cmp(half) {
+		case 0:
+			if i+1 < len(weighted) {
+				return tieBreak(wv.value, weighted[i+1].value)
+			}
+
+			return wv.value
+		case 1:
+			return wv.value
+		}
+	}
+
+	return weighted[len(weighted)-1].value
+}
+
+// MaxVolumeWeight is the maximum volume weight of a single value in a volume-weighted median.
+// Larger volumes are clamped, such that the total volume of any practical number of values
+// cannot overflow a uint256.
+var MaxVolumeWeight = new(uint256.Int).Lsh(uint256.NewInt(1), 128)
+
+// VolumeWeight converts a reported volume to the integer weight of its value in a volume-weighted
+// median. The volume is truncated toward zero and clamped to MaxVolumeWeight. Negative and nil
+// volumes have a weight of 0.
+func VolumeWeight(volume *big.Float) *uint256.Int {
+	if volume == nil || volume.Sign() <= 0 {
+		return new(uint256.Int)
+	}
+
+	if volume.IsInf() {
+		return new(uint256.Int).Set(MaxVolumeWeight)
+	}
+
+	truncated, _ := volume.Int(nil)
+	weight, overflow := uint256.FromBig(truncated)
+	if overflow || weight.Gt(MaxVolumeWeight) {
+		return new(uint256.Int).Set(MaxVolumeWeight)
+	}
+
+	return weight
+}
+
+// CalculateVolumeWeightedMedian calculates the weighted median from a list of big.Float and their
+// corresponding integer volume weights, see VolumeWeight. Only integer arithmetic is used on the
+// weights, so the result is reproducible across machines. Values with a weight of 0 do not
+// contribute to the median. If the cumulative weight lands exactly on half of the total weight,
+// the average of the two middle values is returned. Returns nil if the inputs are empty,
+// mismatched, or if the total weight is 0.
+func CalculateVolumeWeightedMedian(values []*big.Float, weights []*uint256.Int) *big.Float {
+	return calculateVolumeWeightedMedian(values, weights, averageOf)
+}
+
+// CalculateVolumeWeightedLowerMedian calculates the volume-weighted median like
+// CalculateVolumeWeightedMedian, except that the lower of the two middle values is returned if the
+// cumulative weight lands exactly on half of the total weight.
+func CalculateVolumeWeightedLowerMedian(values []*big.Float, weights []*uint256.Int) *big.Float {
+	return calculateVolumeWeightedMedian(values, weights, lowerOf)
+}
+
+// CalculateVolumeWeightedUpperMedian calculates the volume-weighted median like
+// CalculateVolumeWeightedMedian, except that the upper of the two middle values is returned if the
+// cumulative weight lands exactly on half of the total weight.
+func CalculateVolumeWeightedUpperMedian(values []*big.Float, weights []*uint256.Int) *big.Float {
+	return calculateVolumeWeightedMedian(values, weights, upperOf)
+}
+
+func calculateVolumeWeightedMedian(values []*big.Float, weights []*uint256.Int, tieBreak tieBreakFn) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	type weightedValue struct {
+		value  *big.Float
+		weight *uint256.Int
+	}
+
+	// Filter out any values that do not have a positive weight.
+	totalWeight := new(uint256.Int)
+	weighted := make([]weightedValue, 0, len(values))
+	for i, value := range values {
+		if weights[i] == nil || weights[i].IsZero() {
+			continue
+		}
+
+		weight := weights[i]
+		if weight.Gt(MaxVolumeWeight) {
+			weight = MaxVolumeWeight
+		}
+
+		weighted = append(weighted, weightedValue{value: value, weight: weight})
+		totalWeight.Add(totalWeight, weight)
+	}
+
+	if len(weighted) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].value.Cmp(weighted[j].value) < 0
+	})
+
+	// Twice the cumulative weight is compared against the total weight, so that the median is
+	// located without dividing the total weight.
+	cumulativeWeight := new(uint256.Int)
+	doubled := new(uint256.Int)
+	for i, wv := range weighted {
+		cumulativeWeight.Add(cumulativeWeight, wv.weight)
+		doubled.Lsh(cumulativeWeight, 1)
+
+		switch doubled.Cmp(totalWeight) {
+		case 0:
+			if i+1 < len(weighted) {
+				return tieBreak(wv.value, weighted[i+1].value)
+			}
+
+			return wv.value
+		case 1:
+			return wv.value
+		}
+	}
+
+	return weighted[len(weighted)-1].value
+}
+
 // GetScalingFactor returns the scaling factor for the price based on the difference between
 // the token decimals in the erc20 token contracts or similar.
 func GetScalingFactor(
@@ -1,10 +1,12 @@
 package math_test
 
 import (
+	gomath "math"
 	"math/big"
 	"strconv"
 	"testing"
 
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/slinky/pkg/math"
@@ -218,7 +220,8 @@ func TestFloat64ToBigInt(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := math.Float64ToBigInt(tc.input, tc.base)
+			result, err := math.Float64ToBigInt(tc.input, tc.base)
+			require.NoError(t, err)
 			require.Equal(t, tc.expected, result)
 		})
 	}
@@ -266,12 +269,115 @@ func TestBigFloatToBigInt(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := math.BigFloatToBigInt(tc.input, tc.base)
+			result, err := math.BigFloatToBigInt(tc.input, tc.base)
+			require.NoError(t, err)
 			require.Equal(t, tc.expected, result)
 		})
 	}
 }
 
+func TestInvalidFloatConversions(t *testing.T) {
+	overflow := new(big.Float).SetMantExp(big.NewFloat(1), math.MaxIntBits)
+
+	testCases := []struct {
+		name     string
+		convert  func() error
+		expected error
+	}{
+		{
+			name: "NaN string to big.Float",
+			convert: func() error {
+				_, err := math.Float64StringToBigFloat("NaN")
+				return err
+			},
+			expected: math.ErrNaN,
+		},
+		{
+			name: "negative NaN string to big.Float",
+			convert: func() error {
+				_, err := math.Float64StringToBigFloat("-nan")
+				return err
+			},
+			expected: math.ErrNaN,
+		},
+		{
+			name: "Inf string to big.Float",
+			convert: func() error {
+				_, err := math.Float64StringToBigFloat("+Inf")
+				return err
+			},
+			expected: math.ErrInf,
+		},
+		{
+			name: "Inf string to big.Int",
+			convert: func() error {
+				_, err := math.Float64StringToBigInt("-inf", 6)
+				return err
+			},
+			expected: math.ErrInf,
+		},
+		{
+			name: "overflowing scientific notation string to big.Int",
+			convert: func() error {
+				_, err := math.Float64StringToBigInt("1e400", 6)
+				return err
+			},
+			expected: math.ErrOverflow,
+		},
+		{
+			name: "NaN float64 to big.Int",
+			convert: func() error {
+				_, err := math.Float64ToBigInt(gomath.NaN(), 6)
+				return err
+			},
+			expected: math.ErrNaN,
+		},
+		{
+			name: "Inf float64 to big.Int",
+			convert: func() error {
+				_, err := math.Float64ToBigInt(gomath.Inf(1), 6)
+				return err
+			},
+			expected: math.ErrInf,
+		},
+		{
+			name: "max float64 to big.Int",
+			convert: func() error {
+				_, err := math.Float64ToBigInt(gomath.MaxFloat64, 0)
+				return err
+			},
+			expected: math.ErrOverflow,
+		},
+		{
+			name: "big.Float that overflows once scaled",
+			convert: func() error {
+				_, err := math.BigFloatToBigInt(new(big.Float).Quo(overflow, big.NewFloat(1e3)), 6)
+				return err
+			},
+			expected: math.ErrOverflow,
+		},
+		{
+			name: "big.Float at the limit",
+			convert: func() error {
+				_, err := math.BigFloatToBigInt(new(big.Float).SetMantExp(big.NewFloat(1), math.MaxIntBits-1), 0)
+				return err
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.convert()
+			if tc.expected == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expected)
+			}
+		})
+	}
+}
+
 func TestFloat64StringToBigFloat(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -303,6 +409,16 @@ func TestFloat64StringToBigFloat(t *testing.T) {
 			out:  big.NewFloat(420420420420420.420420420),
 			err:  false,
 		},
+		{
+			name: "value is NaN",
+			in:   "NaN",
+			err:  true,
+		},
+		{
+			name: "value is infinite",
+			in:   "Inf",
+			err:  true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -412,6 +528,480 @@ func TestCalculateMedian(t *testing.T) {
 	}
 }
 
+func TestCalculateLowerAndUpperMedian(t *testing.T) {
+	testCases := []struct {
+		name          string
+		values        []*big.Float
+		expectedLower *big.Float
+		expectedUpper *big.Float
+	}{
+		{
+			name:          "do nothing for nil slice",
+			values:        nil,
+			expectedLower: nil,
+			expectedUpper: nil,
+		},
+		{
+			name: "even number of values selects one of the middle values",
+			values: []*big.Float{
+				big.NewFloat(100),
+				big.NewFloat(-2),
+				big.NewFloat(10),
+				big.NewFloat(0),
+			},
+			expectedLower: big.NewFloat(0),
+			expectedUpper: big.NewFloat(10),
+		},
+		{
+			name: "odd number of values selects the middle value",
+			values: []*big.Float{
+				big.NewFloat(10),
+				big.NewFloat(-2),
+				big.NewFloat(100),
+			},
+			expectedLower: big.NewFloat(10),
+			expectedUpper: big.NewFloat(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedLower, math.CalculateLowerMedian(tc.values))
+			require.Equal(t, tc.expectedUpper, math.CalculateUpperMedian(tc.values))
+		})
+	}
+}
+
+func TestCalculateMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			expected: nil,
+		},
+		{
+			name: "calculate mean for a single value",
+			values: []*big.Float{
+				big.NewFloat(10),
+			},
+			expected: big.NewFloat(10),
+		},
+		{
+			name: "calculate mean for multiple values",
+			values: []*big.Float{
+				big.NewFloat(-2),
+				big.NewFloat(0),
+				big.NewFloat(10),
+				big.NewFloat(100),
+			},
+			expected: big.NewFloat(27),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mean := math.CalculateMean(tc.values)
+			if tc.expected == nil {
+				require.Nil(t, mean)
+				return
+			}
+
+			require.Equal(t, 0, tc.expected.Cmp(mean))
+		})
+	}
+}
+
+func TestCalculateWeightedMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slices",
+			expected: nil,
+		},
+		{
+			name:     "mismatched inputs",
+			values:   []*big.Float{big.NewFloat(10)},
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name:     "equal weights take the mean",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20), big.NewFloat(60)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(1)},
+			expected: big.NewFloat(30),
+		},
+		{
+			name:     "values are weighted",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20)},
+			weights:  []*big.Float{big.NewFloat(3), big.NewFloat(1)},
+			expected: big.NewFloat(12.5),
+		},
+		{
+			name:     "values with a non-positive weight are ignored",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20), big.NewFloat(1000)},
+			weights:  []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(0)},
+			expected: big.NewFloat(15),
+		},
+		{
+			name:     "no positive weights",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20)},
+			weights:  []*big.Float{big.NewFloat(0), big.NewFloat(-1)},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mean := math.CalculateWeightedMean(tc.values, tc.weights)
+			if tc.expected == nil {
+				require.Nil(t, mean)
+				return
+			}
+
+			require.Equal(t, 0, tc.expected.Cmp(mean))
+		})
+	}
+}
+
+func TestCalculateHuberMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		k        float64
+		expected float64
+	}{
+		{
+			name:     "single value",
+			values:   []*big.Float{big.NewFloat(10)},
+			k:        math.DefaultHuberK,
+			expected: 10,
+		},
+		{
+			name: "symmetric outliers do not move the estimate",
+			values: []*big.Float{
+				big.NewFloat(50),
+				big.NewFloat(99),
+				big.NewFloat(100),
+				big.NewFloat(101),
+				big.NewFloat(150),
+			},
+			k:        math.DefaultHuberK,
+			expected: 100,
+		},
+		{
+			name: "outlier is down-weighted",
+			values: []*big.Float{
+				big.NewFloat(100),
+				big.NewFloat(101),
+				big.NewFloat(102),
+				big.NewFloat(103),
+				big.NewFloat(1000),
+			},
+			k: math.DefaultHuberK,
+			// The residuals of the outlier and of 100 are both capped at 1.4826 * 1.345, so
+			// they cancel out, whereas the mean is 281.2.
+			expected: 102,
+		},
+		{
+			name: "large k takes the mean",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+				big.NewFloat(3),
+				big.NewFloat(10),
+			},
+			k:        1000,
+			expected: 4,
+		},
+		{
+			name: "zero median absolute deviation takes the median",
+			values: []*big.Float{
+				big.NewFloat(100),
+				big.NewFloat(100),
+				big.NewFloat(100),
+				big.NewFloat(500),
+			},
+			k:        math.DefaultHuberK,
+			expected: 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate, _ := math.CalculateHuberMean(tc.values, tc.k).Float64()
+			require.InDelta(t, tc.expected, estimate, 1e-3)
+		})
+	}
+
+	t.Run("empty list", func(t *testing.T) {
+		require.Nil(t, math.CalculateHuberMean(nil, math.DefaultHuberK))
+	})
+
+	t.Run("values are not reordered", func(t *testing.T) {
+		values := []*big.Float{big.NewFloat(3), big.NewFloat(1), big.NewFloat(2)}
+		math.CalculateHuberMean(values, math.DefaultHuberK)
+		require.Equal(t, []*big.Float{big.NewFloat(3), big.NewFloat(1), big.NewFloat(2)}, values)
+	})
+}
+
+func TestCalculateWeightedHuberMean(t *testing.T) {
+	t.Run("equal weights match the unweighted estimate", func(t *testing.T) {
+		values := []*big.Float{
+			big.NewFloat(100),
+			big.NewFloat(101),
+			big.NewFloat(102),
+			big.NewFloat(103),
+			big.NewFloat(1000),
+		}
+		weights := []*big.Float{big.NewFloat(2), big.NewFloat(2), big.NewFloat(2), big.NewFloat(2), big.NewFloat(2)}
+
+		expected, _ := math.CalculateHuberMean(values, math.DefaultHuberK).Float64()
+		estimate, _ := math.CalculateWeightedHuberMean(values, weights, math.DefaultHuberK).Float64()
+		require.InDelta(t, expected, estimate, 1e-6)
+	})
+
+	t.Run("large k takes the weighted mean", func(t *testing.T) {
+		values := []*big.Float{big.NewFloat(10), big.NewFloat(20), big.NewFloat(30)}
+		weights := []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(2)}
+
+		estimate, _ := math.CalculateWeightedHuberMean(values, weights, 1000).Float64()
+		require.InDelta(t, 22.5, estimate, 1e-3)
+	})
+
+	t.Run("values with a non-positive weight are ignored", func(t *testing.T) {
+		values := []*big.Float{big.NewFloat(99), big.NewFloat(101), big.NewFloat(1000)}
+		weights := []*big.Float{big.NewFloat(1), big.NewFloat(1), big.NewFloat(0)}
+
+		estimate, _ := math.CalculateWeightedHuberMean(values, weights, math.DefaultHuberK).Float64()
+		require.InDelta(t, 100, estimate, 1e-3)
+	})
+
+	t.Run("no positive weights", func(t *testing.T) {
+		values := []*big.Float{big.NewFloat(10), big.NewFloat(20)}
+		weights := []*big.Float{big.NewFloat(0), big.NewFloat(0)}
+		require.Nil(t, math.CalculateWeightedHuberMean(values, weights, math.DefaultHuberK))
+	})
+
+	t.Run("mismatched inputs", func(t *testing.T) {
+		require.Nil(t, math.CalculateWeightedHuberMean([]*big.Float{big.NewFloat(10)}, nil, math.DefaultHuberK))
+	})
+}
+
+func TestCalculateWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name: "mismatched values and weights",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+			},
+			expected: nil,
+		},
+		{
+			name: "no positive weights",
+			values: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(2),
+			},
+			weights: []*big.Float{
+				big.NewFloat(0),
+				big.NewFloat(-1),
+			},
+			expected: nil,
+		},
+		{
+			name: "equal weights with an even number of values matches the median",
+			values: []*big.Float{
+				big.NewFloat(-2),
+				big.NewFloat(0),
+				big.NewFloat(10),
+				big.NewFloat(100),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(1),
+			},
+			expected: big.NewFloat(5),
+		},
+		{
+			name: "equal weights with an odd number of values matches the median",
+			values: []*big.Float{
+				big.NewFloat(10),
+				big.NewFloat(-2),
+				big.NewFloat(100),
+			},
+			weights: []*big.Float{
+				big.NewFloat(2),
+				big.NewFloat(2),
+				big.NewFloat(2),
+			},
+			expected: big.NewFloat(10),
+		},
+		{
+			name: "heavily weighted value is selected",
+			values: []*big.Float{
+				big.NewFloat(10),
+				big.NewFloat(20),
+				big.NewFloat(30),
+			},
+			weights: []*big.Float{
+				big.NewFloat(0.1),
+				big.NewFloat(0.1),
+				big.NewFloat(0.8),
+			},
+			expected: big.NewFloat(30),
+		},
+		{
+			name: "values with a zero weight are ignored",
+			values: []*big.Float{
+				big.NewFloat(10),
+				big.NewFloat(20),
+				big.NewFloat(30),
+			},
+			weights: []*big.Float{
+				big.NewFloat(1),
+				big.NewFloat(1),
+				big.NewFloat(0),
+			},
+			expected: big.NewFloat(15),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := math.CalculateWeightedMedian(tc.values, tc.weights)
+			if tc.expected == nil {
+				require.Nil(t, result)
+				return
+			}
+
+			require.Equal(t, tc.expected.String(), result.String())
+		})
+	}
+
+	t.Run("lower and upper weighted medians select one of the middle values on a tie", func(t *testing.T) {
+		values := []*big.Float{
+			big.NewFloat(10),
+			big.NewFloat(20),
+			big.NewFloat(30),
+		}
+		weights := []*big.Float{
+			big.NewFloat(1),
+			big.NewFloat(1),
+			big.NewFloat(2),
+		}
+
+		require.Equal(t, "25", math.CalculateWeightedMedian(values, weights).String())
+		require.Equal(t, "20", math.CalculateWeightedLowerMedian(values, weights).String())
+		require.Equal(t, "30", math.CalculateWeightedUpperMedian(values, weights).String())
+	})
+}
+
+func TestCalculateVolumeWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*uint256.Int
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			values:   nil,
+			weights:  nil,
+			expected: nil,
+		},
+		{
+			name:     "mismatched values and weights",
+			values:   []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*uint256.Int{uint256.NewInt(1)},
+			expected: nil,
+		},
+		{
+			name:     "no positive weights",
+			values:   []*big.Float{big.NewFloat(1), big.NewFloat(2)},
+			weights:  []*uint256.Int{uint256.NewInt(0), nil},
+			expected: nil,
+		},
+		{
+			name:     "equal weights with an even number of values matches the median",
+			values:   []*big.Float{big.NewFloat(-2), big.NewFloat(0), big.NewFloat(10), big.NewFloat(100)},
+			weights:  []*uint256.Int{uint256.NewInt(7), uint256.NewInt(7), uint256.NewInt(7), uint256.NewInt(7)},
+			expected: big.NewFloat(5),
+		},
+		{
+			name:     "high volume value is selected",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20), big.NewFloat(30)},
+			weights:  []*uint256.Int{uint256.NewInt(1), uint256.NewInt(1), uint256.NewInt(1_000_000)},
+			expected: big.NewFloat(30),
+		},
+		{
+			name:     "values with a zero weight are ignored",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20), big.NewFloat(30)},
+			weights:  []*uint256.Int{uint256.NewInt(5), uint256.NewInt(5), uint256.NewInt(0)},
+			expected: big.NewFloat(15),
+		},
+		{
+			name:     "weights above the max volume weight are clamped",
+			values:   []*big.Float{big.NewFloat(10), big.NewFloat(20)},
+			weights:  []*uint256.Int{new(uint256.Int).Set(math.MaxVolumeWeight), new(uint256.Int).Lsh(math.MaxVolumeWeight, 8)},
+			expected: big.NewFloat(15),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := math.CalculateVolumeWeightedMedian(tc.values, tc.weights)
+			if tc.expected == nil {
+				require.Nil(t, result)
+				return
+			}
+
+			require.Equal(t, tc.expected.String(), result.String())
+		})
+	}
+
+	t.Run("lower and upper volume-weighted medians select one of the middle values on a tie", func(t *testing.T) {
+		values := []*big.Float{big.NewFloat(10), big.NewFloat(20), big.NewFloat(30)}
+		weights := []*uint256.Int{uint256.NewInt(1), uint256.NewInt(1), uint256.NewInt(2)}
+
+		require.Equal(t, "25", math.CalculateVolumeWeightedMedian(values, weights).String())
+		require.Equal(t, "20", math.CalculateVolumeWeightedLowerMedian(values, weights).String())
+		require.Equal(t, "30", math.CalculateVolumeWeightedUpperMedian(values, weights).String())
+	})
+}
+
+func TestVolumeWeight(t *testing.T) {
+	require.True(t, math.VolumeWeight(nil).IsZero())
+	require.True(t, math.VolumeWeight(big.NewFloat(-10)).IsZero())
+	require.Equal(t, uint256.NewInt(12), math.VolumeWeight(big.NewFloat(12.9)))
+	require.Equal(t, math.MaxVolumeWeight, math.VolumeWeight(big.NewFloat(1e300)))
+	require.Equal(t, math.MaxVolumeWeight, math.VolumeWeight(new(big.Float).SetInf(false)))
+}
+
 func TestSortBigInts(t *testing.T) {
 	testCases := []struct {
 		name     string
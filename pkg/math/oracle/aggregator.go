@@ -1,20 +1,37 @@
 package oracle
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/holiman/uint256"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
 	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/pkg/tracing"
+	pkgtypes "github.com/skip-mev/slinky/pkg/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
 
-var _ oracle.PriceAggregator = &IndexPriceAggregator{}
+var (
+	_ oracle.PriceAggregator     = &IndexPriceAggregator{}
+	_ oracle.AuditAggregator     = &IndexPriceAggregator{}
+	_ oracle.VolumeAggregator    = &IndexPriceAggregator{}
+	_ oracle.TimestampAggregator = &IndexPriceAggregator{}
+)
 
 // IndexPriceAggregator is an aggregator that calculates the median price for each ticker,
 // resolved from a predefined set of conversion markets. A conversion market is a set of
@@ -34,6 +51,157 @@ type IndexPriceAggregator struct {
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// priceInfo caches the metadata of each aggregated price i.e. its decimals and the
+	// providers that contributed to it.
+	priceInfo map[string]types.PriceInfo
+
+	// noDataGracePeriod is the amount of time a market may go without a price after it
+	// is added to the aggregator before it is reported as failing.
+	noDataGracePeriod time.Duration
+	// trackedSince is the time at which each market was first seen by the aggregator i.e.
+	// either on construction or on a market map update.
+	trackedSince map[string]time.Time
+	// warmingUp is the set of enabled markets that failed to resolve a price in the last
+	// aggregation but are still within the no data grace period.
+	warmingUp []string
+	// failing is the set of enabled markets that failed to resolve a price in the last
+	// aggregation and are past the no data grace period.
+	failing []string
+
+	// stablecoins is the set of stablecoin tickers whose index price must be within
+	// maxStablecoinDeviation of 1.0 for conversions through them to be utilized.
+	stablecoins map[string]struct{}
+	// maxStablecoinDeviation is the maximum relative deviation of a stablecoin's index
+	// price from 1.0. A nil value disables the check.
+	maxStablecoinDeviation *big.Float
+
+	// providerWeightFn returns the weight of each provider when calculating the median price.
+	// A nil value results in all providers being weighted equally.
+	providerWeightFn ProviderWeightFn
+	// medianVariant determines how the median of an even number of prices is calculated.
+	medianVariant types.MedianVariant
+	// providerPriority is the rank of each provider, from most to least trusted, used to break
+	// ties when attributing an aggregated price to a single provider.
+	providerPriority map[string]int
+
+	// lastGoodGracePeriod is the amount of time after a provider's price was last seen fresh
+	// during which its last good price continues to be utilized. A value of 0 disables the mode.
+	lastGoodGracePeriod time.Duration
+	// lastGoodWeight is the weight of a last good price relative to a fresh price.
+	lastGoodWeight float64
+	// lastGoodPrices cache the last fresh price seen for each provider. These are indexed by
+	// provider -> offChainTicker -> price.
+	lastGoodPrices map[string]map[string]lastGoodPrice
+
+	// fallbacks is the ordered chain of fallbacks used to price a market that does not meet its
+	// minimum provider count.
+	fallbacks []config.AggregationFallback
+	// lastKnownMaxAge is the maximum age of a last known price utilized by the last known fallback.
+	lastKnownMaxAge time.Duration
+	// lastKnownPrices cache the last successfully aggregated price of each market. These are only
+	// recorded if the last known fallback is configured.
+	lastKnownPrices map[string]lastKnownPrice
+
+	// providerLag is the configuration used to detect providers whose prices systematically lag
+	// the index price.
+	providerLag config.ProviderLagConfig
+	// lagTrackers records whether each provider lagged the recent moves of each market's index
+	// price. These are indexed by ticker -> provider.
+	lagTrackers map[string]map[string]*lagTracker
+
+	// providerCollapse is the configuration used to guard against a sudden collapse of the number
+	// of providers contributing to a market's price.
+	providerCollapse config.ProviderCollapseConfig
+	// collapseTrackers records the number of providers contributing to each market's price across
+	// aggregations. These are indexed by ticker.
+	collapseTrackers map[string]*collapseTracker
+
+	// decimalDisagreement is the configuration used to withhold the prices of markets whose
+	// providers report prices at different implied decimals.
+	decimalDisagreement config.DecimalDisagreementConfig
+
+	// minProviderCounts is the minimum number of distinct providers that must report a fresh price
+	// for each market that configures a minimum via the aggregator options. Markets below their
+	// minimum are suppressed rather than priced by the fallbacks.
+	minProviderCounts map[string]int
+
+	// providerSpread determines whether the spread between the highest and lowest converted
+	// provider prices of each market is computed.
+	providerSpread bool
+
+	// aggregationWorkers is the number of workers used to aggregate prices across markets. A
+	// value of 0 or 1 aggregates markets sequentially.
+	aggregationWorkers int
+
+	// defaultAggregationStrategy is the aggregation strategy used for markets that do not
+	// configure a strategy in their ticker metadata.
+	defaultAggregationStrategy AggregationStrategy
+	// aggregationStrategies is the resolved aggregation strategy for each market.
+	aggregationStrategies map[string]AggregationStrategy
+	// defaultHuberK is the tuning constant of the huber aggregation strategy for markets that do
+	// not configure one in their ticker metadata.
+	defaultHuberK float64
+	// huberKs is the resolved tuning constant of each market that uses the huber aggregation
+	// strategy.
+	huberKs map[string]float64
+	// defaultAggregationWindow is the window of the twap aggregation strategy for markets that do
+	// not configure one in their ticker metadata.
+	defaultAggregationWindow time.Duration
+	// providerTWAPs is the sampled converted price history of the providers of each market that
+	// uses the twap aggregation strategy.
+	providerTWAPs map[string]*providerTWAPBuffers
+	// providerWeights is the resolved provider weight overrides of each market that configures
+	// overrides in its ticker metadata.
+	providerWeights map[string]map[string]float64
+
+	// defaultMinSignificantDigits is the minimum number of significant digits of the scaled price
+	// of derived markets that do not configure a minimum in their ticker metadata. A value of 0
+	// disables the check.
+	defaultMinSignificantDigits int
+	// minSignificantDigits is the resolved minimum number of significant digits of each derived
+	// market that configures a minimum.
+	minSignificantDigits map[string]int
+	// lowPrecision is the set of markets whose scaled price currently has fewer significant
+	// digits than their minimum.
+	lowPrecision map[string]struct{}
+
+	// quoteCurrencies is the set of quote currencies that each provider supports, indexed by
+	// provider name.
+	quoteCurrencies map[string]map[string]struct{}
+	// routes is the set of conversion paths that are routed automatically for each market that
+	// enables auto routing, in addition to the market's provider configs.
+	routes map[string][]mmtypes.ProviderConfig
+
+	// audit indicates whether the aggregator records why each provider was included in or excluded
+	// from the price of each market.
+	audit bool
+	// providerExclusions cache the reasons that the oracle dropped the latest prices of each
+	// provider before they reached the aggregator. These are indexed by provider -> offChainTicker
+	// -> reason.
+	providerExclusions map[string]map[string]types.ExclusionReason
+	// audits is the audit of the latest aggregation of each market. These are only recorded if the
+	// audit mode is enabled.
+	audits map[string]types.AggregationAudit
+
+	// volumeWeighting indicates whether the median is weighted by the volume reported alongside
+	// each price.
+	volumeWeighting bool
+	// defaultVolumeWeight is the volume weight of prices whose provider did not report a volume.
+	defaultVolumeWeight *uint256.Int
+	// providerVolumes cache the volume reported alongside the latest prices of each provider. These
+	// are indexed by provider -> offChainTicker -> volume.
+	providerVolumes map[string]types.Prices
+
+	// providerStaleness is the maximum age of the prices of each provider that configures a
+	// staleness threshold, indexed by provider name.
+	providerStaleness map[string]time.Duration
+	// providerTimestamps cache the time at which the latest prices of each provider were produced.
+	// These are indexed by provider -> offChainTicker -> timestamp.
+	providerTimestamps map[string]map[string]time.Time
+
+	// twaps is the sampled index price history of each market that configures a TWAP in its
+	// ticker metadata.
+	twaps map[string]*twapBuffer
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -41,6 +209,7 @@ func NewIndexPriceAggregator(
 	logger *zap.Logger,
 	cfg mmtypes.MarketMap,
 	metrics oraclemetrics.Metrics,
+	opts ...Option,
 ) (*IndexPriceAggregator, error) {
 	if logger == nil {
 		return nil, fmt.Errorf("logger cannot be nil")
@@ -51,14 +220,54 @@ func NewIndexPriceAggregator(
 		metrics = oraclemetrics.NewNopMetrics()
 	}
 
-	return &IndexPriceAggregator{
+	m := &IndexPriceAggregator{
 		logger:         logger,
 		cfg:            cfg,
 		metrics:        metrics,
 		indexPrices:    make(types.Prices),
 		scaledPrices:   make(types.Prices),
 		providerPrices: make(map[string]types.Prices),
-	}, nil
+		priceInfo:      make(map[string]types.PriceInfo),
+		trackedSince:   make(map[string]time.Time),
+		lastGoodPrices: make(map[string]map[string]lastGoodPrice),
+
+		lastKnownPrices: make(map[string]lastKnownPrice),
+		twaps:           make(map[string]*twapBuffer),
+		providerVolumes: make(map[string]types.Prices),
+		lagTrackers:     make(map[string]map[string]*lagTracker),
+
+		providerExclusions: make(map[string]map[string]types.ExclusionReason),
+		audits:             make(map[string]types.AggregationAudit),
+		providerTimestamps: make(map[string]map[string]time.Time),
+
+		collapseTrackers: make(map[string]*collapseTracker),
+		lowPrecision:     make(map[string]struct{}),
+
+		defaultAggregationStrategy: MedianAggregation,
+		defaultHuberK:              math.DefaultHuberK,
+		defaultAggregationWindow:   DefaultAggregationWindow,
+		medianVariant:              types.MedianAverage,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.trackMarkets(time.Now().UTC())
+	m.resolveRoutes()
+	if err := m.resolveAggregationStrategies(); err != nil {
+		return nil, err
+	}
+
+	if err := m.resolveTWAPConfigs(); err != nil {
+		return nil, err
+	}
+
+	if err := m.resolveMinSignificantDigits(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
 }
 
 // AggregatePrices implements the aggregate function for the median price calculation. Specifically, this
@@ -70,65 +279,386 @@ func NewIndexPriceAggregator(
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
 // The index price cache contains the previously calculated median prices.
-func (m *IndexPriceAggregator) AggregatePrices() {
+func (m *IndexPriceAggregator) AggregatePrices(ctx context.Context) {
+	ctx, span := tracing.Tracer().Start(ctx, "IndexPriceAggregator.AggregatePrices")
+	defer span.End()
+
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	indexPrices := make(types.Prices)
-	scaledPrices := make(types.Prices)
+	now := time.Now().UTC()
+	m.updateLastGoodPrices(now)
 
-	for ticker, market := range m.cfg.Markets {
+	markets := make([]mmtypes.Market, 0, len(m.cfg.Markets))
+	for _, market := range m.cfg.Markets {
 		if !market.Ticker.Enabled {
 			m.logger.Debug("skipping disabled market", zap.Any("market", market))
 			continue
 		}
 
-		// Get the converted prices for set of convertible markets.
-		// ex. BTC/USDT * Index USDT/USD = BTC/USD
-		//     BTC/USDC * Index USDC/USD = BTC/USD
-		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
-		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
-
-		// We need to have at least the minimum number of providers to calculate the median.
-		if len(convertedPrices) < int(target.MinProviderCount) {
-			m.logger.Error(
-				"insufficient amount of converted prices",
-				zap.String("target_ticker", ticker),
-				zap.Int("num_converted_prices", len(convertedPrices)),
-				zap.Any("converted_prices", convertedPrices),
-				zap.Int("min_provider_count", int(target.MinProviderCount)),
-			)
+		market.ProviderConfigs = m.providerConfigs(market)
+		markets = append(markets, market)
+	}
 
+	indexPrices := make(types.Prices, len(markets))
+	scaledPrices := make(types.Prices, len(markets))
+	priceInfo := make(map[string]types.PriceInfo, len(markets))
+	missing := make([]string, 0)
+	results := m.aggregateMarkets(ctx, now, markets)
+	m.guardProviderCollapse(results)
+	m.updateAudits(now, results)
+	for _, result := range results {
+		if result.price == nil {
+			missing = append(missing, result.ticker)
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
-		indexPrices[target.String()] = new(big.Float).Copy(price)
+		indexPrices[result.ticker] = result.price
+		scaledPrices[result.ticker] = result.scaledPrice
+		priceInfo[result.ticker] = result.info
+	}
 
-		// Scale the price to the target ticker's decimals.
-		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
+	m.updateLastKnownPrices(now, results)
+	m.updateTWAPs(now, results, priceInfo)
+	m.updateSpreads(results, priceInfo)
+	m.updateProviderLag(results)
+	m.checkPrecision(results)
 
-		m.logger.Debug(
-			"calculated median price",
-			zap.String("target_ticker", ticker),
-
-			zap.String("unscaled_price", indexPrices[target.String()].String()),
-			zap.String("scaled_price", scaledPrices[target.String()].String()),
-			zap.Any("converted_prices", convertedPrices),
-		)
-		floatPrice, _ := price.Float64()
-		m.metrics.AddTickerTick(target.String())
-		m.metrics.UpdateAggregatePrice(target.String(), target.GetDecimals(), floatPrice)
-	}
+	span.SetAttributes(
+		attribute.Int("slinky.num_markets", len(markets)),
+		attribute.Int("slinky.num_prices", len(indexPrices)),
+		attribute.Int("slinky.num_missing", len(missing)),
+	)
 
 	// Update the aggregated data. These prices are going to be used as the index prices the
 	// next time we calculate prices.
 	m.logger.Debug("calculated median prices for price feeds", zap.Int("num_prices", len(indexPrices)))
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.priceInfo = priceInfo
+	m.classifyMissingPrices(missing, now)
+}
+
+// marketPrice is the result of aggregating the prices of a single market.
+type marketPrice struct {
+	// ticker is the ticker of the market.
+	ticker string
+	// price is the unscaled index price of the market. A nil value indicates that the market
+	// did not have enough converted prices.
+	price *big.Float
+	// scaledPrice is the price scaled to the decimals of the market.
+	scaledPrice *big.Float
+	// info is the metadata of the price.
+	info types.PriceInfo
+	// convertedPrices are the converted prices the price was aggregated from, in the order of
+	// the providers in info.
+	convertedPrices []*big.Float
+	// lastGood indicates whether each converted price is a last good price.
+	lastGood []bool
+	// fallback is the fallback used to resolve the price, if any.
+	fallback config.AggregationFallback
+	// reason is the reason the price of the market was withheld, if any.
+	reason string
+	// audit records whether each provider was included in the price, if the audit mode is enabled.
+	audit *marketAudit
+}
+
+// aggregateMarkets aggregates the prices of the given markets. Each market only depends on the
+// index prices of the previous aggregation, so if the aggregator is configured with more than one
+// worker, the markets are aggregated concurrently. The results are returned in the order of the
+// given markets.
+func (m *IndexPriceAggregator) aggregateMarkets(ctx context.Context, now time.Time, markets []mmtypes.Market) []marketPrice {
+	results := make([]marketPrice, len(markets))
+
+	workers := min(m.aggregationWorkers, len(markets))
+	if workers <= 1 {
+		for i, market := range markets {
+			results[i] = m.traceMarket(ctx, now, market)
+		}
+
+		return results
+	}
+
+	var wg sync.WaitGroup
+	indices := make(chan int)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = m.traceMarket(ctx, now, markets[i])
+			}
+		}()
+	}
+
+	for i := range markets {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results
+}
+
+// traceMarket aggregates the given market within a span that records the providers that
+// contributed to its price.
+func (m *IndexPriceAggregator) traceMarket(ctx context.Context, now time.Time, market mmtypes.Market) marketPrice {
+	_, span := tracing.Tracer().Start(
+		ctx,
+		"IndexPriceAggregator.aggregateMarket",
+		trace.WithAttributes(tracing.PairKey.String(market.Ticker.String())),
+	)
+	defer span.End()
+
+	result := m.aggregateMarket(now, market)
+	span.SetAttributes(tracing.ProvidersKey.StringSlice(result.info.Providers))
+	if len(result.fallback) > 0 {
+		span.SetAttributes(attribute.String("slinky.aggregation_fallback", string(result.fallback)))
+	}
+
+	switch {
+	case len(result.reason) > 0:
+		span.SetStatus(codes.Error, result.reason)
+	case result.price == nil:
+		span.SetStatus(codes.Error, "insufficient converted prices")
+	}
+
+	return result
+}
+
+// aggregateMarket calculates the index price of a single market from the converted prices of its
+// providers. This must be safe to call concurrently for different markets.
+func (m *IndexPriceAggregator) aggregateMarket(now time.Time, market mmtypes.Market) marketPrice {
+	// Get the converted prices for set of convertible markets.
+	// ex. BTC/USDT * Index USDT/USD = BTC/USD
+	//     BTC/USDC * Index USDC/USD = BTC/USD
+	target := market.Ticker
+	ticker := target.String()
+	audit := m.newMarketAudit()
+	convertedPrices, providers, lastGood, volumes, twapKeys := m.calculateConvertedPrices(market, audit)
+	m.metrics.AddProviderCountForMarket(ticker, len(convertedPrices))
+
+	// Prices reported at different decimals cannot be meaningfully aggregated, so the price of the
+	// market is withheld, and not resolved by the fallbacks either.
+	if m.checkDecimalDisagreement(ticker, convertedPrices, providers) {
+		audit.excludeIncluded(types.ExclusionDecimalDisagreement)
+		return marketPrice{ticker: ticker, reason: decimalDisagreementReason, audit: audit}
+	}
+
+	// Markets that require a minimum number of providers to agree in every aggregation are
+	// suppressed, and not resolved by the fallbacks either, if too few providers reported.
+	if m.checkMinProviderCount(ticker, providers, lastGood) {
+		return marketPrice{ticker: ticker, reason: insufficientProvidersReason, audit: audit}
+	}
+
+	// We need to have at least the minimum number of providers to calculate the median. Otherwise,
+	// the market is priced using the configured fallbacks, if any.
+	if len(convertedPrices) < int(target.MinProviderCount) {
+		m.logger.Error(
+			"insufficient amount of converted prices",
+			zap.String("target_ticker", ticker),
+			zap.Int("num_converted_prices", len(convertedPrices)),
+			zap.Any("converted_prices", convertedPrices),
+			zap.Int("min_provider_count", int(target.MinProviderCount)),
+		)
+
+		result := m.aggregateWithFallbacks(target, convertedPrices, providers, lastGood, volumes)
+		result.audit = audit
+		return result
+	}
+
+	// Aggregate the converted prices using the market's aggregation strategy. By default, this
+	// takes the median, which is the average of the middle two prices if the number of prices
+	// is even.
+	price := m.aggregate(now, ticker, convertedPrices, providers, lastGood, volumes, twapKeys)
+	result := m.newMarketPrice(target, price, convertedPrices, providers, lastGood)
+	result.audit = audit
+	return result
+}
+
+// newMarketPrice returns the result of aggregating the given price for the target ticker, and
+// records the price in the metrics.
+func (m *IndexPriceAggregator) newMarketPrice(
+	target mmtypes.Ticker,
+	price *big.Float,
+	convertedPrices []*big.Float,
+	providers []string,
+	lastGood []bool,
+) marketPrice {
+	ticker := target.String()
+	result := marketPrice{
+		ticker: ticker,
+		price:  new(big.Float).Copy(price),
+		// Scale the price to the target ticker's decimals.
+		scaledPrice: math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals),
+		info: types.PriceInfo{
+			Decimals:  target.Decimals,
+			Providers: providers,
+			Source:    m.attributeSource(price, convertedPrices, providers, lastGood),
+		},
+		convertedPrices: convertedPrices,
+		lastGood:        lastGood,
+	}
+
+	m.logger.Debug(
+		"calculated median price",
+		zap.String("target_ticker", ticker),
+		zap.String("unscaled_price", result.price.String()),
+		zap.String("scaled_price", result.scaledPrice.String()),
+		zap.Any("converted_prices", convertedPrices),
+		zap.String("source", result.info.Source),
+	)
+	floatPrice, _ := price.Float64()
+	m.metrics.AddTickerTick(ticker)
+	m.metrics.UpdateAggregatePrice(ticker, target.GetDecimals(), floatPrice)
+
+	return result
+}
+
+// classifyMissingPrices splits the markets that failed to resolve a price into those that
+// are still warming up (i.e. were added within the no data grace period) and those that
+// are failing.
+func (m *IndexPriceAggregator) classifyMissingPrices(missing []string, now time.Time) {
+	warmingUp := make([]string, 0)
+	failing := make([]string, 0)
+
+	for _, ticker := range missing {
+		since, ok := m.trackedSince[ticker]
+		if ok && now.Sub(since) < m.noDataGracePeriod {
+			warmingUp = append(warmingUp, ticker)
+			continue
+		}
+
+		failing = append(failing, ticker)
+	}
+
+	sort.Strings(warmingUp)
+	sort.Strings(failing)
+
+	m.warmingUp = warmingUp
+	m.failing = failing
+}
+
+// trackMarkets records the time at which each market in the market map was first seen by
+// the aggregator. Markets that are no longer in the market map are no longer tracked.
+func (m *IndexPriceAggregator) trackMarkets(now time.Time) {
+	trackedSince := make(map[string]time.Time, len(m.cfg.Markets))
+	for _, market := range m.cfg.Markets {
+		ticker := market.Ticker.String()
+		if since, ok := m.trackedSince[ticker]; ok {
+			trackedSince[ticker] = since
+			continue
+		}
+
+		trackedSince[ticker] = now
+	}
+
+	m.trackedSince = trackedSince
+}
+
+// resolveAggregationStrategies resolves the aggregation strategy and provider weight overrides of
+// each market in the market map, as well as the tuning constant of each market that uses the huber
+// strategy and the window of each market that uses the twap strategy. Markets that configure an
+// unknown strategy, an invalid constant or an invalid window fall back to the default, markets that
+// configure invalid weights are not weighted, and an error is returned.
+func (m *IndexPriceAggregator) resolveAggregationStrategies() error {
+	strategies := make(map[string]AggregationStrategy, len(m.cfg.Markets))
+	huberKs := make(map[string]float64)
+	providerTWAPs := make(map[string]*providerTWAPBuffers)
+	providerWeights := make(map[string]map[string]float64)
+
+	var errs []error
+	for _, market := range m.cfg.Markets {
+		ticker := market.Ticker.String()
+		strategy, err := ParseAggregationStrategy(market.Ticker.Metadata_JSON, m.defaultAggregationStrategy)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid aggregation strategy for %s: %w", ticker, err))
+			strategy = m.defaultAggregationStrategy
+		}
+
+		strategies[ticker] = strategy
+		if strategy == TWAPAggregation {
+			window, err := ParseAggregationWindow(market.Ticker.Metadata_JSON, m.defaultAggregationWindow)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid aggregation window for %s: %w", ticker, err))
+				window = m.defaultAggregationWindow
+			}
+
+			// The sampled history of the market's providers is retained if its window is unchanged.
+			if buffers, ok := m.providerTWAPs[ticker]; ok && buffers.window == window {
+				providerTWAPs[ticker] = buffers
+			} else {
+				providerTWAPs[ticker] = newProviderTWAPBuffers(window)
+			}
+		}
+
+		weights, err := ParseProviderWeights(market.Ticker.Metadata_JSON)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid provider weights for %s: %w", ticker, err))
+		} else if len(weights) > 0 {
+			providerWeights[ticker] = weights
+		}
+
+		if strategy != HuberAggregation {
+			continue
+		}
+
+		k, err := ParseHuberK(market.Ticker.Metadata_JSON, m.defaultHuberK)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid huber k for %s: %w", ticker, err))
+			k = m.defaultHuberK
+		}
+
+		huberKs[ticker] = k
+	}
+
+	m.aggregationStrategies = strategies
+	m.huberKs = huberKs
+	m.providerTWAPs = providerTWAPs
+	m.providerWeights = providerWeights
+	return errors.Join(errs...)
+}
+
+// calculateMedian calculates the median of the converted prices using the configured median variant.
+// If volume weighting is enabled, each price is weighted by its volume weight. Otherwise, if a
+// provider weight function or provider weight overrides for the market are configured, each price
+// is weighted by the weight of the provider that supplied it, and last good prices are additionally
+// weighted by the configured last good weight. If none of the prices have a positive weight, all
+// prices are weighted equally.
+func (m *IndexPriceAggregator) calculateMedian(
+	ticker string,
+	prices []*big.Float,
+	providers []string,
+	lastGood []bool,
+	volumes []*uint256.Int,
+) *big.Float {
+	if m.volumeWeighting {
+		if median := m.medianVariant.CalculateVolumeWeightedMedian(prices, volumes); median != nil {
+			return median
+		}
+
+		m.logNoPositiveWeights(ticker, providers)
+		return m.medianVariant.CalculateMedian(slices.Clone(prices))
+	}
+
+	weighted := m.providerWeightFn != nil || len(m.providerWeights[ticker]) > 0
+	for _, isLastGood := range lastGood {
+		weighted = weighted || isLastGood
+	}
+
+	// The median is calculated over a copy of the prices, since it sorts the prices in place and
+	// the prices must remain aligned with their providers.
+	if !weighted {
+		return m.medianVariant.CalculateMedian(slices.Clone(prices))
+	}
+
+	weights := m.priceWeights(ticker, providers, lastGood)
+	if median := m.medianVariant.CalculateWeightedMedian(prices, weights); median != nil {
+		return median
+	}
+
+	m.logNoPositiveWeights(ticker, providers)
+	return m.medianVariant.CalculateMedian(slices.Clone(prices))
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -137,6 +667,19 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
 ) []*big.Float {
+	convertedPrices, _, _, _, _ := m.calculateConvertedPrices(market, nil)
+	return convertedPrices
+}
+
+// calculateConvertedPrices calculates the converted prices for a given market, along with the name
+// of the provider that supplied each converted price, whether each price is a last good price, the
+// volume weight of each price if volume weighting is enabled and the key of the sampled history of
+// each price if the market uses the twap aggregation strategy. Whether each provider config's price
+// was included is recorded in the given audit, if any.
+func (m *IndexPriceAggregator) calculateConvertedPrices(
+	market mmtypes.Market,
+	audit *marketAudit,
+) ([]*big.Float, []string, []bool, []*uint256.Int, []string) {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -144,13 +687,20 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			zap.String("target_ticker", market.Ticker.String()),
 		)
 
-		return nil
+		return nil, nil, nil, nil, nil
 	}
 
+	var (
+		volumes  []*uint256.Int
+		twapKeys []string
+	)
+	sampled := m.aggregationStrategies[market.Ticker.String()] == TWAPAggregation
 	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	providers := make([]string, 0, len(market.ProviderConfigs))
+	lastGood := make([]bool, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
 		// Calculate the converted price.
-		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
+		adjustedPrice, isLastGood, err := m.calculateAdjustedPrice(cfg)
 		if err != nil {
 			m.logger.Debug(
 				"failed to calculate converted price",
@@ -160,23 +710,43 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			)
 
 			m.metrics.AddProviderTick(cfg.Name, market.Ticker.String(), false)
+			m.auditExclusion(audit, cfg, err)
+			continue
+		}
+
+		// A weight override of 0 excludes the provider from the market, regardless of the strategy,
+		// so its price does not count towards the market's minimum provider count either.
+		if weight, ok := m.providerWeights[market.Ticker.String()][cfg.Name]; ok && weight == 0 {
+			audit.exclude(cfg, types.ExclusionZeroWeight, "")
 			continue
 		}
+		audit.include(cfg, isLastGood)
 
 		convertedPrices = append(convertedPrices, adjustedPrice)
+		providers = append(providers, cfg.Name)
+		lastGood = append(lastGood, isLastGood)
+		if m.volumeWeighting {
+			volumes = append(volumes, m.volumeWeight(market.Ticker.String(), cfg, isLastGood))
+		}
+		if sampled {
+			twapKeys = append(twapKeys, providerTWAPKey(cfg))
+		}
+
 		m.logger.Debug(
 			"calculated converted price",
 			zap.String("target_ticker", market.Ticker.String()),
 			zap.String("price", adjustedPrice.String()),
 			zap.Any("provider", cfg.Name),
+			zap.Bool("last_good", isLastGood),
 		)
 
-		m.metrics.AddProviderTick(cfg.Name, market.Ticker.String(), true)
+		// Last good prices are not fresh, so they are not recorded as a successful provider tick.
+		m.metrics.AddProviderTick(cfg.Name, market.Ticker.String(), !isLastGood)
 		floatPrice, _ := adjustedPrice.Float64()
 		m.metrics.UpdatePrice(cfg.Name, market.Ticker.String(), market.Ticker.GetDecimals(), floatPrice)
 	}
 
-	return convertedPrices
+	return convertedPrices, providers, lastGood, volumes, twapKeys
 }
 
 // CalculateAdjustedPrice calculates an adjusted price for a given set of operations (if applicable).
@@ -197,6 +767,41 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 		return nil, err
 	}
 
+	return m.adjustPrice(cfg, price)
+}
+
+// calculateAdjustedPrice calculates the adjusted price for the given provider config. If the
+// provider does not have a fresh price, its last good price is used instead (if any), in which
+// case true is returned.
+func (m *IndexPriceAggregator) calculateAdjustedPrice(
+	cfg mmtypes.ProviderConfig,
+) (*big.Float, bool, error) {
+	price, err := m.GetProviderPrice(cfg)
+	if err == nil {
+		// Stale prices are excluded entirely, rather than replaced by the last good price.
+		if err := m.checkStaleness(cfg, time.Now().UTC()); err != nil {
+			return nil, false, err
+		}
+
+		adjusted, err := m.adjustPrice(cfg, price)
+		return adjusted, false, err
+	}
+
+	lastGood, ok := m.getLastGoodPrice(cfg)
+	if !ok {
+		return nil, false, err
+	}
+
+	adjusted, err := m.adjustPrice(cfg, lastGood)
+	return adjusted, true, err
+}
+
+// adjustPrice adjusts the given provider price by the index price of the provider config's
+// normalize by pair (if applicable).
+func (m *IndexPriceAggregator) adjustPrice(
+	cfg mmtypes.ProviderConfig,
+	price *big.Float,
+) (*big.Float, error) {
 	if cfg.NormalizeByPair == nil {
 		return price, nil
 	}
@@ -206,6 +811,39 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 		return nil, err
 	}
 
+	if err := m.checkStablecoinPeg(*cfg.NormalizeByPair, normalizeByIndexPrice); err != nil {
+		return nil, err
+	}
+
 	// Make sure that the price is adjusted by the market price.
 	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
 }
+
+// checkStablecoinPeg returns an error if the given currency pair is a configured stablecoin and its
+// index price has deviated from 1.0 by more than the configured max deviation.
+func (m *IndexPriceAggregator) checkStablecoinPeg(cp pkgtypes.CurrencyPair, price *big.Float) error {
+	if m.maxStablecoinDeviation == nil {
+		return nil
+	}
+
+	if _, ok := m.stablecoins[cp.String()]; !ok {
+		return nil
+	}
+
+	one := big.NewFloat(1)
+	deviation := new(big.Float).Sub(price, one)
+	deviation.Abs(deviation)
+	if deviation.Cmp(m.maxStablecoinDeviation) <= 0 {
+		return nil
+	}
+
+	m.logger.Warn(
+		"stablecoin depegged; halting conversion",
+		zap.String("stablecoin", cp.String()),
+		zap.String("index_price", price.String()),
+		zap.String("max_deviation", m.maxStablecoinDeviation.String()),
+	)
+	m.metrics.AddStablecoinDepeg(cp.String())
+
+	return fmt.Errorf("stablecoin %s is depegged with index price %s", cp, price)
+}
@@ -1,14 +1,22 @@
 package oracle_test
 
 import (
+	"context"
+	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/constants"
 	"github.com/skip-mev/slinky/oracle/metrics"
+	metricmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
 	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
 	"github.com/skip-mev/slinky/pkg/math/oracle"
 	pkgtypes "github.com/skip-mev/slinky/pkg/types"
 	"github.com/skip-mev/slinky/providers/apis/binance"
@@ -145,7 +153,7 @@ func TestAggregateData(t *testing.T) {
 			tc.malleate(m)
 
 			// Aggregate the data.
-			m.AggregatePrices()
+			m.AggregatePrices(context.Background())
 
 			// Ensure that the aggregated data is as expected.
 			result := m.GetIndexPrices()
@@ -159,6 +167,79 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestGetMissingPrices(t *testing.T) {
+	allMarkets := []string{
+		BTC_USD.String(),
+		ETH_USD.String(),
+		PEPE_USD.String(),
+		USDT_USD.String(),
+	}
+
+	t.Run("no aggregation returns no missing prices", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		warmingUp, failing := m.GetMissingPrices()
+		require.Empty(t, warmingUp)
+		require.Empty(t, failing)
+	})
+
+	t.Run("no grace period reports all missing prices as failing", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.AggregatePrices(context.Background())
+
+		warmingUp, failing := m.GetMissingPrices()
+		require.Empty(t, warmingUp)
+		require.Equal(t, allMarkets, failing)
+	})
+
+	t.Run("grace period reports all missing prices as warming up", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketmap,
+			metrics.NewNopMetrics(),
+			oracle.WithNoDataGracePeriod(time.Hour),
+		)
+		require.NoError(t, err)
+
+		m.AggregatePrices(context.Background())
+
+		warmingUp, failing := m.GetMissingPrices()
+		require.Equal(t, allMarkets, warmingUp)
+		require.Empty(t, failing)
+	})
+
+	t.Run("grace period elapsed reports missing prices as failing", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketmap,
+			metrics.NewNopMetrics(),
+			oracle.WithNoDataGracePeriod(10*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+		m.AggregatePrices(context.Background())
+
+		warmingUp, failing := m.GetMissingPrices()
+		require.Empty(t, warmingUp)
+		require.Equal(t, allMarkets, failing)
+	})
+
+	t.Run("negative grace period panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithNoDataGracePeriod(-time.Second),
+			)
+		})
+	})
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -634,3 +715,2223 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestStablecoinDepeg(t *testing.T) {
+	depegCfg := config.StablecoinDepegConfig{
+		MaxDeviation: 0.02,
+		Stablecoins:  []string{constants.USDT_USD.String()},
+	}
+	cfg := mmtypes.ProviderConfig{
+		Name:           binance.Name,
+		OffChainTicker: "BTCUSDT",
+		NormalizeByPair: &pkgtypes.CurrencyPair{
+			Base:  "USDT",
+			Quote: "USD",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		indexPrice    *big.Float
+		expectedPrice *big.Float
+		expectedErr   bool
+	}{
+		{
+			name:          "stablecoin within the band",
+			indexPrice:    big.NewFloat(1.01),
+			expectedPrice: big.NewFloat(70_700),
+			expectedErr:   false,
+		},
+		{
+			name:          "stablecoin above the band",
+			indexPrice:    big.NewFloat(1.05),
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
+		{
+			name:          "stablecoin below the band",
+			indexPrice:    big.NewFloat(0.9),
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithStablecoinDepegConfig(depegCfg),
+			)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(70_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): tc.indexPrice,
+			})
+
+			price, err := m.CalculateAdjustedPrice(cfg)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.SetPrec(36), price.SetPrec(36))
+		})
+	}
+
+	t.Run("depegged bridges are ignored when the check is disabled", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(70_000),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(0.5),
+		})
+
+		price, err := m.CalculateAdjustedPrice(cfg)
+		require.NoError(t, err)
+		require.Equal(t, big.NewFloat(35_000).SetPrec(36), price.SetPrec(36))
+	})
+}
+
+func TestProviderWeightFn(t *testing.T) {
+	testCases := []struct {
+		name          string
+		opts          []oracle.Option
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "equal weighting by default",
+			opts:          nil,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name: "heavily weighted provider is selected",
+			opts: []oracle.Option{
+				oracle.WithProviderWeightFn(func(provider string) float64 {
+					if provider == binance.Name {
+						return 0.9
+					}
+					return 0.05
+				}),
+			},
+			expectedPrice: big.NewFloat(69_000),
+		},
+		{
+			name: "falls back to equal weighting if no provider has a positive weight",
+			opts: []oracle.Option{
+				oracle.WithProviderWeightFn(func(string) float64 {
+					return 0
+				}),
+			},
+			expectedPrice: big.NewFloat(70_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), tc.opts...)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(70_000),
+				"BTC-USDT": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(69_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("nil weight function panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithProviderWeightFn(nil))
+		})
+	})
+}
+
+func TestMedianVariant(t *testing.T) {
+	// Only require two providers for BTC/USD so that an even number of prices is aggregated.
+	markets := make(map[string]mmtypes.Market, len(marketmap.Markets))
+	for ticker, market := range marketmap.Markets {
+		markets[ticker] = market
+	}
+	btcusd := markets[BTC_USD.String()]
+	btcusd.Ticker.MinProviderCount = 2
+	markets[BTC_USD.String()] = btcusd
+	mm := mmtypes.MarketMap{Markets: markets}
+
+	testCases := []struct {
+		name          string
+		opts          []oracle.Option
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "averages the middle prices by default",
+			opts:          nil,
+			expectedPrice: big.NewFloat(69_500),
+		},
+		{
+			name:          "lower median selects the lower middle price",
+			opts:          []oracle.Option{oracle.WithMedianVariant(types.MedianLower)},
+			expectedPrice: big.NewFloat(69_000),
+		},
+		{
+			name:          "upper median selects the upper middle price",
+			opts:          []oracle.Option{oracle.WithMedianVariant(types.MedianUpper)},
+			expectedPrice: big.NewFloat(70_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, mm, metrics.NewNopMetrics(), tc.opts...)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(69_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("unknown median variant panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithMedianVariant("mode"))
+		})
+	})
+}
+
+func TestLastGood(t *testing.T) {
+	// Only require two providers for BTC/USD so that a single stale provider drops the market.
+	markets := make(map[string]mmtypes.Market, len(marketmap.Markets))
+	for ticker, market := range marketmap.Markets {
+		markets[ticker] = market
+	}
+	btcusd := markets[BTC_USD.String()]
+	btcusd.Ticker.MinProviderCount = 2
+	markets[BTC_USD.String()] = btcusd
+	mm := mmtypes.MarketMap{Markets: markets}
+
+	testCases := []struct {
+		name          string
+		cfg           config.LastGoodConfig
+		wait          time.Duration
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "stale provider is dropped by default",
+			cfg:           config.LastGoodConfig{},
+			expectedPrice: nil,
+		},
+		{
+			name: "stale provider contributes its last good price with a reduced weight",
+			cfg: config.LastGoodConfig{
+				GracePeriod: time.Minute,
+				Weight:      0.5,
+			},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name: "stale provider contributes its last good price with an equal weight",
+			cfg: config.LastGoodConfig{
+				GracePeriod: time.Minute,
+				Weight:      1,
+			},
+			expectedPrice: big.NewFloat(69_500),
+		},
+		{
+			name: "stale provider is dropped after the grace period",
+			cfg: config.LastGoodConfig{
+				GracePeriod: time.Millisecond,
+				Weight:      0.5,
+			},
+			wait:          10 * time.Millisecond,
+			expectedPrice: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				mm,
+				metrics.NewNopMetrics(),
+				oracle.WithLastGoodConfig(tc.cfg),
+			)
+			require.NoError(t, err)
+
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			// Both providers are fresh.
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(69_000),
+			})
+			m.AggregatePrices(context.Background())
+			require.Contains(t, m.GetIndexPrices(), BTC_USD.String())
+
+			time.Sleep(tc.wait)
+
+			// Binance goes stale.
+			m.Reset()
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(70_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			if tc.expectedPrice == nil {
+				require.NotContains(t, prices, BTC_USD.String())
+				return
+			}
+
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("reset provider does not contribute its last good price", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			mm,
+			metrics.NewNopMetrics(),
+			oracle.WithLastGoodConfig(config.LastGoodConfig{GracePeriod: time.Minute, Weight: 1}),
+		)
+		require.NoError(t, err)
+
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD": big.NewFloat(70_000),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(69_000),
+		})
+		m.AggregatePrices(context.Background())
+		require.Contains(t, m.GetIndexPrices(), BTC_USD.String())
+
+		// Binance is reset, so neither its cached price nor its last good price is utilized.
+		m.ResetProvider(binance.Name)
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+		m.AggregatePrices(context.Background())
+		require.NotContains(t, m.GetIndexPrices(), BTC_USD.String())
+	})
+
+	t.Run("invalid last good config panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithLastGoodConfig(config.LastGoodConfig{GracePeriod: time.Minute}),
+			)
+		})
+	})
+}
+
+func TestAggregationFallback(t *testing.T) {
+	// Only require two providers for BTC/USD so that a single stale provider drops the market.
+	markets := make(map[string]mmtypes.Market, len(marketmap.Markets))
+	for ticker, market := range marketmap.Markets {
+		markets[ticker] = market
+	}
+	btcusd := markets[BTC_USD.String()]
+	btcusd.Ticker.MinProviderCount = 2
+	markets[BTC_USD.String()] = btcusd
+	mm := mmtypes.MarketMap{Markets: markets}
+
+	testCases := []struct {
+		name          string
+		cfg           config.AggregationFallbackConfig
+		wait          time.Duration
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "market is dropped without fallbacks",
+			cfg:           config.AggregationFallbackConfig{},
+			expectedPrice: nil,
+		},
+		{
+			name: "median fallback prices the market from the available prices",
+			cfg: config.AggregationFallbackConfig{
+				Fallbacks: []config.AggregationFallback{config.AggregationFallbackMedian},
+			},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name: "last known fallback prices the market from the last aggregated price",
+			cfg: config.AggregationFallbackConfig{
+				Fallbacks:       []config.AggregationFallback{config.AggregationFallbackLastKnown},
+				LastKnownMaxAge: time.Minute,
+			},
+			expectedPrice: big.NewFloat(69_500),
+		},
+		{
+			name: "last known fallback is not utilized after the max age",
+			cfg: config.AggregationFallbackConfig{
+				Fallbacks:       []config.AggregationFallback{config.AggregationFallbackLastKnown},
+				LastKnownMaxAge: time.Millisecond,
+			},
+			wait:          10 * time.Millisecond,
+			expectedPrice: nil,
+		},
+		{
+			name: "fallbacks are evaluated in order",
+			cfg: config.AggregationFallbackConfig{
+				Fallbacks:       []config.AggregationFallback{config.AggregationFallbackLastKnown, config.AggregationFallbackMedian},
+				LastKnownMaxAge: time.Minute,
+			},
+			expectedPrice: big.NewFloat(69_500),
+		},
+		{
+			name: "next fallback is evaluated if a fallback fails",
+			cfg: config.AggregationFallbackConfig{
+				Fallbacks:       []config.AggregationFallback{config.AggregationFallbackLastKnown, config.AggregationFallbackMedian},
+				LastKnownMaxAge: time.Millisecond,
+			},
+			wait:          10 * time.Millisecond,
+			expectedPrice: big.NewFloat(70_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				mm,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationFallbackConfig(tc.cfg),
+			)
+			require.NoError(t, err)
+
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			// Both providers are fresh.
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(69_000),
+			})
+			m.AggregatePrices(context.Background())
+			require.Contains(t, m.GetIndexPrices(), BTC_USD.String())
+
+			time.Sleep(tc.wait)
+
+			// Binance goes stale, so the market no longer meets its minimum provider count.
+			m.Reset()
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD": big.NewFloat(70_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			if tc.expectedPrice == nil {
+				require.NotContains(t, prices, BTC_USD.String())
+				return
+			}
+
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("invalid aggregation fallback config panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationFallbackConfig(config.AggregationFallbackConfig{
+					Fallbacks: []config.AggregationFallback{config.AggregationFallbackLastKnown},
+				}),
+			)
+		})
+	})
+}
+
+func TestAggregationStrategy(t *testing.T) {
+	testCases := []struct {
+		name          string
+		metadata      string
+		opts          []oracle.Option
+		expectedPrice *big.Float
+		expectErr     bool
+	}{
+		{
+			name:          "median by default",
+			metadata:      "",
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "median if the metadata does not configure a strategy",
+			metadata:      `{"foo":"bar"}`,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "mean configured for the market",
+			metadata:      `{"aggregation":"mean"}`,
+			expectedPrice: new(big.Float).Quo(big.NewFloat(211_000), big.NewFloat(3)),
+		},
+		{
+			name:          "first configured for the market",
+			metadata:      `{"aggregation":"first"}`,
+			expectedPrice: big.NewFloat(72_000),
+		},
+		{
+			name:          "huber configured for the market",
+			metadata:      `{"aggregation":"huber"}`,
+			expectedPrice: math.CalculateHuberMean([]*big.Float{big.NewFloat(72_000), big.NewFloat(70_000), big.NewFloat(69_000)}, math.DefaultHuberK),
+		},
+		{
+			name:          "huber with a large k configured for the market takes the mean",
+			metadata:      `{"aggregation":"huber","huberK":100}`,
+			expectedPrice: new(big.Float).Quo(big.NewFloat(211_000), big.NewFloat(3)),
+		},
+		{
+			name:          "huber with the default k takes the mean if k is large",
+			metadata:      `{"aggregation":"huber"}`,
+			opts:          []oracle.Option{oracle.WithHuberK(100)},
+			expectedPrice: new(big.Float).Quo(big.NewFloat(211_000), big.NewFloat(3)),
+		},
+		{
+			name:      "non-positive huber k is rejected",
+			metadata:  `{"aggregation":"huber","huberK":0}`,
+			expectErr: true,
+		},
+		{
+			name:          "twap configured for the market takes the median of the first samples",
+			metadata:      `{"aggregation":"twap"}`,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:      "non-positive aggregation window is rejected",
+			metadata:  `{"aggregation":"twap","aggregationWindow":0}`,
+			expectErr: true,
+		},
+		{
+			name:          "default strategy is used if the market does not configure one",
+			metadata:      "",
+			opts:          []oracle.Option{oracle.WithDefaultAggregationStrategy(oracle.FirstAggregation)},
+			expectedPrice: big.NewFloat(72_000),
+		},
+		{
+			name:          "market strategy overrides the default strategy",
+			metadata:      `{"aggregation":"median"}`,
+			opts:          []oracle.Option{oracle.WithDefaultAggregationStrategy(oracle.FirstAggregation)},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:      "unknown strategy is rejected",
+			metadata:  `{"aggregation":"last"}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, btcWithMetadata(tc.metadata), metrics.NewNopMetrics(), tc.opts...)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(72_000),
+				"BTC-USDT": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(69_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("unknown strategy in a market map update falls back to the default", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.UpdateMarketMap(btcWithMetadata(`{"aggregation":"last"}`))
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(72_000),
+			"BTC-USDT": big.NewFloat(70_000),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(69_000),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+
+		m.AggregatePrices(context.Background())
+
+		prices := m.GetIndexPrices()
+		require.Equal(t, big.NewFloat(70_000).SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+	})
+
+	t.Run("unknown default strategy panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithDefaultAggregationStrategy("last"))
+		})
+	})
+
+	t.Run("non-positive default huber k panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithHuberK(-1))
+		})
+	})
+
+	t.Run("non-positive default aggregation window panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithAggregationWindow(0))
+		})
+	})
+}
+
+func TestAggregationAudit(t *testing.T) {
+	t.Run("nothing is recorded if the audit mode is disabled", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.AggregatePrices(context.Background())
+		require.Empty(t, m.GetAggregationAudit())
+	})
+
+	t.Run("records why each provider was included or excluded", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithAggregationAudit(true))
+		require.NoError(t, err)
+
+		// the index price of USDT/USD is missing, so the BTC-USDT price cannot be converted, and
+		// the oracle dropped binance's BTCUSDT price as stale.
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(70_000),
+			"BTC-USDT": big.NewFloat(70_000),
+		})
+		m.SetProviderExclusions(binance.Name, map[string]types.ExclusionReason{"BTCUSDT": types.ExclusionStale})
+		m.AggregatePrices(context.Background())
+
+		audit, ok := m.GetAggregationAudit()[BTC_USD.String()]
+		require.True(t, ok)
+		require.False(t, audit.Timestamp.IsZero())
+		require.Equal(t, "insufficient converted prices", audit.WithheldReason)
+		require.Len(t, audit.Providers, 3)
+
+		require.Equal(t, types.ProviderAudit{Provider: coinbase.Name, OffChainTicker: "BTC-USD", Included: true}, audit.Providers[0])
+		require.Equal(t, types.ExclusionConversionFailed, audit.Providers[1].Reason)
+		require.NotEmpty(t, audit.Providers[1].Detail)
+		require.Equal(t, types.ProviderAudit{Provider: binance.Name, OffChainTicker: "BTCUSDT", Reason: types.ExclusionStale}, audit.Providers[2])
+	})
+
+	t.Run("providers without a price or with a zero weight are excluded", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			btcWithMetadata(`{"providerWeights":{"binance_api":0}}`),
+			metrics.NewNopMetrics(),
+			oracle.WithAggregationAudit(true),
+		)
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{"BTC-USD": big.NewFloat(70_000)})
+		m.SetProviderPrices(binance.Name, types.Prices{"BTCUSDT": big.NewFloat(70_000)})
+		m.SetIndexPrices(types.Prices{constants.USDT_USD.String(): big.NewFloat(1)})
+		m.AggregatePrices(context.Background())
+
+		audit := m.GetAggregationAudit()[BTC_USD.String()]
+		require.Len(t, audit.Providers, 3)
+		require.True(t, audit.Providers[0].Included)
+		require.Equal(t, types.ExclusionNoPrice, audit.Providers[1].Reason)
+		require.Equal(t, types.ExclusionZeroWeight, audit.Providers[2].Reason)
+	})
+}
+
+func TestVolumeWeighting(t *testing.T) {
+	testCases := []struct {
+		name          string
+		metadata      string
+		opts          []oracle.Option
+		volumes       map[string]types.Prices
+		expectedPrice *big.Float
+	}{
+		{
+			name: "volumes are ignored if volume weighting is disabled",
+			volumes: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(10), "BTC-USDT": big.NewFloat(5)},
+				binance.Name:  {"BTCUSDT": big.NewFloat(1e9)},
+			},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name: "the index tracks the venue with the highest volume",
+			opts: []oracle.Option{oracle.WithVolumeWeighting(1)},
+			volumes: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(10), "BTC-USDT": big.NewFloat(5)},
+				binance.Name:  {"BTCUSDT": big.NewFloat(1e9)},
+			},
+			expectedPrice: big.NewFloat(60_000),
+		},
+		{
+			name: "prices without a reported volume have the default weight",
+			opts: []oracle.Option{oracle.WithVolumeWeighting(1_000_000)},
+			volumes: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(10), "BTC-USDT": big.NewFloat(5)},
+			},
+			expectedPrice: big.NewFloat(60_000),
+		},
+		{
+			name: "prices without a reported volume are excluded with a default weight of 0",
+			opts: []oracle.Option{oracle.WithVolumeWeighting(0)},
+			volumes: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(10), "BTC-USDT": big.NewFloat(5)},
+			},
+			expectedPrice: big.NewFloat(72_000),
+		},
+		{
+			name:     "volumes are scaled by the provider weight overrides",
+			metadata: `{"providerWeights":{"binance_api":0.5}}`,
+			opts:     []oracle.Option{oracle.WithVolumeWeighting(1)},
+			volumes: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(10), "BTC-USDT": big.NewFloat(5)},
+				binance.Name:  {"BTCUSDT": big.NewFloat(20)},
+			},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:     "a provider with a zero weight override is excluded regardless of its volume",
+			metadata: `{"providerWeights":{"binance_api":0}}`,
+			opts:     []oracle.Option{oracle.WithVolumeWeighting(1)},
+			volumes: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(10), "BTC-USDT": big.NewFloat(5)},
+				binance.Name:  {"BTCUSDT": big.NewFloat(1e9)},
+			},
+			expectedPrice: big.NewFloat(72_000),
+		},
+		{
+			name:          "all prices are weighted equally if none has a positive weight",
+			opts:          []oracle.Option{oracle.WithVolumeWeighting(0)},
+			expectedPrice: big.NewFloat(70_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// a single provider is required, so that excluding providers does not withhold the price
+			marketMap := btcWithMetadata(tc.metadata)
+			market := marketMap.Markets[BTC_USD.String()]
+			market.Ticker.MinProviderCount = 1
+			marketMap.Markets[BTC_USD.String()] = market
+
+			m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics(), tc.opts...)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(72_000),
+				"BTC-USDT": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(60_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+			for provider, volumes := range tc.volumes {
+				m.SetProviderVolumes(provider, volumes)
+			}
+
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+}
+
+func TestProviderStaleness(t *testing.T) {
+	// setPrices sets the provider prices of BTC/USD, each produced the given age ago.
+	setPrices := func(m *oracle.IndexPriceAggregator, ages map[string]time.Duration) {
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(72_000),
+			"BTC-USDT": big.NewFloat(70_000),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(60_000),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+
+		now := time.Now().UTC()
+		m.SetProviderTimestamps(coinbase.Name, map[string]time.Time{
+			"BTC-USD":  now.Add(-ages[coinbase.Name]),
+			"BTC-USDT": now.Add(-ages[coinbase.Name]),
+		})
+		m.SetProviderTimestamps(binance.Name, map[string]time.Time{
+			"BTCUSDT": now.Add(-ages[binance.Name]),
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		staleness     map[string]time.Duration
+		ages          map[string]time.Duration
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "prices of any age are included without a staleness threshold",
+			ages:          map[string]time.Duration{coinbase.Name: time.Minute, binance.Name: time.Minute},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "prices within their provider's threshold are included",
+			staleness:     map[string]time.Duration{coinbase.Name: 5 * time.Minute, binance.Name: 10 * time.Second},
+			ages:          map[string]time.Duration{coinbase.Name: time.Minute, binance.Name: time.Second},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "the market is not priced if too few fresh prices are left",
+			staleness:     map[string]time.Duration{coinbase.Name: 5 * time.Minute, binance.Name: 10 * time.Second},
+			ages:          map[string]time.Duration{coinbase.Name: time.Minute, binance.Name: time.Minute},
+			expectedPrice: nil,
+		},
+		{
+			name:          "providers without a threshold are not filtered",
+			staleness:     map[string]time.Duration{binance.Name: 10 * time.Second},
+			ages:          map[string]time.Duration{coinbase.Name: time.Hour, binance.Name: time.Second},
+			expectedPrice: big.NewFloat(70_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithProviderStaleness(tc.staleness),
+			)
+			require.NoError(t, err)
+
+			setPrices(m, tc.ages)
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			if tc.expectedPrice == nil {
+				require.NotContains(t, prices, BTC_USD.String())
+				return
+			}
+
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("stale prices are not replaced by the last good price", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketmap,
+			metrics.NewNopMetrics(),
+			oracle.WithProviderStaleness(map[string]time.Duration{binance.Name: 10 * time.Second}),
+			oracle.WithLastGoodConfig(config.LastGoodConfig{GracePeriod: time.Hour, Weight: 1}),
+		)
+		require.NoError(t, err)
+
+		setPrices(m, map[string]time.Duration{binance.Name: time.Second})
+		m.AggregatePrices(context.Background())
+		require.Contains(t, m.GetIndexPrices(), BTC_USD.String())
+
+		setPrices(m, map[string]time.Duration{binance.Name: time.Minute})
+		m.AggregatePrices(context.Background())
+		require.NotContains(t, m.GetIndexPrices(), BTC_USD.String())
+	})
+
+	t.Run("stale prices are audited as stale", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			marketmap,
+			metrics.NewNopMetrics(),
+			oracle.WithProviderStaleness(map[string]time.Duration{binance.Name: 10 * time.Second}),
+			oracle.WithAggregationAudit(true),
+		)
+		require.NoError(t, err)
+
+		setPrices(m, map[string]time.Duration{binance.Name: time.Minute})
+		m.AggregatePrices(context.Background())
+
+		audit := m.GetAggregationAudit()[BTC_USD.String()]
+		require.Len(t, audit.Providers, 3)
+		for _, provider := range audit.Providers {
+			if provider.Provider == binance.Name {
+				require.False(t, provider.Included)
+				require.Equal(t, types.ExclusionStale, provider.Reason)
+			}
+		}
+	})
+
+	t.Run("non-positive staleness threshold panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithProviderStaleness(map[string]time.Duration{binance.Name: 0}),
+			)
+		})
+	})
+}
+
+func TestTWAPAggregation(t *testing.T) {
+	// setPrices sets the provider prices such that every converted BTC/USD price is the given price.
+	setPrices := func(m *oracle.IndexPriceAggregator, price float64) {
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(price),
+			"BTC-USDT": big.NewFloat(price),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(price),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+	}
+
+	t.Run("the converted prices are weighted by the time each sample was held", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			btcWithMetadata(`{"aggregation":"twap","aggregationWindow":1000000000}`),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+
+		setPrices(m, 70_000)
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, big.NewFloat(70_000).Cmp(m.GetIndexPrices()[BTC_USD.String()]))
+
+		time.Sleep(10 * time.Millisecond)
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+
+		// the first prices were held for the entire time between the aggregations, and the second
+		// prices have not been held yet
+		require.Equal(t, 0, big.NewFloat(70_000).Cmp(m.GetIndexPrices()[BTC_USD.String()]))
+
+		time.Sleep(10 * time.Millisecond)
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+
+		price := m.GetIndexPrices()[BTC_USD.String()]
+		require.Equal(t, 1, price.Cmp(big.NewFloat(70_000)))
+		require.Equal(t, -1, price.Cmp(big.NewFloat(80_000)))
+	})
+
+	t.Run("samples are reset when the aggregation window changes", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			btcWithMetadata(`{"aggregation":"twap","aggregationWindow":3600000000000}`),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+
+		setPrices(m, 70_000)
+		m.AggregatePrices(context.Background())
+
+		// the window is unchanged, so the new prices have not been held yet
+		m.UpdateMarketMap(btcWithMetadata(`{"aggregation":"twap","aggregationWindow":3600000000000}`))
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, big.NewFloat(70_000).Cmp(m.GetIndexPrices()[BTC_USD.String()]))
+
+		m.UpdateMarketMap(btcWithMetadata(`{"aggregation":"twap","aggregationWindow":1800000000000}`))
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, big.NewFloat(80_000).Cmp(m.GetIndexPrices()[BTC_USD.String()]))
+	})
+
+	t.Run("the history of each conversion path is retained when another path drops out", func(t *testing.T) {
+		// a single provider is required, so that the dropped path does not withhold the price
+		marketMap := btcWithMetadata(`{"aggregation":"twap","aggregationWindow":3600000000000}`)
+		market := marketMap.Markets[BTC_USD.String()]
+		market.Ticker.MinProviderCount = 1
+		marketMap.Markets[BTC_USD.String()] = market
+
+		m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(70_000),
+			"BTC-USDT": big.NewFloat(80_000),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(90_000),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, big.NewFloat(80_000).Cmp(m.GetIndexPrices()[BTC_USD.String()]))
+
+		// the BTC-USD path drops out, so the BTC-USDT path must keep averaging its own history
+		// rather than the history of the BTC-USD path
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USDT": big.NewFloat(80_000),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, big.NewFloat(85_000).Cmp(m.GetIndexPrices()[BTC_USD.String()]))
+	})
+}
+
+func TestProviderWeightOverrides(t *testing.T) {
+	testCases := []struct {
+		name          string
+		metadata      string
+		opts          []oracle.Option
+		expectedPrice *big.Float
+		expectErr     bool
+	}{
+		{
+			name:          "median without overrides",
+			metadata:      `{}`,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "median excludes a provider with a zero weight",
+			metadata:      `{"providerWeights":{"binance_api":0}}`,
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name:          "median selects a heavily weighted provider",
+			metadata:      `{"providerWeights":{"binance_api":10}}`,
+			expectedPrice: big.NewFloat(69_000),
+		},
+		{
+			name:          "overrides of providers that do not supply the market are ignored",
+			metadata:      `{"providerWeights":{"kraken_api":10}}`,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "mean is weighted",
+			metadata:      `{"aggregation":"mean","providerWeights":{"binance_api":2}}`,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "mean excludes a provider with a zero weight",
+			metadata:      `{"aggregation":"mean","providerWeights":{"coinbase_api":0}}`,
+			expectedPrice: big.NewFloat(69_000),
+		},
+		{
+			name:     "price is withheld if every provider has a zero weight",
+			metadata: `{"aggregation":"mean","providerWeights":{"coinbase_api":0,"binance_api":0}}`,
+		},
+		{
+			name:          "huber excludes a provider with a zero weight",
+			metadata:      `{"aggregation":"huber","providerWeights":{"binance_api":0}}`,
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name:          "first skips a provider with a zero weight",
+			metadata:      `{"aggregation":"first","providerWeights":{"coinbase_api":0}}`,
+			expectedPrice: big.NewFloat(69_000),
+		},
+		{
+			name:     "overrides are applied on top of the provider weight function",
+			metadata: `{"providerWeights":{"binance_api":0}}`,
+			opts: []oracle.Option{
+				oracle.WithProviderWeightFn(func(provider string) float64 {
+					if provider == binance.Name {
+						return 0.9
+					}
+					return 0.05
+				}),
+			},
+			expectedPrice: big.NewFloat(71_000),
+		},
+		{
+			name:      "negative weight is rejected",
+			metadata:  `{"providerWeights":{"binance_api":-1}}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// a single provider is required, so that excluding providers does not withhold the price
+			marketMap := btcWithMetadata(tc.metadata)
+			market := marketMap.Markets[BTC_USD.String()]
+			market.Ticker.MinProviderCount = 1
+			marketMap.Markets[BTC_USD.String()] = market
+
+			m, err := oracle.NewIndexPriceAggregator(logger, marketMap, metrics.NewNopMetrics(), tc.opts...)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(72_000),
+				"BTC-USDT": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(69_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			if tc.expectedPrice == nil {
+				require.NotContains(t, prices, BTC_USD.String())
+				return
+			}
+
+			require.Contains(t, prices, BTC_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[BTC_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("providers with a zero weight do not count towards the minimum provider count", func(t *testing.T) {
+		// BTC/USD requires 3 providers, and only 2 of its 3 providers have a non-zero weight
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			btcWithMetadata(`{"providerWeights":{"binance_api":0}}`),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(72_000),
+			"BTC-USDT": big.NewFloat(70_000),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(69_000),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+
+		m.AggregatePrices(context.Background())
+		require.NotContains(t, m.GetIndexPrices(), BTC_USD.String())
+	})
+}
+
+func TestMinSignificantDigits(t *testing.T) {
+	testCases := []struct {
+		name           string
+		metadata       string
+		opts           []oracle.Option
+		price          float64
+		expectedDigits int
+		expectErr      bool
+	}{
+		{
+			name:           "minimum configured for the market",
+			metadata:       `{"minSignificantDigits":14}`,
+			price:          70_000,
+			expectedDigits: 13,
+		},
+		{
+			name:           "default minimum is used if the market does not configure one",
+			opts:           []oracle.Option{oracle.WithMinSignificantDigits(5)},
+			price:          70_000,
+			expectedDigits: 13,
+		},
+		{
+			name:           "low value price derived through a conversion",
+			metadata:       `{"minSignificantDigits":5}`,
+			price:          0.0000012,
+			expectedDigits: 3,
+		},
+		{
+			name:     "market can disable the default minimum",
+			metadata: `{"minSignificantDigits":0}`,
+			opts:     []oracle.Option{oracle.WithMinSignificantDigits(5)},
+			price:    70_000,
+		},
+		{
+			name:  "check is disabled by default",
+			price: 70_000,
+		},
+		{
+			name:      "negative minimum is rejected",
+			metadata:  `{"minSignificantDigits":-1}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMetrics := metricmocks.NewMetrics(t)
+			mockMetrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddTickerTick", mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			if tc.expectedDigits > 0 {
+				mockMetrics.On("UpdatePriceSignificantDigits", BTC_USD.String(), tc.expectedDigits).Return().Once()
+			}
+
+			m, err := oracle.NewIndexPriceAggregator(logger, btcWithMetadata(tc.metadata), mockMetrics, tc.opts...)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(tc.price),
+				"BTC-USDT": big.NewFloat(tc.price),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(tc.price),
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+			require.Contains(t, m.GetPrices(), BTC_USD.String())
+		})
+	}
+
+	t.Run("negative default minimum panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithMinSignificantDigits(-1))
+		})
+	})
+}
+
+func TestProviderPriority(t *testing.T) {
+	testCases := []struct {
+		name           string
+		metadata       string
+		opts           []oracle.Option
+		binancePrice   *big.Float
+		expectedSource string
+	}{
+		{
+			name:           "median is attributed to the provider that reported it",
+			binancePrice:   big.NewFloat(71_000),
+			expectedSource: binance.Name,
+		},
+		{
+			name:           "tie is broken by provider name by default",
+			binancePrice:   big.NewFloat(70_000),
+			expectedSource: binance.Name,
+		},
+		{
+			name:           "tie is broken by the provider priority",
+			opts:           []oracle.Option{oracle.WithProviderPriority([]string{coinbase.Name, binance.Name})},
+			binancePrice:   big.NewFloat(70_000),
+			expectedSource: coinbase.Name,
+		},
+		{
+			name:           "listed providers are preferred over unlisted providers",
+			opts:           []oracle.Option{oracle.WithProviderPriority([]string{"kraken_api", coinbase.Name})},
+			binancePrice:   big.NewFloat(70_000),
+			expectedSource: coinbase.Name,
+		},
+		{
+			name:           "first is attributed to the highest priority provider that reported it",
+			metadata:       `{"aggregation":"first"}`,
+			opts:           []oracle.Option{oracle.WithProviderPriority([]string{binance.Name})},
+			binancePrice:   big.NewFloat(72_000),
+			expectedSource: binance.Name,
+		},
+		{
+			name:           "mean is not attributed",
+			metadata:       `{"aggregation":"mean"}`,
+			opts:           []oracle.Option{oracle.WithProviderPriority([]string{coinbase.Name})},
+			binancePrice:   big.NewFloat(70_000),
+			expectedSource: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, btcWithMetadata(tc.metadata), metrics.NewNopMetrics(), tc.opts...)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(72_000),
+				"BTC-USDT": big.NewFloat(70_000),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": tc.binancePrice,
+			})
+			m.SetIndexPrices(types.Prices{
+				constants.USDT_USD.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+
+			info := m.GetPriceInfo()
+			require.Contains(t, info, BTC_USD.String())
+			require.Equal(t, tc.expectedSource, info[BTC_USD.String()].Source)
+		})
+	}
+
+	t.Run("duplicate providers in the priority are rejected", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(
+				logger,
+				marketmap,
+				metrics.NewNopMetrics(),
+				oracle.WithProviderPriority([]string{coinbase.Name, coinbase.Name}),
+			)
+		})
+	})
+}
+
+func TestProviderLag(t *testing.T) {
+	lagCfg := config.ProviderLagConfig{
+		Window:    3,
+		Threshold: 0.8,
+	}
+
+	newMetrics := func(t *testing.T) *metricmocks.Metrics {
+		t.Helper()
+
+		m := metricmocks.NewMetrics(t)
+		m.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return().Maybe()
+		m.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		m.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		m.On("AddTickerTick", mock.Anything).Return().Maybe()
+		m.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		return m
+	}
+
+	// aggregate sets coinbase's prices to the given index price, and binance's price to the
+	// given binance price, and aggregates the prices.
+	aggregate := func(m *oracle.IndexPriceAggregator, index, binancePrice float64) {
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(index),
+			"BTC-USDT": big.NewFloat(index),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(binancePrice),
+		})
+
+		indexPrices := m.GetIndexPrices()
+		indexPrices[constants.USDT_USD.String()] = big.NewFloat(1)
+		m.SetIndexPrices(indexPrices)
+
+		m.AggregatePrices(context.Background())
+	}
+
+	t.Run("provider that reports the previous index price is lagging", func(t *testing.T) {
+		mockMetrics := newMetrics(t)
+		mockMetrics.On("UpdateProviderLag", binance.Name, BTC_USD.String(), 1.0).Return().Times(3)
+		mockMetrics.On("UpdateProviderLag", coinbase.Name, BTC_USD.String(), 0.0).Return().Times(3)
+
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, mockMetrics, oracle.WithProviderLagConfig(lagCfg))
+		require.NoError(t, err)
+
+		// The first aggregation has no previous index price to compare against, and the next
+		// window - 1 moves do not fill the window.
+		prices := []float64{70_000, 70_100, 70_200, 70_300, 70_400, 70_500}
+		for i, price := range prices {
+			previous := price
+			if i > 0 {
+				previous = prices[i-1]
+			}
+
+			aggregate(m, price, previous)
+		}
+	})
+
+	t.Run("provider that tracks the index price is not lagging", func(t *testing.T) {
+		mockMetrics := newMetrics(t)
+		mockMetrics.On("UpdateProviderLag", binance.Name, BTC_USD.String(), 0.0).Return().Once()
+		mockMetrics.On("UpdateProviderLag", coinbase.Name, BTC_USD.String(), 0.0).Return().Once()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, mockMetrics, oracle.WithProviderLagConfig(lagCfg))
+		require.NoError(t, err)
+
+		for _, price := range []float64{70_000, 70_100, 70_200, 70_300} {
+			aggregate(m, price, price+10)
+		}
+	})
+
+	t.Run("unchanged index price is not evaluated", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, newMetrics(t), oracle.WithProviderLagConfig(lagCfg))
+		require.NoError(t, err)
+
+		for range 5 {
+			aggregate(m, 70_000, 69_000)
+		}
+	})
+
+	t.Run("detection is disabled by default", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, marketmap, newMetrics(t))
+		require.NoError(t, err)
+
+		for _, price := range []float64{70_000, 70_100, 70_200, 70_300, 70_400} {
+			aggregate(m, price, price-100)
+		}
+	})
+}
+
+func TestTWAP(t *testing.T) {
+	// setPrices sets the provider prices such that the median BTC/USD price is the given price.
+	setPrices := func(m *oracle.IndexPriceAggregator, price float64) {
+		m.SetProviderPrices(coinbase.Name, types.Prices{
+			"BTC-USD":  big.NewFloat(price),
+			"BTC-USDT": big.NewFloat(price),
+		})
+		m.SetProviderPrices(binance.Name, types.Prices{
+			"BTCUSDT": big.NewFloat(price),
+		})
+		m.SetIndexPrices(types.Prices{
+			constants.USDT_USD.String(): big.NewFloat(1),
+		})
+	}
+
+	// scaled returns the given BTC/USD price scaled by its decimals.
+	scaled := func(price float64) *big.Float {
+		return new(big.Float).Mul(big.NewFloat(price), big.NewFloat(1e8))
+	}
+
+	t.Run("no twap is maintained if the market does not configure one", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, btcWithMetadata(`{"aggregation":"median"}`), metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m, 70_000)
+		m.AggregatePrices(context.Background())
+
+		info := m.GetPriceInfo()
+		require.Contains(t, info, BTC_USD.String())
+		require.Nil(t, info[BTC_USD.String()].TWAP)
+	})
+
+	t.Run("invalid twap configs are rejected", func(t *testing.T) {
+		for _, metadata := range []string{
+			`{"twap":{"window":0,"sampleInterval":1000000000}}`,
+			`{"twap":{"window":60000000000,"sampleInterval":0}}`,
+			`{"twap":{"window":60000000000,"sampleInterval":120000000000}}`,
+			`{"twap":{"window":3600000000000,"sampleInterval":1000000}}`,
+		} {
+			_, err := oracle.NewIndexPriceAggregator(logger, btcWithMetadata(metadata), metrics.NewNopMetrics())
+			require.Error(t, err, metadata)
+		}
+	})
+
+	t.Run("the first sample is the twap", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			btcWithMetadata(`{"twap":{"window":3600000000000,"sampleInterval":3600000000000}}`),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+
+		setPrices(m, 70_000)
+		m.AggregatePrices(context.Background())
+
+		twap := m.GetPriceInfo()[BTC_USD.String()].TWAP
+		require.NotNil(t, twap)
+		require.Equal(t, 0, scaled(70_000).Cmp(twap))
+
+		// the sample interval has not elapsed, so the new price is not sampled
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+
+		require.Equal(t, 0, scaled(80_000).Cmp(m.GetPrices()[BTC_USD.String()]))
+		require.Equal(t, 0, scaled(70_000).Cmp(m.GetPriceInfo()[BTC_USD.String()].TWAP))
+	})
+
+	t.Run("the twap is weighted by the time each sample was held", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			btcWithMetadata(`{"twap":{"window":1000000000,"sampleInterval":1000000}}`),
+			metrics.NewNopMetrics(),
+		)
+		require.NoError(t, err)
+
+		setPrices(m, 70_000)
+		m.AggregatePrices(context.Background())
+
+		time.Sleep(10 * time.Millisecond)
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+
+		// the first price was held for the entire time between the aggregations, and the second
+		// price has not been held yet
+		require.Equal(t, 0, scaled(70_000).Cmp(m.GetPriceInfo()[BTC_USD.String()].TWAP))
+
+		time.Sleep(10 * time.Millisecond)
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+
+		twap := m.GetPriceInfo()[BTC_USD.String()].TWAP
+		require.Equal(t, 1, twap.Cmp(scaled(70_000)))
+		require.Equal(t, -1, twap.Cmp(scaled(80_000)))
+	})
+
+	t.Run("samples are retained across market map updates that do not change the config", func(t *testing.T) {
+		metadata := `{"twap":{"window":3600000000000,"sampleInterval":3600000000000}}`
+		m, err := oracle.NewIndexPriceAggregator(logger, btcWithMetadata(metadata), metrics.NewNopMetrics())
+		require.NoError(t, err)
+
+		setPrices(m, 70_000)
+		m.AggregatePrices(context.Background())
+
+		m.UpdateMarketMap(btcWithMetadata(metadata))
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, scaled(70_000).Cmp(m.GetPriceInfo()[BTC_USD.String()].TWAP))
+
+		// changing the config resets the samples
+		m.UpdateMarketMap(btcWithMetadata(`{"twap":{"window":1800000000000,"sampleInterval":1800000000000}}`))
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+		require.Equal(t, 0, scaled(80_000).Cmp(m.GetPriceInfo()[BTC_USD.String()].TWAP))
+
+		// an invalid config disables the twap
+		m.UpdateMarketMap(btcWithMetadata(`{"twap":{"window":0}}`))
+		setPrices(m, 80_000)
+		m.AggregatePrices(context.Background())
+		require.Nil(t, m.GetPriceInfo()[BTC_USD.String()].TWAP)
+	})
+}
+
+// largeMarketMap returns a market map with the given number of markets, each with three providers,
+// one of which is normalized by USDT/USD, along with the provider prices for every market.
+func largeMarketMap(numMarkets int) (mmtypes.MarketMap, types.Prices, types.Prices) {
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			USDT_USD.String(): {
+				Ticker: USDT_USD,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "USDT-USD"},
+					{Name: binance.Name, OffChainTicker: "USDTUSD"},
+				},
+			},
+		},
+	}
+	coinbasePrices := types.Prices{"USDT-USD": big.NewFloat(1.01)}
+	binancePrices := types.Prices{"USDTUSD": big.NewFloat(0.99)}
+
+	for i := 0; i < numMarkets; i++ {
+		base := fmt.Sprintf("TOKEN%d", i)
+		ticker := mmtypes.Ticker{
+			CurrencyPair:     pkgtypes.NewCurrencyPair(base, "USD"),
+			Decimals:         8,
+			MinProviderCount: 2,
+			Enabled:          true,
+		}
+
+		mm.Markets[ticker.String()] = mmtypes.Market{
+			Ticker: ticker,
+			ProviderConfigs: []mmtypes.ProviderConfig{
+				{Name: coinbase.Name, OffChainTicker: base + "-USD"},
+				{Name: coinbase.Name, OffChainTicker: base + "-USDT", NormalizeByPair: &USDT_USD.CurrencyPair},
+				{Name: binance.Name, OffChainTicker: base + "USD"},
+			},
+		}
+
+		coinbasePrices[base+"-USD"] = big.NewFloat(float64(i + 1))
+		coinbasePrices[base+"-USDT"] = big.NewFloat(float64(i) + 1.5)
+		binancePrices[base+"USD"] = big.NewFloat(float64(i) + 1.25)
+	}
+
+	return mm, coinbasePrices, binancePrices
+}
+
+func TestAggregationWorkers(t *testing.T) {
+	mm, coinbasePrices, binancePrices := largeMarketMap(100)
+
+	// aggregate runs two aggregations, so that the normalized prices resolve against the USDT/USD
+	// index price, and returns the resulting prices.
+	aggregate := func(opts ...oracle.Option) types.Prices {
+		m, err := oracle.NewIndexPriceAggregator(zap.NewNop(), mm, metrics.NewNopMetrics(), opts...)
+		require.NoError(t, err)
+
+		m.SetProviderPrices(coinbase.Name, coinbasePrices)
+		m.SetProviderPrices(binance.Name, binancePrices)
+		m.AggregatePrices(context.Background())
+		m.AggregatePrices(context.Background())
+
+		return m.GetPrices()
+	}
+
+	sequential := aggregate()
+	require.Len(t, sequential, len(mm.Markets))
+
+	for _, workers := range []int{1, 4, 200} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			parallel := aggregate(oracle.WithAggregationWorkers(workers))
+			require.Len(t, parallel, len(sequential))
+			for ticker, price := range sequential {
+				require.Equal(t, price.String(), parallel[ticker].String(), ticker)
+			}
+		})
+	}
+
+	t.Run("negative workers panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics(), oracle.WithAggregationWorkers(-1))
+		})
+	})
+}
+
+func TestProviderCollapse(t *testing.T) {
+	// BTC/USD is priced directly by three providers, any one of which is enough to price it.
+	btcusd := BTC_USD
+	btcusd.MinProviderCount = 1
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: btcusd,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "BTCUSD"},
+					{Name: kucoin.Name, OffChainTicker: "BTC-USD"},
+				},
+			},
+		},
+	}
+
+	collapseCfg := config.ProviderCollapseConfig{
+		Enabled:             true,
+		MaxDrop:             1,
+		StabilizationCycles: 2,
+		Action:              config.ProviderCollapseActionWithhold,
+	}
+
+	newMetrics := func(t *testing.T) *metricmocks.Metrics {
+		t.Helper()
+
+		m := metricmocks.NewMetrics(t)
+		m.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return().Maybe()
+		m.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		m.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		m.On("AddTickerTick", mock.Anything).Return().Maybe()
+		m.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		return m
+	}
+
+	// aggregate sets the price of the given providers, clears the prices of all other providers,
+	// aggregates the prices and returns the price of BTC/USD.
+	aggregate := func(m *oracle.IndexPriceAggregator, providers ...string) *big.Float {
+		prices := map[string]types.Prices{
+			coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+			binance.Name:  {"BTCUSD": big.NewFloat(70_000)},
+			kucoin.Name:   {"BTC-USD": big.NewFloat(70_000)},
+		}
+		for provider := range prices {
+			m.SetProviderPrices(provider, nil)
+		}
+		for _, provider := range providers {
+			m.SetProviderPrices(provider, prices[provider])
+		}
+
+		m.AggregatePrices(context.Background())
+		return m.GetPrices()[btcusd.String()]
+	}
+
+	t.Run("collapsed market is withheld until it stabilizes", func(t *testing.T) {
+		mockMetrics := newMetrics(t)
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), true).Return().Once()
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), false).Return().Once()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, mockMetrics, oracle.WithProviderCollapseConfig(collapseCfg))
+		require.NoError(t, err)
+
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name, kucoin.Name))
+
+		// Losing two providers at once collapses the market.
+		require.Nil(t, aggregate(m, coinbase.Name))
+		_, failing := m.GetMissingPrices()
+		require.Equal(t, []string{btcusd.String()}, failing)
+
+		// The market is released once it does not drop further for the stabilization cycles.
+		require.Nil(t, aggregate(m, coinbase.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name))
+	})
+
+	t.Run("further drop restarts the stabilization", func(t *testing.T) {
+		mockMetrics := newMetrics(t)
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), true).Return().Once()
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), false).Return().Once()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, mockMetrics, oracle.WithProviderCollapseConfig(collapseCfg))
+		require.NoError(t, err)
+
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name, kucoin.Name))
+		require.Nil(t, aggregate(m, coinbase.Name))
+		require.Nil(t, aggregate(m))
+		require.Nil(t, aggregate(m, coinbase.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name))
+	})
+
+	t.Run("collapsed market is released once its providers recover", func(t *testing.T) {
+		mockMetrics := newMetrics(t)
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), true).Return().Once()
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), false).Return().Once()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, mockMetrics, oracle.WithProviderCollapseConfig(collapseCfg))
+		require.NoError(t, err)
+
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name, kucoin.Name))
+		require.Nil(t, aggregate(m, coinbase.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name))
+	})
+
+	t.Run("gradual drop does not collapse the market", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, newMetrics(t), oracle.WithProviderCollapseConfig(collapseCfg))
+		require.NoError(t, err)
+
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name, kucoin.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name))
+	})
+
+	t.Run("flagged market continues to be priced", func(t *testing.T) {
+		mockMetrics := newMetrics(t)
+		mockMetrics.On("UpdateProviderCollapse", btcusd.String(), true).Return().Once()
+
+		flagCfg := collapseCfg
+		flagCfg.Action = config.ProviderCollapseActionFlag
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, mockMetrics, oracle.WithProviderCollapseConfig(flagCfg))
+		require.NoError(t, err)
+
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name, kucoin.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name))
+	})
+
+	t.Run("guard is disabled by default", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(logger, mm, newMetrics(t))
+		require.NoError(t, err)
+
+		require.NotNil(t, aggregate(m, coinbase.Name, binance.Name, kucoin.Name))
+		require.NotNil(t, aggregate(m, coinbase.Name))
+	})
+
+	t.Run("invalid config panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(logger, mm, newMetrics(t), oracle.WithProviderCollapseConfig(config.ProviderCollapseConfig{
+				Enabled: true,
+			}))
+		})
+	})
+}
+
+func BenchmarkAggregatePrices(b *testing.B) {
+	mm, coinbasePrices, binancePrices := largeMarketMap(1000)
+
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			m, err := oracle.NewIndexPriceAggregator(
+				zap.NewNop(),
+				mm,
+				metrics.NewNopMetrics(),
+				oracle.WithAggregationWorkers(workers),
+			)
+			require.NoError(b, err)
+
+			m.SetProviderPrices(coinbase.Name, coinbasePrices)
+			m.SetProviderPrices(binance.Name, binancePrices)
+			m.AggregatePrices(context.Background())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				m.AggregatePrices(context.Background())
+			}
+		})
+	}
+}
+
+func TestAutoRouting(t *testing.T) {
+	usdtusd := pkgtypes.NewCurrencyPair("USDT", "USD")
+	routedMarketMap := func(metadata string) mmtypes.MarketMap {
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				constants.BITCOIN_USD.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     constants.BITCOIN_USD,
+						Decimals:         8,
+						MinProviderCount: 1,
+						Enabled:          true,
+						Metadata_JSON:    metadata,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					},
+				},
+				constants.BITCOIN_USDT.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     constants.BITCOIN_USDT,
+						Decimals:         8,
+						MinProviderCount: 1,
+						Enabled:          true,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: binance.Name, OffChainTicker: "BTCUSDT"},
+						{Name: kucoin.Name, OffChainTicker: "BTC-USDT"},
+						// Conversions are not routed any further.
+						{Name: coinbase.Name, OffChainTicker: "BTC-USD", NormalizeByPair: &usdtusd},
+					},
+				},
+				usdtusd.String(): {
+					Ticker: mmtypes.Ticker{
+						CurrencyPair:     usdtusd,
+						Decimals:         6,
+						MinProviderCount: 1,
+						Enabled:          true,
+					},
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: coinbase.Name, OffChainTicker: "USDT-USD"},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		metadata      string
+		quotes        map[string][]string
+		expectedPrice *big.Float
+	}{
+		{
+			name:          "markets are not routed by default",
+			metadata:      "",
+			quotes:        map[string][]string{binance.Name: {"USDT"}},
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "markets are not routed through providers without quote currencies",
+			metadata:      `{"autoRoute":true}`,
+			quotes:        nil,
+			expectedPrice: big.NewFloat(70_000),
+		},
+		{
+			name:          "markets are routed through the supported quote currencies",
+			metadata:      `{"autoRoute":true}`,
+			quotes:        map[string][]string{binance.Name: {"usdt"}},
+			expectedPrice: big.NewFloat(69_000),
+		},
+		{
+			name:     "markets are routed through every provider that supports the quote currency",
+			metadata: `{"autoRoute":true}`,
+			quotes: map[string][]string{
+				binance.Name:  {"USDT"},
+				kucoin.Name:   {"USDT"},
+				coinbase.Name: {"USD", "USDT"},
+			},
+			expectedPrice: big.NewFloat(68_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				routedMarketMap(tc.metadata),
+				metrics.NewNopMetrics(),
+				oracle.WithProviderQuoteCurrencies(tc.quotes),
+			)
+			require.NoError(t, err)
+
+			m.SetProviderPrices(coinbase.Name, types.Prices{
+				"BTC-USD":  big.NewFloat(70_000),
+				"USDT-USD": big.NewFloat(1),
+			})
+			m.SetProviderPrices(binance.Name, types.Prices{
+				"BTCUSDT": big.NewFloat(68_000),
+			})
+			m.SetProviderPrices(kucoin.Name, types.Prices{
+				"BTC-USDT": big.NewFloat(60_000),
+			})
+			m.SetIndexPrices(types.Prices{
+				usdtusd.String(): big.NewFloat(1),
+			})
+
+			m.AggregatePrices(context.Background())
+
+			prices := m.GetIndexPrices()
+			require.Contains(t, prices, constants.BITCOIN_USD.String())
+			require.Equal(t, tc.expectedPrice.SetPrec(36), prices[constants.BITCOIN_USD.String()].SetPrec(36))
+		})
+	}
+
+	t.Run("routed tickers contribute to the market", func(t *testing.T) {
+		m, err := oracle.NewIndexPriceAggregator(
+			logger,
+			mmtypes.MarketMap{},
+			metrics.NewNopMetrics(),
+			oracle.WithProviderQuoteCurrencies(map[string][]string{binance.Name: {"USDT"}}),
+		)
+		require.NoError(t, err)
+
+		// Routes are resolved on market map updates.
+		m.UpdateMarketMap(routedMarketMap(`{"autoRoute":true}`))
+
+		tickers := m.GetProviderTickers([]string{constants.BITCOIN_USD.String()})
+		require.Equal(t, map[string][]string{
+			coinbase.Name: {"BTC-USD", "USDT-USD"},
+			binance.Name:  {"BTCUSDT"},
+		}, tickers)
+	})
+}
+
+func TestDecimalDisagreement(t *testing.T) {
+	// BTC/USD is priced directly by three providers, any one of which is enough to price it.
+	btcusd := BTC_USD
+	btcusd.MinProviderCount = 1
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: btcusd,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "BTCUSD"},
+					{Name: kucoin.Name, OffChainTicker: "BTC-USD"},
+				},
+			},
+		},
+	}
+
+	disagreementCfg := config.DecimalDisagreementConfig{
+		Enabled:  true,
+		MinRatio: 100,
+	}
+
+	testCases := []struct {
+		name         string
+		prices       map[string]types.Prices
+		disagreement bool
+	}{
+		{
+			name: "providers that agree on decimals are aggregated",
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(71_000)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(69_000)},
+			},
+			disagreement: false,
+		},
+		{
+			name: "diverging providers below the min ratio are aggregated",
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(700_000)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(69_000)},
+			},
+			disagreement: false,
+		},
+		{
+			name: "providers that report at different decimals are withheld",
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(0.7)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(0.69)},
+			},
+			disagreement: true,
+		},
+		{
+			name: "a single provider that reports at different decimals withholds the market",
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(7_000_000)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(69_000)},
+			},
+			disagreement: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMetrics := metricmocks.NewMetrics(t)
+			mockMetrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddTickerTick", mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdateDecimalDisagreement", btcusd.String(), tc.disagreement).Return().Once()
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				mm,
+				mockMetrics,
+				oracle.WithDecimalDisagreementConfig(disagreementCfg),
+			)
+			require.NoError(t, err)
+
+			for provider, prices := range tc.prices {
+				m.SetProviderPrices(provider, prices)
+			}
+			m.AggregatePrices(context.Background())
+
+			price, ok := m.GetPrices()[btcusd.String()]
+			if !tc.disagreement {
+				require.True(t, ok)
+				require.NotNil(t, price)
+				return
+			}
+
+			require.False(t, ok)
+			_, failing := m.GetMissingPrices()
+			require.Equal(t, []string{btcusd.String()}, failing)
+		})
+	}
+}
+
+func TestMinProviderCount(t *testing.T) {
+	// BTC/USD is priced directly by three providers, any one of which is enough to price it
+	// according to the market map.
+	btcusd := BTC_USD
+	btcusd.MinProviderCount = 1
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: btcusd,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "BTCUSD"},
+					{Name: kucoin.Name, OffChainTicker: "BTC-USD"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		minCounts  map[pkgtypes.CurrencyPair]int
+		prices     map[string]types.Prices
+		suppressed bool
+	}{
+		{
+			name:      "markets without a minimum are priced by a single provider",
+			minCounts: nil,
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+			},
+			suppressed: false,
+		},
+		{
+			name:      "markets that meet their minimum are priced",
+			minCounts: map[pkgtypes.CurrencyPair]int{btcusd.CurrencyPair: 2},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(71_000)},
+			},
+			suppressed: false,
+		},
+		{
+			name:      "markets below their minimum are suppressed",
+			minCounts: map[pkgtypes.CurrencyPair]int{btcusd.CurrencyPair: 3},
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(71_000)},
+			},
+			suppressed: true,
+		},
+		{
+			name:       "markets without any price are suppressed",
+			minCounts:  map[pkgtypes.CurrencyPair]int{btcusd.CurrencyPair: 1},
+			prices:     map[string]types.Prices{},
+			suppressed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMetrics := metricmocks.NewMetrics(t)
+			mockMetrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddTickerTick", mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			if tc.suppressed {
+				mockMetrics.On("AddInsufficientProviders", btcusd.String()).Return().Once()
+			}
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				mm,
+				mockMetrics,
+				oracle.WithMinProviderCount(tc.minCounts),
+				oracle.WithAggregationAudit(true),
+			)
+			require.NoError(t, err)
+
+			for provider, prices := range tc.prices {
+				m.SetProviderPrices(provider, prices)
+			}
+			m.AggregatePrices(context.Background())
+
+			price, ok := m.GetPrices()[btcusd.String()]
+			if !tc.suppressed {
+				require.True(t, ok)
+				require.NotNil(t, price)
+				return
+			}
+
+			require.False(t, ok)
+			require.Equal(t, "insufficient providers", m.GetAggregationAudit()[btcusd.String()].WithheldReason)
+		})
+	}
+
+	t.Run("min provider counts below 1 are rejected", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = oracle.NewIndexPriceAggregator(
+				logger,
+				mm,
+				metrics.NewNopMetrics(),
+				oracle.WithMinProviderCount(map[pkgtypes.CurrencyPair]int{btcusd.CurrencyPair: 0}),
+			)
+		})
+	})
+}
+
+func TestProviderSpread(t *testing.T) {
+	btcusd := BTC_USD
+	btcusd.MinProviderCount = 1
+	mm := mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			btcusd.String(): {
+				Ticker: btcusd,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "BTCUSD"},
+					{Name: kucoin.Name, OffChainTicker: "BTC-USD"},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		enabled  bool
+		prices   map[string]types.Prices
+		spread   *big.Float
+		relative float64
+	}{
+		{
+			name:    "the spread is not computed if disabled",
+			enabled: false,
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(71_000)},
+			},
+		},
+		{
+			name:    "the spread is the difference between the highest and lowest prices",
+			enabled: true,
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+				binance.Name:  {"BTCUSD": big.NewFloat(70_700)},
+				kucoin.Name:   {"BTC-USD": big.NewFloat(70_350)},
+			},
+			spread:   big.NewFloat(70_000_000_000),
+			relative: 700.0 / 70_350,
+		},
+		{
+			name:    "a single provider has no spread",
+			enabled: true,
+			prices: map[string]types.Prices{
+				coinbase.Name: {"BTC-USD": big.NewFloat(70_000)},
+			},
+			spread:   big.NewFloat(0),
+			relative: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMetrics := metricmocks.NewMetrics(t)
+			mockMetrics.On("AddProviderCountForMarket", mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMetrics.On("AddTickerTick", mock.Anything).Return().Maybe()
+			mockMetrics.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			if tc.enabled {
+				mockMetrics.On("UpdateProviderSpread", btcusd.String(), mock.MatchedBy(func(spread float64) bool {
+					diff := spread - tc.relative
+					return diff < 1e-9 && diff > -1e-9
+				})).Return().Once()
+			}
+
+			m, err := oracle.NewIndexPriceAggregator(
+				logger,
+				mm,
+				mockMetrics,
+				oracle.WithProviderSpread(tc.enabled),
+			)
+			require.NoError(t, err)
+
+			for provider, prices := range tc.prices {
+				m.SetProviderPrices(provider, prices)
+			}
+			m.AggregatePrices(context.Background())
+
+			info, ok := m.GetPriceInfo()[btcusd.String()]
+			require.True(t, ok)
+			if tc.spread == nil {
+				require.Nil(t, info.Spread)
+				return
+			}
+
+			require.NotNil(t, info.Spread)
+			require.Equal(t, 0, tc.spread.Cmp(info.Spread), info.Spread.String())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package oracle
+
+import (
+	"slices"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// insufficientPricesReason is the reason recorded in the audit of a market that did not have
+// enough converted prices to be priced.
+const insufficientPricesReason = "insufficient converted prices"
+
+// marketAudit records whether the price of each provider config of a market was included in the
+// aggregation of the market. A nil audit records nothing, so the aggregation does not need to
+// check whether the audit mode is enabled.
+type marketAudit struct {
+	providers []types.ProviderAudit
+}
+
+// newMarketAudit returns a new audit if the audit mode is enabled, and nil otherwise.
+func (m *IndexPriceAggregator) newMarketAudit() *marketAudit {
+	if !m.audit {
+		return nil
+	}
+
+	return &marketAudit{}
+}
+
+// include records that the converted price of the given provider config was included.
+func (a *marketAudit) include(cfg mmtypes.ProviderConfig, lastGood bool) {
+	if a == nil {
+		return
+	}
+
+	a.providers = append(a.providers, types.ProviderAudit{
+		Provider:       cfg.Name,
+		OffChainTicker: cfg.OffChainTicker,
+		Included:       true,
+		LastGood:       lastGood,
+	})
+}
+
+// exclude records that the price of the given provider config was excluded for the given reason.
+func (a *marketAudit) exclude(cfg mmtypes.ProviderConfig, reason types.ExclusionReason, detail string) {
+	if a == nil {
+		return
+	}
+
+	a.providers = append(a.providers, types.ProviderAudit{
+		Provider:       cfg.Name,
+		OffChainTicker: cfg.OffChainTicker,
+		Reason:         reason,
+		Detail:         detail,
+	})
+}
+
+// excludeIncluded marks every included price as excluded for the given reason.
+func (a *marketAudit) excludeIncluded(reason types.ExclusionReason) {
+	if a == nil {
+		return
+	}
+
+	for i := range a.providers {
+		if a.providers[i].Included {
+			a.providers[i].Included = false
+			a.providers[i].LastGood = false
+			a.providers[i].Reason = reason
+		}
+	}
+}
+
+// auditExclusion records why the converted price of the given provider config could not be
+// calculated. Prices that were dropped by the oracle before they reached the aggregator are
+// recorded with the reason reported by the oracle.
+func (m *IndexPriceAggregator) auditExclusion(audit *marketAudit, cfg mmtypes.ProviderConfig, err error) {
+	if audit == nil {
+		return
+	}
+
+	if _, priceErr := m.GetProviderPrice(cfg); priceErr == nil {
+		if _, stale := m.isStale(cfg.Name, cfg.OffChainTicker, time.Now().UTC()); stale {
+			audit.exclude(cfg, types.ExclusionStale, err.Error())
+			return
+		}
+
+		audit.exclude(cfg, types.ExclusionConversionFailed, err.Error())
+		return
+	}
+
+	if _, ok := m.getLastGoodPrice(cfg); ok {
+		audit.exclude(cfg, types.ExclusionConversionFailed, err.Error())
+		return
+	}
+
+	if reason, ok := m.providerExclusions[cfg.Name][cfg.OffChainTicker]; ok {
+		audit.exclude(cfg, reason, "")
+		return
+	}
+
+	audit.exclude(cfg, types.ExclusionNoPrice, err.Error())
+}
+
+// SetProviderExclusions updates the data aggregator with the reasons that the oracle dropped the
+// latest prices of the given provider before they reached the aggregator, indexed by off-chain
+// ticker. These are only utilized by the audit mode.
+func (m *IndexPriceAggregator) SetProviderExclusions(provider string, exclusions map[string]types.ExclusionReason) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if exclusions == nil {
+		exclusions = make(map[string]types.ExclusionReason)
+	}
+
+	m.providerExclusions[provider] = exclusions
+}
+
+// updateAudits records the audit of each market in the given results. This is a no-op if the audit
+// mode is disabled.
+func (m *IndexPriceAggregator) updateAudits(now time.Time, results []marketPrice) {
+	if !m.audit {
+		return
+	}
+
+	audits := make(map[string]types.AggregationAudit, len(results))
+	for _, result := range results {
+		audit := types.AggregationAudit{
+			Timestamp:      now,
+			WithheldReason: result.reason,
+		}
+
+		if result.audit != nil {
+			audit.Providers = result.audit.providers
+		}
+
+		if result.price == nil && len(audit.WithheldReason) == 0 {
+			audit.WithheldReason = insufficientPricesReason
+		}
+
+		audits[result.ticker] = audit
+	}
+
+	m.audits = audits
+}
+
+// GetAggregationAudit returns the audit of the latest aggregation of each enabled market, which
+// records whether the price of each of the market's provider configs was included in the market's
+// price and, if not, why it was excluded. This is empty if the audit mode is disabled.
+func (m *IndexPriceAggregator) GetAggregationAudit() map[string]types.AggregationAudit {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(map[string]types.AggregationAudit, len(m.audits))
+	for ticker, audit := range m.audits {
+		audit.Providers = slices.Clone(audit.Providers)
+		cpy[ticker] = audit
+	}
+
+	return cpy
+}
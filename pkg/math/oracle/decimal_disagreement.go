@@ -0,0 +1,99 @@
+package oracle
+
+import (
+	"math/big"
+	"sort"
+
+	"go.uber.org/zap"
+)
+
+// decimalDisagreementReason is the reason logged when the price of a market is withheld because
+// its providers disagree on decimals.
+const decimalDisagreementReason = "decimal disagreement"
+
+// checkDecimalDisagreement returns true if the given converted prices of the market are split into
+// clusters that are at least the configured min ratio apart, i.e. if the market's providers report
+// prices at different implied decimals. Disagreements are logged and recorded in the metrics. This
+// always returns false if the guard is disabled.
+func (m *IndexPriceAggregator) checkDecimalDisagreement(
+	ticker string,
+	convertedPrices []*big.Float,
+	providers []string,
+) bool {
+	if !m.decimalDisagreement.Enabled {
+		return false
+	}
+
+	split, ratio := decimalDisagreementSplit(convertedPrices, m.decimalDisagreement.MinRatio)
+	disagreement := split > 0
+	m.metrics.UpdateDecimalDisagreement(ticker, disagreement)
+	if !disagreement {
+		return false
+	}
+
+	order := make([]int, len(convertedPrices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return convertedPrices[order[i]].Cmp(convertedPrices[order[j]]) < 0
+	})
+
+	clusters := [2][]string{}
+	prices := [2][]string{}
+	for rank, i := range order {
+		cluster := 0
+		if rank >= split {
+			cluster = 1
+		}
+
+		clusters[cluster] = append(clusters[cluster], providers[i])
+		prices[cluster] = append(prices[cluster], convertedPrices[i].String())
+	}
+
+	m.logger.Warn(
+		"withholding price of market whose providers report prices at different decimals",
+		zap.String("target_ticker", ticker),
+		zap.String("reason", decimalDisagreementReason),
+		zap.Float64("ratio", ratio),
+		zap.Float64("min_ratio", m.decimalDisagreement.MinRatio),
+		zap.Strings("low_providers", clusters[0]),
+		zap.Strings("low_prices", prices[0]),
+		zap.Strings("high_providers", clusters[1]),
+		zap.Strings("high_prices", prices[1]),
+	)
+
+	return true
+}
+
+// decimalDisagreementSplit orders the given prices and returns the number of prices below the
+// largest ratio between two consecutive prices, along with the ratio, if the ratio is at least
+// minRatio. Otherwise, this returns 0. Prices that are not positive are not compared.
+func decimalDisagreementSplit(prices []*big.Float, minRatio float64) (int, float64) {
+	sorted := make([]*big.Float, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	var (
+		split    int
+		maxRatio float64
+	)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Sign() <= 0 {
+			continue
+		}
+
+		ratio, _ := new(big.Float).Quo(sorted[i], sorted[i-1]).Float64()
+		if ratio > maxRatio {
+			split, maxRatio = i, ratio
+		}
+	}
+
+	if maxRatio < minRatio {
+		return 0, 0
+	}
+
+	return split, maxRatio
+}
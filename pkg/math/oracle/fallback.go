@@ -0,0 +1,93 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/holiman/uint256"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// lastKnownPrice is the last successfully aggregated price of a market.
+type lastKnownPrice struct {
+	result    marketPrice
+	timestamp time.Time
+}
+
+// aggregateWithFallbacks prices a market that does not meet its minimum provider count by
+// evaluating the configured fallbacks in order until one of them resolves a price. If none of
+// the fallbacks resolve a price, the market is not priced.
+func (m *IndexPriceAggregator) aggregateWithFallbacks(
+	target mmtypes.Ticker,
+	convertedPrices []*big.Float,
+	providers []string,
+	lastGood []bool,
+	volumes []*uint256.Int,
+) marketPrice {
+	ticker := target.String()
+	for _, fallback := range m.fallbacks {
+		var result marketPrice
+		switch fallback {
+		case config.AggregationFallbackMedian:
+			if len(convertedPrices) == 0 {
+				continue
+			}
+
+			price := m.calculateMedian(ticker, convertedPrices, providers, lastGood, volumes)
+			result = m.newMarketPrice(target, price, convertedPrices, providers, lastGood)
+		case config.AggregationFallbackLastKnown:
+			lastKnown, ok := m.lastKnownPrices[ticker]
+			if !ok || time.Since(lastKnown.timestamp) > m.lastKnownMaxAge {
+				continue
+			}
+
+			result = lastKnown.result
+			result.price = new(big.Float).Copy(result.price)
+			result.scaledPrice = new(big.Float).Copy(result.scaledPrice)
+		default:
+			continue
+		}
+
+		m.logger.Warn(
+			"priced market using aggregation fallback",
+			zap.String("target_ticker", ticker),
+			zap.String("fallback", string(fallback)),
+			zap.String("price", result.price.String()),
+		)
+
+		result.fallback = fallback
+		return result
+	}
+
+	return marketPrice{ticker: ticker}
+}
+
+// updateLastKnownPrices records the prices of the given results as the last known prices and removes
+// any last known prices that are older than the max age. Prices resolved by the last known fallback
+// are not recorded, so a last known price ages out even if it is utilized. This is a no-op if the
+// last known fallback is not configured.
+func (m *IndexPriceAggregator) updateLastKnownPrices(now time.Time, results []marketPrice) {
+	if m.lastKnownMaxAge == 0 {
+		return
+	}
+
+	for _, result := range results {
+		if result.price == nil || result.fallback == config.AggregationFallbackLastKnown {
+			continue
+		}
+
+		m.lastKnownPrices[result.ticker] = lastKnownPrice{
+			result:    result,
+			timestamp: now,
+		}
+	}
+
+	for ticker, lastKnown := range m.lastKnownPrices {
+		if now.Sub(lastKnown.timestamp) > m.lastKnownMaxAge {
+			delete(m.lastKnownPrices, ticker)
+		}
+	}
+}
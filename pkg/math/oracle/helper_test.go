@@ -141,3 +141,17 @@ var (
 		},
 	}
 )
+
+// btcWithMetadata returns a copy of the test market map where BTC/USD has the given ticker metadata.
+func btcWithMetadata(metadata string) mmtypes.MarketMap {
+	markets := make(map[string]mmtypes.Market, len(marketmap.Markets))
+	for ticker, market := range marketmap.Markets {
+		markets[ticker] = market
+	}
+
+	market := markets[BTC_USD.String()]
+	market.Ticker.Metadata_JSON = metadata
+	markets[BTC_USD.String()] = market
+
+	return mmtypes.MarketMap{Markets: markets}
+}
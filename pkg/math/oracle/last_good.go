@@ -0,0 +1,83 @@
+package oracle
+
+import (
+	"math/big"
+	"time"
+
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// lastGoodPrice is the last fresh price seen for a provider's ticker.
+type lastGoodPrice struct {
+	price     *big.Float
+	timestamp time.Time
+}
+
+// updateLastGoodPrices records the current provider prices as the last good prices and removes
+// any last good prices that are older than the grace period. This is a no-op if the last good
+// mode is disabled.
+func (m *IndexPriceAggregator) updateLastGoodPrices(now time.Time) {
+	if m.lastGoodGracePeriod == 0 {
+		return
+	}
+
+	for provider, prices := range m.providerPrices {
+		cache, ok := m.lastGoodPrices[provider]
+		if !ok {
+			cache = make(map[string]lastGoodPrice)
+			m.lastGoodPrices[provider] = cache
+		}
+
+		for ticker, price := range prices {
+			if price == nil {
+				continue
+			}
+
+			// Stale prices are not good prices, so they must not outlive their staleness threshold.
+			if _, stale := m.isStale(provider, ticker, now); stale {
+				continue
+			}
+
+			cache[ticker] = lastGoodPrice{
+				price:     new(big.Float).Copy(price),
+				timestamp: now,
+			}
+		}
+	}
+
+	for provider, cache := range m.lastGoodPrices {
+		for ticker, lastGood := range cache {
+			if now.Sub(lastGood.timestamp) > m.lastGoodGracePeriod {
+				delete(cache, ticker)
+			}
+		}
+
+		if len(cache) == 0 {
+			delete(m.lastGoodPrices, provider)
+		}
+	}
+}
+
+// getLastGoodPrice returns the last good price of the given provider config, if the last good
+// mode is enabled and the price is within the grace period.
+func (m *IndexPriceAggregator) getLastGoodPrice(cfg mmtypes.ProviderConfig) (*big.Float, bool) {
+	if m.lastGoodGracePeriod == 0 {
+		return nil, false
+	}
+
+	lastGood, ok := m.lastGoodPrices[cfg.Name][cfg.OffChainTicker]
+	if !ok {
+		return nil, false
+	}
+
+	if cfg.Invert {
+		inverted, err := invertPrice(lastGood.price)
+		if err != nil {
+			return nil, false
+		}
+
+		return inverted, true
+	}
+
+	return lastGood.price, true
+}
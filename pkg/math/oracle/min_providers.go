@@ -0,0 +1,43 @@
+package oracle
+
+import (
+	"go.uber.org/zap"
+)
+
+// insufficientProvidersReason is the reason recorded when the price of a market is suppressed
+// because fewer than its configured minimum number of providers reported a fresh price.
+const insufficientProvidersReason = "insufficient providers"
+
+// checkMinProviderCount returns true if the market configures a minimum provider count via the
+// aggregator options, and fewer than that many distinct providers contributed a fresh price to the
+// market in this aggregation. Last good prices are not counted, as their providers did not report
+// in this aggregation. Suppressions are logged and recorded in the metrics.
+func (m *IndexPriceAggregator) checkMinProviderCount(ticker string, providers []string, lastGood []bool) bool {
+	minCount, ok := m.minProviderCounts[ticker]
+	if !ok {
+		return false
+	}
+
+	fresh := make([]string, 0, len(providers))
+	for i, provider := range providers {
+		if !lastGood[i] {
+			fresh = append(fresh, provider)
+		}
+	}
+
+	count := countProviders(fresh)
+	if count >= minCount {
+		return false
+	}
+
+	m.logger.Warn(
+		"suppressing price of market with insufficient providers",
+		zap.String("target_ticker", ticker),
+		zap.String("reason", insufficientProvidersReason),
+		zap.Int("num_providers", count),
+		zap.Int("min_provider_count", minCount),
+	)
+	m.metrics.AddInsufficientProviders(ticker)
+
+	return true
+}
@@ -0,0 +1,339 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/holiman/uint256"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	pkgtypes "github.com/skip-mev/slinky/pkg/types"
+)
+
+// Option is a function that can be used to configure an IndexPriceAggregator.
+type Option func(*IndexPriceAggregator)
+
+// ProviderWeightFn returns the weight of the given provider when calculating the median price
+// for a market. The function is invoked on every aggregation, so it may return live values such
+// as a provider's current reliability score, and must be safe for concurrent use. Providers with
+// a non-positive weight do not contribute to the median.
+type ProviderWeightFn func(provider string) float64
+
+// WithNoDataGracePeriod sets the amount of time a market may go without a price after
+// it is added to the aggregator before it is reported as failing rather than warming up.
+func WithNoDataGracePeriod(gracePeriod time.Duration) Option {
+	return func(m *IndexPriceAggregator) {
+		if gracePeriod < 0 {
+			panic("no data grace period cannot be negative")
+		}
+
+		m.noDataGracePeriod = gracePeriod
+	}
+}
+
+// WithStablecoinDepegConfig sets the stablecoin depeg guard on the aggregator. Any conversion
+// that is normalized by one of the configured stablecoins is halted if the stablecoin's index
+// price deviates from 1.0 by more than the configured max deviation.
+func WithStablecoinDepegConfig(cfg config.StablecoinDepegConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid stablecoin depeg config: %s", err))
+		}
+
+		if cfg.MaxDeviation == 0 {
+			return
+		}
+
+		stablecoins := make(map[string]struct{}, len(cfg.Stablecoins))
+		for _, stablecoin := range cfg.Stablecoins {
+			cp, _ := pkgtypes.CurrencyPairFromString(stablecoin)
+			stablecoins[cp.String()] = struct{}{}
+		}
+
+		m.stablecoins = stablecoins
+		m.maxStablecoinDeviation = big.NewFloat(cfg.MaxDeviation)
+	}
+}
+
+// WithProviderWeightFn sets the function used to weight each provider's price when calculating
+// the median price for a market. By default, all providers are weighted equally.
+func WithProviderWeightFn(fn ProviderWeightFn) Option {
+	return func(m *IndexPriceAggregator) {
+		if fn == nil {
+			panic("cannot set nil provider weight function")
+		}
+
+		m.providerWeightFn = fn
+	}
+}
+
+// WithProviderPriority sets the ordered list of providers, from most to least trusted, used to
+// break ties when attributing an aggregated price to a single provider. Providers that are not
+// listed are ranked after the listed providers. By default, ties are broken by provider name.
+func WithProviderPriority(priority []string) Option {
+	return func(m *IndexPriceAggregator) {
+		ranks := make(map[string]int, len(priority))
+		for i, provider := range priority {
+			if len(provider) == 0 {
+				panic("provider priority cannot contain an empty provider name")
+			}
+
+			if _, ok := ranks[provider]; ok {
+				panic(fmt.Sprintf("duplicate provider %s in provider priority", provider))
+			}
+
+			ranks[provider] = i
+		}
+
+		m.providerPriority = ranks
+	}
+}
+
+// WithDefaultAggregationStrategy sets the aggregation strategy used for markets that do not
+// configure a strategy in their ticker metadata. By default, the median is used.
+func WithDefaultAggregationStrategy(strategy AggregationStrategy) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := strategy.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid default aggregation strategy: %s", err))
+		}
+
+		m.defaultAggregationStrategy = strategy
+	}
+}
+
+// WithHuberK sets the tuning constant of the huber aggregation strategy for markets that do not
+// configure one in their ticker metadata. By default, math.DefaultHuberK is used.
+func WithHuberK(k float64) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := validateHuberK(k); err != nil {
+			panic(fmt.Sprintf("invalid default huber k: %s", err))
+		}
+
+		m.defaultHuberK = k
+	}
+}
+
+// WithAggregationWindow sets the window of the twap aggregation strategy for markets that do not
+// configure one in their ticker metadata. By default, DefaultAggregationWindow is used.
+func WithAggregationWindow(window time.Duration) Option {
+	return func(m *IndexPriceAggregator) {
+		if window <= 0 {
+			panic("aggregation window must be greater than 0")
+		}
+
+		m.defaultAggregationWindow = window
+	}
+}
+
+// WithMinSignificantDigits sets the minimum number of significant digits of the scaled price of
+// derived markets that do not configure a minimum in their ticker metadata. A warning is logged
+// whenever the scaled price of such a market has fewer significant digits. By default, or if
+// digits is 0, the check is disabled.
+func WithMinSignificantDigits(digits int) Option {
+	return func(m *IndexPriceAggregator) {
+		if digits < 0 {
+			panic("min significant digits cannot be negative")
+		}
+
+		m.defaultMinSignificantDigits = digits
+	}
+}
+
+// WithMedianVariant sets how the median of an even number of prices is calculated. By default,
+// the two middle prices are averaged.
+func WithMedianVariant(variant types.MedianVariant) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := variant.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid median variant: %s", err))
+		}
+
+		m.medianVariant = variant
+	}
+}
+
+// WithLastGoodConfig sets the last good mode on the aggregator. When a provider's price for a
+// market goes stale, its last good price continues to contribute to the market's median with a
+// reduced weight until the configured grace period has elapsed.
+func WithLastGoodConfig(cfg config.LastGoodConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid last good config: %s", err))
+		}
+
+		m.lastGoodGracePeriod = cfg.GracePeriod
+		m.lastGoodWeight = cfg.Weight
+	}
+}
+
+// WithAggregationFallbackConfig sets the fallbacks used to price a market that does not meet its
+// minimum provider count. The fallbacks are evaluated in order until one of them resolves a price.
+// By default, such markets are not priced.
+func WithAggregationFallbackConfig(cfg config.AggregationFallbackConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid aggregation fallback config: %s", err))
+		}
+
+		m.fallbacks = cfg.Fallbacks
+		m.lastKnownMaxAge = 0
+		for _, fallback := range cfg.Fallbacks {
+			if fallback == config.AggregationFallbackLastKnown {
+				m.lastKnownMaxAge = cfg.LastKnownMaxAge
+			}
+		}
+	}
+}
+
+// WithProviderLagConfig sets the provider lag detection on the aggregator. Each time the index
+// price of a market moves, each provider's price for the market is evaluated for whether it lagged
+// the move. Providers that lagged in at least the configured threshold of the last window moves are
+// reported as lagging. By default, the detection is disabled.
+func WithProviderLagConfig(cfg config.ProviderLagConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid provider lag config: %s", err))
+		}
+
+		m.providerLag = cfg
+	}
+}
+
+// WithProviderCollapseConfig sets the guard against a sudden collapse of the number of providers
+// contributing to a market's price. Markets whose number of contributing providers drops by more
+// than the configured max drop between two aggregations are withheld or flagged until the number
+// stabilizes. By default, the guard is disabled.
+func WithProviderCollapseConfig(cfg config.ProviderCollapseConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid provider collapse config: %s", err))
+		}
+
+		m.providerCollapse = cfg
+	}
+}
+
+// WithDecimalDisagreementConfig sets the guard against providers of the same market reporting
+// prices at different implied decimals. The prices of markets whose converted prices are split
+// into clusters at least the configured min ratio apart are withheld. By default, the guard is
+// disabled.
+func WithDecimalDisagreementConfig(cfg config.DecimalDisagreementConfig) Option {
+	return func(m *IndexPriceAggregator) {
+		if err := cfg.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid decimal disagreement config: %s", err))
+		}
+
+		m.decimalDisagreement = cfg
+	}
+}
+
+// WithAggregationAudit sets whether the aggregator runs in audit mode, in which it records, for each
+// market in every aggregation, whether the price of each of its provider configs was included in the
+// market's price and, if not, why it was excluded. The audit of the latest aggregation is returned
+// by GetAggregationAudit. By default, the audit mode is disabled.
+func WithAggregationAudit(enabled bool) Option {
+	return func(m *IndexPriceAggregator) {
+		m.audit = enabled
+	}
+}
+
+// WithVolumeWeighting enables the volume weighting of the median. The median of each market is then
+// the volume-weighted median of its converted prices, where each price is weighted by the volume
+// its provider reported alongside it, truncated to an integer. Prices whose provider did not report
+// a volume, as well as last good prices, are weighted by the given default weight. The weights are
+// integers, such that the median is reproducible across nodes. By default, the median is not
+// weighted by volume.
+func WithVolumeWeighting(defaultWeight uint64) Option {
+	return func(m *IndexPriceAggregator) {
+		m.volumeWeighting = true
+		m.defaultVolumeWeight = uint256.NewInt(defaultWeight)
+	}
+}
+
+// WithProviderStaleness sets the maximum age of the prices of each of the given providers, indexed
+// by provider name. The age of a price is measured from the time at which it was produced. Prices
+// older than their provider's threshold are excluded from the aggregation entirely, and are not
+// replaced by the provider's last good price, such that a market without any fresh price is not
+// priced. Providers without a threshold are only subject to the max price age of the oracle. By
+// default, no provider has a threshold.
+func WithProviderStaleness(staleness map[string]time.Duration) Option {
+	return func(m *IndexPriceAggregator) {
+		thresholds := make(map[string]time.Duration, len(staleness))
+		for provider, threshold := range staleness {
+			if len(provider) == 0 {
+				panic("provider staleness cannot contain an empty provider name")
+			}
+
+			if threshold <= 0 {
+				panic(fmt.Sprintf("staleness threshold of provider %s must be greater than 0", provider))
+			}
+
+			thresholds[provider] = threshold
+		}
+
+		m.providerStaleness = thresholds
+	}
+}
+
+// WithMinProviderCount sets the minimum number of distinct providers that must report a fresh price
+// for each of the given markets in an aggregation, indexed by currency pair. If fewer providers
+// report, the market is dropped from the output of the aggregation and is not priced by the
+// aggregation fallbacks. This is enforced in addition to the min provider count of the market in the
+// market map. By default, no market configures a minimum, such that a single provider suffices.
+func WithMinProviderCount(counts map[pkgtypes.CurrencyPair]int) Option {
+	return func(m *IndexPriceAggregator) {
+		minCounts := make(map[string]int, len(counts))
+		for cp, count := range counts {
+			if err := cp.ValidateBasic(); err != nil {
+				panic(fmt.Sprintf("invalid min provider count currency pair %s: %v", cp, err))
+			}
+
+			if count < 1 {
+				panic(fmt.Sprintf("min provider count of %s must be at least 1", cp))
+			}
+
+			minCounts[cp.String()] = count
+		}
+
+		m.minProviderCounts = minCounts
+	}
+}
+
+// WithProviderSpread sets whether the aggregator computes the spread between the highest and
+// lowest converted provider prices that contribute to the price of each market.
+func WithProviderSpread(enabled bool) Option {
+	return func(m *IndexPriceAggregator) {
+		m.providerSpread = enabled
+	}
+}
+
+// WithProviderQuoteCurrencies sets the quote currencies that each provider supports, indexed by
+// provider name. These determine the conversion paths that are routed automatically for markets
+// that enable auto routing in their ticker metadata. By default, no provider supports any quote
+// currency, so no conversion paths are routed.
+func WithProviderQuoteCurrencies(quotes map[string][]string) Option {
+	return func(m *IndexPriceAggregator) {
+		m.quoteCurrencies = make(map[string]map[string]struct{}, len(quotes))
+		for provider, currencies := range quotes {
+			m.quoteCurrencies[provider] = make(map[string]struct{}, len(currencies))
+			for _, currency := range currencies {
+				m.quoteCurrencies[provider][strings.ToUpper(currency)] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithAggregationWorkers sets the number of workers used to aggregate prices across markets.
+// Markets are independent within an aggregation, so they can be aggregated concurrently. By
+// default, or if workers is 0 or 1, markets are aggregated sequentially.
+func WithAggregationWorkers(workers int) Option {
+	return func(m *IndexPriceAggregator) {
+		if workers < 0 {
+			panic("aggregation workers cannot be negative")
+		}
+
+		m.aggregationWorkers = workers
+	}
+}
@@ -0,0 +1,117 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"go.uber.org/zap"
+)
+
+// ParseMinSignificantDigits returns the minimum number of significant digits configured in the
+// given ticker metadata JSON. If the metadata does not configure a minimum, the default minimum is
+// returned. An error is returned if the configured minimum is negative.
+func ParseMinSignificantDigits(metadataJSON string, defaultDigits int) (int, error) {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	if !ok || metadata.MinSignificantDigits == nil {
+		return defaultDigits, nil
+	}
+
+	if *metadata.MinSignificantDigits < 0 {
+		return 0, fmt.Errorf("min significant digits cannot be negative")
+	}
+
+	return *metadata.MinSignificantDigits, nil
+}
+
+// resolveMinSignificantDigits resolves the minimum number of significant digits of each derived
+// market in the market map i.e. each market that is priced through at least one conversion. Markets
+// without a minimum are not evaluated. Markets that configure an invalid minimum use the default
+// minimum and an error is returned.
+func (m *IndexPriceAggregator) resolveMinSignificantDigits() error {
+	minimums := make(map[string]int)
+
+	var errs []error
+	for _, market := range m.cfg.Markets {
+		derived := false
+		for _, cfg := range m.providerConfigs(market) {
+			if cfg.NormalizeByPair != nil {
+				derived = true
+				break
+			}
+		}
+
+		if !derived {
+			continue
+		}
+
+		ticker := market.Ticker.String()
+		digits, err := ParseMinSignificantDigits(market.Ticker.Metadata_JSON, m.defaultMinSignificantDigits)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid min significant digits for %s: %w", ticker, err))
+			digits = m.defaultMinSignificantDigits
+		}
+
+		if digits > 0 {
+			minimums[ticker] = digits
+		}
+	}
+
+	m.minSignificantDigits = minimums
+	for ticker := range m.lowPrecision {
+		if _, ok := minimums[ticker]; !ok {
+			delete(m.lowPrecision, ticker)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkPrecision evaluates the effective precision of the price of each derived market that
+// configures a minimum number of significant digits, i.e. the number of significant digits of its
+// scaled price, which is the price consumers receive. A warning is logged once a market's precision
+// falls below its minimum, and another message once it recovers.
+func (m *IndexPriceAggregator) checkPrecision(results []marketPrice) {
+	for _, result := range results {
+		minimum, ok := m.minSignificantDigits[result.ticker]
+		if !ok || result.scaledPrice == nil {
+			continue
+		}
+
+		digits := significantDigits(result.scaledPrice)
+		m.metrics.UpdatePriceSignificantDigits(result.ticker, digits)
+
+		low := digits < minimum
+		_, reported := m.lowPrecision[result.ticker]
+		switch {
+		case low && !reported:
+			m.logger.Warn(
+				"precision of derived price is below the minimum significant digits",
+				zap.String("target_ticker", result.ticker),
+				zap.String("scaled_price", result.scaledPrice.String()),
+				zap.Int("significant_digits", digits),
+				zap.Int("min_significant_digits", minimum),
+			)
+			m.lowPrecision[result.ticker] = struct{}{}
+		case !low && reported:
+			m.logger.Info(
+				"precision of derived price recovered",
+				zap.String("target_ticker", result.ticker),
+				zap.Int("significant_digits", digits),
+				zap.Int("min_significant_digits", minimum),
+			)
+			delete(m.lowPrecision, result.ticker)
+		}
+	}
+}
+
+// significantDigits returns the number of significant digits of the integer part of the given
+// scaled price, i.e. of the price as it is published.
+func significantDigits(scaledPrice *big.Float) int {
+	integer, _ := new(big.Float).Abs(scaledPrice).Int(nil)
+	if integer.Sign() == 0 {
+		return 0
+	}
+
+	return len(integer.String())
+}
@@ -0,0 +1,68 @@
+package oracle
+
+import (
+	"math/big"
+)
+
+// attributeSource returns the provider that the given aggregated price is attributed to i.e. the
+// provider whose converted price is the aggregated price. If several providers reported the
+// aggregated price, fresh prices are preferred over last good prices, and the remaining ties are
+// broken by the configured provider priority and then by name. This returns an empty string if
+// the aggregated price is not one of the converted prices e.g. if it is the mean of the prices.
+func (m *IndexPriceAggregator) attributeSource(
+	price *big.Float,
+	prices []*big.Float,
+	providers []string,
+	lastGood []bool,
+) string {
+	if price == nil {
+		return ""
+	}
+
+	source := -1
+	for i, provider := range providers {
+		if i >= len(prices) || prices[i] == nil || prices[i].Cmp(price) != 0 {
+			continue
+		}
+
+		if source < 0 || m.preferSource(provider, isLastGood(lastGood, i), providers[source], isLastGood(lastGood, source)) {
+			source = i
+		}
+	}
+
+	if source < 0 {
+		return ""
+	}
+
+	return providers[source]
+}
+
+// preferSource returns true if the price of the candidate provider should be attributed over the
+// price of the current provider.
+func (m *IndexPriceAggregator) preferSource(candidate string, candidateLastGood bool, current string, currentLastGood bool) bool {
+	if candidateLastGood != currentLastGood {
+		return !candidateLastGood
+	}
+
+	candidateRank, currentRank := m.priorityRank(candidate), m.priorityRank(current)
+	if candidateRank != currentRank {
+		return candidateRank < currentRank
+	}
+
+	return candidate < current
+}
+
+// priorityRank returns the rank of the provider in the configured provider priority. Providers
+// that are not listed are ranked after all of the listed providers.
+func (m *IndexPriceAggregator) priorityRank(provider string) int {
+	if rank, ok := m.providerPriority[provider]; ok {
+		return rank
+	}
+
+	return len(m.providerPriority)
+}
+
+// isLastGood returns true if the i-th converted price is a last good price.
+func isLastGood(lastGood []bool, i int) bool {
+	return i < len(lastGood) && lastGood[i]
+}
@@ -0,0 +1,145 @@
+package oracle
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// providerCollapseReason is the reason recorded when the price of a market is withheld because its
+// number of contributing providers collapsed.
+const providerCollapseReason = "provider collapse"
+
+// collapseTracker records the number of providers contributing to a market's price across
+// aggregations.
+type collapseTracker struct {
+	// baseline is the number of contributing providers of the market before it collapsed, or the
+	// number of contributing providers in the last aggregation if it is not collapsed.
+	baseline int
+	// last is the number of contributing providers in the last aggregation.
+	last int
+	// collapsed is true if the market is currently collapsed.
+	collapsed bool
+	// remaining is the number of aggregations in which the number of contributing providers must
+	// not drop further before the collapsed market is released.
+	remaining int
+}
+
+// guardProviderCollapse evaluates, for each market, whether its number of contributing providers
+// dropped by more than the configured max drop since the last aggregation. A collapsed market is
+// released once its number of contributing providers recovers to within the max drop of the number
+// before the collapse, or once it has not dropped further for the configured number of
+// stabilization cycles. Depending on the configured action, the prices of collapsed markets are
+// either withheld or only flagged. Prices resolved by the last known fallback are not evaluated.
+// This is a no-op if the guard is disabled.
+func (m *IndexPriceAggregator) guardProviderCollapse(results []marketPrice) {
+	if !m.providerCollapse.Enabled {
+		return
+	}
+
+	for i, result := range results {
+		if result.fallback == config.AggregationFallbackLastKnown {
+			continue
+		}
+
+		// Markets that failed to resolve a price have no contributing providers.
+		count := 0
+		if result.price != nil {
+			count = countProviders(result.info.Providers)
+		}
+
+		tracker, ok := m.collapseTrackers[result.ticker]
+		if !ok {
+			m.collapseTrackers[result.ticker] = &collapseTracker{baseline: count, last: count}
+			continue
+		}
+
+		m.updateCollapseTracker(result.ticker, tracker, count)
+		tracker.last = count
+		if tracker.collapsed && m.providerCollapse.Action == config.ProviderCollapseActionWithhold && result.price != nil {
+			m.logger.Debug(
+				"withholding price of market with collapsed provider count",
+				zap.String("target_ticker", result.ticker),
+				zap.Int("num_providers", count),
+				zap.Int("baseline_num_providers", tracker.baseline),
+			)
+
+			results[i] = marketPrice{ticker: result.ticker, reason: providerCollapseReason, audit: result.audit}
+		}
+	}
+}
+
+// updateCollapseTracker evaluates the number of contributing providers of the market in the given
+// aggregation against the tracker, and logs and records in the metrics whenever the market
+// collapses or is released.
+func (m *IndexPriceAggregator) updateCollapseTracker(ticker string, tracker *collapseTracker, count int) {
+	if !tracker.collapsed {
+		if tracker.baseline-count <= m.providerCollapse.MaxDrop {
+			tracker.baseline = count
+			return
+		}
+
+		tracker.collapsed = true
+		tracker.remaining = m.providerCollapse.StabilizationCycles
+		m.metrics.UpdateProviderCollapse(ticker, true)
+		m.logger.Warn(
+			"number of providers contributing to market collapsed",
+			zap.String("target_ticker", ticker),
+			zap.Int("num_providers", count),
+			zap.Int("previous_num_providers", tracker.baseline),
+			zap.Int("max_drop", m.providerCollapse.MaxDrop),
+			zap.String("action", string(m.providerCollapse.Action)),
+		)
+
+		return
+	}
+
+	switch {
+	case tracker.baseline-count <= m.providerCollapse.MaxDrop:
+		// The contributing providers recovered.
+	case count < tracker.last:
+		// The number of contributing providers dropped further, so the stabilization restarts.
+		tracker.remaining = m.providerCollapse.StabilizationCycles
+		return
+	default:
+		tracker.remaining--
+		if tracker.remaining > 0 {
+			return
+		}
+	}
+
+	tracker.collapsed = false
+	tracker.baseline = count
+	m.metrics.UpdateProviderCollapse(ticker, false)
+	m.logger.Info(
+		"number of providers contributing to market stabilized",
+		zap.String("target_ticker", ticker),
+		zap.Int("num_providers", count),
+	)
+}
+
+// pruneCollapseTrackers removes the collapse trackers of markets that are no longer in the market
+// map.
+func (m *IndexPriceAggregator) pruneCollapseTrackers() {
+	markets := make(map[string]struct{}, len(m.cfg.Markets))
+	for _, market := range m.cfg.Markets {
+		markets[market.Ticker.String()] = struct{}{}
+	}
+
+	for ticker := range m.collapseTrackers {
+		if _, ok := markets[ticker]; !ok {
+			delete(m.collapseTrackers, ticker)
+		}
+	}
+}
+
+// countProviders returns the number of distinct providers in the given list. A provider may supply
+// several converted prices for the same market.
+func countProviders(providers []string) int {
+	distinct := make(map[string]struct{}, len(providers))
+	for _, provider := range providers {
+		distinct[provider] = struct{}{}
+	}
+
+	return len(distinct)
+}
@@ -0,0 +1,150 @@
+package oracle
+
+import (
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// lagTracker records whether a provider's price lagged each of the most recent moves of a market's
+// index price.
+type lagTracker struct {
+	// samples is a ring buffer of whether the provider lagged each move.
+	samples []bool
+	// next is the index of the next sample.
+	next int
+	// size is the number of samples in the buffer.
+	size int
+	// lagged is the number of samples in the buffer in which the provider lagged.
+	lagged int
+	// reported is true if the provider is currently reported as lagging.
+	reported bool
+}
+
+// add records whether the provider lagged a move of the index price. Once the buffer is full, the
+// oldest sample is overwritten.
+func (t *lagTracker) add(lagged bool) {
+	if t.size == len(t.samples) {
+		if t.samples[t.next] {
+			t.lagged--
+		}
+	} else {
+		t.size++
+	}
+
+	t.samples[t.next] = lagged
+	if lagged {
+		t.lagged++
+	}
+	t.next = (t.next + 1) % len(t.samples)
+}
+
+// score returns the fraction of the samples in the buffer in which the provider lagged.
+func (t *lagTracker) score() float64 {
+	if t.size == 0 {
+		return 0
+	}
+
+	return float64(t.lagged) / float64(t.size)
+}
+
+// updateProviderLag evaluates, for each market whose index price moved since the last aggregation,
+// whether each provider's converted price lagged the move i.e. whether the price is closer to the
+// previous index price than to the current one. Providers that lagged in at least the configured
+// threshold of the last window moves are reported as lagging. Last good prices and prices resolved
+// by the last known fallback are not evaluated. This is a no-op if the detection is disabled.
+func (m *IndexPriceAggregator) updateProviderLag(results []marketPrice) {
+	if m.providerLag.Window == 0 {
+		return
+	}
+
+	for _, result := range results {
+		if result.price == nil || result.fallback == config.AggregationFallbackLastKnown {
+			continue
+		}
+
+		previous, ok := m.indexPrices[result.ticker]
+		if !ok || previous == nil || previous.Cmp(result.price) == 0 {
+			continue
+		}
+
+		trackers, ok := m.lagTrackers[result.ticker]
+		if !ok {
+			trackers = make(map[string]*lagTracker)
+			m.lagTrackers[result.ticker] = trackers
+		}
+
+		// A provider may supply several converted prices for the same market, in which case only
+		// the first is evaluated.
+		evaluated := make(map[string]struct{}, len(result.info.Providers))
+		for i, provider := range result.info.Providers {
+			if _, ok := evaluated[provider]; ok || i >= len(result.convertedPrices) || isLastGood(result.lastGood, i) {
+				continue
+			}
+			evaluated[provider] = struct{}{}
+
+			tracker, ok := trackers[provider]
+			if !ok {
+				tracker = &lagTracker{samples: make([]bool, m.providerLag.Window)}
+				trackers[provider] = tracker
+			}
+
+			price := result.convertedPrices[i]
+			tracker.add(distance(price, previous).Cmp(distance(price, result.price)) < 0)
+			m.reportProviderLag(result.ticker, provider, tracker)
+		}
+	}
+}
+
+// reportProviderLag records the lag score of the provider and logs a warning once the provider
+// starts lagging. Providers are only reported once they have been evaluated over a full window.
+func (m *IndexPriceAggregator) reportProviderLag(ticker, provider string, tracker *lagTracker) {
+	if tracker.size < len(tracker.samples) {
+		return
+	}
+
+	score := tracker.score()
+	m.metrics.UpdateProviderLag(provider, ticker, score)
+
+	lagging := score >= m.providerLag.Threshold
+	switch {
+	case lagging && !tracker.reported:
+		m.logger.Warn(
+			"provider price consistently lags the index price",
+			zap.String("target_ticker", ticker),
+			zap.String("provider", provider),
+			zap.Float64("lag_score", score),
+			zap.Int("window", m.providerLag.Window),
+		)
+	case !lagging && tracker.reported:
+		m.logger.Info(
+			"provider price no longer lags the index price",
+			zap.String("target_ticker", ticker),
+			zap.String("provider", provider),
+			zap.Float64("lag_score", score),
+		)
+	}
+
+	tracker.reported = lagging
+}
+
+// pruneLagTrackers removes the lag trackers of markets that are no longer in the market map.
+func (m *IndexPriceAggregator) pruneLagTrackers() {
+	markets := make(map[string]struct{}, len(m.cfg.Markets))
+	for _, market := range m.cfg.Markets {
+		markets[market.Ticker.String()] = struct{}{}
+	}
+
+	for ticker := range m.lagTrackers {
+		if _, ok := markets[ticker]; !ok {
+			delete(m.lagTrackers, ticker)
+		}
+	}
+}
+
+// distance returns the absolute difference between the two prices.
+func distance(a, b *big.Float) *big.Float {
+	return new(big.Float).Abs(new(big.Float).Sub(a, b))
+}
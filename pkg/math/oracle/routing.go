@@ -0,0 +1,122 @@
+package oracle
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+
+	pkgtypes "github.com/skip-mev/slinky/pkg/types"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// ParseAutoRoute returns true if the given ticker metadata JSON enables auto routing.
+func ParseAutoRoute(metadataJSON string) bool {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	return ok && metadata.AutoRoute
+}
+
+// resolveRoutes resolves the conversion paths that are routed automatically for each enabled market
+// that enables auto routing in its ticker metadata. A market BASE/QUOTE is routed through each
+// provider config of another enabled market BASE/X that supplies the price directly, i.e. without a
+// conversion, if the provider supports X as a quote currency and the market map contains an enabled
+// X/QUOTE market to normalize the price by. Providers that are already configured for the market
+// are not routed, and each provider is routed at most once per market.
+func (m *IndexPriceAggregator) resolveRoutes() {
+	routes := make(map[string][]mmtypes.ProviderConfig)
+	if len(m.quoteCurrencies) == 0 {
+		m.routes = routes
+		return
+	}
+
+	// Index the enabled markets by their base currency, sorted by ticker so that the routes are
+	// deterministic.
+	byBase := make(map[string][]mmtypes.Market)
+	for _, market := range m.cfg.Markets {
+		if market.Ticker.Enabled {
+			base := market.Ticker.CurrencyPair.Base
+			byBase[base] = append(byBase[base], market)
+		}
+	}
+	for _, markets := range byBase {
+		sort.Slice(markets, func(i, j int) bool {
+			return markets[i].Ticker.String() < markets[j].Ticker.String()
+		})
+	}
+
+	for ticker, market := range m.cfg.Markets {
+		if !market.Ticker.Enabled || !ParseAutoRoute(market.Ticker.Metadata_JSON) {
+			continue
+		}
+
+		target := market.Ticker.CurrencyPair
+		routed := make(map[string]struct{}, len(market.ProviderConfigs))
+		for _, cfg := range market.ProviderConfigs {
+			routed[cfg.Name] = struct{}{}
+		}
+
+		for _, direct := range byBase[target.Base] {
+			quote := direct.Ticker.CurrencyPair.Quote
+			if quote == target.Quote {
+				continue
+			}
+
+			normalizeBy := pkgtypes.NewCurrencyPair(quote, target.Quote)
+			if conversion, ok := m.cfg.Markets[normalizeBy.String()]; !ok || !conversion.Ticker.Enabled {
+				continue
+			}
+
+			for _, cfg := range direct.ProviderConfigs {
+				if cfg.NormalizeByPair != nil {
+					continue
+				}
+
+				if _, ok := routed[cfg.Name]; ok {
+					continue
+				}
+
+				if _, ok := m.quoteCurrencies[cfg.Name][quote]; !ok {
+					continue
+				}
+
+				routes[ticker] = append(routes[ticker], mmtypes.ProviderConfig{
+					Name:            cfg.Name,
+					OffChainTicker:  cfg.OffChainTicker,
+					NormalizeByPair: &normalizeBy,
+					Invert:          cfg.Invert,
+					Metadata_JSON:   cfg.Metadata_JSON,
+				})
+				routed[cfg.Name] = struct{}{}
+			}
+		}
+
+		if len(routes[ticker]) == 0 {
+			continue
+		}
+
+		providers := make([]string, len(routes[ticker]))
+		for i, cfg := range routes[ticker] {
+			providers[i] = cfg.Name
+		}
+
+		m.logger.Debug(
+			"routed conversion paths for market",
+			zap.String("target_ticker", ticker),
+			zap.Strings("providers", providers),
+		)
+	}
+
+	m.routes = routes
+}
+
+// providerConfigs returns the provider configs of the given market, followed by the conversion
+// paths that are routed automatically for the market, if any.
+func (m *IndexPriceAggregator) providerConfigs(market mmtypes.Market) []mmtypes.ProviderConfig {
+	routes := m.routes[market.Ticker.String()]
+	if len(routes) == 0 {
+		return market.ProviderConfigs
+	}
+
+	cfgs := make([]mmtypes.ProviderConfig, 0, len(market.ProviderConfigs)+len(routes))
+	cfgs = append(cfgs, market.ProviderConfigs...)
+	return append(cfgs, routes...)
+}
@@ -0,0 +1,54 @@
+package oracle
+
+import (
+	"math/big"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// updateSpreads records, for each priced market, the spread between the highest and lowest
+// converted provider prices that contributed to its price, scaled by the decimals of the market.
+// The spread relative to the index price is exposed via metrics. Prices resolved without any
+// converted prices, e.g. by the last known fallback, do not have a spread. This is a no-op if the
+// spread is not enabled.
+func (m *IndexPriceAggregator) updateSpreads(results []marketPrice, priceInfo map[string]types.PriceInfo) {
+	if !m.providerSpread {
+		return
+	}
+
+	for _, result := range results {
+		if result.price == nil || len(result.convertedPrices) == 0 {
+			continue
+		}
+
+		spread := priceSpread(result.convertedPrices)
+
+		info := priceInfo[result.ticker]
+		info.Spread = math.ScaleBigFloat(new(big.Float).Set(spread), info.Decimals)
+		priceInfo[result.ticker] = info
+
+		if result.price.Sign() == 0 {
+			continue
+		}
+
+		relative, _ := new(big.Float).Quo(spread, result.price).Float64()
+		m.metrics.UpdateProviderSpread(result.ticker, relative)
+	}
+}
+
+// priceSpread returns the difference between the highest and lowest of the given prices.
+func priceSpread(prices []*big.Float) *big.Float {
+	low, high := prices[0], prices[0]
+	for _, price := range prices[1:] {
+		if price.Cmp(low) < 0 {
+			low = price
+		}
+
+		if price.Cmp(high) > 0 {
+			high = price
+		}
+	}
+
+	return new(big.Float).Sub(high, low)
+}
@@ -0,0 +1,57 @@
+package oracle
+
+import (
+	"fmt"
+	"time"
+
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// SetProviderTimestamps updates the data aggregator with the time at which each of the latest
+// prices of the given provider was produced, indexed by off-chain ticker. These are only utilized
+// by the per-provider staleness filter.
+func (m *IndexPriceAggregator) SetProviderTimestamps(provider string, timestamps map[string]time.Time) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if timestamps == nil {
+		timestamps = make(map[string]time.Time)
+	}
+
+	m.providerTimestamps[provider] = timestamps
+}
+
+// isStale returns the age of the latest price of the given provider's off-chain ticker, and whether
+// the price is older than the staleness threshold of the provider. Prices of providers without a
+// threshold, and prices without a timestamp, are never stale.
+func (m *IndexPriceAggregator) isStale(provider, offChainTicker string, now time.Time) (time.Duration, bool) {
+	threshold, ok := m.providerStaleness[provider]
+	if !ok {
+		return 0, false
+	}
+
+	timestamp, ok := m.providerTimestamps[provider][offChainTicker]
+	if !ok {
+		return 0, false
+	}
+
+	age := now.Sub(timestamp)
+	return age, age > threshold
+}
+
+// checkStaleness returns an error if the latest price of the given provider config is older than
+// the staleness threshold of its provider.
+func (m *IndexPriceAggregator) checkStaleness(cfg mmtypes.ProviderConfig, now time.Time) error {
+	age, stale := m.isStale(cfg.Name, cfg.OffChainTicker, now)
+	if !stale {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%s price for ticker %s is stale: age %s exceeds staleness threshold %s",
+		cfg.Name,
+		cfg.OffChainTicker,
+		age,
+		m.providerStaleness[cfg.Name],
+	)
+}
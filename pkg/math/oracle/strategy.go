@@ -0,0 +1,272 @@
+package oracle
+
+import (
+	"encoding/json"
+	"fmt"
+	gomath "math"
+	"math/big"
+	"time"
+
+	"github.com/holiman/uint256"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/pkg/math"
+)
+
+// AggregationStrategy is the strategy used to aggregate the converted prices of a market into
+// a single index price.
+type AggregationStrategy string
+
+const (
+	// MedianAggregation takes the median of the converted prices. If a provider weight function
+	// is configured, the weighted median is used instead. This is the default strategy.
+	MedianAggregation AggregationStrategy = "median"
+	// MeanAggregation takes the arithmetic mean of the converted prices.
+	MeanAggregation AggregationStrategy = "mean"
+	// FirstAggregation takes the converted price of the first provider, in the order of the
+	// market's provider configs, that has a price. This is useful for illiquid markets where
+	// a single primary venue should be preferred over the remaining providers.
+	FirstAggregation AggregationStrategy = "first"
+	// HuberAggregation takes the Huber M-estimate of the converted prices, a robust mean that
+	// continuously down-weights prices that deviate from the bulk of the prices by more than the
+	// market's Huber k times their scaled median absolute deviation.
+	HuberAggregation AggregationStrategy = "huber"
+	// TWAPAggregation takes the median of the time-weighted average of each provider's converted
+	// prices over the market's aggregation window. This smooths the price of thinly traded markets,
+	// whose providers update infrequently and whose median jumps between snapshots.
+	TWAPAggregation AggregationStrategy = "twap"
+)
+
+// ValidateBasic returns an error if the aggregation strategy is not supported.
+func (s AggregationStrategy) ValidateBasic() error {
+	switch s {
+	case MedianAggregation, MeanAggregation, FirstAggregation, HuberAggregation, TWAPAggregation:
+		return nil
+	default:
+		return fmt.Errorf("unknown aggregation strategy %q", s)
+	}
+}
+
+// TickerMetadata is the subset of a ticker's metadata JSON that is consumed by the aggregator.
+type TickerMetadata struct {
+	// Aggregation is the aggregation strategy to use for the ticker. If empty, the aggregator's
+	// default strategy is used.
+	Aggregation AggregationStrategy `json:"aggregation"`
+
+	// HuberK is the tuning constant of the huber aggregation strategy, in units of the scaled
+	// median absolute deviation of the prices. If nil, the aggregator's default is used.
+	HuberK *float64 `json:"huberK,omitempty"`
+
+	// AggregationWindow is the trailing window over which the twap aggregation strategy averages
+	// the converted prices of each provider. If nil, the aggregator's default window is used.
+	AggregationWindow *time.Duration `json:"aggregationWindow,omitempty"`
+
+	// ProviderWeights overrides the weight of individual providers for the ticker, on top of the
+	// aggregator's provider weight function, regardless of the aggregation strategy. Providers
+	// that are not listed keep their weight, and a weight of 0 excludes the provider.
+	ProviderWeights map[string]float64 `json:"providerWeights,omitempty"`
+
+	// TWAP configures the time-weighted average price of the ticker. If nil, no TWAP is
+	// maintained for the ticker.
+	TWAP *TWAPConfig `json:"twap,omitempty"`
+
+	// MinSignificantDigits is the minimum number of significant digits of the ticker's scaled
+	// price, below which a warning is logged if the ticker is derived through a conversion. If
+	// nil, the aggregator's default minimum is used. A value of 0 disables the check.
+	MinSignificantDigits *int `json:"minSignificantDigits,omitempty"`
+
+	// AutoRoute enables the automatic routing of the ticker's price through the quote currencies
+	// supported by each provider, in addition to the ticker's provider configs.
+	AutoRoute bool `json:"autoRoute,omitempty"`
+}
+
+// parseTickerMetadata parses the given ticker metadata JSON, and returns false if the metadata is
+// empty or not a JSON object. Ticker metadata is free form, so such metadata is not an error, and
+// does not configure any of the aggregator's settings for the ticker.
+func parseTickerMetadata(metadataJSON string) (TickerMetadata, bool) {
+	var metadata TickerMetadata
+	if len(metadataJSON) == 0 {
+		return metadata, false
+	}
+
+	if err := json.Unmarshal([]byte(metadataJSON), &metadata); err != nil {
+		return metadata, false
+	}
+
+	return metadata, true
+}
+
+// ParseAggregationStrategy returns the aggregation strategy configured in the given ticker
+// metadata JSON. If the metadata does not configure a strategy, the default strategy is
+// returned. An error is returned if the configured strategy is not supported.
+func ParseAggregationStrategy(metadataJSON string, defaultStrategy AggregationStrategy) (AggregationStrategy, error) {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	if !ok || len(metadata.Aggregation) == 0 {
+		return defaultStrategy, nil
+	}
+
+	if err := metadata.Aggregation.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	return metadata.Aggregation, nil
+}
+
+// ParseHuberK returns the tuning constant of the huber aggregation strategy configured in the given
+// ticker metadata JSON. If the metadata does not configure a constant, the default constant is
+// returned. An error is returned if the configured constant is not positive.
+func ParseHuberK(metadataJSON string, defaultK float64) (float64, error) {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	if !ok || metadata.HuberK == nil {
+		return defaultK, nil
+	}
+
+	if err := validateHuberK(*metadata.HuberK); err != nil {
+		return 0, err
+	}
+
+	return *metadata.HuberK, nil
+}
+
+// validateHuberK returns an error if the given tuning constant of the huber aggregation strategy
+// is not a positive, finite number.
+func validateHuberK(k float64) error {
+	if !(k > 0) || gomath.IsInf(k, 1) {
+		return fmt.Errorf("huber k must be positive and finite; got %v", k)
+	}
+
+	return nil
+}
+
+// ParseAggregationWindow returns the window of the twap aggregation strategy configured in the given
+// ticker metadata JSON. If the metadata does not configure a window, the default window is returned.
+// An error is returned if the configured window is not positive.
+func ParseAggregationWindow(metadataJSON string, defaultWindow time.Duration) (time.Duration, error) {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	if !ok || metadata.AggregationWindow == nil {
+		return defaultWindow, nil
+	}
+
+	if *metadata.AggregationWindow <= 0 {
+		return 0, fmt.Errorf("aggregation window must be greater than 0; got %s", *metadata.AggregationWindow)
+	}
+
+	return *metadata.AggregationWindow, nil
+}
+
+// aggregate aggregates the converted prices of a market using the given strategy. The prices,
+// providers, last good flags, volume weights and twap keys are expected to be in the order of the
+// market's provider configs.
+func (m *IndexPriceAggregator) aggregate(
+	now time.Time,
+	ticker string,
+	prices []*big.Float,
+	providers []string,
+	lastGood []bool,
+	volumes []*uint256.Int,
+	twapKeys []string,
+) *big.Float {
+	// Markets without provider weight overrides retain the unweighted behavior of the mean, huber
+	// and first strategies.
+	weighted := len(m.providerWeights[ticker]) > 0
+
+	switch m.aggregationStrategies[ticker] {
+	case MeanAggregation:
+		if weighted {
+			if mean := math.CalculateWeightedMean(prices, m.priceWeights(ticker, providers, lastGood)); mean != nil {
+				return mean
+			}
+
+			m.logNoPositiveWeights(ticker, providers)
+		}
+
+		return math.CalculateMean(prices)
+	case HuberAggregation:
+		if weighted {
+			weights := m.priceWeights(ticker, providers, lastGood)
+			if estimate := math.CalculateWeightedHuberMean(prices, weights, m.huberKs[ticker]); estimate != nil {
+				return estimate
+			}
+
+			m.logNoPositiveWeights(ticker, providers)
+		}
+
+		return math.CalculateHuberMean(prices, m.huberKs[ticker])
+	case FirstAggregation:
+		if len(prices) == 0 {
+			return nil
+		}
+
+		if weighted {
+			for i, weight := range m.priceWeights(ticker, providers, lastGood) {
+				if weight.Sign() > 0 {
+					return prices[i]
+				}
+			}
+
+			m.logNoPositiveWeights(ticker, providers)
+		}
+
+		return prices[0]
+	case TWAPAggregation:
+		return m.aggregateTWAP(now, ticker, prices, providers, lastGood, volumes, twapKeys)
+	default:
+		return m.calculateMedian(ticker, prices, providers, lastGood, volumes)
+	}
+}
+
+// ParseProviderWeights returns the provider weight overrides configured in the given ticker metadata
+// JSON. If the metadata does not configure overrides, nil is returned. An error is returned if a
+// configured weight is negative or not finite.
+func ParseProviderWeights(metadataJSON string) (map[string]float64, error) {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	if !ok || len(metadata.ProviderWeights) == 0 {
+		return nil, nil
+	}
+
+	for provider, weight := range metadata.ProviderWeights {
+		if !(weight >= 0) || gomath.IsInf(weight, 1) {
+			return nil, fmt.Errorf("weight of provider %s must be non-negative and finite; got %v", provider, weight)
+		}
+	}
+
+	return metadata.ProviderWeights, nil
+}
+
+// priceWeights returns the weight of each converted price of the market. Each price is weighted by
+// the weight of the provider that supplied it, as returned by the provider weight function, if any,
+// multiplied by the market's weight override of the provider, if any. Last good prices are
+// additionally weighted by the configured last good weight.
+func (m *IndexPriceAggregator) priceWeights(ticker string, providers []string, lastGood []bool) []*big.Float {
+	overrides := m.providerWeights[ticker]
+
+	weights := make([]*big.Float, len(providers))
+	for i, provider := range providers {
+		weight := 1.0
+		if m.providerWeightFn != nil {
+			weight = m.providerWeightFn(provider)
+		}
+
+		if override, ok := overrides[provider]; ok {
+			weight *= override
+		}
+
+		if i < len(lastGood) && lastGood[i] {
+			weight *= m.lastGoodWeight
+		}
+
+		weights[i] = big.NewFloat(weight)
+	}
+
+	return weights
+}
+
+// logNoPositiveWeights logs that none of the prices of the market have a positive weight, in which
+// case the prices are weighted equally.
+func (m *IndexPriceAggregator) logNoPositiveWeights(ticker string, providers []string) {
+	m.logger.Debug(
+		"no providers with a positive weight; falling back to equal weighting",
+		zap.String("target_ticker", ticker),
+		zap.Strings("providers", providers),
+	)
+}
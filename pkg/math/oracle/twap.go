@@ -0,0 +1,295 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/holiman/uint256"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// MaxTWAPSamples is the maximum number of index price samples that a market's TWAP window may span
+// i.e. the maximum ratio of the window to the sample interval.
+const MaxTWAPSamples = 10_000
+
+// TWAPConfig is the configuration of a market's time-weighted average price (TWAP). It is set via
+// the `twap` field of the ticker's metadata JSON.
+type TWAPConfig struct {
+	// Window is the length of the trailing window over which the index price is averaged.
+	Window time.Duration `json:"window"`
+
+	// SampleInterval is the minimum amount of time between two samples of the index price.
+	SampleInterval time.Duration `json:"sampleInterval"`
+}
+
+// ValidateBasic performs basic validation of the TWAP config.
+func (c TWAPConfig) ValidateBasic() error {
+	if c.Window <= 0 {
+		return fmt.Errorf("twap window must be greater than 0")
+	}
+
+	if c.SampleInterval <= 0 || c.SampleInterval > c.Window {
+		return fmt.Errorf("twap sample interval must be greater than 0 and at most the window")
+	}
+
+	if c.Window/c.SampleInterval > MaxTWAPSamples {
+		return fmt.Errorf("twap window cannot span more than %d sample intervals", MaxTWAPSamples)
+	}
+
+	return nil
+}
+
+// ParseTWAPConfig returns the TWAP config set in the given ticker metadata JSON. This returns nil
+// if the metadata does not configure a TWAP, and an error if the configured TWAP is invalid.
+func ParseTWAPConfig(metadataJSON string) (*TWAPConfig, error) {
+	metadata, ok := parseTickerMetadata(metadataJSON)
+	if !ok || metadata.TWAP == nil {
+		return nil, nil
+	}
+
+	if err := metadata.TWAP.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return metadata.TWAP, nil
+}
+
+// twapSample is a sample of a market's index price.
+type twapSample struct {
+	price     *big.Float
+	timestamp time.Time
+}
+
+// twapBuffer is a fixed size ring buffer of a market's most recent index price samples, from which
+// the market's TWAP is calculated. The buffer holds enough samples to span the entire window,
+// along with the sample that precedes it.
+type twapBuffer struct {
+	cfg     TWAPConfig
+	samples []twapSample
+	// start is the index of the oldest sample.
+	start int
+	// size is the number of samples in the buffer.
+	size int
+}
+
+// newTWAPBuffer returns a new, empty buffer for the given TWAP config.
+func newTWAPBuffer(cfg TWAPConfig) *twapBuffer {
+	return &twapBuffer{
+		cfg:     cfg,
+		samples: make([]twapSample, int(cfg.Window/cfg.SampleInterval)+2),
+	}
+}
+
+// at returns the i-th oldest sample in the buffer.
+func (b *twapBuffer) at(i int) twapSample {
+	return b.samples[(b.start+i)%len(b.samples)]
+}
+
+// add records the given index price if at least the sample interval has elapsed since the last
+// sample. Once the buffer is full, the oldest sample is overwritten.
+func (b *twapBuffer) add(price *big.Float, now time.Time) {
+	if b.size > 0 && now.Sub(b.at(b.size-1).timestamp) < b.cfg.SampleInterval {
+		return
+	}
+
+	sample := twapSample{
+		price:     new(big.Float).Copy(price),
+		timestamp: now,
+	}
+
+	if b.size < len(b.samples) {
+		b.samples[(b.start+b.size)%len(b.samples)] = sample
+		b.size++
+		return
+	}
+
+	b.samples[b.start] = sample
+	b.start = (b.start + 1) % len(b.samples)
+}
+
+// average returns the time-weighted average of the sampled index prices over the window ending at
+// now. Each sample is weighted by the amount of time within the window that it was the latest
+// sample. This returns nil if there is no sample within the window.
+func (b *twapBuffer) average(now time.Time) *big.Float {
+	if b.size == 0 {
+		return nil
+	}
+
+	windowStart := now.Add(-b.cfg.Window)
+	latest := b.at(b.size - 1)
+	if latest.timestamp.Before(windowStart) {
+		return nil
+	}
+
+	var (
+		sum   = new(big.Float)
+		total time.Duration
+	)
+	for i := 0; i < b.size; i++ {
+		sample := b.at(i)
+
+		end := now
+		if i+1 < b.size {
+			end = b.at(i + 1).timestamp
+		}
+
+		begin := sample.timestamp
+		if begin.Before(windowStart) {
+			begin = windowStart
+		}
+
+		held := end.Sub(begin)
+		if held <= 0 {
+			continue
+		}
+
+		sum.Add(sum, new(big.Float).Mul(sample.price, new(big.Float).SetInt64(int64(held))))
+		total += held
+	}
+
+	// The latest sample was taken at now, and is the only sample within the window.
+	if total == 0 {
+		return new(big.Float).Copy(latest.price)
+	}
+
+	return sum.Quo(sum, new(big.Float).SetInt64(int64(total)))
+}
+
+// resolveTWAPConfigs resolves the TWAP config of each market in the market map. The samples of
+// markets whose config is unchanged are retained. Markets that configure an invalid TWAP do not
+// maintain a TWAP and an error is returned.
+func (m *IndexPriceAggregator) resolveTWAPConfigs() error {
+	twaps := make(map[string]*twapBuffer)
+
+	var errs []error
+	for _, market := range m.cfg.Markets {
+		ticker := market.Ticker.String()
+		cfg, err := ParseTWAPConfig(market.Ticker.Metadata_JSON)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid twap config for %s: %w", ticker, err))
+			continue
+		}
+
+		if cfg == nil {
+			continue
+		}
+
+		if buffer, ok := m.twaps[ticker]; ok && buffer.cfg == *cfg {
+			twaps[ticker] = buffer
+			continue
+		}
+
+		twaps[ticker] = newTWAPBuffer(*cfg)
+	}
+
+	m.twaps = twaps
+	return errors.Join(errs...)
+}
+
+// updateTWAPs samples the index price of each market that maintains a TWAP, and sets the scaled
+// TWAP in the info of each market that was priced in the current aggregation.
+func (m *IndexPriceAggregator) updateTWAPs(now time.Time, results []marketPrice, priceInfo map[string]types.PriceInfo) {
+	for _, result := range results {
+		buffer, ok := m.twaps[result.ticker]
+		if !ok || result.price == nil {
+			continue
+		}
+
+		buffer.add(result.price, now)
+
+		twap := buffer.average(now)
+		if twap == nil {
+			continue
+		}
+
+		info := priceInfo[result.ticker]
+		info.TWAP = math.ScaleBigFloat(twap, info.Decimals)
+		priceInfo[result.ticker] = info
+	}
+}
+
+const (
+	// DefaultAggregationWindow is the window of the twap aggregation strategy for markets that do
+	// not configure one in their ticker metadata.
+	DefaultAggregationWindow = time.Minute
+
+	// maxProviderTWAPSamples is the maximum number of converted prices that are sampled per window
+	// for each provider of a market that uses the twap aggregation strategy.
+	maxProviderTWAPSamples = 256
+)
+
+// providerTWAPBuffers is the sampled converted price history of the providers of a market that
+// uses the twap aggregation strategy. A provider that supplies several converted prices for the
+// market, e.g. through different conversion paths, has a separate history for each of them, keyed
+// by providerTWAPKey.
+type providerTWAPBuffers struct {
+	window  time.Duration
+	buffers map[string]*twapBuffer
+}
+
+// newProviderTWAPBuffers returns an empty history for the given aggregation window.
+func newProviderTWAPBuffers(window time.Duration) *providerTWAPBuffers {
+	return &providerTWAPBuffers{
+		window:  window,
+		buffers: make(map[string]*twapBuffer),
+	}
+}
+
+// providerTWAPKey returns the key of the sampled converted price history of the given provider
+// config, which identifies the provider's conversion path for the market.
+func providerTWAPKey(cfg mmtypes.ProviderConfig) string {
+	if cfg.NormalizeByPair == nil {
+		return cfg.Name + "/" + cfg.OffChainTicker
+	}
+
+	return cfg.Name + "/" + cfg.OffChainTicker + "/" + cfg.NormalizeByPair.String()
+}
+
+// aggregateTWAP samples the converted prices of a market that uses the twap aggregation strategy,
+// and returns the median of the time-weighted average of each provider's converted prices over the
+// market's aggregation window. A provider with a single sample is averaged to its latest price.
+// Providers without a converted price in the current aggregation are ignored, and their history is
+// dropped once it falls out of the window. This must be safe to call concurrently for different
+// markets.
+func (m *IndexPriceAggregator) aggregateTWAP(
+	now time.Time,
+	ticker string,
+	prices []*big.Float,
+	providers []string,
+	lastGood []bool,
+	volumes []*uint256.Int,
+	keys []string,
+) *big.Float {
+	history := m.providerTWAPs[ticker]
+	cfg := TWAPConfig{
+		Window:         history.window,
+		SampleInterval: max(history.window/maxProviderTWAPSamples, 1),
+	}
+
+	averages := make([]*big.Float, len(prices))
+	sampled := make(map[string]struct{}, len(prices))
+	for i, key := range keys {
+		buffer, ok := history.buffers[key]
+		if !ok {
+			buffer = newTWAPBuffer(cfg)
+			history.buffers[key] = buffer
+		}
+
+		buffer.add(prices[i], now)
+		averages[i] = buffer.average(now)
+		sampled[key] = struct{}{}
+	}
+
+	for key, buffer := range history.buffers {
+		if _, ok := sampled[key]; !ok && buffer.average(now) == nil {
+			delete(history.buffers, key)
+		}
+	}
+
+	return m.calculateMedian(ticker, averages, providers, lastGood, volumes)
+}
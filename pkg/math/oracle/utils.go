@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"maps"
 	"math/big"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/types"
 	pkgtypes "github.com/skip-mev/slinky/pkg/types"
@@ -30,12 +34,38 @@ func (m *IndexPriceAggregator) GetProviderPrice(
 	}
 
 	if cfg.Invert {
-		return new(big.Float).Quo(big.NewFloat(1), price), nil
+		inverted, err := invertPrice(price)
+		if err != nil {
+			return nil, fmt.Errorf("failed to invert %s price for ticker %s: %w", cfg.Name, cfg.OffChainTicker, err)
+		}
+
+		return inverted, nil
 	}
 
 	return price, nil
 }
 
+// invertedPricePrecision is the precision, in bits, of inverted prices. The reciprocal of a price is
+// rarely exact, so it is computed with as many bits as a uint256 price once scaled to the decimals of
+// its market, rather than with the 53 bits of a float64.
+const invertedPricePrecision = 256
+
+// invertPrice returns the reciprocal of the given price, i.e. the price of the quote denominated in the
+// base. Prices are inverted before they are scaled to the decimals of the market, so the inverted price
+// is scaled like any other price. Zero prices cannot be inverted, and are rejected.
+func invertPrice(price *big.Float) (*big.Float, error) {
+	if price.Sign() == 0 {
+		return nil, fmt.Errorf("cannot invert a zero price")
+	}
+
+	if price.IsInf() {
+		return nil, fmt.Errorf("cannot invert an infinite price")
+	}
+
+	one := new(big.Float).SetPrec(invertedPricePrecision).SetInt64(1)
+	return new(big.Float).SetPrec(invertedPricePrecision).Quo(one, price), nil
+}
+
 // GetIndexPrice returns the relevant index price. Note that the aggregator's
 // index price cache stores prices in the form of ticker -> price.
 func (m *IndexPriceAggregator) GetIndexPrice(
@@ -80,6 +110,22 @@ func (m *IndexPriceAggregator) UpdateMarketMap(marketMap mmtypes.MarketMap) {
 	defer m.mtx.Unlock()
 
 	m.cfg = marketMap
+	m.trackMarkets(time.Now().UTC())
+	m.resolveRoutes()
+	if err := m.resolveAggregationStrategies(); err != nil {
+		m.logger.Error("market map contains invalid aggregation strategies; using default strategy", zap.Error(err))
+	}
+
+	if err := m.resolveTWAPConfigs(); err != nil {
+		m.logger.Error("market map contains invalid twap configs; twaps are disabled for those markets", zap.Error(err))
+	}
+
+	if err := m.resolveMinSignificantDigits(); err != nil {
+		m.logger.Error("market map contains invalid min significant digits; using default minimum", zap.Error(err))
+	}
+
+	m.pruneLagTrackers()
+	m.pruneCollapseTrackers()
 }
 
 // GetMarketMap returns the market map for the oracle.
@@ -90,6 +136,51 @@ func (m *IndexPriceAggregator) GetMarketMap() *mmtypes.MarketMap {
 	return &m.cfg
 }
 
+// GetProviderTickers returns the off-chain tickers, indexed by provider, whose prices contribute to
+// the given markets. This includes the tickers of the markets that the given markets are
+// normalized by. Markets that are not in the market map are ignored.
+func (m *IndexPriceAggregator) GetProviderTickers(pairs []string) map[string][]string {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	tickers := make(map[string][]string)
+	visited := make(map[string]struct{})
+	seen := make(map[string]map[string]struct{})
+
+	var visit func(pair string)
+	visit = func(pair string) {
+		if _, ok := visited[pair]; ok {
+			return
+		}
+		visited[pair] = struct{}{}
+
+		market, ok := m.cfg.Markets[pair]
+		if !ok {
+			return
+		}
+
+		for _, cfg := range m.providerConfigs(market) {
+			if _, ok := seen[cfg.Name]; !ok {
+				seen[cfg.Name] = make(map[string]struct{})
+			}
+			if _, ok := seen[cfg.Name][cfg.OffChainTicker]; !ok {
+				seen[cfg.Name][cfg.OffChainTicker] = struct{}{}
+				tickers[cfg.Name] = append(tickers[cfg.Name], cfg.OffChainTicker)
+			}
+
+			if cfg.NormalizeByPair != nil {
+				visit(cfg.NormalizeByPair.String())
+			}
+		}
+	}
+
+	for _, pair := range pairs {
+		visit(pair)
+	}
+
+	return tickers
+}
+
 // SetProviderPrices updates the data aggregator with the given provider and data.
 func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Prices) {
 	m.mtx.Lock()
@@ -108,6 +199,76 @@ func (m *IndexPriceAggregator) Reset() {
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.providerExclusions = make(map[string]map[string]types.ExclusionReason)
+	m.providerVolumes = make(map[string]types.Prices)
+	m.providerTimestamps = make(map[string]map[string]time.Time)
+}
+
+// ResetProvider resets the data aggregator for the given provider, including the provider's last
+// good prices and the sampled history of its converted prices, so that none of the provider's
+// prices are aggregated until it reports new prices.
+func (m *IndexPriceAggregator) ResetProvider(provider string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.providerPrices, provider)
+	delete(m.providerExclusions, provider)
+	delete(m.providerVolumes, provider)
+	delete(m.providerTimestamps, provider)
+	delete(m.lastGoodPrices, provider)
+
+	for _, history := range m.providerTWAPs {
+		for key := range history.buffers {
+			if strings.HasPrefix(key, provider+"/") {
+				delete(history.buffers, key)
+			}
+		}
+	}
+}
+
+// GetMissingPrices returns the enabled markets that failed to resolve a price in the last
+// aggregation. Markets that were added to the aggregator within the no data grace period
+// are returned as warming up, all others are returned as failing.
+func (m *IndexPriceAggregator) GetMissingPrices() (warmingUp []string, failing []string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	warmingUp = make([]string, len(m.warmingUp))
+	copy(warmingUp, m.warmingUp)
+
+	failing = make([]string, len(m.failing))
+	copy(failing, m.failing)
+
+	return warmingUp, failing
+}
+
+// GetPriceInfo returns the metadata of each price aggregated in the last aggregation.
+func (m *IndexPriceAggregator) GetPriceInfo() map[string]types.PriceInfo {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(map[string]types.PriceInfo, len(m.priceInfo))
+	for ticker, info := range m.priceInfo {
+		providers := make([]string, len(info.Providers))
+		copy(providers, info.Providers)
+		cpyInfo := types.PriceInfo{
+			Decimals:  info.Decimals,
+			Providers: providers,
+			Source:    info.Source,
+		}
+
+		if info.TWAP != nil {
+			cpyInfo.TWAP = new(big.Float).Copy(info.TWAP)
+		}
+
+		if info.Spread != nil {
+			cpyInfo.Spread = new(big.Float).Copy(info.Spread)
+		}
+
+		cpy[ticker] = cpyInfo
+	}
+
+	return cpy
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -8,7 +8,10 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/constants"
 	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
 	"github.com/skip-mev/slinky/pkg/math/oracle"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/coinbase"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
 
@@ -79,6 +82,46 @@ func TestGetProviderPrice(t *testing.T) {
 		require.Equal(t, big.NewFloat(0.01).SetPrec(18), price.SetPrec(18))
 	})
 
+	t.Run("inverted price is exact to the decimals of the market", func(t *testing.T) {
+		agg, err := oracle.NewIndexPriceAggregator(logger, marketmap, nil)
+		require.NoError(t, err)
+
+		cfg := mmtypes.ProviderConfig{
+			Name:           "test",
+			OffChainTicker: "BTC/USD",
+			Invert:         true,
+		}
+		prices := types.Prices{
+			"BTC/USD": big.NewFloat(3),
+		}
+		agg.SetProviderPrices("test", prices)
+
+		price, err := agg.GetProviderPrice(cfg)
+		require.NoError(t, err)
+
+		scaled, err := math.BigFloatToBigInt(price, 18)
+		require.NoError(t, err)
+		require.Equal(t, "333333333333333333", scaled.String())
+	})
+
+	t.Run("provider price is zero, invert is true", func(t *testing.T) {
+		agg, err := oracle.NewIndexPriceAggregator(logger, marketmap, nil)
+		require.NoError(t, err)
+
+		cfg := mmtypes.ProviderConfig{
+			Name:           "test",
+			OffChainTicker: "BTC/USD",
+			Invert:         true,
+		}
+		prices := types.Prices{
+			"BTC/USD": big.NewFloat(0),
+		}
+		agg.SetProviderPrices("test", prices)
+
+		_, err = agg.GetProviderPrice(cfg)
+		require.ErrorContains(t, err, "cannot invert a zero price")
+	})
+
 	t.Run("provider price is nil", func(t *testing.T) {
 		agg, err := oracle.NewIndexPriceAggregator(logger, marketmap, nil)
 		require.NoError(t, err)
@@ -133,3 +176,24 @@ func TestGetIndexPrice(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGetProviderTickers(t *testing.T) {
+	mm, _, _ := largeMarketMap(1)
+	agg, err := oracle.NewIndexPriceAggregator(logger, mm, nil)
+	require.NoError(t, err)
+
+	t.Run("includes the tickers of the markets a market is normalized by", func(t *testing.T) {
+		tickers := agg.GetProviderTickers([]string{"TOKEN0/USD"})
+		require.Len(t, tickers, 2)
+		require.ElementsMatch(t, []string{"TOKEN0-USD", "TOKEN0-USDT", "USDT-USD"}, tickers[coinbase.Name])
+		require.ElementsMatch(t, []string{"TOKEN0USD", "USDTUSD"}, tickers[binance.Name])
+	})
+
+	t.Run("ignores markets that are not in the market map", func(t *testing.T) {
+		tickers := agg.GetProviderTickers([]string{"USDT/USD", "MOG/USD"})
+		require.Equal(t, map[string][]string{
+			coinbase.Name: {"USDT-USD"},
+			binance.Name:  {"USDTUSD"},
+		}, tickers)
+	})
+}
@@ -0,0 +1,60 @@
+package oracle
+
+import (
+	gomath "math"
+
+	"github.com/holiman/uint256"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// SetProviderVolumes updates the data aggregator with the volumes reported alongside the latest
+// prices of the given provider, indexed by off-chain ticker. Prices without a reported volume are
+// omitted.
+func (m *IndexPriceAggregator) SetProviderVolumes(provider string, volumes types.Prices) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if volumes == nil {
+		volumes = make(types.Prices)
+	}
+
+	m.providerVolumes[provider] = volumes
+}
+
+// overrideWeightPrecision is the fixed-point precision with which a market's provider weight
+// overrides scale the volume weights of its prices, so that only integer arithmetic is used on them.
+const overrideWeightPrecision = 1_000_000
+
+// volumeWeight returns the volume weight of the converted price of the given provider config. Last
+// good prices, and prices whose provider did not report a volume, have the default volume weight.
+// The volume weight is scaled by the market's weight override of the provider, if any.
+func (m *IndexPriceAggregator) volumeWeight(ticker string, cfg mmtypes.ProviderConfig, isLastGood bool) *uint256.Int {
+	weight := m.defaultVolumeWeight
+	if !isLastGood {
+		if volume, ok := m.providerVolumes[cfg.Name][cfg.OffChainTicker]; ok {
+			weight = math.VolumeWeight(volume)
+		}
+	}
+
+	return new(uint256.Int).Mul(weight, uint256.NewInt(m.overrideWeightScale(ticker, cfg.Name)))
+}
+
+// overrideWeightScale returns the market's weight override of the given provider in fixed-point
+// with overrideWeightPrecision, or overrideWeightPrecision if the provider has no override. The
+// scale is clamped to the maximum uint64 so that scaled volume weights cannot overflow.
+func (m *IndexPriceAggregator) overrideWeightScale(ticker, provider string) uint64 {
+	override, ok := m.providerWeights[ticker][provider]
+	if !ok {
+		return overrideWeightPrecision
+	}
+
+	scaled := gomath.Round(override * overrideWeightPrecision)
+	if scaled >= gomath.MaxUint64 {
+		return gomath.MaxUint64
+	}
+
+	return uint64(scaled)
+}
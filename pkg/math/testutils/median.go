@@ -1,6 +1,7 @@
 package testutils
 
 import (
+	"context"
 	"math/big"
 	"sync"
 
@@ -40,7 +41,7 @@ func (m *MedianAggregator) SetProviderPrices(provider string, data types.Prices)
 
 // AggregatePrices inputs the aggregated prices from all providers and computes
 // the median price for each asset.
-func (m *MedianAggregator) AggregatePrices() {
+func (m *MedianAggregator) AggregatePrices(context.Context) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
@@ -82,6 +83,36 @@ func (m *MedianAggregator) GetPrices() types.Prices {
 	return m.finalPrices
 }
 
+// GetMissingPrices returns no missing prices as the median aggregator only aggregates
+// the prices it is given.
+func (m *MedianAggregator) GetMissingPrices() ([]string, []string) {
+	return nil, nil
+}
+
+// GetPriceInfo returns no price info as the median aggregator is not aware of the
+// decimals of each price.
+func (m *MedianAggregator) GetPriceInfo() map[string]types.PriceInfo {
+	return nil
+}
+
+// GetProviderTickers returns, for each provider that last reported prices, the given pairs it
+// reported as the median aggregator uses the pairs as off-chain tickers.
+func (m *MedianAggregator) GetProviderTickers(pairs []string) map[string][]string {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	tickers := make(map[string][]string)
+	for provider, providerPrices := range m.providerPrices {
+		for _, pair := range pairs {
+			if _, ok := providerPrices[pair]; ok {
+				tickers[provider] = append(tickers[provider], pair)
+			}
+		}
+	}
+
+	return tickers
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *MedianAggregator) Reset() {
 	m.mtx.Lock()
@@ -306,6 +306,95 @@ func (s *MathTestSuite) TestMedian() {
 	}
 }
 
+func (s *MathTestSuite) TestMedianWeighting() {
+	btcUSD := slinkytypes.CurrencyPair{Base: "BTC", Quote: "USD"}
+	validators := []validator{
+		{
+			stake:    sdkmath.NewInt(10),
+			consAddr: validator1,
+		},
+		{
+			stake:    sdkmath.NewInt(10),
+			consAddr: validator2,
+		},
+		{
+			stake:    sdkmath.NewInt(100),
+			consAddr: validator3,
+		},
+	}
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): {btcUSD: big.NewInt(100)},
+		validator2.String(): {btcUSD: big.NewInt(200)},
+		validator3.String(): {btcUSD: big.NewInt(300)},
+	}
+
+	cases := []struct {
+		name          string
+		opts          []voteweighted.Option
+		expectedPrice *big.Int
+	}{
+		{
+			name:          "stake weighting by default",
+			expectedPrice: big.NewInt(300),
+		},
+		{
+			name:          "stake weighting",
+			opts:          []voteweighted.Option{voteweighted.WithWeighting(voteweighted.StakeWeighting)},
+			expectedPrice: big.NewInt(300),
+		},
+		{
+			name:          "equal weighting",
+			opts:          []voteweighted.Option{voteweighted.WithWeighting(voteweighted.EqualWeighting)},
+			expectedPrice: big.NewInt(200),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			mockValidatorStore := s.createMockValidatorStore(validators, sdkmath.NewInt(120))
+
+			aggregateFn := voteweighted.MedianFromContext(
+				log.NewTestLogger(s.T()),
+				mockValidatorStore,
+				voteweighted.DefaultPowerThreshold,
+				tc.opts...,
+			)(s.ctx)
+
+			result := aggregateFn(providerPrices)
+			s.Require().Equal(map[slinkytypes.CurrencyPair]*big.Int{btcUSD: tc.expectedPrice}, result)
+		})
+	}
+
+	s.Run("equal weighting still requires the power threshold", func() {
+		// The validators with the majority of the prices lack the majority of the stake.
+		mockValidatorStore := s.createMockValidatorStore(validators[:2], sdkmath.NewInt(120))
+		aggregateFn := voteweighted.Median(
+			s.ctx,
+			log.NewTestLogger(s.T()),
+			mockValidatorStore,
+			voteweighted.DefaultPowerThreshold,
+			voteweighted.WithWeighting(voteweighted.EqualWeighting),
+		)
+
+		result := aggregateFn(aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+			validator1.String(): {btcUSD: big.NewInt(100)},
+			validator2.String(): {btcUSD: big.NewInt(200)},
+		})
+		s.Require().Empty(result)
+	})
+
+	s.Run("unknown weighting panics", func() {
+		s.Require().Panics(func() {
+			voteweighted.MedianFromContext(
+				log.NewTestLogger(s.T()),
+				s.createMockValidatorStore(validators, sdkmath.NewInt(120)),
+				voteweighted.DefaultPowerThreshold,
+				voteweighted.WithWeighting("plutocratic"),
+			)
+		})
+	})
+}
+
 func (s *MathTestSuite) TestComputeMedian() {
 	cases := []struct {
 		name      string
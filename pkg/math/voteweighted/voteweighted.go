@@ -38,9 +38,12 @@ func MedianFromContext(
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+	opts ...Option,
 ) aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	// Options are applied eagerly so that invalid options panic when the application is wired.
+	cfg := newMedianConfig(opts...)
 	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
-		return Median(ctx, logger, validatorStore, threshold)
+		return cfg.median(ctx, logger, validatorStore, threshold)
 	}
 }
 
@@ -55,12 +58,24 @@ func MedianFromContext(
 //  2. In the case where there are not enough price updates for a given currency pair, the
 //     price will not be included in the final set of oracle prices.
 //  3. Given the threshold is met, the final oracle price for a given currency pair is the
-//     median price weighted by the stake of each validator that submitted a price.
+//     median price weighted by the stake of each validator that submitted a price. With equal
+//     weighting (see WithWeighting), the price of each validator is instead weighted equally.
 func Median(
 	ctx sdk.Context,
 	logger log.Logger,
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
+	opts ...Option,
+) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	return newMedianConfig(opts...).median(ctx, logger, validatorStore, threshold)
+}
+
+// median returns the median aggregate function with the configured weighting.
+func (cfg medianConfig) median(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
 ) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
 	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
 		priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
@@ -137,18 +152,19 @@ func Median(
 			// The total voting power % that submitted a price update for the given currency pair must be
 			// greater than the threshold to be included in the final oracle price.
 			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
-				prices[currencyPair] = ComputeMedian(info)
+				prices[currencyPair] = ComputeMedian(cfg.weighPrices(info))
 
 				logger.Info(
-					"computed stake-weighted median price for currency pair",
+					"computed weighted median price for currency pair",
 					"currency_pair", currencyPair.String(),
+					"weighting", cfg.weighting,
 					"percent_submitted", percentSubmitted.String(),
 					"threshold", threshold.String(),
 					"final_price", prices[currencyPair].String(),
 				)
 			} else {
 				logger.Info(
-					"not enough voting power to compute stake-weighted median price price for currency pair",
+					"not enough voting power to compute weighted median price for currency pair",
 					"currency_pair", currencyPair.String(),
 					"threshold", threshold.String(),
 					"percent_submitted", percentSubmitted.String(),
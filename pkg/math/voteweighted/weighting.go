@@ -0,0 +1,91 @@
+package voteweighted
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Weighting determines how much the price of each validator contributes to the median price.
+//
+// NOTE: The weighting is consensus critical. All validators must be configured with the same
+// weighting, otherwise they will compute different oracle prices and fail to reach consensus.
+type Weighting string
+
+const (
+	// StakeWeighting weights the price of each validator by its bonded tokens. This is the default.
+	StakeWeighting Weighting = "stake"
+	// EqualWeighting weights the price of each validator equally, regardless of its stake.
+	EqualWeighting Weighting = "equal"
+)
+
+// equalVoteWeight is the vote weight of each validator with equal weighting. ComputeMedian selects
+// the first price whose cumulative weight reaches half of the total weight rounded down, so a weight
+// of 1 would select the price below the middle price for an odd number of validators.
+var equalVoteWeight = math.NewInt(2)
+
+// ValidateBasic returns an error if the weighting is not supported.
+func (w Weighting) ValidateBasic() error {
+	switch w {
+	case StakeWeighting, EqualWeighting:
+		return nil
+	default:
+		return fmt.Errorf("unknown weighting %q", w)
+	}
+}
+
+// Option is a functional option for the median aggregate function.
+type Option func(*medianConfig)
+
+// medianConfig is the configuration of the median aggregate function.
+type medianConfig struct {
+	weighting Weighting
+}
+
+// newMedianConfig returns the configuration of the median aggregate function with the given options
+// applied.
+func newMedianConfig(opts ...Option) medianConfig {
+	cfg := medianConfig{
+		weighting: StakeWeighting,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// WithWeighting sets how the price of each validator is weighted in the median price. By default,
+// prices are stake weighted. The power threshold is always evaluated against the stake of the
+// validators that submitted a price, regardless of the weighting.
+func WithWeighting(weighting Weighting) Option {
+	return func(cfg *medianConfig) {
+		if err := weighting.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid weighting: %s", err))
+		}
+
+		cfg.weighting = weighting
+	}
+}
+
+// weighPrices returns the price info used to compute the median price given the stake weighted
+// price info of a currency pair.
+func (cfg medianConfig) weighPrices(info PriceInfo) PriceInfo {
+	if cfg.weighting != EqualWeighting {
+		return info
+	}
+
+	prices := make([]PricePerValidator, len(info.Prices))
+	for i, price := range info.Prices {
+		prices[i] = PricePerValidator{
+			VoteWeight: equalVoteWeight,
+			Price:      price.Price,
+		}
+	}
+
+	return PriceInfo{
+		Prices:      prices,
+		TotalWeight: equalVoteWeight.MulRaw(int64(len(prices))),
+	}
+}
@@ -0,0 +1,313 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// OTLPTracesPath is the path of the OTLP/HTTP endpoint that spans are exported to.
+	OTLPTracesPath = "/v1/traces"
+
+	// DefaultExportTimeout is the timeout of each export request.
+	DefaultExportTimeout = 10 * time.Second
+)
+
+var _ sdktrace.SpanExporter = (*OTLPExporter)(nil)
+
+// OTLPExporter is a span exporter that posts spans to an OTLP/HTTP collector using the JSON
+// encoding of the OTLP protocol.
+type OTLPExporter struct {
+	client  *http.Client
+	url     string
+	stopped atomic.Bool
+}
+
+// NewOTLPExporter returns a new OTLPExporter that exports spans to the collector at the given
+// base URL e.g. http://localhost:4318.
+func NewOTLPExporter(endpoint string) (*OTLPExporter, error) {
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid otlp endpoint %s: %w", endpoint, err)
+	}
+
+	if (base.Scheme != "http" && base.Scheme != "https") || len(base.Host) == 0 {
+		return nil, fmt.Errorf("otlp endpoint %s must be an http or https url", endpoint)
+	}
+
+	return &OTLPExporter{
+		client: &http.Client{Timeout: DefaultExportTimeout},
+		url:    strings.TrimSuffix(endpoint, "/") + OTLPTracesPath,
+	}, nil
+}
+
+// ExportSpans posts the given spans to the collector.
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.stopped.Load() || len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(newExportRequest(spans))
+	if err != nil {
+		return fmt.Errorf("failed to marshal spans: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export spans: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("failed to export spans: collector returned status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Shutdown stops the exporter. Spans exported after shutdown are dropped.
+func (e *OTLPExporter) Shutdown(context.Context) error {
+	e.stopped.Store(true)
+	return nil
+}
+
+// The types below mirror the JSON encoding of the OTLP ExportTraceServiceRequest. Trace and span
+// IDs are hex encoded, and 64-bit integers are encoded as strings.
+type (
+	exportRequest struct {
+		ResourceSpans []resourceSpans `json:"resourceSpans"`
+	}
+
+	resourceSpans struct {
+		Resource   resource     `json:"resource"`
+		ScopeSpans []scopeSpans `json:"scopeSpans"`
+	}
+
+	resource struct {
+		Attributes []keyValue `json:"attributes,omitempty"`
+	}
+
+	scopeSpans struct {
+		Scope scope  `json:"scope"`
+		Spans []span `json:"spans"`
+	}
+
+	scope struct {
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+	}
+
+	span struct {
+		TraceID           string     `json:"traceId"`
+		SpanID            string     `json:"spanId"`
+		ParentSpanID      string     `json:"parentSpanId,omitempty"`
+		Name              string     `json:"name"`
+		Kind              int        `json:"kind"`
+		StartTimeUnixNano uint64     `json:"startTimeUnixNano,string"`
+		EndTimeUnixNano   uint64     `json:"endTimeUnixNano,string"`
+		Attributes        []keyValue `json:"attributes,omitempty"`
+		Events            []event    `json:"events,omitempty"`
+		Status            status     `json:"status"`
+	}
+
+	event struct {
+		TimeUnixNano uint64     `json:"timeUnixNano,string"`
+		Name         string     `json:"name"`
+		Attributes   []keyValue `json:"attributes,omitempty"`
+	}
+
+	status struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+
+	keyValue struct {
+		Key   string   `json:"key"`
+		Value anyValue `json:"value"`
+	}
+
+	anyValue struct {
+		StringValue *string     `json:"stringValue,omitempty"`
+		BoolValue   *bool       `json:"boolValue,omitempty"`
+		IntValue    *int64      `json:"intValue,omitempty,string"`
+		DoubleValue *float64    `json:"doubleValue,omitempty"`
+		ArrayValue  *arrayValue `json:"arrayValue,omitempty"`
+	}
+
+	arrayValue struct {
+		Values []anyValue `json:"values"`
+	}
+)
+
+// OTLP status codes, which are ordered differently than the codes of the otel API.
+const (
+	statusCodeUnset = 0
+	statusCodeOk    = 1
+	statusCodeError = 2
+)
+
+// newExportRequest groups the given spans by resource and instrumentation scope.
+func newExportRequest(spans []sdktrace.ReadOnlySpan) exportRequest {
+	var (
+		req       exportRequest
+		resources = make(map[attribute.Distinct]int)
+		scopes    = make(map[attribute.Distinct]map[string]int)
+	)
+
+	for _, s := range spans {
+		res := s.Resource()
+		key := res.Equivalent()
+
+		ri, ok := resources[key]
+		if !ok {
+			ri = len(req.ResourceSpans)
+			resources[key] = ri
+			scopes[key] = make(map[string]int)
+			req.ResourceSpans = append(req.ResourceSpans, resourceSpans{
+				Resource: resource{Attributes: toKeyValues(res.Attributes())},
+			})
+		}
+
+		is := s.InstrumentationScope()
+		scopeKey := is.Name + "@" + is.Version
+		si, ok := scopes[key][scopeKey]
+		if !ok {
+			si = len(req.ResourceSpans[ri].ScopeSpans)
+			scopes[key][scopeKey] = si
+			req.ResourceSpans[ri].ScopeSpans = append(req.ResourceSpans[ri].ScopeSpans, scopeSpans{
+				Scope: scope{Name: is.Name, Version: is.Version},
+			})
+		}
+
+		req.ResourceSpans[ri].ScopeSpans[si].Spans = append(req.ResourceSpans[ri].ScopeSpans[si].Spans, toSpan(s))
+	}
+
+	return req
+}
+
+// toSpan converts a span to its OTLP representation.
+func toSpan(s sdktrace.ReadOnlySpan) span {
+	sc := s.SpanContext()
+	out := span{
+		TraceID:           sc.TraceID().String(),
+		SpanID:            sc.SpanID().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: unixNano(s.StartTime()),
+		EndTimeUnixNano:   unixNano(s.EndTime()),
+		Attributes:        toKeyValues(s.Attributes()),
+		Status:            toStatus(s.Status()),
+	}
+
+	if parent := s.Parent(); parent.SpanID().IsValid() {
+		out.ParentSpanID = parent.SpanID().String()
+	}
+
+	for _, e := range s.Events() {
+		out.Events = append(out.Events, event{
+			TimeUnixNano: unixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   toKeyValues(e.Attributes),
+		})
+	}
+
+	return out
+}
+
+// toStatus converts a span status to its OTLP representation.
+func toStatus(s sdktrace.Status) status {
+	switch s.Code {
+	case codes.Ok:
+		return status{Code: statusCodeOk}
+	case codes.Error:
+		return status{Code: statusCodeError, Message: s.Description}
+	default:
+		return status{Code: statusCodeUnset}
+	}
+}
+
+// toKeyValues converts a set of attributes to their OTLP representation.
+func toKeyValues(attrs []attribute.KeyValue) []keyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	out := make([]keyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		out = append(out, keyValue{Key: string(attr.Key), Value: toAnyValue(attr.Value)})
+	}
+
+	return out
+}
+
+// toAnyValue converts an attribute value to its OTLP representation.
+func toAnyValue(v attribute.Value) anyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return anyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := v.AsInt64()
+		return anyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return anyValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		values := make([]anyValue, 0)
+		for _, b := range v.AsBoolSlice() {
+			values = append(values, toAnyValue(attribute.BoolValue(b)))
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	case attribute.INT64SLICE:
+		values := make([]anyValue, 0)
+		for _, i := range v.AsInt64Slice() {
+			values = append(values, toAnyValue(attribute.Int64Value(i)))
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	case attribute.FLOAT64SLICE:
+		values := make([]anyValue, 0)
+		for _, f := range v.AsFloat64Slice() {
+			values = append(values, toAnyValue(attribute.Float64Value(f)))
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	case attribute.STRINGSLICE:
+		values := make([]anyValue, 0)
+		for _, s := range v.AsStringSlice() {
+			values = append(values, toAnyValue(attribute.StringValue(s)))
+		}
+		return anyValue{ArrayValue: &arrayValue{Values: values}}
+	default:
+		s := v.Emit()
+		return anyValue{StringValue: &s}
+	}
+}
+
+// unixNano returns the given time as nanoseconds since the unix epoch.
+func unixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return uint64(t.UnixNano())
+}
@@ -0,0 +1,216 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/pkg/tracing"
+)
+
+func TestNewOTLPExporter(t *testing.T) {
+	testCases := []struct {
+		name        string
+		endpoint    string
+		expectedErr bool
+	}{
+		{
+			name:        "http endpoint",
+			endpoint:    "http://localhost:4318",
+			expectedErr: false,
+		},
+		{
+			name:        "https endpoint with a trailing slash",
+			endpoint:    "https://otel.example.com/",
+			expectedErr: false,
+		},
+		{
+			name:        "endpoint without a scheme",
+			endpoint:    "localhost:4318",
+			expectedErr: true,
+		},
+		{
+			name:        "empty endpoint",
+			endpoint:    "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tracing.NewOTLPExporter(tc.endpoint)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	type request struct {
+		ResourceSpans []struct {
+			Resource struct {
+				Attributes []map[string]any `json:"attributes"`
+			} `json:"resource"`
+			ScopeSpans []struct {
+				Scope struct {
+					Name string `json:"name"`
+				} `json:"scope"`
+				Spans []map[string]any `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+
+	newServer := func(t *testing.T, status int, requests chan<- request) *httptest.Server {
+		t.Helper()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, tracing.OTLPTracesPath, r.URL.Path)
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			var req request
+			require.NoError(t, json.Unmarshal(body, &req))
+			requests <- req
+
+			w.WriteHeader(status)
+		}))
+		t.Cleanup(srv.Close)
+
+		return srv
+	}
+
+	newProvider := func(t *testing.T, exporter sdktrace.SpanExporter) trace.Tracer {
+		t.Helper()
+
+		provider := sdktrace.NewTracerProvider(
+			sdktrace.WithSyncer(exporter),
+			sdktrace.WithResource(sdkresource.NewSchemaless(tracing.ProviderKey.String("test"))),
+		)
+		t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+		return provider.Tracer(tracing.TracerName)
+	}
+
+	t.Run("exports spans with their attributes and parents", func(t *testing.T) {
+		requests := make(chan request, 2)
+		srv := newServer(t, http.StatusOK, requests)
+
+		exporter, err := tracing.NewOTLPExporter(srv.URL)
+		require.NoError(t, err)
+		tracer := newProvider(t, exporter)
+
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		_, child := tracer.Start(
+			ctx,
+			"child",
+			trace.WithAttributes(
+				tracing.PairKey.String("BTC/USD"),
+				tracing.ProvidersKey.StringSlice([]string{"binance", "coinbase"}),
+			),
+		)
+		child.SetStatus(codes.Error, "insufficient converted prices")
+		child.End()
+		parent.End()
+
+		childReq := <-requests
+		parentReq := <-requests
+
+		require.Len(t, childReq.ResourceSpans, 1)
+		require.Equal(t, []map[string]any{
+			{"key": "slinky.provider", "value": map[string]any{"stringValue": "test"}},
+		}, childReq.ResourceSpans[0].Resource.Attributes)
+		require.Len(t, childReq.ResourceSpans[0].ScopeSpans, 1)
+		require.Equal(t, tracing.TracerName, childReq.ResourceSpans[0].ScopeSpans[0].Scope.Name)
+
+		spans := childReq.ResourceSpans[0].ScopeSpans[0].Spans
+		require.Len(t, spans, 1)
+		span := spans[0]
+		require.Equal(t, "child", span["name"])
+		require.Equal(t, child.SpanContext().TraceID().String(), span["traceId"])
+		require.Equal(t, child.SpanContext().SpanID().String(), span["spanId"])
+		require.Equal(t, parent.SpanContext().SpanID().String(), span["parentSpanId"])
+		require.IsType(t, "", span["startTimeUnixNano"])
+		require.IsType(t, "", span["endTimeUnixNano"])
+		require.Equal(t, map[string]any{"code": float64(2), "message": "insufficient converted prices"}, span["status"])
+		require.Equal(t, []any{
+			map[string]any{"key": "slinky.pair", "value": map[string]any{"stringValue": "BTC/USD"}},
+			map[string]any{"key": "slinky.providers", "value": map[string]any{"arrayValue": map[string]any{
+				"values": []any{
+					map[string]any{"stringValue": "binance"},
+					map[string]any{"stringValue": "coinbase"},
+				},
+			}}},
+		}, span["attributes"])
+
+		parentSpan := parentReq.ResourceSpans[0].ScopeSpans[0].Spans[0]
+		require.Equal(t, "parent", parentSpan["name"])
+		require.NotContains(t, parentSpan, "parentSpanId")
+		require.Equal(t, map[string]any{"code": float64(0)}, parentSpan["status"])
+	})
+
+	t.Run("returns an error if the collector rejects the spans", func(t *testing.T) {
+		requests := make(chan request, 1)
+		srv := newServer(t, http.StatusBadRequest, requests)
+
+		exporter, err := tracing.NewOTLPExporter(srv.URL)
+		require.NoError(t, err)
+
+		recorder := sdktrace.NewSimpleSpanProcessor(nopExporter{})
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		_, span := provider.Tracer(tracing.TracerName).Start(context.Background(), "span")
+		span.End()
+
+		ro, ok := span.(sdktrace.ReadOnlySpan)
+		require.True(t, ok)
+		require.Error(t, exporter.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{ro}))
+		<-requests
+	})
+
+	t.Run("drops spans after shutdown", func(t *testing.T) {
+		exporter, err := tracing.NewOTLPExporter("http://localhost:0")
+		require.NoError(t, err)
+		require.NoError(t, exporter.Shutdown(context.Background()))
+
+		provider := sdktrace.NewTracerProvider()
+		_, span := provider.Tracer(tracing.TracerName).Start(context.Background(), "span")
+		span.End()
+
+		ro, ok := span.(sdktrace.ReadOnlySpan)
+		require.True(t, ok)
+		require.NoError(t, exporter.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{ro}))
+	})
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Run("fails if tracing is disabled", func(t *testing.T) {
+		_, err := tracing.NewTracerProvider(config.TracingConfig{})
+		require.Error(t, err)
+	})
+
+	t.Run("fails with an invalid config", func(t *testing.T) {
+		_, err := tracing.NewTracerProvider(config.TracingConfig{Enabled: true, Endpoint: "localhost:4318"})
+		require.Error(t, err)
+	})
+}
+
+// nopExporter is a span exporter that drops all spans.
+type nopExporter struct{}
+
+func (nopExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error { return nil }
+
+func (nopExporter) Shutdown(context.Context) error { return nil }
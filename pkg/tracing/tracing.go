@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+const (
+	// TracerName is the name of the tracer used to instrument the oracle.
+	TracerName = "github.com/skip-mev/slinky"
+
+	// DefaultServiceName is the service name that spans are attributed to if none is configured.
+	DefaultServiceName = "slinky"
+)
+
+const (
+	// ProviderKey is the attribute key of the provider that a span pertains to.
+	ProviderKey = attribute.Key("slinky.provider")
+
+	// PairKey is the attribute key of the currency pair that a span pertains to.
+	PairKey = attribute.Key("slinky.pair")
+
+	// PairsKey is the attribute key of the currency pairs that a span pertains to.
+	PairsKey = attribute.Key("slinky.pairs")
+
+	// ProvidersKey is the attribute key of the providers that a span pertains to.
+	ProvidersKey = attribute.Key("slinky.providers")
+)
+
+// Tracer returns the tracer used to instrument the oracle. Spans are only recorded once a tracer
+// provider has been registered via NewTracerProvider; until then the tracer is a no-op.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// NewTracerProvider creates a tracer provider that batches spans and exports them to the OTLP
+// endpoint of the given config, and registers it as the global tracer provider. The caller is
+// responsible for shutting the provider down, which flushes any buffered spans.
+func NewTracerProvider(cfg config.TracingConfig) (*sdktrace.TracerProvider, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !cfg.Enabled {
+		return nil, fmt.Errorf("tracing is not enabled")
+	}
+
+	exporter, err := NewOTLPExporter(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := cfg.ServiceName
+	if len(serviceName) == 0 {
+		serviceName = DefaultServiceName
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if cfg.SampleRatio > 0 {
+		sampler = sdktrace.TraceIDRatioBased(cfg.SampleRatio)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(sdkresource.NewSchemaless(semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider, nil
+}
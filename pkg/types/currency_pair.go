@@ -3,11 +3,16 @@ package types
 import (
 	"fmt"
 	"strings"
+	"unicode"
 )
 
 const (
 	ethereum         = "ETHEREUM"
 	MaxCPFieldLength = 128
+
+	// instrumentSuffixSeparators are the separators used by providers to append instrument
+	// suffixes (i.e. perpetuals, dated futures, settlement currencies) to a base or quote.
+	instrumentSuffixSeparators = "-:"
 )
 
 // NewCurrencyPair returns a new CurrencyPair with the given base and quote strings.
@@ -72,6 +77,27 @@ func CurrencyPairFromString(s string) (CurrencyPair, error) {
 	return cp, cp.ValidateBasic()
 }
 
+// SpotCurrencyPairFromString parses a spot currency pair referenced by a provider or oracle config. Unlike
+// CurrencyPairFromString, which parses the currency pairs stored on chain, it rejects any components that
+// contain whitespace or an instrument suffix (i.e. BTC/USD-PERP or BTC/USDT:USDT) rather than producing a
+// malformed pair.
+func SpotCurrencyPairFromString(s string) (CurrencyPair, error) {
+	for _, component := range strings.Split(s, "/") {
+		if strings.IndexFunc(component, unicode.IsSpace) >= 0 {
+			return CurrencyPair{}, fmt.Errorf("incorrectly formatted CurrencyPair: %s contains whitespace", s)
+		}
+
+		if strings.ContainsAny(component, instrumentSuffixSeparators) {
+			return CurrencyPair{}, fmt.Errorf(
+				"incorrectly formatted CurrencyPair: %s contains an instrument suffix; only spot base / quote pairs are supported",
+				s,
+			)
+		}
+	}
+
+	return CurrencyPairFromString(s)
+}
+
 // LegacyDecimals returns the number of decimals that the quote will be reported to. If the quote is Ethereum, then
 // the number of decimals is 18. Otherwise, the decimals will be reorted to 8.
 func (cp *CurrencyPair) LegacyDecimals() int {
@@ -112,6 +112,24 @@ func TestToFromString(t *testing.T) {
 			slinkytypes.CurrencyPair{Base: "A", Quote: "B"},
 			true,
 		},
+		{
+			"if the string contains more than two components, return an error",
+			"BTC/USD/PERP",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+		{
+			"if the base is empty, return an error",
+			"/USD",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+		{
+			"if the string contains underscores and symbols, return the original CurrencyPair",
+			"harry_potter_obama_sonic_10_inu/$WIF",
+			slinkytypes.CurrencyPair{Base: "HARRY_POTTER_OBAMA_SONIC_10_INU", Quote: "$WIF"},
+			true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -127,6 +145,73 @@ func TestToFromString(t *testing.T) {
 	}
 }
 
+func TestSpotCurrencyPairFromString(t *testing.T) {
+	tcs := []struct {
+		name string
+		// string formatted CurrencyPair
+		cps        string
+		cp         slinkytypes.CurrencyPair
+		expectPass bool
+	}{
+		{
+			"if the string is correctly formatted, return the original CurrencyPair",
+			"btc/usd",
+			slinkytypes.CurrencyPair{Base: "BTC", Quote: "USD"},
+			true,
+		},
+		{
+			"if the string contains more than two components, return an error",
+			"BTC/USD/PERP",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+		{
+			"if the quote contains a perpetual suffix, return an error",
+			"BTC/USD-PERP",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+		{
+			"if the base contains a dated futures suffix, return an error",
+			"BTC-240628/USD",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+		{
+			"if the quote contains a settlement currency suffix, return an error",
+			"BTC/USDT:USDT",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+		{
+			"if the string contains whitespace, return an error",
+			"BTC / USD",
+			slinkytypes.CurrencyPair{},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cp, err := slinkytypes.SpotCurrencyPairFromString(tc.cps)
+			if tc.expectPass {
+				require.Nil(t, err)
+				require.Equal(t, cp, tc.cp)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+
+	// instrument suffixes are still parsed by CurrencyPairFromString, so that every currency
+	// pair that passes ValidateBasic can be parsed back from its string representation
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD-PERP")
+	require.NoError(t, cp.ValidateBasic())
+	parsed, err := slinkytypes.CurrencyPairFromString(cp.String())
+	require.NoError(t, err)
+	require.Equal(t, cp, parsed)
+}
+
 func TestDecimals(t *testing.T) {
 	tcs := []struct {
 		name string
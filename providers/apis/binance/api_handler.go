@@ -102,7 +102,7 @@ func (h *APIHandler) ParseResponse(
 		if err != nil {
 			wErr := fmt.Errorf("failed to convert price %s to big.Float: %w", data.Price, err)
 			unresolved[ticker] = providertypes.UnresolvedResult{
-				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+				ErrorWithCode: providertypes.NewParsePriceError(wErr),
 			}
 			continue
 		}
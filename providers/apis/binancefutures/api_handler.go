@@ -0,0 +1,128 @@
+package binancefutures
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for the Binance USDⓈ-M futures
+// API. Rather than a traded price, the handler reports the index or mark price published by
+// the venue for each perpetual contract, which is already a blend of several spot venues.
+// For more information about the Binance futures API, refer to the following link:
+// https://binance-docs.github.io/apidocs/futures/en/#mark-price
+type APIHandler struct {
+	// api is the config for the Binance futures API.
+	api config.APIConfig
+	// cache maintains the latest set of tickers seen by the handler.
+	cache types.ProviderTickers
+}
+
+// NewAPIHandler returns a new Binance futures PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if api.Name != Name {
+		return nil, fmt.Errorf("expected api config name %s, got %s", Name, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", Name, err)
+	}
+
+	return &APIHandler{
+		api:   api,
+		cache: types.NewProviderTickers(),
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch data from the Binance futures API. The
+// premium index endpoint returns every contract, so the URL does not depend on the tickers.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	if len(tickers) == 0 {
+		return "", fmt.Errorf("empty url created. invalid or no ticker were provided")
+	}
+
+	for _, ticker := range tickers {
+		h.cache.Add(ticker)
+	}
+
+	return h.api.URL, nil
+}
+
+// ParseResponse parses the response from the Binance futures API and returns a GetResponse.
+// Each of the tickers supplied will get a response or an error. The price reported for each
+// ticker is determined by the price type configured in the ticker's metadata.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	result, err := Decode(resp)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+	)
+
+	for _, data := range result {
+		// Filter out the responses that are not expected.
+		ticker, ok := h.cache.FromOffChainTicker(data.Symbol)
+		if !ok {
+			continue
+		}
+
+		metadata, err := unmarshalMetadataJSON(ticker.GetJSON())
+		if err != nil {
+			wErr := fmt.Errorf("invalid metadata for ticker %s: %w", ticker, err)
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorAPIGeneral),
+			}
+			continue
+		}
+
+		rawPrice := data.Price(metadata.PriceType)
+		price, err := math.Float64StringToBigFloat(rawPrice)
+		if err != nil {
+			wErr := fmt.Errorf("failed to convert %s price %s to big.Float: %w", metadata.PriceType, rawPrice, err)
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewParsePriceError(wErr),
+			}
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	}
+
+	// Add currency pairs that received no response to the unresolved map.
+	for _, ticker := range tickers {
+		_, resolvedOk := resolved[ticker]
+		_, unresolvedOk := unresolved[ticker]
+
+		if !resolvedOk && !unresolvedOk {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+			}
+		}
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
@@ -0,0 +1,219 @@
+package binancefutures_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/binancefutures"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var (
+	btcusdt     = binancefutures.DefaultMarketConfig.MustGetProviderTicker(constants.BITCOIN_USDT)
+	ethusdtMark = types.NewProviderTicker("ETHUSDT", `{"price_type":"mark"}`)
+	solusdtBad  = types.NewProviderTicker("SOLUSDT", `{"price_type":"funding"}`)
+
+	premiumIndex = `[
+		{"symbol":"BTCUSDT","markPrice":"46710.50000000","indexPrice":"46707.03000000","lastFundingRate":"0.00010000","time":1597370495002},
+		{"symbol":"ETHUSDT","markPrice":"2975.10000000","indexPrice":"2974.90000000","lastFundingRate":"0.00010000","time":1597370495002},
+		{"symbol":"SOLUSDT","markPrice":"101.10000000","indexPrice":"101.00000000","lastFundingRate":"0.00010000","time":1597370495002}
+	]`
+)
+
+func TestCreateURL(t *testing.T) {
+	h, err := binancefutures.NewAPIHandler(binancefutures.DefaultAPIConfig)
+	require.NoError(t, err)
+
+	_, err = h.CreateURL([]types.ProviderTicker{})
+	require.Error(t, err)
+
+	url, err := h.CreateURL([]types.ProviderTicker{btcusdt, ethusdtMark})
+	require.NoError(t, err)
+	require.Equal(t, binancefutures.URL, url)
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cps      []types.ProviderTicker
+		response *http.Response
+		expected types.PriceResponse
+	}{
+		{
+			name: "index price is reported by default",
+			cps: []types.ProviderTicker{
+				btcusdt,
+			},
+			response: testutils.CreateResponseFromJSON(premiumIndex),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value: big.NewFloat(46707.03),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "mark price is reported if configured",
+			cps: []types.ProviderTicker{
+				btcusdt,
+				ethusdtMark,
+			},
+			response: testutils.CreateResponseFromJSON(premiumIndex),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value: big.NewFloat(46707.03),
+					},
+					ethusdtMark: {
+						Value: big.NewFloat(2975.1),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "unknown price type is unresolved",
+			cps: []types.ProviderTicker{
+				solusdtBad,
+			},
+			response: testutils.CreateResponseFromJSON(premiumIndex),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					solusdtBad: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("invalid metadata"), providertypes.ErrorAPIGeneral),
+					},
+				},
+			),
+		},
+		{
+			name: "bad price response",
+			cps: []types.ProviderTicker{
+				btcusdt,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`[{"symbol":"BTCUSDT","markPrice":"46710.5","indexPrice":"$46707.03"}]`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusdt: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("invalid syntax"), providertypes.ErrorFailedToParsePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "infinite price response",
+			cps: []types.ProviderTicker{
+				btcusdt,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`[{"symbol":"BTCUSDT","markPrice":"46710.5","indexPrice":"Infinity"}]`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusdt: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("value is infinite"), providertypes.ErrorInvalidPrice),
+					},
+				},
+			),
+		},
+		{
+			name: "NaN price response",
+			cps: []types.ProviderTicker{
+				btcusdt,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`[{"symbol":"BTCUSDT","markPrice":"46710.5","indexPrice":"NaN"}]`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusdt: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("value is NaN"), providertypes.ErrorInvalidPrice),
+					},
+				},
+			),
+		},
+		{
+			name: "bad response",
+			cps: []types.ProviderTicker{
+				btcusdt,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`shout out my label that's me`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusdt: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorFailedToDecode),
+					},
+				},
+			),
+		},
+		{
+			name: "no response",
+			cps: []types.ProviderTicker{
+				btcusdt,
+				ethusdtMark,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`[]`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusdt: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+					},
+					ethusdtMark: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorNoResponse),
+					},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := binancefutures.NewAPIHandler(binancefutures.DefaultAPIConfig)
+			require.NoError(t, err)
+
+			// Update the cache since it is assumed that createURL is executed before ParseResponse.
+			_, err = h.CreateURL(tc.cps)
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.cps, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.expected.Resolved))
+			require.Len(t, resp.UnResolved, len(tc.expected.UnResolved))
+
+			for cp, result := range tc.expected.Resolved {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+				require.True(t, r.Timestamp.After(now))
+			}
+
+			for cp, result := range tc.expected.UnResolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+				require.Equal(t, result.Code(), resp.UnResolved[cp].Code())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package binancefutures
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// NOTE: All documentation for this file can be located on the Binance USDⓈ-M futures API
+// documentation: https://binance-docs.github.io/apidocs/futures/en/#mark-price. This API does
+// not require a subscription to use (i.e. No API key is required).
+
+const (
+	// Name is the name of the Binance futures provider.
+	Name = "binance_futures_api"
+
+	// URL is the URL of the Binance USDⓈ-M futures premium index endpoint. The endpoint
+	// returns the mark and index price of every perpetual contract listed on the venue.
+	URL = "https://fapi.binance.com/fapi/v1/premiumIndex"
+)
+
+// PriceType is the price published by the venue that is reported for a market.
+type PriceType string
+
+const (
+	// IndexPrice is the venue's index price, a blend of the spot prices of the underlying
+	// asset across several exchanges. This is the default price type.
+	IndexPrice PriceType = "index"
+	// MarkPrice is the venue's mark price, the index price adjusted by the perpetual's
+	// funding basis.
+	MarkPrice PriceType = "mark"
+)
+
+// TickerMetadata is the metadata that can be configured for each market in the provider
+// config's metadata JSON e.g. {"price_type": "mark"}.
+type TickerMetadata struct {
+	// PriceType is the published price reported for the market. If empty, the index price
+	// is reported.
+	PriceType PriceType `json:"price_type"`
+}
+
+// ValidateBasic returns an error if the price type is not supported.
+func (m TickerMetadata) ValidateBasic() error {
+	switch m.PriceType {
+	case "", IndexPrice, MarkPrice:
+		return nil
+	default:
+		return fmt.Errorf("unknown price type %q", m.PriceType)
+	}
+}
+
+// unmarshalMetadataJSON unmarshals and validates the given ticker metadata JSON. Empty
+// metadata reports the index price.
+func unmarshalMetadataJSON(metadata string) (TickerMetadata, error) {
+	if len(metadata) == 0 {
+		return TickerMetadata{PriceType: IndexPrice}, nil
+	}
+
+	var tickerMetadata TickerMetadata
+	if err := json.Unmarshal([]byte(metadata), &tickerMetadata); err != nil {
+		return TickerMetadata{}, err
+	}
+
+	if err := tickerMetadata.ValidateBasic(); err != nil {
+		return TickerMetadata{}, err
+	}
+
+	if tickerMetadata.PriceType == "" {
+		tickerMetadata.PriceType = IndexPrice
+	}
+
+	return tickerMetadata, nil
+}
+
+var (
+	// DefaultAPIConfig is the default configuration for the Binance futures API.
+	DefaultAPIConfig = config.APIConfig{
+		Name:             Name,
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          3000 * time.Millisecond,
+		Interval:         750 * time.Millisecond,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       1,
+		URL:              URL,
+	}
+
+	// DefaultMarketConfig is the default market configuration for the Binance futures API.
+	DefaultMarketConfig = types.CurrencyPairsToProviderTickers{
+		constants.BITCOIN_USDT: {
+			OffChainTicker: "BTCUSDT",
+		},
+		constants.ETHEREUM_USDT: {
+			OffChainTicker: "ETHUSDT",
+		},
+		constants.SOLANA_USDT: {
+			OffChainTicker: "SOLUSDT",
+		},
+	}
+)
+
+type (
+	// Response is the expected response returned by the Binance futures premium index
+	// endpoint. The response is json formatted.
+	// Response format:
+	//
+	//	[
+	//	  {
+	//	    "symbol": "BTCUSDT",
+	//	    "markPrice": "11793.63104562",
+	//	    "indexPrice": "11781.80495970",
+	//	    "estimatedSettlePrice": "11781.16138815",
+	//	    "lastFundingRate": "0.00038246",
+	//	    "interestRate": "0.00010000",
+	//	    "nextFundingTime": 1597392000000,
+	//	    "time": 1597370495002
+	//	  }
+	//	].
+	Response []Data
+
+	// Data is the premium index of a single perpetual contract.
+	Data struct {
+		Symbol          string `json:"symbol"`
+		MarkPrice       string `json:"markPrice"`
+		IndexPrice      string `json:"indexPrice"`
+		LastFundingRate string `json:"lastFundingRate"`
+		Time            int64  `json:"time"`
+	}
+)
+
+// Price returns the published price of the given type.
+func (d Data) Price(priceType PriceType) string {
+	if priceType == MarkPrice {
+		return d.MarkPrice
+	}
+
+	return d.IndexPrice
+}
+
+// Decode decodes the given http response into a Response.
+func Decode(resp *http.Response) (Response, error) {
+	var result Response
+	err := json.NewDecoder(resp.Body).Decode(&result)
+	return result, err
+}
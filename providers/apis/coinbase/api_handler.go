@@ -88,7 +88,7 @@ func (h *APIHandler) ParseResponse(
 	if err != nil {
 		return types.NewPriceResponseWithErr(
 			tickers,
-			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			providertypes.NewParsePriceError(err),
 		)
 	}
 
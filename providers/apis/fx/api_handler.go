@@ -0,0 +1,164 @@
+package fx
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/pkg/math"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for FX rate APIs. The handler reports
+// the rate of each fiat currency pair e.g. EUR/USD, so that crypto markets quoted in fiat
+// currencies other than USD can be priced by using the pair as a conversion intermediate. Each
+// rate is checked against its publication time rather than the time at which it was fetched, so
+// that an API that stops publishing is not mistaken for a fresh one.
+type APIHandler struct {
+	// api is the config for the FX API.
+	api config.APIConfig
+}
+
+// NewAPIHandler returns a new FX PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if api.Name != Name {
+		return nil, fmt.Errorf("expected api config name %s, got %s", Name, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", Name, err)
+	}
+
+	return &APIHandler{
+		api: api,
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch the rate of the given ticker from the FX API.
+// Only one ticker can be fetched per request, so this returns an error if given more than one
+// ticker.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	if len(tickers) != 1 {
+		return "", fmt.Errorf("expected 1 ticker, got %d", len(tickers))
+	}
+
+	base, quote, err := splitTicker(tickers[0].GetOffChainTicker())
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(h.api.URL, base, quote), nil
+}
+
+// ParseResponse parses the response from the FX API and returns the rate of the given ticker.
+// Rates that were published longer ago than the max rate age configured in the ticker's metadata
+// are unresolved. Note that this can only parse a single ticker at a time.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	if len(tickers) != 1 {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("expected 1 ticker, got %d", len(tickers)),
+				providertypes.ErrorInvalidResponse,
+			),
+		)
+	}
+	ticker := tickers[0]
+
+	metadata, err := unmarshalMetadataJSON(ticker.GetJSON())
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("invalid metadata for ticker %s: %w", ticker, err),
+				providertypes.ErrorAPIGeneral,
+			),
+		)
+	}
+
+	base, quote, err := splitTicker(ticker.GetOffChainTicker())
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorUnknownPair),
+		)
+	}
+
+	result, err := Decode(resp)
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	if !strings.EqualFold(result.Base, base) {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("expected rates for base %s, got %s", base, result.Base),
+				providertypes.ErrorInvalidResponse,
+			),
+		)
+	}
+
+	rate, ok := result.Rates[strings.ToUpper(quote)]
+	if !ok {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(fmt.Errorf("no rate for quote %s", quote), providertypes.ErrorNoResponse),
+		)
+	}
+
+	publishedAt, err := result.PublishedAt()
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorInvalidResponse),
+		)
+	}
+
+	now := time.Now().UTC()
+	if age := now.Sub(publishedAt); age > metadata.MaxRateAge {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("rate published at %s is older than the max rate age %s", publishedAt, metadata.MaxRateAge),
+				providertypes.ErrorStalePrice,
+			),
+		)
+	}
+
+	price, err := math.Float64StringToBigFloat(rate.String())
+	if err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewParsePriceError(fmt.Errorf("failed to convert rate %s to big.Float: %w", rate, err)),
+		)
+	}
+
+	// The rate is timestamped with the time at which it was fetched rather than published, as
+	// its staleness has already been checked against the max rate age.
+	return types.NewPriceResponse(
+		types.ResolvedPrices{
+			ticker: types.NewPriceResult(price, now),
+		},
+		nil,
+	)
+}
@@ -0,0 +1,215 @@
+package fx_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/fx"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var (
+	eurusd       = fx.DefaultMarketConfig.MustGetProviderTicker(constants.EUR_USD)
+	jpyusdStrict = types.NewProviderTicker("JPY/USD", `{"max_rate_age":3600000000000}`)
+	gbpusdBad    = types.NewProviderTicker("GBP/USD", `{"max_rate_age":-1}`)
+
+	today    = time.Now().UTC().Format(fx.DateLayout)
+	lastWeek = time.Now().UTC().Add(-7 * 24 * time.Hour).Format(fx.DateLayout)
+)
+
+func TestCreateURL(t *testing.T) {
+	h, err := fx.NewAPIHandler(fx.DefaultAPIConfig)
+	require.NoError(t, err)
+
+	_, err = h.CreateURL([]types.ProviderTicker{})
+	require.Error(t, err)
+
+	_, err = h.CreateURL([]types.ProviderTicker{eurusd, jpyusdStrict})
+	require.Error(t, err)
+
+	_, err = h.CreateURL([]types.ProviderTicker{types.NewProviderTicker("EURUSD", "")})
+	require.Error(t, err)
+
+	url, err := h.CreateURL([]types.ProviderTicker{eurusd})
+	require.NoError(t, err)
+	require.Equal(t, "https://api.frankfurter.app/latest?from=EUR&to=USD", url)
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cps      []types.ProviderTicker
+		response *http.Response
+		expected types.PriceResponse
+	}{
+		{
+			name: "rate published today is resolved",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"amount":1.0,"base":"EUR","date":"%s","rates":{"USD":1.0921}}`, today),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					eurusd: {
+						Value: big.NewFloat(1.0921),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "rate published as a unix timestamp is resolved",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"base":"EUR","timestamp":%d,"rates":{"USD":1.0921}}`, time.Now().Unix()),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					eurusd: {
+						Value: big.NewFloat(1.0921),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name: "rate older than the default max rate age is unresolved",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"amount":1.0,"base":"EUR","date":"%s","rates":{"USD":1.0921}}`, lastWeek),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					eurusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("stale"), providertypes.ErrorStalePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "rate older than the configured max rate age is unresolved",
+			cps:  []types.ProviderTicker{jpyusdStrict},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"base":"JPY","timestamp":%d,"rates":{"USD":0.0069}}`, time.Now().Add(-2*time.Hour).Unix()),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					jpyusdStrict: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("stale"), providertypes.ErrorStalePrice),
+					},
+				},
+			),
+		},
+		{
+			name: "invalid metadata is unresolved",
+			cps:  []types.ProviderTicker{gbpusdBad},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"amount":1.0,"base":"GBP","date":"%s","rates":{"USD":1.27}}`, today),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					gbpusdBad: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("invalid metadata"), providertypes.ErrorAPIGeneral),
+					},
+				},
+			),
+		},
+		{
+			name: "rates for a different base are unresolved",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"amount":1.0,"base":"USD","date":"%s","rates":{"EUR":0.9156}}`, today),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					eurusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("wrong base"), providertypes.ErrorInvalidResponse),
+					},
+				},
+			),
+		},
+		{
+			name: "missing quote is unresolved",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				fmt.Sprintf(`{"amount":1.0,"base":"EUR","date":"%s","rates":{"GBP":0.86}}`, today),
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					eurusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no rate"), providertypes.ErrorNoResponse),
+					},
+				},
+			),
+		},
+		{
+			name: "missing publication date is unresolved",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				`{"amount":1.0,"base":"EUR","rates":{"USD":1.0921}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					eurusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no date"), providertypes.ErrorInvalidResponse),
+					},
+				},
+			),
+		},
+		{
+			name: "bad response",
+			cps:  []types.ProviderTicker{eurusd},
+			response: testutils.CreateResponseFromJSON(
+				`shout out my label that's me`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					eurusd: providertypes.UnresolvedResult{
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no response"), providertypes.ErrorFailedToDecode),
+					},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := fx.NewAPIHandler(fx.DefaultAPIConfig)
+			require.NoError(t, err)
+
+			now := time.Now()
+			resp := h.ParseResponse(tc.cps, tc.response)
+
+			require.Len(t, resp.Resolved, len(tc.expected.Resolved))
+			require.Len(t, resp.UnResolved, len(tc.expected.UnResolved))
+
+			for cp, result := range tc.expected.Resolved {
+				require.Contains(t, resp.Resolved, cp)
+				r := resp.Resolved[cp]
+				require.Equal(t, result.Value.SetPrec(18), r.Value.SetPrec(18))
+				require.True(t, r.Timestamp.After(now))
+			}
+
+			for cp, result := range tc.expected.UnResolved {
+				require.Contains(t, resp.UnResolved, cp)
+				require.Error(t, resp.UnResolved[cp])
+				require.Equal(t, result.Code(), resp.UnResolved[cp].Code())
+			}
+		})
+	}
+}
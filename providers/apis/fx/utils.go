@@ -0,0 +1,158 @@
+package fx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/oracle/types"
+)
+
+// NOTE: All documentation for this file can be located on the Frankfurter API documentation:
+// https://www.frankfurter.app/docs. The API publishes the reference rates of the European Central
+// Bank once every business day and does not require a subscription to use (i.e. No API key is
+// required). Any FX rate API that accepts the same query parameters and returns the same response
+// format can be used by overriding the URL of the provider.
+
+const (
+	// Name is the name of the FX provider.
+	Name = "fx_api"
+
+	// URL is the URL of the Frankfurter latest rates endpoint. The base and quote currencies of
+	// the ticker are inserted into the URL.
+	URL = "https://api.frankfurter.app/latest?from=%s&to=%s"
+
+	// TickerSeparator is the separator of the base and quote currencies in an off-chain ticker
+	// e.g. EUR/USD.
+	TickerSeparator = "/"
+
+	// DefaultMaxRateAge is the default maximum age of a rate, measured from the time at which the
+	// API published it. Reference rates are published once every business day, so the bound
+	// spans a weekend followed by a holiday.
+	DefaultMaxRateAge = 96 * time.Hour
+
+	// DateLayout is the layout of the publication date returned by the API.
+	DateLayout = "2006-01-02"
+)
+
+var (
+	// DefaultAPIConfig is the default configuration for the FX API. FX rates update far less
+	// often than crypto prices, so the API is polled infrequently.
+	DefaultAPIConfig = config.APIConfig{
+		Name:             Name,
+		Atomic:           false,
+		Enabled:          true,
+		Timeout:          3000 * time.Millisecond,
+		Interval:         time.Minute,
+		ReconnectTimeout: 2000 * time.Millisecond,
+		MaxQueries:       3,
+		URL:              URL,
+	}
+
+	// DefaultMarketConfig is the default market configuration for the FX API.
+	DefaultMarketConfig = types.CurrencyPairsToProviderTickers{
+		constants.EUR_USD: {
+			OffChainTicker: "EUR/USD",
+		},
+		constants.GBP_USD: {
+			OffChainTicker: "GBP/USD",
+		},
+		constants.JPY_USD: {
+			OffChainTicker: "JPY/USD",
+		},
+	}
+)
+
+// TickerMetadata is the metadata that can be configured for each market in the provider config's
+// metadata JSON e.g. {"max_rate_age": 172800000000000}.
+type TickerMetadata struct {
+	// MaxRateAge is the maximum age of the market's rate in nanoseconds, measured from the time
+	// at which the API published it. If zero, DefaultMaxRateAge is used.
+	MaxRateAge time.Duration `json:"max_rate_age"`
+}
+
+// ValidateBasic returns an error if the max rate age is negative.
+func (m TickerMetadata) ValidateBasic() error {
+	if m.MaxRateAge < 0 {
+		return fmt.Errorf("max rate age cannot be negative")
+	}
+
+	return nil
+}
+
+// unmarshalMetadataJSON unmarshals and validates the given ticker metadata JSON. Empty metadata
+// uses the default max rate age.
+func unmarshalMetadataJSON(metadata string) (TickerMetadata, error) {
+	tickerMetadata := TickerMetadata{}
+	if len(metadata) > 0 {
+		if err := json.Unmarshal([]byte(metadata), &tickerMetadata); err != nil {
+			return TickerMetadata{}, err
+		}
+
+		if err := tickerMetadata.ValidateBasic(); err != nil {
+			return TickerMetadata{}, err
+		}
+	}
+
+	if tickerMetadata.MaxRateAge == 0 {
+		tickerMetadata.MaxRateAge = DefaultMaxRateAge
+	}
+
+	return tickerMetadata, nil
+}
+
+// splitTicker returns the base and quote currencies of the given off-chain ticker.
+func splitTicker(ticker string) (string, string, error) {
+	split := strings.Split(ticker, TickerSeparator)
+	if len(split) != 2 || len(split[0]) == 0 || len(split[1]) == 0 {
+		return "", "", fmt.Errorf("ticker %s is not formatted as BASE%sQUOTE", ticker, TickerSeparator)
+	}
+
+	return split[0], split[1], nil
+}
+
+type (
+	// Response is the expected response returned by the FX API. The response is json
+	// formatted. APIs that report the publication time as a unix timestamp rather than a
+	// date are supported as well.
+	// Response format:
+	//
+	//	{
+	//	  "amount": 1.0,
+	//	  "base": "EUR",
+	//	  "date": "2024-01-05",
+	//	  "rates": {
+	//	    "USD": 1.0921
+	//	  }
+	//	}
+	Response struct {
+		Base      string                 `json:"base"`
+		Date      string                 `json:"date"`
+		Timestamp int64                  `json:"timestamp"`
+		Rates     map[string]json.Number `json:"rates"`
+	}
+)
+
+// PublishedAt returns the time at which the API published the rates of the response.
+func (r Response) PublishedAt() (time.Time, error) {
+	if r.Timestamp > 0 {
+		return time.Unix(r.Timestamp, 0).UTC(), nil
+	}
+
+	if len(r.Date) == 0 {
+		return time.Time{}, fmt.Errorf("response does not include a publication date")
+	}
+
+	return time.Parse(DateLayout, r.Date)
+}
+
+// Decode decodes the given http response into a Response.
+func Decode(resp *http.Response) (Response, error) {
+	var result Response
+	err := json.NewDecoder(resp.Body).Decode(&result)
+	return result, err
+}
@@ -107,10 +107,7 @@ func (h *APIHandler) ParseResponse(
 		if err != nil {
 			wErr := fmt.Errorf("failed to convert price to big.Float: %w", err)
 			unresolved[ticker] = providertypes.UnresolvedResult{
-				ErrorWithCode: providertypes.NewErrorWithCode(
-					wErr,
-					providertypes.ErrorFailedToParsePrice,
-				),
+				ErrorWithCode: providertypes.NewParsePriceError(wErr),
 			}
 
 			continue
@@ -103,11 +103,21 @@ func (h *APIHandler) ParseResponse(
 		)
 	}
 
+	// Kraken may respond with a different name for a pair than the one that was requested
+	// e.g. XXBTZUSD for BTCUSD, so the requested tickers are also indexed by their normalized pair.
+	normalized := make(map[string]types.ProviderTicker, len(tickers))
+	for _, ticker := range tickers {
+		normalized[normalizePair(ticker.GetOffChainTicker())] = ticker
+	}
+
 	for pair, resultTicker := range result.Tickers {
 		resultTicker.pair = pair
 		ticker, ok := h.cache.FromOffChainTicker(pair)
 		if !ok {
-			continue
+			ticker, ok = normalized[normalizePair(pair)]
+			if !ok {
+				continue
+			}
 		}
 
 		price, err := math.Float64StringToBigFloat(resultTicker.LastPrice())
@@ -119,10 +129,7 @@ func (h *APIHandler) ParseResponse(
 			)
 
 			unresolved[ticker] = providertypes.UnresolvedResult{
-				ErrorWithCode: providertypes.NewErrorWithCode(
-					wErr,
-					providertypes.ErrorFailedToParsePrice,
-				),
+				ErrorWithCode: providertypes.NewParsePriceError(wErr),
 			}
 			continue
 		}
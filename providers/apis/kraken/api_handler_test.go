@@ -21,6 +21,11 @@ var (
 	btcusdt = kraken.DefaultMarketConfig.MustGetProviderTicker(constants.BITCOIN_USDT)
 	ethusdt = kraken.DefaultMarketConfig.MustGetProviderTicker(constants.ETHEREUM_USDT)
 	ethusd  = kraken.DefaultMarketConfig.MustGetProviderTicker(constants.ETHEREUM_USD)
+
+	// Tickers configured with the standard asset codes rather than Kraken's pair names.
+	btcusdStandard  = types.NewProviderTicker("BTCUSD", "")
+	dogeusdStandard = types.NewProviderTicker("DOGEUSD", "")
+	ethbtcStandard  = types.NewProviderTicker("ETHBTC", "")
 )
 
 func TestCreateURL(t *testing.T) {
@@ -116,6 +121,31 @@ func TestParseResponse(t *testing.T) {
 				types.UnResolvedPrices{},
 			),
 		},
+		{
+			name: "pairs renamed by kraken are mapped to the requested tickers",
+			cps: []types.ProviderTicker{
+				btcusdStandard,
+				dogeusdStandard,
+				ethbtcStandard,
+			},
+			response: testutils.CreateResponseFromJSON(
+				`{"error":[],"result":{"XXBTZUSD":{"c":["64547.20000","0.00013362"]},"XDGUSD":{"c":["0.15340000","100"]},"XETHXXBT":{"c":["0.05170000","0.1"]}}}`,
+			),
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdStandard: {
+						Value: big.NewFloat(64547.2),
+					},
+					dogeusdStandard: {
+						Value: big.NewFloat(0.1534),
+					},
+					ethbtcStandard: {
+						Value: big.NewFloat(0.0517),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
 		{
 			name: "bad response",
 			cps: []types.ProviderTicker{
@@ -3,6 +3,7 @@ package kraken
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -26,6 +27,13 @@ const (
 	Separator = ","
 )
 
+// assetAliases maps the legacy asset codes used by Kraken to the codes used by the rest of the
+// market, i.e. Kraken refers to BTC as XBT and to DOGE as XDG.
+var assetAliases = map[string]string{
+	"XBT": "BTC",
+	"XDG": "DOGE",
+}
+
 var (
 	// DefaultAPIConfig is the default configuration for the Kraken API.
 	DefaultAPIConfig = config.APIConfig{
@@ -209,6 +217,30 @@ func (ktr *TickerResult) LastPrice() string {
 	return ktr.ClosePriceStats[0]
 }
 
+// normalizePair returns the canonical form of a Kraken pair, such that the pair returned by the
+// Kraken API matches the pair that was requested regardless of the naming scheme used. Kraken
+// responds with its own pair names, which prefix legacy assets with X (crypto) or Z (fiat) and use
+// XBT and XDG for BTC and DOGE, e.g. a request for BTCUSD is answered with XXBTZUSD.
+func normalizePair(pair string) string {
+	pair = strings.ToUpper(pair)
+
+	// Legacy pairs consist of two prefixed three character asset codes e.g. XXBTZUSD.
+	if len(pair) == 8 && strings.ContainsRune("XZ", rune(pair[0])) && strings.ContainsRune("XZ", rune(pair[4])) {
+		pair = pair[1:4] + pair[5:]
+	}
+
+	for alias, asset := range assetAliases {
+		if strings.HasPrefix(pair, alias) {
+			pair = asset + strings.TrimPrefix(pair, alias)
+		}
+		if strings.HasSuffix(pair, alias) {
+			pair = strings.TrimSuffix(pair, alias) + asset
+		}
+	}
+
+	return pair
+}
+
 // ResponseBody returns a list of tickers for the response.  If there is an error, it will be included,
 // and all Tickers will be undefined.
 type ResponseBody struct {
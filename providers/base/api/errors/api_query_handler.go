@@ -22,6 +22,10 @@ var (
 	// ErrRateLimit is returned when the APIQueryHandler encounters a rate limit.
 	ErrRateLimit = errors.New("api query handler encountered a rate limit")
 
+	// ErrResponseTooLarge is returned when the response body exceeds the maximum size
+	// configured for the provider.
+	ErrResponseTooLarge = errors.New("api response body exceeds the maximum size")
+
 	// ErrUnexpectedStatusCode is returned when the APIQueryHandler encounters an unexpected status code.
 	ErrUnexpectedStatusCode = errors.New("api query handler encountered an unexpected status code")
 )
@@ -53,3 +57,8 @@ func ErrParseResponseWithErr(err error) error {
 func ErrUnexpectedStatusCodeWithCode(code int) error {
 	return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, code)
 }
+
+// ErrResponseTooLargeWithLimit is used to create a new ErrResponseTooLarge with the given limit.
+func ErrResponseTooLargeWithLimit(limit int64) error {
+	return fmt.Errorf("%w of %d bytes", ErrResponseTooLarge, limit)
+}
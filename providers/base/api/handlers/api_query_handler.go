@@ -8,11 +8,15 @@ import (
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/pkg/math"
+	"github.com/skip-mev/slinky/pkg/tracing"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
@@ -31,6 +35,23 @@ type APIQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseValue]
 	)
 }
 
+// APIRefresher is an optional interface implemented by API query handlers that can fetch data
+// for a set of IDs on demand, outside of the regular query interval. The handler must respect
+// the context timeout and return once all requests have completed.
+type APIRefresher[K providertypes.ResponseKey, V providertypes.ResponseValue] interface {
+	Refresh(
+		ctx context.Context,
+		ids []K,
+	) providertypes.GetResponse[K, V]
+}
+
+// APIRateLimiter is an optional interface implemented by API query handlers whose requests can
+// be governed by a rate limiter, e.g. one that is shared with other providers that query the
+// same rate-limited service. The rate limiter must be set before the handler is started.
+type APIRateLimiter interface {
+	SetRateLimiter(limiter *rate.Limiter)
+}
+
 // APIFetcher is an interface that encapsulates fetching data from a provider. This interface
 // is meant to abstract over the various processes of interacting w/ GRPC, JSON-RPC, REST, etc. APIs.
 //
@@ -58,6 +79,9 @@ type APIQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseVa
 
 	// fetcher is responsible for fetching data from the API.
 	fetcher APIFetcher[K, V]
+
+	// limiter is an optional rate limiter that every request waits on before it is made.
+	limiter *rate.Limiter
 }
 
 // NewAPIQueryHandler creates a new APIQueryHandler. It manages querying the data
@@ -249,9 +273,138 @@ func (h *APIQueryHandlerImpl[K, V]) subTask(
 		}()
 
 		h.logger.Debug("starting subtask", zap.Any("ids", ids))
-		h.writeResponse(ctx, responseCh, h.fetcher.Fetch(ctx, ids))
+		if err := h.waitRateLimit(ctx); err != nil {
+			h.logger.Debug("stopped waiting for rate limit", zap.Any("ids", ids), zap.Error(err))
+			return nil
+		}
+
+		fetchCtx, span := h.startFetchSpan(ctx, ids)
+		response := h.fetcher.Fetch(fetchCtx, ids)
+		h.markOmittedIDs(ids, &response)
+		h.handleUnrequestedIDs(ids, &response)
+		span.SetAttributes(
+			attribute.Int("slinky.num_resolved", len(response.Resolved)),
+			attribute.Int("slinky.num_unresolved", len(response.UnResolved)),
+		)
+		span.End()
+
+		h.writeResponse(ctx, responseCh, response)
+		return nil
+	}
+}
+
+// SetRateLimiter sets the rate limiter that every request made by the handler waits on. The
+// same rate limiter may be shared by the handlers of several providers.
+func (h *APIQueryHandlerImpl[K, V]) SetRateLimiter(limiter *rate.Limiter) {
+	h.limiter = limiter
+}
+
+// waitRateLimit blocks until the rate limiter, if any, allows a request to be made. This returns
+// an error if the context is cancelled, or would expire, before a request is allowed.
+func (h *APIQueryHandlerImpl[K, V]) waitRateLimit(ctx context.Context) error {
+	if h.limiter == nil {
 		return nil
 	}
+
+	if err := h.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait for rate limit of provider %s: %w", h.config.Name, err)
+	}
+
+	return nil
+}
+
+// startFetchSpan starts the span that times a single fetch of the given IDs from the provider.
+func (h *APIQueryHandlerImpl[K, V]) startFetchSpan(ctx context.Context, ids []K) (context.Context, trace.Span) {
+	pairs := make([]string, len(ids))
+	for i, id := range ids {
+		pairs[i] = id.String()
+	}
+
+	return tracing.Tracer().Start(
+		ctx,
+		"APIQueryHandler.Fetch",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.ProviderKey.String(h.config.Name),
+			tracing.PairsKey.StringSlice(pairs),
+		),
+	)
+}
+
+// markOmittedIDs compares the requested IDs against the IDs returned by the fetcher. Any ID
+// that was neither resolved nor unresolved was silently omitted by the provider (e.g. the
+// market was delisted) and is marked as unresolved with ErrorNotReturned. This distinguishes
+// pairs that the provider no longer returns from network or parsing errors.
+func (h *APIQueryHandlerImpl[K, V]) markOmittedIDs(
+	ids []K,
+	response *providertypes.GetResponse[K, V],
+) {
+	for _, id := range ids {
+		if _, ok := response.Resolved[id]; ok {
+			continue
+		}
+
+		if _, ok := response.UnResolved[id]; ok {
+			continue
+		}
+
+		h.logger.Warn("id was requested but not returned by provider", zap.String("id", id.String()))
+
+		if response.UnResolved == nil {
+			response.UnResolved = make(map[K]providertypes.UnresolvedResult)
+		}
+
+		response.UnResolved[id] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(
+				fmt.Errorf("%s was not returned by provider %s", id.String(), h.config.Name),
+				providertypes.ErrorNotReturned,
+			),
+		}
+	}
+}
+
+// handleUnrequestedIDs applies the configured unrequested policy to the IDs returned by the
+// fetcher that were not requested. Such IDs can indicate that an off-chain ticker maps to more
+// than one pair. By default, they are passed through silently.
+func (h *APIQueryHandlerImpl[K, V]) handleUnrequestedIDs(
+	ids []K,
+	response *providertypes.GetResponse[K, V],
+) {
+	policy := h.config.UnrequestedPolicy
+	if policy == "" || policy == config.UnrequestedPolicyIgnore {
+		return
+	}
+
+	requested := make(map[K]struct{}, len(ids))
+	for _, id := range ids {
+		requested[id] = struct{}{}
+	}
+
+	unrequested := make(map[K]struct{})
+	for id := range response.Resolved {
+		if _, ok := requested[id]; !ok {
+			unrequested[id] = struct{}{}
+		}
+	}
+	for id := range response.UnResolved {
+		if _, ok := requested[id]; !ok {
+			unrequested[id] = struct{}{}
+		}
+	}
+
+	for id := range unrequested {
+		h.logger.Warn(
+			"provider returned a result for an id that was not requested",
+			zap.String("id", id.String()),
+			zap.String("policy", string(policy)),
+		)
+		h.metrics.AddUnrequestedID(h.config.Name, strings.ToLower(id.String()))
+
+		if policy == config.UnrequestedPolicyDrop {
+			delete(response.Resolved, id)
+			delete(response.UnResolved, id)
+		}
+	}
 }
 
 // writeResponse is used to write the response to the response channel.
@@ -279,3 +432,76 @@ func (h *APIQueryHandlerImpl[K, V]) writeResponse(
 		h.metrics.AddProviderResponse(h.config.Name, strings.ToLower(id.String()), unresolvedResult.Code())
 	}
 }
+
+// Refresh fetches the data for the given IDs once, outside of the regular query interval, and
+// returns the merged response. The IDs are batched in the same way as in Query, and at most
+// MaxQueries requests are made concurrently.
+func (h *APIQueryHandlerImpl[K, V]) Refresh(
+	ctx context.Context,
+	ids []K,
+) providertypes.GetResponse[K, V] {
+	if len(ids) == 0 {
+		return providertypes.NewGetResponse[K, V](nil, nil)
+	}
+
+	batchSize := len(ids)
+	if !h.config.Atomic {
+		batchSize = math.Max(1, h.config.BatchSize)
+	}
+	threads := int(gomath.Ceil(float64(len(ids)) / float64(batchSize)))
+
+	wg := errgroup.Group{}
+	wg.SetLimit(math.Min(h.config.MaxQueries, threads))
+
+	responses := make([]providertypes.GetResponse[K, V], threads)
+	for i := 0; i < threads; i++ {
+		batch := ids[i*batchSize : math.Min(len(ids), (i+1)*batchSize)]
+
+		wg.Go(func() (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					h.logger.Error("panic occurred in refresh", zap.Any("panic", r), zap.Any("ids", batch))
+					responses[i] = providertypes.NewGetResponseWithErr[K, V](
+						batch,
+						providertypes.NewErrorWithCode(fmt.Errorf("panic occurred in refresh: %v", r), providertypes.ErrorUnknown),
+					)
+				}
+			}()
+
+			if err := h.waitRateLimit(ctx); err != nil {
+				responses[i] = providertypes.NewGetResponseWithErr[K, V](
+					batch,
+					providertypes.NewErrorWithCode(err, providertypes.ErrorRateLimitExceeded),
+				)
+				return nil
+			}
+
+			fetchCtx, span := h.startFetchSpan(ctx, batch)
+			defer span.End()
+
+			response := h.fetcher.Fetch(fetchCtx, batch)
+			h.markOmittedIDs(batch, &response)
+			h.handleUnrequestedIDs(batch, &response)
+			responses[i] = response
+
+			return nil
+		})
+	}
+	_ = wg.Wait()
+
+	// Merge the responses of all batches.
+	resolved := make(map[K]providertypes.ResolvedResult[V])
+	unResolved := make(map[K]providertypes.UnresolvedResult)
+	for _, response := range responses {
+		for id, result := range response.Resolved {
+			resolved[id] = result
+			h.metrics.AddProviderResponse(h.config.Name, strings.ToLower(id.String()), providertypes.OK)
+		}
+		for id, result := range response.UnResolved {
+			unResolved[id] = result
+			h.metrics.AddProviderResponse(h.config.Name, strings.ToLower(id.String()), result.Code())
+		}
+	}
+
+	return providertypes.NewGetResponse(resolved, unResolved)
+}
@@ -1,6 +1,7 @@
 package handlers_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -114,7 +116,7 @@ func TestAPIQueryHandler(t *testing.T) {
 					nil,
 				)
 
-				h.On("ParseResponse", expectedIDs, newValidResponse()).Return(response).Maybe()
+				h.On("ParseResponse", expectedIDs, validResponse).Return(response).Maybe()
 
 				return h
 			},
@@ -164,7 +166,7 @@ func TestAPIQueryHandler(t *testing.T) {
 					nil,
 				)
 
-				h.On("ParseResponse", expectedIDs, newValidResponse()).Return(response).Maybe()
+				h.On("ParseResponse", expectedIDs, validResponse).Return(response).Maybe()
 
 				return h
 			},
@@ -327,7 +329,7 @@ func TestAPIQueryHandler(t *testing.T) {
 					nil,
 				)
 
-				h.On("ParseResponse", expectedIDs, newValidResponse()).Return(response).Maybe()
+				h.On("ParseResponse", expectedIDs, validResponse).Return(response).Maybe()
 
 				return h
 			},
@@ -359,6 +361,64 @@ func TestAPIQueryHandler(t *testing.T) {
 				UnResolved: map[slinkytypes.CurrencyPair]providertypes.UnresolvedResult{},
 			},
 		},
+		{
+			name: "multiple ids to query with an id omitted by the provider and atomic handler",
+			requestHandler: func() handlers.RequestHandler {
+				h := mocks.NewRequestHandler(t)
+
+				h.On("Do", mock.Anything, constantURL).Return(newValidResponse(), nil).Maybe().After(1 * time.Second)
+
+				return h
+			},
+			apiHandler: func() handlers.APIDataHandler[slinkytypes.CurrencyPair, *big.Int] {
+				expectedIDs := []slinkytypes.CurrencyPair{btcusd, ethusd}
+
+				h := mocks.NewAPIDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+
+				h.On("CreateURL", expectedIDs).Return(constantURL, nil).Maybe()
+
+				resolved := map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					btcusd: {
+						Value: big.NewInt(100),
+					},
+				}
+				response := providertypes.NewGetResponse(
+					resolved,
+					nil,
+				)
+
+				h.On("ParseResponse", expectedIDs, validResponse).Return(response).Maybe()
+
+				return h
+			},
+			metrics: func() metrics.APIMetrics {
+				m := mockmetrics.NewAPIMetrics(t)
+
+				m.On("ObserveProviderResponseLatency", "handler1", metrics.RedactedURL, mock.Anything).Maybe()
+				m.On("AddHTTPStatusCode", "handler1", mock.Anything).Maybe()
+				m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(btcusd)), providertypes.OK).Maybe()
+				m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(ethusd)), providertypes.ErrorNotReturned).Maybe()
+
+				return m
+			},
+			ids:    []slinkytypes.CurrencyPair{btcusd, ethusd},
+			atomic: true,
+			responses: providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+				Resolved: map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					btcusd: {
+						Value: big.NewInt(100),
+					},
+				},
+				UnResolved: map[slinkytypes.CurrencyPair]providertypes.UnresolvedResult{
+					ethusd: {
+						ErrorWithCode: providertypes.NewErrorWithCode(
+							fmt.Errorf("%s was not returned by provider %s", ethusd.String(), "handler1"),
+							providertypes.ErrorNotReturned,
+						),
+					},
+				},
+			},
+		},
 		{
 			name: "multiple ids to query with no errors and non-atomic handler",
 			requestHandler: func() handlers.RequestHandler {
@@ -405,9 +465,9 @@ func TestAPIQueryHandler(t *testing.T) {
 					nil,
 				)
 
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{btcusd}, newValidResponse()).Return(btcResponse).Maybe()
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{ethusd}, newValidResponse()).Return(ethResponse).Maybe()
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{atomusd}, newValidResponse()).Return(atomResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{btcusd}, validResponse).Return(btcResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{ethusd}, validResponse).Return(ethResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{atomusd}, validResponse).Return(atomResponse).Maybe()
 
 				return h
 			},
@@ -476,8 +536,8 @@ func TestAPIQueryHandler(t *testing.T) {
 					nil,
 				)
 
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{btcusd}, newValidResponse()).Return(btcResponse).Maybe()
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{atomusd}, newValidResponse()).Return(atomResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{btcusd}, validResponse).Return(btcResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{atomusd}, validResponse).Return(atomResponse).Maybe()
 
 				return h
 			},
@@ -557,9 +617,9 @@ func TestAPIQueryHandler(t *testing.T) {
 					nil,
 				)
 
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{btcusd}, newValidResponse()).Return(btcResponse).Maybe()
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{ethusd}, newValidResponse()).Return(ethResponse).Maybe()
-				h.On("ParseResponse", []slinkytypes.CurrencyPair{atomusd}, newValidResponse()).Return(atomResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{btcusd}, validResponse).Return(btcResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{ethusd}, validResponse).Return(ethResponse).Maybe()
+				h.On("ParseResponse", []slinkytypes.CurrencyPair{atomusd}, validResponse).Return(atomResponse).Maybe()
 
 				return h
 			},
@@ -740,6 +800,215 @@ func TestAPIQueryHandlerWithBatchSize(t *testing.T) {
 	})
 }
 
+func TestAPIQueryHandlerUnrequestedPolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		policy      config.UnrequestedPolicy
+		reported    bool
+		expectedIDs []slinkytypes.CurrencyPair
+	}{
+		{
+			name:        "unrequested ids are passed through silently by default",
+			policy:      "",
+			expectedIDs: []slinkytypes.CurrencyPair{btcusd, ethusd, atomusd},
+		},
+		{
+			name:        "unrequested ids are passed through silently with the ignore policy",
+			policy:      config.UnrequestedPolicyIgnore,
+			expectedIDs: []slinkytypes.CurrencyPair{btcusd, ethusd, atomusd},
+		},
+		{
+			name:        "unrequested ids are reported and passed through with the report policy",
+			policy:      config.UnrequestedPolicyReport,
+			reported:    true,
+			expectedIDs: []slinkytypes.CurrencyPair{btcusd, ethusd, atomusd},
+		},
+		{
+			name:        "unrequested ids are reported and dropped with the drop policy",
+			policy:      config.UnrequestedPolicyDrop,
+			reported:    true,
+			expectedIDs: []slinkytypes.CurrencyPair{btcusd},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			apiCfg := cfg
+			apiCfg.UnrequestedPolicy = tc.policy
+
+			// The provider returns a price for eth and an error for atom, neither of which was requested.
+			pf := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+			pf.On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{btcusd}).Return(providertypes.NewGetResponse(
+				map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					btcusd: {Value: big.NewInt(100)},
+					ethusd: {Value: big.NewInt(200)},
+				},
+				map[slinkytypes.CurrencyPair]providertypes.UnresolvedResult{
+					atomusd: {
+						ErrorWithCode: providertypes.NewErrorWithCode(fmt.Errorf("no price"), providertypes.ErrorUnknownPair),
+					},
+				},
+			)).Maybe()
+
+			m := mockmetrics.NewAPIMetrics(t)
+			m.On("ObserveProviderResponseLatency", "handler1", mock.Anything, mock.Anything).Maybe()
+			m.On("AddProviderResponse", "handler1", mock.Anything, mock.Anything).Maybe()
+			if tc.reported {
+				m.On("AddUnrequestedID", "handler1", strings.ToLower(fmt.Sprint(ethusd))).Once()
+				m.On("AddUnrequestedID", "handler1", strings.ToLower(fmt.Sprint(atomusd))).Once()
+			}
+
+			handler, err := handlers.NewAPIQueryHandlerWithFetcher(zap.NewNop(), apiCfg, pf, m)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], 1)
+			done := make(chan struct{})
+			go func() {
+				handler.Query(ctx, []slinkytypes.CurrencyPair{btcusd}, responseCh)
+				close(done)
+			}()
+
+			var resp providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]
+			select {
+			case resp = <-responseCh:
+			case <-time.After(3 * time.Second):
+				t.Fatal("handler did not respond")
+			}
+
+			cancel()
+			<-done
+
+			ids := make([]slinkytypes.CurrencyPair, 0, len(resp.Resolved)+len(resp.UnResolved))
+			for id := range resp.Resolved {
+				ids = append(ids, id)
+			}
+			for id := range resp.UnResolved {
+				ids = append(ids, id)
+			}
+			require.ElementsMatch(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestAPIQueryHandlerRefresh(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     config.APIConfig
+		batches [][]slinkytypes.CurrencyPair
+	}{
+		{
+			name: "atomic handler fetches all ids at once",
+			cfg: func() config.APIConfig {
+				apiCfg := nonAtomicCfg
+				apiCfg.Atomic = true
+				return apiCfg
+			}(),
+			batches: [][]slinkytypes.CurrencyPair{{btcusd, ethusd, atomusd}},
+		},
+		{
+			name:    "non-atomic handler fetches each id separately",
+			cfg:     nonAtomicCfg,
+			batches: [][]slinkytypes.CurrencyPair{{btcusd}, {ethusd}, {atomusd}},
+		},
+		{
+			name: "non-atomic handler fetches ids in batches",
+			cfg: func() config.APIConfig {
+				apiCfg := nonAtomicCfg
+				apiCfg.BatchSize = 2
+				return apiCfg
+			}(),
+			batches: [][]slinkytypes.CurrencyPair{{btcusd, ethusd}, {atomusd}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The provider resolves every id except atom, which it omits from its response.
+			pf := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+			for _, batch := range tc.batches {
+				resolved := make(map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int])
+				for _, id := range batch {
+					if id != atomusd {
+						resolved[id] = providertypes.ResolvedResult[*big.Int]{Value: big.NewInt(100)}
+					}
+				}
+
+				pf.On("Fetch", mock.Anything, batch).Return(providertypes.NewGetResponse(resolved, nil)).Once()
+			}
+
+			m := mockmetrics.NewAPIMetrics(t)
+			m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(btcusd)), providertypes.OK).Once()
+			m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(ethusd)), providertypes.OK).Once()
+			m.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(atomusd)), providertypes.ErrorNotReturned).Once()
+
+			handler, err := handlers.NewAPIQueryHandlerWithFetcher(zap.NewNop(), tc.cfg, pf, m)
+			require.NoError(t, err)
+
+			refresher, ok := handler.(handlers.APIRefresher[slinkytypes.CurrencyPair, *big.Int])
+			require.True(t, ok)
+
+			resp := refresher.Refresh(context.Background(), []slinkytypes.CurrencyPair{btcusd, ethusd, atomusd})
+			require.Len(t, resp.Resolved, 2)
+			require.Contains(t, resp.Resolved, btcusd)
+			require.Contains(t, resp.Resolved, ethusd)
+			require.Len(t, resp.UnResolved, 1)
+			require.Equal(t, providertypes.ErrorNotReturned, resp.UnResolved[atomusd].Code())
+		})
+	}
+}
+
+func TestAPIQueryHandlerSharedRateLimit(t *testing.T) {
+	// The limiter allows a single request, and refills far slower than the test runs.
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+
+	// The first handler consumes the only request allowed by the shared limiter.
+	pf1 := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+	pf1.On("Fetch", mock.Anything, []slinkytypes.CurrencyPair{btcusd}).Return(providertypes.NewGetResponse(
+		map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+			btcusd: {Value: big.NewInt(100)},
+		},
+		nil,
+	)).Once()
+
+	m1 := mockmetrics.NewAPIMetrics(t)
+	m1.On("AddProviderResponse", "handler1", strings.ToLower(fmt.Sprint(btcusd)), providertypes.OK).Once()
+
+	handler1, err := handlers.NewAPIQueryHandlerWithFetcher(zap.NewNop(), cfg, pf1, m1)
+	require.NoError(t, err)
+	handler1.(handlers.APIRateLimiter).SetRateLimiter(limiter)
+
+	resp := handler1.(handlers.APIRefresher[slinkytypes.CurrencyPair, *big.Int]).Refresh(
+		context.Background(),
+		[]slinkytypes.CurrencyPair{btcusd},
+	)
+	require.Contains(t, resp.Resolved, btcusd)
+
+	// The second handler shares the limiter, so its request is not made.
+	pf2 := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+
+	apiCfg := cfg
+	apiCfg.Name = "handler2"
+	m2 := mockmetrics.NewAPIMetrics(t)
+	m2.On("AddProviderResponse", "handler2", strings.ToLower(fmt.Sprint(btcusd)), providertypes.ErrorRateLimitExceeded).Once()
+
+	handler2, err := handlers.NewAPIQueryHandlerWithFetcher(zap.NewNop(), apiCfg, pf2, m2)
+	require.NoError(t, err)
+	handler2.(handlers.APIRateLimiter).SetRateLimiter(limiter)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	resp = handler2.(handlers.APIRefresher[slinkytypes.CurrencyPair, *big.Int]).Refresh(
+		ctx,
+		[]slinkytypes.CurrencyPair{btcusd},
+	)
+	require.Empty(t, resp.Resolved)
+	require.Equal(t, providertypes.ErrorRateLimitExceeded, resp.UnResolved[btcusd].Code())
+}
+
 func newRateLimitResponse() *http.Response {
 	return &http.Response{
 		StatusCode: http.StatusTooManyRequests,
@@ -754,6 +1023,25 @@ func newUnexpectedStatusCodeResponse() *http.Response {
 	}
 }
 
+// validResponse matches a response equal to newValidResponse. The fetcher wraps the body of the
+// response to cap its size, so the body is compared by what it reads back, and is then restored so
+// that the response can be matched again.
+var validResponse = mock.MatchedBy(func(resp *http.Response) bool {
+	expected := newValidResponse()
+	if resp.StatusCode != expected.StatusCode {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	expectedBody, err := io.ReadAll(expected.Body)
+	return err == nil && bytes.Equal(body, expectedBody)
+})
+
 func newValidResponse() *http.Response {
 	return &http.Response{
 		StatusCode: http.StatusOK,
@@ -1,14 +1,22 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	stderrors "errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	slinkyjson "github.com/skip-mev/slinky/pkg/json"
+	slinkylog "github.com/skip-mev/slinky/pkg/log"
 	"github.com/skip-mev/slinky/providers/base/api/errors"
 	"github.com/skip-mev/slinky/providers/base/api/metrics"
 	providertypes "github.com/skip-mev/slinky/providers/types"
@@ -32,6 +40,20 @@ type RestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue]
 
 	// logger
 	logger *zap.Logger
+
+	// mtx guards consecutiveTimeouts.
+	mtx sync.Mutex
+
+	// consecutiveTimeouts is the number of consecutive requests that have timed out. It is
+	// used to escalate the timeout of subsequent requests.
+	consecutiveTimeouts int
+
+	// renames maps the field names returned by the API to the field names expected by the API
+	// data handler, as configured by the field overrides.
+	renames map[string]string
+
+	// errorLogs suppresses repeated error logs of the requests made for each set of IDs.
+	errorLogs *slinkylog.ErrorSuppressor
 }
 
 // NewRestAPIFetcher creates a new RestAPIFetcher.
@@ -62,12 +84,19 @@ func NewRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValu
 		return nil, fmt.Errorf("metrics is nil")
 	}
 
+	logger = logger.With(zap.String("fetcher", config.Name))
 	return &RestAPIFetcher[K, V]{
 		requestHandler: requestHandler,
 		apiDataHandler: apiDataHandler,
 		metrics:        metrics,
 		config:         config,
-		logger:         logger.With(zap.String("fetcher", config.Name)),
+		logger:         logger,
+		renames:        config.FieldOverrides.Renames(),
+		errorLogs: slinkylog.NewErrorSuppressor(
+			logger,
+			config.ErrorLogSuppression.Enabled,
+			config.ErrorLogSuppression.SummaryInterval,
+		),
 	}, nil
 }
 
@@ -85,6 +114,10 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 
 	// Create the URL for the request.
 	url, err := pf.apiDataHandler.CreateURL(ids)
+	if err == nil {
+		// Apply the base URL override, if any.
+		url, err = pf.config.ResolveURL(url)
+	}
 	if err != nil {
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -98,7 +131,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	pf.logger.Debug("created url", zap.String("url", url))
 
 	// Make the request.
-	apiCtx, cancel := context.WithTimeout(ctx, pf.config.Timeout)
+	apiCtx, cancel := context.WithTimeout(ctx, pf.timeout())
 	defer cancel()
 
 	pf.logger.Debug("making request", zap.String("url", url))
@@ -106,17 +139,14 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	// Record the status code in the metrics.
 	resp, err := pf.requestHandler.Do(apiCtx, url)
 	pf.metrics.AddHTTPStatusCode(pf.config.Name, resp)
+	pf.recordTimeout(ctx.Err() == nil && isTimeout(err))
 	if err != nil {
 		status := providertypes.ErrorUnknown
 		if resp != nil {
 			status = providertypes.ErrorCode(resp.StatusCode)
 		}
 
-		pf.logger.Error(
-			"failed to make request",
-			zap.Error(err),
-			zap.String("url", url),
-		)
+		pf.errorLogs.Error(idsKey(ids), "failed to make request", err, zap.String("url", url))
 
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -149,17 +179,172 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 				providertypes.ErrorCode(resp.StatusCode),
 			),
 		)
+	case resp.ContentLength > pf.config.GetMaxResponseSize():
+		response = providertypes.NewGetResponseWithErr[K, V](
+			ids,
+			providertypes.NewErrorWithCode(
+				errors.ErrResponseTooLargeWithLimit(pf.config.GetMaxResponseSize()),
+				providertypes.ErrorResponseTooLarge,
+			),
+		)
 	default:
+		// Cap the amount of the response body that is read so that a misbehaving endpoint
+		// cannot exhaust the memory of the oracle.
+		resp.Body = newLimitedBody(resp.Body, pf.config.GetMaxResponseSize())
+		if err := pf.overrideFields(resp); err != nil {
+			code := providertypes.ErrorFailedToDecode
+			if stderrors.Is(err, errors.ErrResponseTooLarge) {
+				code = providertypes.ErrorResponseTooLarge
+			}
+
+			response = providertypes.NewGetResponseWithErr[K, V](
+				ids,
+				providertypes.NewErrorWithCode(errors.ErrParseResponseWithErr(err), code),
+			)
+			break
+		}
+
 		response = pf.apiDataHandler.ParseResponse(ids, resp)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		pf.logger.Error(
+		pf.errorLogs.Error(
+			idsKey(ids),
 			"failed to make and parse response",
+			errors.ErrUnexpectedStatusCodeWithCode(resp.StatusCode),
 			zap.Int("status_code", resp.StatusCode),
 			zap.String("url", url),
 		)
+	} else {
+		pf.errorLogs.Clear(idsKey(ids))
 	}
 
 	return response
 }
+
+// idsKey returns the key of the given IDs, which identifies the requests made for them.
+func idsKey[K providertypes.ResponseKey](ids []K) string {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.String()
+	}
+
+	return strings.Join(keys, ",")
+}
+
+// overrideFields renames the fields of the response body according to the configured field
+// overrides, so that the API data handler can parse the response with its hard-coded field
+// names. Bodies that are not valid JSON are passed to the API data handler as is. This is a
+// no-op if no field overrides are configured.
+func (pf *RestAPIFetcher[K, V]) overrideFields(resp *http.Response) error {
+	if len(pf.renames) == 0 {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	renamed, err := slinkyjson.RenameFields(body, pf.renames)
+	if err != nil {
+		pf.logger.Debug("response is not valid json; skipping field overrides", zap.Error(err))
+		renamed = body
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(renamed))
+	resp.ContentLength = int64(len(renamed))
+	return nil
+}
+
+// timeout returns the timeout of the next request, escalated according to the number of
+// consecutive timeouts.
+func (pf *RestAPIFetcher[K, V]) timeout() time.Duration {
+	pf.mtx.Lock()
+	defer pf.mtx.Unlock()
+
+	return pf.config.EscalatedTimeout(pf.consecutiveTimeouts)
+}
+
+// recordTimeout updates the number of consecutive timeouts with the outcome of a request. A
+// request that did not time out resets the escalation.
+func (pf *RestAPIFetcher[K, V]) recordTimeout(timedOut bool) {
+	if !pf.config.TimeoutEscalation.Enabled() {
+		return
+	}
+
+	pf.mtx.Lock()
+	defer pf.mtx.Unlock()
+
+	if !timedOut {
+		if pf.consecutiveTimeouts > 0 {
+			pf.logger.Info(
+				"resetting escalated timeout",
+				zap.Int("consecutive_timeouts", pf.consecutiveTimeouts),
+				zap.Duration("timeout", pf.config.Timeout),
+			)
+		}
+
+		pf.consecutiveTimeouts = 0
+		return
+	}
+
+	// Stop counting once the timeout has reached its cap.
+	current := pf.config.EscalatedTimeout(pf.consecutiveTimeouts)
+	if current >= pf.config.TimeoutEscalation.MaxTimeout {
+		return
+	}
+
+	pf.consecutiveTimeouts++
+	pf.logger.Warn(
+		"escalating timeout after consecutive timeouts",
+		zap.Int("consecutive_timeouts", pf.consecutiveTimeouts),
+		zap.Duration("timeout", pf.config.EscalatedTimeout(pf.consecutiveTimeouts)),
+	)
+}
+
+// isTimeout returns true if the error returned by a request indicates that the request
+// timed out.
+func isTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return stderrors.As(err, &netErr) && netErr.Timeout()
+}
+
+// limitedBody is a response body that returns an error once more than limit bytes have been
+// read, rather than silently truncating the body.
+type limitedBody struct {
+	io.ReadCloser
+
+	reader io.Reader
+	limit  int64
+	read   int64
+}
+
+// newLimitedBody returns a response body that reads at most limit bytes from body.
+func newLimitedBody(body io.ReadCloser, limit int64) io.ReadCloser {
+	return &limitedBody{
+		ReadCloser: body,
+		reader:     io.LimitReader(body, limit+1),
+		limit:      limit,
+	}
+}
+
+// Read reads from the underlying body, returning ErrResponseTooLarge if the body exceeds
+// the limit.
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.reader.Read(p)
+	b.read += int64(n)
+	if b.read > b.limit {
+		return n - int(b.read-b.limit), errors.ErrResponseTooLargeWithLimit(b.limit)
+	}
+
+	return n, err
+}
@@ -0,0 +1,238 @@
+package handlers_test
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/providers/base/api/errors"
+	"github.com/skip-mev/slinky/providers/base/api/handlers"
+	"github.com/skip-mev/slinky/providers/base/api/handlers/mocks"
+	mockmetrics "github.com/skip-mev/slinky/providers/base/api/metrics/mocks"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+func TestRestAPIFetcherMaxResponseSize(t *testing.T) {
+	limitedCfg := cfg
+	limitedCfg.MaxResponseSize = 24
+
+	testCases := []struct {
+		name     string
+		response func() *http.Response
+		parsed   bool
+		err      error
+	}{
+		{
+			name: "response within the limit is parsed",
+			response: func() *http.Response {
+				return newValidResponse()
+			},
+			parsed: true,
+		},
+		{
+			name: "response exceeding the limit fails to parse",
+			response: func() *http.Response {
+				return &http.Response{
+					StatusCode:    http.StatusOK,
+					Body:          io.NopCloser(strings.NewReader(strings.Repeat("a", 32))),
+					ContentLength: -1,
+				}
+			},
+			parsed: true,
+			err:    errors.ErrResponseTooLarge,
+		},
+		{
+			name: "response with a content length exceeding the limit is rejected",
+			response: func() *http.Response {
+				return &http.Response{
+					StatusCode:    http.StatusOK,
+					Body:          io.NopCloser(strings.NewReader(strings.Repeat("a", 32))),
+					ContentLength: 32,
+				}
+			},
+			err: errors.ErrResponseTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requestHandler := mocks.NewRequestHandler(t)
+			requestHandler.On("Do", mock.Anything, constantURL).Return(tc.response(), nil)
+
+			apiHandler := mocks.NewAPIDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+			apiHandler.On("CreateURL", mock.Anything).Return(constantURL, nil)
+			if tc.parsed {
+				apiHandler.On("ParseResponse", mock.Anything, mock.Anything).Return(
+					func(ids []slinkytypes.CurrencyPair, resp *http.Response) providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int] {
+						if _, err := io.ReadAll(resp.Body); err != nil {
+							return providertypes.NewGetResponseWithErr[slinkytypes.CurrencyPair, *big.Int](
+								ids,
+								providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+							)
+						}
+
+						return providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+							map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+								btcusd: providertypes.NewResult(big.NewInt(100), time.Now()),
+							},
+							nil,
+						)
+					},
+				)
+			}
+
+			metrics := mockmetrics.NewAPIMetrics(t)
+			metrics.On("AddHTTPStatusCode", cfg.Name, mock.Anything)
+			metrics.On("ObserveProviderResponseLatency", cfg.Name, mock.Anything, mock.Anything)
+
+			fetcher, err := handlers.NewRestAPIFetcher(requestHandler, apiHandler, metrics, limitedCfg, logger)
+			require.NoError(t, err)
+
+			resp := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+			if tc.err == nil {
+				require.Len(t, resp.Resolved, 1)
+				require.Empty(t, resp.UnResolved)
+				return
+			}
+
+			require.Empty(t, resp.Resolved)
+			require.Len(t, resp.UnResolved, 1)
+			require.ErrorContains(t, resp.UnResolved[btcusd], tc.err.Error())
+		})
+	}
+}
+
+func TestRestAPIFetcherFieldOverrides(t *testing.T) {
+	overriddenCfg := cfg
+	overriddenCfg.FieldOverrides = config.FieldOverrides{"lastPrice": "last"}
+
+	testCases := []struct {
+		name     string
+		cfg      config.APIConfig
+		body     string
+		expected string
+	}{
+		{
+			name:     "fields are passed through without overrides",
+			cfg:      cfg,
+			body:     `{"last":"100"}`,
+			expected: `{"last":"100"}`,
+		},
+		{
+			name:     "overridden fields are renamed",
+			cfg:      overriddenCfg,
+			body:     `{"last":"100","symbol":"BTCUSD"}`,
+			expected: `{"lastPrice":"100","symbol":"BTCUSD"}`,
+		},
+		{
+			name:     "response that is not json is passed through",
+			cfg:      overriddenCfg,
+			body:     `last=100`,
+			expected: `last=100`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requestHandler := mocks.NewRequestHandler(t)
+			requestHandler.On("Do", mock.Anything, constantURL).Return(&http.Response{
+				StatusCode:    http.StatusOK,
+				Body:          io.NopCloser(strings.NewReader(tc.body)),
+				ContentLength: int64(len(tc.body)),
+			}, nil)
+
+			apiHandler := mocks.NewAPIDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+			apiHandler.On("CreateURL", mock.Anything).Return(constantURL, nil)
+			apiHandler.On("ParseResponse", mock.Anything, mock.Anything).Return(
+				func(ids []slinkytypes.CurrencyPair, resp *http.Response) providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int] {
+					body, err := io.ReadAll(resp.Body)
+					require.NoError(t, err)
+					require.Equal(t, tc.expected, string(body))
+
+					return providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+						map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+							btcusd: providertypes.NewResult(big.NewInt(100), time.Now()),
+						},
+						nil,
+					)
+				},
+			)
+
+			metrics := mockmetrics.NewAPIMetrics(t)
+			metrics.On("AddHTTPStatusCode", cfg.Name, mock.Anything)
+			metrics.On("ObserveProviderResponseLatency", cfg.Name, mock.Anything, mock.Anything)
+
+			fetcher, err := handlers.NewRestAPIFetcher(requestHandler, apiHandler, metrics, tc.cfg, logger)
+			require.NoError(t, err)
+
+			resp := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+			require.Len(t, resp.Resolved, 1)
+		})
+	}
+}
+
+func TestRestAPIFetcherTimeoutEscalation(t *testing.T) {
+	escalatingCfg := cfg
+	escalatingCfg.TimeoutEscalation = config.TimeoutEscalationConfig{
+		Multiplier: 2,
+		MaxTimeout: 4 * cfg.Timeout,
+	}
+
+	// timeouts records the timeout of each request made by the fetcher.
+	var timeouts []time.Duration
+	recordTimeout := func(args mock.Arguments) {
+		deadline, ok := args.Get(0).(context.Context).Deadline()
+		require.True(t, ok)
+		timeouts = append(timeouts, time.Until(deadline))
+	}
+
+	requestHandler := mocks.NewRequestHandler(t)
+	requestHandler.On("Do", mock.Anything, constantURL).Return(nil, context.DeadlineExceeded).Run(recordTimeout).Times(4)
+	requestHandler.On("Do", mock.Anything, constantURL).Return(newValidResponse(), nil).Run(recordTimeout).Times(2)
+
+	apiHandler := mocks.NewAPIDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+	apiHandler.On("CreateURL", mock.Anything).Return(constantURL, nil)
+	apiHandler.On("ParseResponse", mock.Anything, mock.Anything).Return(
+		providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+			map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+				btcusd: providertypes.NewResult(big.NewInt(100), time.Now()),
+			},
+			nil,
+		),
+	)
+
+	metrics := mockmetrics.NewAPIMetrics(t)
+	metrics.On("AddHTTPStatusCode", cfg.Name, mock.Anything)
+	metrics.On("ObserveProviderResponseLatency", cfg.Name, mock.Anything, mock.Anything)
+
+	fetcher, err := handlers.NewRestAPIFetcher(requestHandler, apiHandler, metrics, escalatingCfg, logger)
+	require.NoError(t, err)
+
+	for i := 0; i < 6; i++ {
+		fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd})
+	}
+
+	// The timeout doubles after each consecutive timeout up to the cap, and is reset once a
+	// request completes.
+	expected := []time.Duration{
+		cfg.Timeout,
+		2 * cfg.Timeout,
+		4 * cfg.Timeout,
+		4 * cfg.Timeout,
+		4 * cfg.Timeout,
+		cfg.Timeout,
+	}
+	require.Len(t, timeouts, len(expected))
+	for i := range expected {
+		require.InDelta(t, expected[i], timeouts[i], float64(50*time.Millisecond), "request %d", i)
+	}
+}
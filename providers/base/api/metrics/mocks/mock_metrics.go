@@ -33,6 +33,11 @@ func (_m *APIMetrics) AddRPCStatusCode(providerName string, endpoint string, cod
 	_m.Called(providerName, endpoint, code)
 }
 
+// AddUnrequestedID provides a mock function with given fields: providerName, id
+func (_m *APIMetrics) AddUnrequestedID(providerName string, id string) {
+	_m.Called(providerName, id)
+}
+
 // ObserveProviderResponseLatency provides a mock function with given fields: providerName, endpoint, duration
 func (_m *APIMetrics) ObserveProviderResponseLatency(providerName string, endpoint string, duration time.Duration) {
 	_m.Called(providerName, endpoint, duration)
@@ -0,0 +1,99 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	"go.uber.org/zap"
+
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+var (
+	// ErrNonPositivePrice is returned when a result fails the positive price assertion.
+	ErrNonPositivePrice = errors.New("price is not positive")
+	// ErrUnrequestedID is returned when a result fails the requested IDs assertion.
+	ErrUnrequestedID = errors.New("id was not requested")
+	// ErrTimestampSkew is returned when a result fails the max timestamp skew assertion.
+	ErrTimestampSkew = errors.New("event timestamp is skewed")
+)
+
+// signed is implemented by numeric values, e.g. *big.Float and *big.Int, whose sign can be
+// evaluated by the positive price assertion.
+type signed interface {
+	Sign() int
+}
+
+// assertResults evaluates the provider's response assertions against the resolved results of the
+// given response. Results that fail an assertion are moved to the unresolved results with an
+// ErrorAssertionFailed error code, so that they are not utilized and are recorded as failures in
+// the metrics.
+func (p *Provider[K, V]) assertResults(r providertypes.GetResponse[K, V]) providertypes.GetResponse[K, V] {
+	if !p.assertions.Enabled() || len(r.Resolved) == 0 {
+		return r
+	}
+
+	var requested map[K]struct{}
+	if p.assertions.RequestedIDs {
+		ids := p.GetIDs()
+		requested = make(map[K]struct{}, len(ids))
+		for _, id := range ids {
+			requested[id] = struct{}{}
+		}
+	}
+
+	resolved := make(map[K]providertypes.ResolvedResult[V], len(r.Resolved))
+	unResolved := maps.Clone(r.UnResolved)
+	if unResolved == nil {
+		unResolved = make(map[K]providertypes.UnresolvedResult)
+	}
+
+	for id, result := range r.Resolved {
+		if err := p.assert(id, result, requested); err != nil {
+			p.logger.Warn(
+				"result failed response assertion; dropping result",
+				zap.String("id", id.String()),
+				zap.String("result", result.String()),
+				zap.Error(err),
+			)
+
+			unResolved[id] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorAssertionFailed),
+			}
+			continue
+		}
+
+		resolved[id] = result
+	}
+
+	return providertypes.NewGetResponse(resolved, unResolved)
+}
+
+// assert returns an error if the given result does not satisfy the provider's response assertions.
+// The requested set is only consulted if the requested IDs assertion is enabled.
+func (p *Provider[K, V]) assert(id K, result providertypes.ResolvedResult[V], requested map[K]struct{}) error {
+	if p.assertions.RequestedIDs {
+		if _, ok := requested[id]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnrequestedID, id.String())
+		}
+	}
+
+	// Values that are not numeric cannot be evaluated by the positive price assertion.
+	if value, ok := any(result.Value).(signed); ok && p.assertions.PositivePrice && value.Sign() <= 0 {
+		return fmt.Errorf("%w: %s", ErrNonPositivePrice, result.Value.String())
+	}
+
+	if maxSkew := p.assertions.MaxTimestampSkew; maxSkew > 0 && !result.EventTimestamp.IsZero() {
+		skew := result.Timestamp.Sub(result.EventTimestamp)
+		if skew < 0 {
+			skew = -skew
+		}
+
+		if skew > maxSkew {
+			return fmt.Errorf("%w: %s exceeds %s", ErrTimestampSkew, skew, maxSkew)
+		}
+	}
+
+	return nil
+}
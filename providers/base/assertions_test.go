@@ -0,0 +1,171 @@
+package base_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	"github.com/skip-mev/slinky/providers/base"
+	providermetrics "github.com/skip-mev/slinky/providers/base/metrics"
+	metricmocks "github.com/skip-mev/slinky/providers/base/metrics/mocks"
+	"github.com/skip-mev/slinky/providers/base/testutils"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+func TestResponseAssertions(t *testing.T) {
+	// The response contains a valid result, a non-positive price, a result whose event timestamp
+	// is an hour behind the time at which it was received, and a result for an unrequested pair.
+	resolved := map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+		pairs[0]: {
+			Value:          big.NewInt(100),
+			Timestamp:      respTime,
+			EventTimestamp: respTime,
+		},
+		pairs[1]: {
+			Value:     big.NewInt(0),
+			Timestamp: respTime,
+		},
+		btcusd: {
+			Value:     big.NewInt(300),
+			Timestamp: respTime,
+		},
+		ethusd: {
+			Value:          big.NewInt(400),
+			Timestamp:      respTime,
+			EventTimestamp: respTime.Add(-time.Hour),
+		},
+	}
+	requested := []slinkytypes.CurrencyPair{pairs[0], pairs[1], ethusd}
+
+	testCases := []struct {
+		name       string
+		assertions config.ResponseAssertionsConfig
+		expected   []slinkytypes.CurrencyPair
+	}{
+		{
+			name:       "no assertions",
+			assertions: config.ResponseAssertionsConfig{},
+			expected:   []slinkytypes.CurrencyPair{pairs[0], pairs[1], btcusd, ethusd},
+		},
+		{
+			name: "positive price",
+			assertions: config.ResponseAssertionsConfig{
+				PositivePrice: true,
+			},
+			expected: []slinkytypes.CurrencyPair{pairs[0], btcusd, ethusd},
+		},
+		{
+			name: "requested ids",
+			assertions: config.ResponseAssertionsConfig{
+				RequestedIDs: true,
+			},
+			expected: []slinkytypes.CurrencyPair{pairs[0], pairs[1], ethusd},
+		},
+		{
+			name: "max timestamp skew",
+			assertions: config.ResponseAssertionsConfig{
+				MaxTimestampSkew: time.Minute,
+			},
+			expected: []slinkytypes.CurrencyPair{pairs[0], pairs[1], btcusd},
+		},
+		{
+			name: "all assertions",
+			assertions: config.ResponseAssertionsConfig{
+				PositivePrice:    true,
+				RequestedIDs:     true,
+				MaxTimestampSkew: time.Minute,
+			},
+			expected: []slinkytypes.CurrencyPair{pairs[0]},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := testutils.CreateAPIQueryHandlerWithGetResponses[slinkytypes.CurrencyPair, *big.Int](
+				t,
+				logger,
+				[]providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+					providertypes.NewGetResponse(resolved, nil),
+				},
+				200*time.Millisecond,
+			)
+
+			provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+				base.WithName[slinkytypes.CurrencyPair, *big.Int](apiCfg.Name),
+				base.WithAPIQueryHandler[slinkytypes.CurrencyPair, *big.Int](handler),
+				base.WithAPIConfig[slinkytypes.CurrencyPair, *big.Int](apiCfg),
+				base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+				base.WithIDs[slinkytypes.CurrencyPair, *big.Int](requested),
+				base.WithResponseAssertions[slinkytypes.CurrencyPair, *big.Int](tc.assertions),
+			)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Interval*2)
+			defer cancel()
+
+			err = provider.Start(ctx)
+			require.Equal(t, context.DeadlineExceeded, err)
+
+			data := provider.GetData()
+			require.Len(t, data, len(tc.expected))
+			for _, cp := range tc.expected {
+				require.Contains(t, data, cp)
+			}
+		})
+	}
+
+	t.Run("failed assertions are recorded in the metrics", func(t *testing.T) {
+		handler := testutils.CreateAPIQueryHandlerWithGetResponses[slinkytypes.CurrencyPair, *big.Int](
+			t,
+			logger,
+			[]providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+				providertypes.NewGetResponse(resolved, nil),
+			},
+			200*time.Millisecond,
+		)
+
+		m := metricmocks.NewProviderMetrics(t)
+		failed := strings.ToLower(pairs[1].String())
+		m.On("AddProviderResponseByID", apiCfg.Name, failed, providermetrics.Failure, providertypes.ErrorAssertionFailed, providertypes.API)
+		m.On("AddProviderResponse", apiCfg.Name, providermetrics.Failure, providertypes.ErrorAssertionFailed, providertypes.API)
+		m.On("AddProviderResponseByID", apiCfg.Name, mock.Anything, providermetrics.Success, providertypes.OK, providertypes.API).Maybe()
+		m.On("AddProviderResponse", apiCfg.Name, providermetrics.Success, providertypes.OK, providertypes.API).Maybe()
+		m.On("LastUpdated", apiCfg.Name, mock.Anything, providertypes.API).Maybe()
+
+		provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](apiCfg.Name),
+			base.WithAPIQueryHandler[slinkytypes.CurrencyPair, *big.Int](handler),
+			base.WithAPIConfig[slinkytypes.CurrencyPair, *big.Int](apiCfg),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](requested),
+			base.WithMetrics[slinkytypes.CurrencyPair, *big.Int](m),
+			base.WithResponseAssertions[slinkytypes.CurrencyPair, *big.Int](config.ResponseAssertionsConfig{
+				PositivePrice: true,
+			}),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Interval*2)
+		defer cancel()
+
+		err = provider.Start(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("invalid assertions panic", func(t *testing.T) {
+		require.Panics(t, func() {
+			_, _ = base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+				base.WithResponseAssertions[slinkytypes.CurrencyPair, *big.Int](config.ResponseAssertionsConfig{
+					MaxTimestampSkew: -time.Minute,
+				}),
+			)
+		})
+	})
+}
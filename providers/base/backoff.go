@@ -0,0 +1,60 @@
+package base
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+)
+
+// reconnectBackoff determines the delay before each attempt to reconnect a websocket connection.
+// If the backoff is disabled, the delay is always the reconnection timeout. Otherwise, the delay
+// starts at the reconnection timeout and is multiplied by the backoff base after every reconnect,
+// up to the max reconnect interval, with random jitter added on top so that nodes that lost their
+// connections at the same time do not reconnect in lockstep.
+type reconnectBackoff struct {
+	cfg config.WebSocketConfig
+
+	// delay is the delay before the next reconnect, excluding the jitter.
+	delay time.Duration
+}
+
+// newReconnectBackoff returns a new reconnect backoff for the given websocket config.
+func newReconnectBackoff(cfg config.WebSocketConfig) *reconnectBackoff {
+	return &reconnectBackoff{
+		cfg:   cfg,
+		delay: cfg.ReconnectionTimeout,
+	}
+}
+
+// enabled returns whether the backoff is enabled.
+func (b *reconnectBackoff) enabled() bool {
+	return b.cfg.MaxReconnectInterval > 0
+}
+
+// next returns the delay before the next reconnect, and grows the delay of the reconnect after it.
+func (b *reconnectBackoff) next() time.Duration {
+	if !b.enabled() {
+		return b.cfg.ReconnectionTimeout
+	}
+
+	delay := b.delay
+
+	grown := float64(b.delay) * b.cfg.ReconnectBackoffBase
+	if grown >= float64(b.cfg.MaxReconnectInterval) {
+		b.delay = b.cfg.MaxReconnectInterval
+	} else {
+		b.delay = time.Duration(grown)
+	}
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1)) //nolint:gosec
+}
+
+// observe records that a connection was up for the given duration. If the connection stayed up for
+// at least the max reconnect interval, it is considered healthy and the delay is reset to the
+// reconnection timeout.
+func (b *reconnectBackoff) observe(uptime time.Duration) {
+	if b.enabled() && uptime >= b.cfg.MaxReconnectInterval {
+		b.delay = b.cfg.ReconnectionTimeout
+	}
+}
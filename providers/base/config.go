@@ -1,6 +1,9 @@
 package base
 
 import (
+	"math/big"
+	"time"
+
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -121,6 +124,75 @@ func (p *Provider[K, V]) GetWebSocketHandler() wshandlers.WebSocketQueryHandler[
 	return p.ws
 }
 
+// GetWebSocketConnectionStates returns the state of each of the provider's websocket connections.
+// This returns nil if the provider is not a websocket provider or has not been started.
+func (p *Provider[K, V]) GetWebSocketConnectionStates() []wshandlers.ConnectionState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.wsConns) == 0 {
+		return nil
+	}
+
+	states := make([]wshandlers.ConnectionState, len(p.wsConns))
+	for i, conn := range p.wsConns {
+		states[i] = conn.State()
+	}
+
+	return states
+}
+
+// GetLastError returns the most recent error that the provider encountered, along with the time
+// at which it was encountered. This returns an empty string and a zero time if the provider has
+// not encountered an error.
+func (p *Provider[K, V]) GetLastError() (string, time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.lastErr, p.lastErrTime
+}
+
+// recordError records the given error as the most recent error that the provider encountered.
+func (p *Provider[K, V]) recordError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastErr = err.Error()
+	p.lastErrTime = time.Now().UTC()
+}
+
+// setWebSocketConnections sets the websocket query handlers that manage each of the provider's
+// websocket connections.
+func (p *Provider[K, V]) setWebSocketConnections(conns []wshandlers.WebSocketQueryHandler[K, V]) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.wsConns = conns
+}
+
+// GetMinVolume returns the minimum reported volume required for a result to be utilized. This
+// returns nil if the provider does not have a minimum volume configured.
+func (p *Provider[K, V]) GetMinVolume() *big.Float {
+	return p.minVolume
+}
+
+// GetBaseUnitScale returns the scale applied by consumers of the provider to normalize each result
+// to whole units.
+func (p *Provider[K, V]) GetBaseUnitScale() config.BaseUnitScale {
+	return p.baseUnitScale
+}
+
+// GetPriceAdjustment returns the adjustment applied to each result by consumers of the provider.
+func (p *Provider[K, V]) GetPriceAdjustment() config.PriceAdjustmentConfig {
+	return p.priceAdjustment
+}
+
+// InMaintenance returns true if the given time falls within one of the provider's scheduled
+// maintenance windows.
+func (p *Provider[K, V]) InMaintenance(t time.Time) bool {
+	return config.InMaintenance(p.maintenanceWindows, t)
+}
+
 // GetAPIConfig returns the API configuration for the provider.
 func (p *Provider[K, V]) GetAPIConfig() config.APIConfig {
 	return p.apiCfg
@@ -11,7 +11,10 @@ import (
 
 	"go.uber.org/zap"
 
+	slinkylog "github.com/skip-mev/slinky/pkg/log"
+	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	providermetrics "github.com/skip-mev/slinky/providers/base/metrics"
+	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
 
@@ -67,6 +70,43 @@ func (p *Provider[K, V]) startAPI(ctx context.Context) error {
 	}
 }
 
+// Refresh fetches the data for the given IDs once, outside of the provider's regular update
+// loop, and updates the data with the result. Only IDs that the provider is responsible for are
+// fetched. This returns an error if the provider is not an API provider, or if its API handler
+// does not support on-demand fetches.
+func (p *Provider[K, V]) Refresh(ctx context.Context, ids []K) error {
+	if p.Type() != providertypes.API {
+		return fmt.Errorf("provider %s does not support refreshing data: not an api provider", p.name)
+	}
+
+	refresher, ok := p.GetAPIHandler().(apihandlers.APIRefresher[K, V])
+	if !ok {
+		return fmt.Errorf("provider %s does not support refreshing data", p.name)
+	}
+
+	// Only refresh the IDs that the provider is responsible for.
+	supported := make(map[K]struct{})
+	for _, id := range p.GetIDs() {
+		supported[id] = struct{}{}
+	}
+
+	refreshIDs := make([]K, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := supported[id]; ok {
+			refreshIDs = append(refreshIDs, id)
+		}
+	}
+
+	if len(refreshIDs) == 0 {
+		return nil
+	}
+
+	p.logger.Debug("refreshing data", zap.Int("num_ids", len(refreshIDs)))
+	p.handleResponse(refresher.Refresh(ctx, refreshIDs))
+
+	return nil
+}
+
 // startMultiplexWebsocket is the main loop for web socket providers. It is responsible for
 // creating a connection to the websocket and handling the incoming messages. In the case
 // where multiple connections (multiplexing) are used, this function will start multiple
@@ -108,8 +148,16 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 		wg.SetLimit(1)
 	}
 
-	for _, subIDs := range subTasks {
-		wg.Go(p.startWebSocket(ctx, subIDs))
+	// Each connection is managed by its own copy of the websocket query handler, which is
+	// retained so that the state of each connection can be reported.
+	conns := make([]wshandlers.WebSocketQueryHandler[K, V], len(subTasks))
+	for i := range subTasks {
+		conns[i] = p.GetWebSocketHandler().Copy()
+	}
+	p.setWebSocketConnections(conns)
+
+	for i, subIDs := range subTasks {
+		wg.Go(p.startWebSocket(ctx, conns[i], subIDs))
 	}
 
 	// Wait for all the sub handlers to finish.
@@ -117,13 +165,21 @@ func (p *Provider[K, V]) startMultiplexWebsocket(ctx context.Context) error {
 }
 
 // startWebSocket starts a connection to the websocket and handles the incoming messages.
-func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K) func() error {
+func (p *Provider[K, V]) startWebSocket(
+	ctx context.Context,
+	handler wshandlers.WebSocketQueryHandler[K, V],
+	subIDs []K,
+) func() error {
 	return func() error {
-		// Start the websocket query handler. If the connection fails to start, then the query handler
-		// will be restarted after a timeout.
+		// Start the websocket query handler. If the connection fails to start, or is torn down
+		// after failing, then the query handler will be restarted after the reconnect backoff delay.
 		restarts := 0
-		handler := p.GetWebSocketHandler()
-		handler = handler.Copy()
+		backoff := newReconnectBackoff(p.wsCfg)
+		errorLogs := slinkylog.NewErrorSuppressor(
+			p.logger,
+			p.wsCfg.ErrorLogSuppression.Enabled,
+			p.wsCfg.ErrorLogSuppression.SummaryInterval,
+		)
 		for {
 			select {
 			case <-ctx.Done():
@@ -131,23 +187,65 @@ func (p *Provider[K, V]) startWebSocket(ctx context.Context, subIDs []K) func()
 				return ctx.Err()
 			default:
 				if restarts > 0 {
-					p.logger.Debug("restarting websocket query handler", zap.Int("num_restarts", restarts))
-
 					// If the websocket query handler returns, then the connection was closed. Wait for
 					// a bit before trying to reconnect.
-					time.Sleep(p.wsCfg.ReconnectionTimeout)
+					delay := backoff.next()
+					p.logger.Debug(
+						"restarting websocket query handler",
+						zap.Int("num_restarts", restarts),
+						zap.Duration("delay", delay),
+					)
+					time.Sleep(delay)
 				}
 
 				p.logger.Debug("starting websocket query handler", zap.Int("num_ids", len(subIDs)), zap.Any("ids", subIDs))
+				started := time.Now()
 				if err := handler.Start(ctx, subIDs, p.responseCh); err != nil {
-					p.logger.Error("websocket query handler returned error", zap.Error(err))
+					errorLogs.Error("", "websocket query handler returned error", err)
+				} else {
+					errorLogs.Clear("")
 				}
+				backoff.observe(time.Since(started))
 				restarts++
+
+				// If the connection repeatedly failed to be established, stop retrying until the
+				// reconnect cooldown elapses.
+				if p.wsCfg.MaxReconnectAttempts > 0 && handler.State() == wshandlers.ConnectionStateDisabled {
+					if err := p.waitReconnectCooldown(ctx); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
 }
 
+// waitReconnectCooldown blocks until the reconnect cooldown of a disabled websocket connection
+// elapses. If no cooldown is configured, the connection stays disabled until the context is
+// cancelled.
+func (p *Provider[K, V]) waitReconnectCooldown(ctx context.Context) error {
+	if p.wsCfg.ReconnectCooldown == 0 {
+		p.logger.Warn("websocket connection disabled; not retrying until the provider is restarted")
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	p.logger.Warn(
+		"websocket connection disabled; retrying after the reconnect cooldown",
+		zap.Duration("reconnect_cooldown", p.wsCfg.ReconnectCooldown),
+	)
+
+	timer := time.NewTimer(p.wsCfg.ReconnectCooldown)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // recv receives responses from the response channel and updates the data.
 func (p *Provider[K, V]) recv(ctx context.Context) {
 	p.logger.Debug("starting recv")
@@ -159,39 +257,53 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 			p.logger.Debug("finishing recv and closing with request context err", zap.Error(ctx.Err()))
 			return
 		case r := <-p.responseCh:
-			resolved, unResolved := r.Resolved, r.UnResolved
-
-			// Update all the resolved data.
-			for id, result := range resolved {
-				p.logger.Debug(
-					"successfully fetched data",
-					zap.String("id", id.String()),
-					zap.String("result", result.String()),
-				)
-
-				p.updateData(id, result)
-
-				// Update the metrics.
-				strID := strings.ToLower(id.String())
-				p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Success, providertypes.OK, p.Type())
-				p.metrics.AddProviderResponse(p.name, providermetrics.Success, providertypes.OK, p.Type())
-				p.metrics.LastUpdated(p.name, strID, p.Type())
-			}
+			p.handleResponse(r)
+		}
+	}
+}
 
-			// Log and record all the unresolved data.
-			for id, result := range unResolved {
-				p.logger.Debug(
-					"failed to fetch data",
-					zap.Any("id", id),
-					zap.Error(fmt.Errorf("%s", result.Error())),
-				)
-
-				// Update the metrics.
-				strID := strings.ToLower(id.String())
-				p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Failure, result.Code(), p.Type())
-				p.metrics.AddProviderResponse(p.name, providermetrics.Failure, result.Code(), p.Type())
-			}
+// handleResponse updates the data with the resolved results of the given response that satisfy the
+// provider's response assertions, and records the resolved and unresolved results in the metrics.
+func (p *Provider[K, V]) handleResponse(r providertypes.GetResponse[K, V]) {
+	r = p.assertResults(r)
+	resolved, unResolved := r.Resolved, r.UnResolved
+
+	// Update all the resolved data.
+	for id, result := range resolved {
+		p.logger.Debug(
+			"successfully fetched data",
+			zap.String("id", id.String()),
+			zap.String("result", result.String()),
+		)
+
+		p.updateData(id, result)
+
+		// Update the metrics.
+		strID := strings.ToLower(id.String())
+		p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Success, providertypes.OK, p.Type())
+		p.metrics.AddProviderResponse(p.name, providermetrics.Success, providertypes.OK, p.Type())
+		p.metrics.LastUpdated(p.name, strID, p.Type())
+	}
+
+	// Log and record all the unresolved data.
+	for id, result := range unResolved {
+		p.logger.Debug(
+			"failed to fetch data",
+			zap.Any("id", id),
+			zap.Error(fmt.Errorf("%s", result.Error())),
+		)
+
+		// Record the error along with its category, e.g. rate limit exceeded.
+		if codeErr := result.Code().Error(); codeErr != nil {
+			p.recordError(fmt.Errorf("failed to fetch %s (%s): %w", id.String(), codeErr, result.ErrorWithCode))
+		} else {
+			p.recordError(fmt.Errorf("failed to fetch %s: %w", id.String(), result.ErrorWithCode))
 		}
+
+		// Update the metrics.
+		strID := strings.ToLower(id.String())
+		p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Failure, result.Code(), p.Type())
+		p.metrics.AddProviderResponse(p.name, providermetrics.Failure, result.Code(), p.Type())
 	}
 }
 
@@ -230,6 +342,7 @@ func (p *Provider[K, V]) updateData(id K, result providertypes.ResolvedResult[V]
 		)
 
 		current.Timestamp = result.Timestamp
+		current.EventTimestamp = result.EventTimestamp
 		p.data[id] = current
 	default:
 		// Otherwise, update the data.
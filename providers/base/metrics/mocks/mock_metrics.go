@@ -15,6 +15,11 @@ type ProviderMetrics struct {
 	mock.Mock
 }
 
+// AddProviderPanic provides a mock function with given fields: providerName
+func (_m *ProviderMetrics) AddProviderPanic(providerName string) {
+	_m.Called(providerName)
+}
+
 // AddProviderResponse provides a mock function with given fields: providerName, status, ec, providerType
 func (_m *ProviderMetrics) AddProviderResponse(providerName string, status metrics.Status, ec types.ErrorCode, providerType types.ProviderType) {
 	_m.Called(providerName, status, ec, providerType)
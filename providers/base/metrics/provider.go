@@ -50,6 +50,10 @@ type ProviderMetrics interface {
 
 	// LastUpdated updates the last time a given ID (i.e. currency pair) was updated.
 	LastUpdated(providerName, id string, providerType providertypes.ProviderType)
+
+	// AddProviderPanic increments the number of panics recovered from a given provider's
+	// goroutines.
+	AddProviderPanic(providerName string)
 }
 
 // ProviderMetricsImpl contains metrics exposed by this package.
@@ -62,6 +66,9 @@ type ProviderMetricsImpl struct {
 
 	// Last time a given ID (i.e. currency pair) was updated.
 	lastUpdatedPerProvider *prometheus.GaugeVec
+
+	// Number of panics recovered per provider.
+	panicsPerProvider *prometheus.CounterVec
 }
 
 // NewProviderMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -90,12 +97,18 @@ func NewProviderMetrics() ProviderMetrics {
 			Name:      "provider_last_updated_id",
 			Help:      "Last time a given ID (i.e. currency pair) was updated.",
 		}, []string{ProviderLabel, IDLabel, ProviderTypeLabel}),
+		panicsPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "provider_panics",
+			Help:      "Number of panics recovered from a given provider.",
+		}, []string{ProviderLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.responseStatusPerProviderByID)
 	prometheus.MustRegister(m.responseStatusPerProvider)
 	prometheus.MustRegister(m.lastUpdatedPerProvider)
+	prometheus.MustRegister(m.panicsPerProvider)
 
 	return m
 }
@@ -113,6 +126,7 @@ func (m *noOpProviderMetricsImpl) AddProviderResponseByID(_, _ string, _ Status,
 func (m *noOpProviderMetricsImpl) AddProviderResponse(_ string, _ Status, _ providertypes.ErrorCode, _ providertypes.ProviderType) {
 }
 func (m *noOpProviderMetricsImpl) LastUpdated(_, _ string, _ providertypes.ProviderType) {}
+func (m *noOpProviderMetricsImpl) AddProviderPanic(_ string)                             {}
 
 // AddProviderResponseByID increments the number of ticks with a fully successful provider update
 // for a given provider and ID (i.e. currency pair).
@@ -148,3 +162,11 @@ func (m *ProviderMetricsImpl) LastUpdated(providerName, id string, providerType
 	},
 	).Set(float64(now.Unix()))
 }
+
+// AddProviderPanic increments the number of panics recovered from a given provider's goroutines.
+func (m *ProviderMetricsImpl) AddProviderPanic(providerName string) {
+	m.panicsPerProvider.With(prometheus.Labels{
+		ProviderLabel: providerName,
+	},
+	).Add(1)
+}
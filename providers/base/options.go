@@ -1,6 +1,9 @@
 package base
 
 import (
+	"fmt"
+	"math/big"
+
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
@@ -110,3 +113,69 @@ func WithMetrics[K providertypes.ResponseKey, V providertypes.ResponseValue](met
 		p.metrics = metrics
 	}
 }
+
+// WithMinVolume sets the minimum reported volume required for a result to be utilized by
+// consumers of the provider. A value of 0 disables the check.
+func WithMinVolume[K providertypes.ResponseKey, V providertypes.ResponseValue](minVolume float64) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if minVolume < 0 {
+			panic("cannot set negative min volume")
+		}
+
+		if minVolume == 0 {
+			p.minVolume = nil
+			return
+		}
+
+		p.minVolume = big.NewFloat(minVolume)
+	}
+}
+
+// WithBaseUnitScale sets the scale applied by consumers of the provider to normalize each result to
+// whole units.
+func WithBaseUnitScale[K providertypes.ResponseKey, V providertypes.ResponseValue](scale config.BaseUnitScale) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if err := scale.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid base unit scale: %s", err))
+		}
+
+		p.baseUnitScale = scale
+	}
+}
+
+// WithPriceAdjustment sets the adjustment applied to each result by consumers of the provider.
+func WithPriceAdjustment[K providertypes.ResponseKey, V providertypes.ResponseValue](adjustment config.PriceAdjustmentConfig) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if err := adjustment.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid price adjustment: %s", err))
+		}
+
+		p.priceAdjustment = adjustment
+	}
+}
+
+// WithMaintenanceWindows sets the scheduled windows during which consumers of the provider should
+// not utilize its results.
+func WithMaintenanceWindows[K providertypes.ResponseKey, V providertypes.ResponseValue](windows []config.MaintenanceWindowConfig) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		for _, window := range windows {
+			if err := window.ValidateBasic(); err != nil {
+				panic(fmt.Sprintf("invalid maintenance window: %s", err))
+			}
+		}
+
+		p.maintenanceWindows = windows
+	}
+}
+
+// WithResponseAssertions sets the sanity checks that every result reported by the provider must
+// satisfy before it is utilized.
+func WithResponseAssertions[K providertypes.ResponseKey, V providertypes.ResponseValue](assertions config.ResponseAssertionsConfig) ProviderOption[K, V] {
+	return func(p *Provider[K, V]) {
+		if err := assertions.ValidateBasic(); err != nil {
+			panic(fmt.Sprintf("invalid response assertions: %s", err))
+		}
+
+		p.assertions = assertions
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"math/big"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -41,10 +43,38 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 	// wsCfg is the websocket configuration for the provider.
 	wsCfg config.WebSocketConfig
 
+	// wsConns are the websocket query handlers that manage each of the provider's current
+	// websocket connections.
+	wsConns []wshandlers.WebSocketQueryHandler[K, V]
+
+	// minVolume is the minimum reported volume required for a result to be utilized by
+	// consumers of the provider. A nil value disables the check.
+	minVolume *big.Float
+
+	// baseUnitScale is the scale applied by consumers of the provider to normalize each result to
+	// whole units.
+	baseUnitScale config.BaseUnitScale
+
+	// priceAdjustment is the adjustment applied to each result by consumers of the provider.
+	priceAdjustment config.PriceAdjustmentConfig
+
+	// maintenanceWindows are the scheduled windows during which consumers of the provider should
+	// not utilize its results.
+	maintenanceWindows []config.MaintenanceWindowConfig
+
+	// assertions are the sanity checks that every result reported by the provider must satisfy
+	// before it is utilized.
+	assertions config.ResponseAssertionsConfig
+
 	// data is the latest set of key -> value pairs for the provider i.e. the latest prices
 	// for a given set of currency pairs.
 	data map[K]providertypes.ResolvedResult[V]
 
+	// lastErr is the most recent error that the provider encountered, e.g. a failed request for
+	// one of its IDs, and lastErrTime is the time at which it was encountered.
+	lastErr     string
+	lastErrTime time.Time
+
 	// ids is the set of IDs that the provider will fetch data for.
 	ids []K
 
@@ -136,10 +166,12 @@ func (p *Provider[K, V]) Start(ctx context.Context) error {
 		// when the provider needs to be restarted.
 		fetchCtx, fetchCancel := p.setFetchCtx(mainCtx)
 
-		// Start the receive loop.
+		// Start the receive loop. If the receive loop panics, the fetch loop is cancelled so
+		// that the provider is restarted.
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			defer p.recoverPanic("recv", fetchCancel, nil)
 			p.recv(fetchCtx)
 		}()
 
@@ -148,9 +180,10 @@ func (p *Provider[K, V]) Start(ctx context.Context) error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			defer close(p.responseCh)
+			defer fetchCancel()
+			defer p.recoverPanic("fetch", fetchCancel, errCh)
 			errCh <- p.fetch(fetchCtx)
-			fetchCancel()
-			close(p.responseCh)
 		}()
 
 		// Wait for the fetch loop to return or the context to be cancelled.
@@ -170,6 +203,11 @@ func (p *Provider[K, V]) Start(ctx context.Context) error {
 
 			break
 		}
+
+		// The fetch loop stopped unexpectedly, so the provider is restarted.
+		if retErr != nil {
+			p.recordError(retErr)
+		}
 	}
 
 	return retErr
@@ -132,6 +132,35 @@ func TestStart(t *testing.T) {
 		require.Equal(t, context.DeadlineExceeded, err)
 	})
 
+	t.Run("recovers and restarts the api loop after a panic", func(t *testing.T) {
+		t.Parallel()
+
+		handler := apihandlermocks.NewQueryHandler[slinkytypes.CurrencyPair, *big.Int](t)
+		handler.On("Query", mock.Anything, mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+			panic("parse failure")
+		}).Once()
+		handler.On("Query", mock.Anything, mock.Anything, mock.Anything).Return().After(200 * time.Millisecond)
+
+		metrics := metricmocks.NewProviderMetrics(t)
+		metrics.On("AddProviderPanic", apiCfg.Name).Once()
+
+		provider, err := base.NewProvider(
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](apiCfg.Name),
+			base.WithAPIQueryHandler[slinkytypes.CurrencyPair, *big.Int](handler),
+			base.WithAPIConfig[slinkytypes.CurrencyPair, *big.Int](apiCfg),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs),
+			base.WithMetrics[slinkytypes.CurrencyPair, *big.Int](metrics),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Interval*2)
+		defer cancel()
+
+		err = provider.Start(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+
 	t.Run("closes on cancel with websocket", func(t *testing.T) {
 		t.Parallel()
 
@@ -621,6 +650,7 @@ func TestAPIProviderLoop(t *testing.T) {
 		handler        func() apihandlers.APIQueryHandler[slinkytypes.CurrencyPair, *big.Int]
 		pairs          []slinkytypes.CurrencyPair
 		expectedPrices map[slinkytypes.CurrencyPair]*big.Int
+		expectedErr    error
 	}{
 		{
 			name: "no prices to fetch",
@@ -753,6 +783,7 @@ func TestAPIProviderLoop(t *testing.T) {
 				pairs[0],
 			},
 			expectedPrices: map[slinkytypes.CurrencyPair]*big.Int{},
+			expectedErr:    apierrors.ErrRateLimit,
 		},
 	}
 
@@ -783,6 +814,16 @@ func TestAPIProviderLoop(t *testing.T) {
 				require.Equal(t, price, result.Value)
 				require.True(t, result.Timestamp.After(now))
 			}
+
+			lastErr, lastErrTime := provider.GetLastError()
+			if tc.expectedErr == nil {
+				require.Empty(t, lastErr)
+				require.True(t, lastErrTime.IsZero())
+			} else {
+				require.Contains(t, lastErr, tc.expectedErr.Error())
+				require.Contains(t, lastErr, pairs[0].String())
+				require.True(t, lastErrTime.After(now))
+			}
 		})
 	}
 }
@@ -99,6 +99,11 @@ func CreateAPIProviderWithGetResponses[K providertypes.ResponseKey, V providerty
 		base.WithAPIConfig[K, V](cfg.API),
 		base.WithLogger[K, V](logger),
 		base.WithIDs[K, V](ids),
+		base.WithMinVolume[K, V](cfg.MinVolume),
+		base.WithBaseUnitScale[K, V](cfg.BaseUnitScale),
+		base.WithPriceAdjustment[K, V](cfg.PriceAdjustment),
+		base.WithMaintenanceWindows[K, V](cfg.MaintenanceWindows),
+		base.WithResponseAssertions[K, V](cfg.Assertions),
 	)
 	require.NoError(t, err)
 
@@ -31,7 +31,7 @@ func CreateWebSocketQueryHandlerWithGetResponses[K providertypes.ResponseKey, V
 	handler.On("Copy").Return(handler).Maybe()
 	handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		ctx := args.Get(0).(context.Context)
-		responseCh := args.Get(2).(chan<- providertypes.GetResponse[K, V])
+		responseCh := args.Get(2).(chan providertypes.GetResponse[K, V])
 
 		for _, resp := range responses {
 			logger.Debug("sending response", zap.String("response", resp.String()))
@@ -60,7 +60,7 @@ func CreateWebSocketQueryHandlerWithResponseFn[K providertypes.ResponseKey, V pr
 	handler.On("Copy").Return(handler).Maybe()
 	handler.On("Start", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
 		ctx := args.Get(0).(context.Context)
-		responseCh := args.Get(2).(chan<- providertypes.GetResponse[K, V])
+		responseCh := args.Get(2).(chan providertypes.GetResponse[K, V])
 		fn(ctx, responseCh)
 	}).Maybe()
 
@@ -3,6 +3,9 @@ package base
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+
+	"go.uber.org/zap"
 
 	providertypes "github.com/skip-mev/slinky/providers/types"
 )
@@ -57,3 +60,26 @@ func (p *Provider[K, V]) getFetchCtx() (context.Context, context.CancelFunc) {
 
 	return p.fetchCtx, p.cancelFetchFn
 }
+
+// recoverPanic recovers from a panic in one of the provider's routines. The panic is logged
+// with its stack trace and recorded in the metrics, the fetch loop is cancelled, and the panic
+// is reported on the error channel (if any) so that the provider's main loop restarts it.
+func (p *Provider[K, V]) recoverPanic(routine string, cancel context.CancelFunc, errCh chan<- error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	p.logger.Error(
+		"recovered from panic in provider routine",
+		zap.String("routine", routine),
+		zap.Any("panic", r),
+		zap.ByteString("stack", debug.Stack()),
+	)
+	p.metrics.AddProviderPanic(p.name)
+	cancel()
+
+	if errCh != nil {
+		errCh <- fmt.Errorf("panic in provider %s routine: %v", routine, r)
+	}
+}
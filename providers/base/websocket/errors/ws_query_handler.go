@@ -24,6 +24,10 @@ var (
 
 	// ErrDial is returned when the WebSocketConnHandler cannot create a connection.
 	ErrDial = errors.New("websocket connection handler failed to create connection")
+
+	// ErrFailedConnection is returned when the WebSocketQueryHandler does not handle any
+	// messages within the failed connection timeout and tears down the connection.
+	ErrFailedConnection = errors.New("websocket connection failed to handle messages within the failed connection timeout")
 )
 
 // ErrHandleMessageWithErr is used to create a new ErrHandleMessage with the given error.
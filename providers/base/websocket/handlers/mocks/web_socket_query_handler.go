@@ -38,7 +38,7 @@ func (_m *WebSocketQueryHandler[K, V]) Copy() handlers.WebSocketQueryHandler[K,
 }
 
 // Start provides a mock function with given fields: ctx, ids, responseCh
-func (_m *WebSocketQueryHandler[K, V]) Start(ctx context.Context, ids []K, responseCh chan<- types.GetResponse[K, V]) error {
+func (_m *WebSocketQueryHandler[K, V]) Start(ctx context.Context, ids []K, responseCh chan types.GetResponse[K, V]) error {
 	ret := _m.Called(ctx, ids, responseCh)
 
 	if len(ret) == 0 {
@@ -46,7 +46,7 @@ func (_m *WebSocketQueryHandler[K, V]) Start(ctx context.Context, ids []K, respo
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []K, chan<- types.GetResponse[K, V]) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []K, chan types.GetResponse[K, V]) error); ok {
 		r0 = rf(ctx, ids, responseCh)
 	} else {
 		r0 = ret.Error(0)
@@ -55,6 +55,24 @@ func (_m *WebSocketQueryHandler[K, V]) Start(ctx context.Context, ids []K, respo
 	return r0
 }
 
+// State provides a mock function with given fields:
+func (_m *WebSocketQueryHandler[K, V]) State() handlers.ConnectionState {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for State")
+	}
+
+	var r0 handlers.ConnectionState
+	if rf, ok := ret.Get(0).(func() handlers.ConnectionState); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(handlers.ConnectionState)
+	}
+
+	return r0
+}
+
 // NewWebSocketQueryHandler creates a new instance of WebSocketQueryHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketQueryHandler[K types.ResponseKey, V types.ResponseValue](t interface {
@@ -9,6 +9,7 @@ import (
 	"github.com/gorilla/websocket"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	slinkyhttp "github.com/skip-mev/slinky/pkg/http"
 )
 
 type (
@@ -81,6 +82,7 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
 	return &websocket.Dialer{
 		Proxy:             http.ProxyFromEnvironment,
+		NetDialContext:    slinkyhttp.NewDialContextWithLocalAddress(h.cfg.LocalAddress),
 		HandshakeTimeout:  h.cfg.HandshakeTimeout,
 		ReadBufferSize:    h.cfg.ReadBufferSize,
 		WriteBufferSize:   h.cfg.WriteBufferSize,
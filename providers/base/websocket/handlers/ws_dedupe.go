@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// processedValue is the last value that was processed for an ID.
+type processedValue struct {
+	// value is the canonical string representation of the value.
+	value string
+	// eventTimestamp is the exchange event timestamp of the value, if any.
+	eventTimestamp time.Time
+	// processedAt is the time at which the value was processed.
+	processedAt time.Time
+}
+
+// dedupe removes the resolved values of the response that are identical to the last value
+// processed for the same ID within the dedupe window. Values are identical if they are equal
+// and were reported with the same exchange event timestamp. Dropped values do not extend the
+// window, so an unchanged value is still processed once per window.
+func (h *WebSocketQueryHandlerImpl[K, V]) dedupe(
+	response providertypes.GetResponse[K, V],
+	now time.Time,
+) providertypes.GetResponse[K, V] {
+	if h.config.DedupeWindow == 0 || len(response.Resolved) == 0 {
+		return response
+	}
+
+	resolved := make(map[K]providertypes.ResolvedResult[V], len(response.Resolved))
+	for id, result := range response.Resolved {
+		current := processedValue{
+			value:          canonicalValue(result.Value),
+			eventTimestamp: result.EventTimestamp,
+			processedAt:    now,
+		}
+
+		// Unchanged responses carry no value, and only refresh the timestamp of the last value.
+		if result.ResponseCode == providertypes.ResponseCodeUnchanged {
+			resolved[id] = result
+			continue
+		}
+
+		last, ok := h.lastProcessed[id]
+		if ok &&
+			last.value == current.value &&
+			last.eventTimestamp.Equal(current.eventTimestamp) &&
+			now.Sub(last.processedAt) < h.config.DedupeWindow {
+			h.logger.Debug(
+				"dropping duplicate value",
+				zap.Any("id", id),
+				zap.String("value", current.value),
+				zap.Time("last_processed", last.processedAt),
+			)
+			h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.Deduplicated)
+			continue
+		}
+
+		h.lastProcessed[id] = current
+		resolved[id] = result
+	}
+
+	response.Resolved = resolved
+	return response
+}
+
+// canonicalValue returns a string representation of the value that distinguishes all distinct
+// values. The String method of a big.Float rounds to 10 significant digits, so floats are
+// formatted with the minimal number of digits needed to represent them exactly instead.
+func canonicalValue[V providertypes.ResponseValue](value V) string {
+	if f, ok := any(value).(*big.Float); ok && f != nil {
+		return f.Text('g', -1)
+	}
+
+	return value.String()
+}
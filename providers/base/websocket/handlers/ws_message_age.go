@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
+	providertypes "github.com/skip-mev/slinky/providers/types"
+)
+
+// observeMessageAge records the age of every resolved value of the response. Values that carry an
+// exchange event timestamp are aged from that timestamp to the time at which they were received.
+// Values without one are aged by the time since the previous message was received, as that is the
+// closest proxy for how stale the provider's data could have been.
+func (h *WebSocketQueryHandlerImpl[K, V]) observeMessageAge(
+	response providertypes.GetResponse[K, V],
+	received time.Time,
+	lastReceived time.Time,
+) {
+	for _, result := range response.Resolved {
+		if result.ResponseCode == providertypes.ResponseCodeUnchanged {
+			continue
+		}
+
+		if !result.EventTimestamp.IsZero() {
+			receivedAt := result.Timestamp
+			if receivedAt.IsZero() {
+				receivedAt = received
+			}
+
+			h.metrics.ObserveWebSocketMessageAge(
+				h.config.Name,
+				metrics.MessageAgeSourceExchange,
+				nonNegative(receivedAt.Sub(result.EventTimestamp)),
+			)
+			continue
+		}
+
+		h.metrics.ObserveWebSocketMessageAge(
+			h.config.Name,
+			metrics.MessageAgeSourceInterarrival,
+			nonNegative(received.Sub(lastReceived)),
+		)
+	}
+}
+
+// nonNegative caps negative durations, i.e. due to clock skew between the exchange and the
+// oracle, at zero.
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
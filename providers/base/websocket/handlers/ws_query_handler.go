@@ -3,11 +3,17 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	slinkyjson "github.com/skip-mev/slinky/pkg/json"
+	slinkylog "github.com/skip-mev/slinky/pkg/log"
+	"github.com/skip-mev/slinky/pkg/tracing"
 	"github.com/skip-mev/slinky/providers/base/websocket/errors"
 	"github.com/skip-mev/slinky/providers/base/websocket/metrics"
 	providertypes "github.com/skip-mev/slinky/providers/types"
@@ -22,14 +28,34 @@ import (
 type WebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseValue] interface {
 	// Start should initialize the websocket connection and start listening for
 	// the data (i.e. ids). All websocket responses should be sent to the response
-	// channel.
-	Start(ctx context.Context, ids []K, responseCh chan<- providertypes.GetResponse[K, V]) error
+	// channel. The handler may receive from the response channel to drop the oldest
+	// buffered response when the channel is full.
+	Start(ctx context.Context, ids []K, responseCh chan providertypes.GetResponse[K, V]) error
 
 	// Copy is used to create a copy of the query handler. This is useful for creating
 	// multiple connections to the same data provider.
 	Copy() WebSocketQueryHandler[K, V]
+
+	// State returns the current state of the handler's connection to the data provider.
+	State() ConnectionState
 }
 
+// ConnectionState is the state of a websocket connection to a data provider.
+type ConnectionState string
+
+const (
+	// ConnectionStateConnected indicates that the connection is established and receiving data.
+	ConnectionStateConnected ConnectionState = "connected"
+	// ConnectionStateReconnecting indicates that the connection is being (re-)established.
+	ConnectionStateReconnecting ConnectionState = "reconnecting"
+	// ConnectionStateFailed indicates that the connection failed and will be torn down and
+	// rebuilt after the reconnection timeout.
+	ConnectionStateFailed ConnectionState = "failed"
+	// ConnectionStateDisabled indicates that the connection failed to be established more than
+	// the max reconnect attempts in a row, and is not rebuilt until the reconnect cooldown elapses.
+	ConnectionStateDisabled ConnectionState = "disabled"
+)
+
 // WebSocketQueryHandlerImpl is the default websocket implementation of the
 // WebSocketQueryHandler interface. This is used to establish a connection to the data
 // provider and subscribe to events for a given set of IDs. It runs in a separate go
@@ -49,6 +75,25 @@ type WebSocketQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.Resp
 
 	// ids is the set of IDs that the provider will fetch data for.
 	ids []K
+
+	// state is the current state of the connection to the data provider.
+	mtx   sync.Mutex
+	state ConnectionState
+
+	// connectFailures is the number of consecutive attempts that failed to establish the
+	// connection to the data provider.
+	connectFailures int
+
+	// lastProcessed is the last value processed for each ID, which is used to drop duplicate
+	// values. This is retained across reconnects so that replayed values are dropped.
+	lastProcessed map[K]processedValue
+
+	// renames maps the field names in the messages received from the data provider to the field
+	// names expected by the data handler, as configured by the field overrides.
+	renames map[string]string
+
+	// errorLogs suppresses repeated errors logged when reading from the connection.
+	errorLogs *slinkylog.ErrorSuppressor
 }
 
 // NewWebSocketQueryHandler creates a new websocket query handler.
@@ -83,12 +128,17 @@ func NewWebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.Respo
 		return nil, fmt.Errorf("websocket metrics is nil")
 	}
 
+	logger = logger.With(zap.String("web_socket_data_handler", config.Name))
 	return &WebSocketQueryHandlerImpl[K, V]{
-		logger:      logger.With(zap.String("web_socket_data_handler", config.Name)),
-		config:      config,
-		dataHandler: dataHandler,
-		connHandler: connHandler,
-		metrics:     m,
+		logger:        logger,
+		config:        config,
+		dataHandler:   dataHandler,
+		connHandler:   connHandler,
+		metrics:       m,
+		state:         ConnectionStateReconnecting,
+		lastProcessed: make(map[K]processedValue),
+		renames:       config.FieldOverrides.Renames(),
+		errorLogs:     newErrorSuppressor(logger, config),
 	}, nil
 }
 
@@ -104,7 +154,7 @@ func NewWebSocketQueryHandler[K providertypes.ResponseKey, V providertypes.Respo
 func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 	ctx context.Context,
 	ids []K,
-	responseCh chan<- providertypes.GetResponse[K, V],
+	responseCh chan providertypes.GetResponse[K, V],
 ) error {
 	defer func() {
 		if err := recover(); err != nil {
@@ -129,7 +179,21 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 
 	// Initialize the connection to the data provider and subscribe to the events
 	// for the corresponding IDs.
-	if err := h.start(); err != nil {
+	h.setState(ConnectionStateReconnecting)
+	if err := h.connect(ctx); err != nil {
+		h.connectFailures++
+		if h.config.MaxReconnectAttempts > 0 && h.connectFailures >= h.config.MaxReconnectAttempts {
+			h.logger.Warn(
+				"max reconnect attempts reached; disabling connection",
+				zap.Int("max_reconnect_attempts", h.config.MaxReconnectAttempts),
+				zap.Duration("reconnect_cooldown", h.config.ReconnectCooldown),
+				zap.Error(err),
+			)
+			h.setState(ConnectionStateDisabled)
+		} else {
+			h.setState(ConnectionStateFailed)
+		}
+
 		responseCh <- providertypes.NewGetResponseWithErr[K, V](
 			ids,
 			providertypes.NewErrorWithCode(
@@ -145,10 +209,39 @@ func (h *WebSocketQueryHandlerImpl[K, V]) Start(
 	}
 
 	// Start receiving messages from the data provider.
+	h.connectFailures = 0
+	h.setState(ConnectionStateConnected)
 	h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.Healthy)
 	return h.recv(ctx, responseCh)
 }
 
+// connect starts the connection to the data provider within a span that times the dial and
+// the subscriptions of the connection.
+func (h *WebSocketQueryHandlerImpl[K, V]) connect(ctx context.Context) error {
+	pairs := make([]string, len(h.ids))
+	for i, id := range h.ids {
+		pairs[i] = id.String()
+	}
+
+	_, span := tracing.Tracer().Start(
+		ctx,
+		"WebSocketQueryHandler.connect",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.ProviderKey.String(h.config.Name),
+			tracing.PairsKey.StringSlice(pairs),
+		),
+	)
+	defer span.End()
+
+	if err := h.start(); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	return nil
+}
+
 // start is used to start the connection to the data provider.
 func (h *WebSocketQueryHandlerImpl[K, V]) start() error {
 	// Start the connection.
@@ -225,7 +318,7 @@ func (h *WebSocketQueryHandlerImpl[K, V]) heartBeat(ctx context.Context) {
 }
 
 // recv is used to manage the connection to the data provider.
-func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh chan<- providertypes.GetResponse[K, V]) error {
+func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh chan providertypes.GetResponse[K, V]) error {
 	defer func() {
 		if err := recover(); err != nil {
 			h.logger.Error("panic occurred", zap.Any("err", err))
@@ -239,10 +332,32 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 	// provider closes the connection or if the connection is interrupted.
 	readErrCount := 0
 
+	// Track the last time a message was successfully handled. If the connection goes without
+	// handling a message for longer than the failed connection timeout, it is considered
+	// failed and is torn down so that the provider can rebuild it.
+	lastHandled := time.Now()
+
+	// Track the last time a message was received. This is used to age price updates from
+	// providers that do not report an exchange timestamp.
+	lastReceived := time.Now()
+
 	for {
 		// Track the time it takes to receive a message from the data provider.
 		now := time.Now().UTC()
 
+		if h.config.FailedConnectionTimeout > 0 && time.Since(lastHandled) > h.config.FailedConnectionTimeout {
+			h.logger.Error(
+				"no messages handled within the failed connection timeout; tearing down connection",
+				zap.Duration("failed_connection_timeout", h.config.FailedConnectionTimeout),
+			)
+			h.setState(ConnectionStateFailed)
+			if err := h.close(); err != nil {
+				return err
+			}
+
+			return errors.ErrFailedConnection
+		}
+
 		select {
 		case <-ctx.Done():
 			// Case 1: The context is cancelled. Close the connection and return.
@@ -256,10 +371,11 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 			// Case 2: The context is not cancelled. Wait for a message from the data provider.
 			message, err := h.connHandler.Read()
 			if err != nil {
-				h.logger.Error(
+				h.errorLogs.Error(
+					"",
 					"failed to read message from websocket handler",
+					err,
 					zap.String("message", string(message)),
-					zap.Error(err),
 				)
 				h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.ReadErr)
 
@@ -271,6 +387,7 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 				}
 
 				h.logger.Error("max read errors reached", zap.Error(err))
+				h.setState(ConnectionStateFailed)
 				if err := h.close(); err != nil {
 					return err
 				}
@@ -278,32 +395,35 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 				return errors.ErrReadWithErr(err)
 			}
 
+			h.errorLogs.Clear("")
 			h.logger.Debug("message received; attempting to handle message", zap.String("message", string(message)))
 			h.metrics.AddWebSocketConnectionStatus(h.config.Name, metrics.ReadSuccess)
+			received := time.Now()
+			previousReceived := lastReceived
+			lastReceived = received
 
 			// Handle the message.
-			response, updateMessage, err := h.dataHandler.HandleMessage(message)
+			response, updateMessage, err := h.dataHandler.HandleMessage(h.overrideFields(message))
 			if err != nil {
 				h.logger.Debug("failed to handle websocket message", zap.Error(err))
 				h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.HandleMessageErr)
 				continue
 			}
+			lastHandled = time.Now()
+			h.observeMessageAge(response, received, previousReceived)
+			response = h.dedupe(response, lastHandled)
 
 			// Immediately send the response to the response channel. Even if this is
 			// empty, it will be handled by the provider. Note that if the context has been
 			// cancelled, we should not send the response to the channel. Otherwise, we risk
 			// sending a response to a closed channel.
-			select {
-			case <-ctx.Done():
+			if !h.sendResponse(ctx, responseCh, response) {
 				h.logger.Debug("context finished")
 				if err := h.close(); err != nil {
 					return errors.ErrCloseWithErr(err)
 				}
 
 				return ctx.Err()
-			case responseCh <- response:
-				h.logger.Debug("handled message successfully; sent response to response channel", zap.String("response", response.String()))
-				h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.HandleMessageSuccess)
 			}
 
 			// If the update messages are not nil, send it to the data provider.
@@ -328,6 +448,74 @@ func (h *WebSocketQueryHandlerImpl[K, V]) recv(ctx context.Context, responseCh c
 	}
 }
 
+// sendResponse sends the response to the response channel in accordance with the configured
+// buffer policy. Responses that are dropped because the buffer is full are recorded in the
+// metrics. Returns false if the context was cancelled before the response could be handled.
+func (h *WebSocketQueryHandlerImpl[K, V]) sendResponse(
+	ctx context.Context,
+	responseCh chan providertypes.GetResponse[K, V],
+	response providertypes.GetResponse[K, V],
+) bool {
+	switch h.config.BufferPolicy {
+	case config.BufferPolicyDropNewest:
+		select {
+		case <-ctx.Done():
+			return false
+		case responseCh <- response:
+		default:
+			h.dropResponse(response)
+			return true
+		}
+	case config.BufferPolicyDropOldest:
+		for sent := false; !sent; {
+			select {
+			case <-ctx.Done():
+				return false
+			case responseCh <- response:
+				sent = true
+			default:
+				// Make room for the new response by dropping the oldest buffered response.
+				select {
+				case oldest := <-responseCh:
+					h.dropResponse(oldest)
+				default:
+				}
+			}
+		}
+	default:
+		var timeout <-chan time.Time
+		if h.config.BufferBlockTimeout > 0 {
+			timer := time.NewTimer(h.config.BufferBlockTimeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case responseCh <- response:
+		case <-timeout:
+			h.dropResponse(response)
+			return true
+		}
+	}
+
+	h.logger.Debug("handled message successfully; sent response to response channel", zap.String("response", response.String()))
+	h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.HandleMessageSuccess)
+	h.metrics.ObserveWebSocketBufferSize(h.config.Name, len(responseCh))
+	return true
+}
+
+// dropResponse records that the response was dropped because the response buffer was full.
+func (h *WebSocketQueryHandlerImpl[K, V]) dropResponse(response providertypes.GetResponse[K, V]) {
+	h.logger.Debug(
+		"response buffer is full; dropping response",
+		zap.String("buffer_policy", string(h.config.BufferPolicy)),
+		zap.String("response", response.String()),
+	)
+	h.metrics.AddWebSocketDataHandlerStatus(h.config.Name, metrics.BufferDropped)
+}
+
 // close is used to close the connection to the data provider.
 func (h *WebSocketQueryHandlerImpl[K, V]) close() error {
 	h.logger.Debug("closing connection to websocket handler")
@@ -342,14 +530,67 @@ func (h *WebSocketQueryHandlerImpl[K, V]) close() error {
 	return nil
 }
 
+// State returns the current state of the connection to the data provider.
+func (h *WebSocketQueryHandlerImpl[K, V]) State() ConnectionState {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	return h.state
+}
+
+// setState updates the state of the connection and records the transition, if any.
+func (h *WebSocketQueryHandlerImpl[K, V]) setState(state ConnectionState) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.state == state {
+		return
+	}
+
+	h.logger.Debug("connection state changed", zap.String("from", string(h.state)), zap.String("to", string(state)))
+	h.metrics.AddWebSocketConnectionStateTransition(h.config.Name, string(h.state), string(state))
+	h.state = state
+}
+
 // Copy is used to create a copy of the query handler. This is useful for creating
 // multiple connections to the same data provider.
 func (h *WebSocketQueryHandlerImpl[K, V]) Copy() WebSocketQueryHandler[K, V] {
 	return &WebSocketQueryHandlerImpl[K, V]{
-		logger:      h.logger,
-		config:      h.config,
-		dataHandler: h.dataHandler.Copy(),
-		connHandler: h.connHandler.Copy(),
-		metrics:     h.metrics,
+		logger:        h.logger,
+		config:        h.config,
+		dataHandler:   h.dataHandler.Copy(),
+		connHandler:   h.connHandler.Copy(),
+		metrics:       h.metrics,
+		state:         ConnectionStateReconnecting,
+		lastProcessed: make(map[K]processedValue),
+		renames:       h.renames,
+		errorLogs:     newErrorSuppressor(h.logger, h.config),
 	}
 }
+
+// newErrorSuppressor returns the error log suppressor of a connection, as configured by the
+// error log suppression of the websocket config.
+func newErrorSuppressor(logger *zap.Logger, cfg config.WebSocketConfig) *slinkylog.ErrorSuppressor {
+	return slinkylog.NewErrorSuppressor(
+		logger,
+		cfg.ErrorLogSuppression.Enabled,
+		cfg.ErrorLogSuppression.SummaryInterval,
+	)
+}
+
+// overrideFields renames the fields of the message according to the configured field overrides,
+// so that the data handler can parse the message with its hard-coded field names. Messages that
+// are not valid JSON, e.g. heartbeats of some data providers, are returned as is.
+func (h *WebSocketQueryHandlerImpl[K, V]) overrideFields(message []byte) []byte {
+	if len(h.renames) == 0 {
+		return message
+	}
+
+	renamed, err := slinkyjson.RenameFields(message, h.renames)
+	if err != nil {
+		h.logger.Debug("message is not valid json; skipping field overrides", zap.Error(err))
+		return message
+	}
+
+	return renamed
+}
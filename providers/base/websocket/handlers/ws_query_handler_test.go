@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -91,6 +92,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialErr).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -125,6 +127,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageErr).Return().Once()
@@ -161,6 +164,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -200,6 +204,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -208,6 +213,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadErr).Return().Twice()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -244,6 +250,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -253,6 +260,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageErr).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -289,6 +297,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -297,9 +306,11 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Maybe()
 				m.On("AddWebSocketConnectionStatus", name, metrics.WriteSuccess).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -337,6 +348,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -346,9 +358,11 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Maybe()
 				m.On("AddWebSocketConnectionStatus", name, metrics.WriteErr).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Maybe()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -385,6 +399,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -393,9 +408,11 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Maybe()
 				m.On("AddWebSocketConnectionStatus", name, metrics.WriteSuccess).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseErr).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -439,6 +456,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -447,7 +465,9 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -497,6 +517,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -505,9 +526,11 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Maybe()
 				m.On("AddWebSocketConnectionStatus", name, metrics.WriteSuccess).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -582,6 +605,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Once()
@@ -590,7 +614,9 @@ func TestWebSocketQueryHandler(t *testing.T) {
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				m.On("AddWebSocketConnectionStatus", name, metrics.CloseSuccess).Return().Once()
 				m.On("AddWebSocketConnectionStatus", name, metrics.Unhealthy).Return().Once()
@@ -644,6 +670,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				// start
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
@@ -654,7 +681,9 @@ func TestWebSocketQueryHandler(t *testing.T) {
 				// recv
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				// heart beat
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HeartBeatSuccess).Return().Maybe()
@@ -699,6 +728,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				// start
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
@@ -709,7 +739,9 @@ func TestWebSocketQueryHandler(t *testing.T) {
 				// recv
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				// heart beat
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HeartBeatErr).Return().Maybe()
@@ -755,6 +787,7 @@ func TestWebSocketQueryHandler(t *testing.T) {
 			},
 			metrics: func() metrics.WebSocketMetrics {
 				m := mockmetrics.NewWebSocketMetrics(t)
+				m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				// start
 				m.On("AddWebSocketConnectionStatus", name, metrics.DialSuccess).Return().Once()
@@ -765,7 +798,9 @@ func TestWebSocketQueryHandler(t *testing.T) {
 				// recv
 				m.On("AddWebSocketConnectionStatus", name, metrics.ReadSuccess).Return().Maybe()
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+				m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
 				m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+				m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
 
 				// heart beat
 				m.On("AddWebSocketDataHandlerStatus", name, metrics.HeartBeatSuccess).Return().Maybe()
@@ -833,3 +868,468 @@ func TestWebSocketQueryHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestWebSocketQueryHandlerBufferPolicy(t *testing.T) {
+	responses := []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+		providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+			map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{btcusd: {Value: big.NewInt(1)}},
+			nil,
+		),
+		providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+			map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{btcusd: {Value: big.NewInt(2)}},
+			nil,
+		),
+		providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+			map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{btcusd: {Value: big.NewInt(3)}},
+			nil,
+		),
+	}
+
+	testCases := []struct {
+		name          string
+		policy        config.BufferPolicy
+		blockTimeout  time.Duration
+		expectedSends int
+		expectedValue *big.Int
+	}{
+		{
+			name:          "drop newest keeps the oldest response",
+			policy:        config.BufferPolicyDropNewest,
+			expectedSends: 1,
+			expectedValue: big.NewInt(1),
+		},
+		{
+			name:          "drop oldest keeps the newest response",
+			policy:        config.BufferPolicyDropOldest,
+			expectedSends: 3,
+			expectedValue: big.NewInt(3),
+		},
+		{
+			name:          "block with a timeout drops new responses after the timeout",
+			policy:        config.BufferPolicyBlock,
+			blockTimeout:  10 * time.Millisecond,
+			expectedSends: 1,
+			expectedValue: big.NewInt(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bufferCfg := cfg
+			bufferCfg.MaxBufferSize = 1
+			bufferCfg.BufferPolicy = tc.policy
+			bufferCfg.BufferBlockTimeout = tc.blockTimeout
+
+			connHandler := handlermocks.NewWebSocketConnHandler(t)
+			connHandler.On("Dial").Return(nil).Once()
+			connHandler.On("Write", testMessage).Return(nil).Once()
+			connHandler.On("Read").Return(testMessage, nil).Maybe()
+			connHandler.On("Close").Return(nil).Once()
+
+			dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+			dataHandler.On("CreateMessages", mock.Anything).Return([]handlers.WebsocketEncodedMessage{testMessage}, nil).Once()
+			for _, resp := range responses {
+				dataHandler.On("HandleMessage", mock.Anything).Return(resp, nil, nil).Once()
+			}
+			dataHandler.On("HandleMessage", mock.Anything).Return(
+				providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{},
+				nil,
+				fmt.Errorf("no more messages"),
+			).Maybe()
+
+			m := mockmetrics.NewWebSocketMetrics(t)
+			m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
+			m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+			m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Maybe()
+			m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Times(tc.expectedSends)
+			m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageErr).Return().Maybe()
+			m.On("AddWebSocketDataHandlerStatus", name, metrics.BufferDropped).Return().Twice()
+			m.On("ObserveWebSocketBufferSize", name, 1).Return().Times(tc.expectedSends)
+			m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+			m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
+
+			handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+				logger,
+				bufferCfg,
+				dataHandler,
+				connHandler,
+				m,
+			)
+			require.NoError(t, err)
+
+			responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], bufferCfg.MaxBufferSize)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			require.Error(t, handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd}, responseCh))
+			close(responseCh)
+
+			resp := <-responseCh
+			require.Equal(t, tc.expectedValue, resp.Resolved[btcusd].Value)
+		})
+	}
+
+	t.Run("unsupported buffer policy is rejected", func(t *testing.T) {
+		bufferCfg := cfg
+		bufferCfg.BufferPolicy = "dropEverything"
+		require.Error(t, bufferCfg.ValidateBasic())
+	})
+}
+
+func TestWebSocketQueryHandlerConnectionState(t *testing.T) {
+	failedCfg := cfg
+	failedCfg.FailedConnectionTimeout = 100 * time.Millisecond
+
+	connHandler := handlermocks.NewWebSocketConnHandler(t)
+	connHandler.On("Dial").Return(nil).Once()
+	connHandler.On("Write", testMessage).Return(nil).Once()
+	connHandler.On("Read").Return(testMessage, nil).Maybe()
+	connHandler.On("Close").Return(nil).Once()
+
+	dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+	dataHandler.On("CreateMessages", mock.Anything).Return([]handlers.WebsocketEncodedMessage{testMessage}, nil).Once()
+	dataHandler.On("HandleMessage", mock.Anything).Return(
+		providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{},
+		nil,
+		fmt.Errorf("error message from the venue"),
+	).Maybe()
+
+	m := mockmetrics.NewWebSocketMetrics(t)
+	m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketDataHandlerStatus", name, mock.Anything).Return().Maybe()
+	m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+	m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketConnectionStateTransition", name, "reconnecting", "connected").Return().Once()
+	m.On("AddWebSocketConnectionStateTransition", name, "connected", "failed").Return().Once()
+
+	handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+		logger,
+		failedCfg,
+		dataHandler,
+		connHandler,
+		m,
+	)
+	require.NoError(t, err)
+	require.Equal(t, handlers.ConnectionStateReconnecting, handler.State())
+
+	responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], cfg.MaxBufferSize)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The connection is torn down since no messages are handled within the failed connection timeout.
+	err = handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd}, responseCh)
+	require.ErrorIs(t, err, wserrors.ErrFailedConnection)
+	require.NoError(t, ctx.Err())
+	require.Equal(t, handlers.ConnectionStateFailed, handler.State())
+}
+
+func TestWebSocketQueryHandlerMaxReconnectAttempts(t *testing.T) {
+	disabledCfg := cfg
+	disabledCfg.MaxReconnectAttempts = 2
+
+	connHandler := handlermocks.NewWebSocketConnHandler(t)
+	connHandler.On("Dial").Return(fmt.Errorf("connection refused")).Times(3)
+
+	dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+
+	m := mockmetrics.NewWebSocketMetrics(t)
+	m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketConnectionStateTransition", name, "reconnecting", "failed").Return().Once()
+	m.On("AddWebSocketConnectionStateTransition", name, "failed", "reconnecting").Return().Once()
+	m.On("AddWebSocketConnectionStateTransition", name, "reconnecting", "disabled").Return().Twice()
+	m.On("AddWebSocketConnectionStateTransition", name, "disabled", "reconnecting").Return().Once()
+
+	handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+		logger,
+		disabledCfg,
+		dataHandler,
+		connHandler,
+		m,
+	)
+	require.NoError(t, err)
+
+	responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], cfg.MaxBufferSize)
+	ids := []slinkytypes.CurrencyPair{btcusd}
+
+	// The first failed attempt is below the max reconnect attempts.
+	require.Error(t, handler.Start(context.Background(), ids, responseCh))
+	require.Equal(t, handlers.ConnectionStateFailed, handler.State())
+
+	// The connection is disabled once the max reconnect attempts are reached.
+	require.Error(t, handler.Start(context.Background(), ids, responseCh))
+	require.Equal(t, handlers.ConnectionStateDisabled, handler.State())
+
+	// Another failed attempt, e.g. after the reconnect cooldown, disables the connection again.
+	require.Error(t, handler.Start(context.Background(), ids, responseCh))
+	require.Equal(t, handlers.ConnectionStateDisabled, handler.State())
+}
+
+func TestWebSocketQueryHandlerDedupe(t *testing.T) {
+	dedupeCfg := cfg
+	dedupeCfg.DedupeWindow = time.Minute
+
+	newResponse := func(values map[slinkytypes.CurrencyPair]int64) providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int] {
+		resolved := make(map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int])
+		for cp, value := range values {
+			resolved[cp] = providertypes.ResolvedResult[*big.Int]{Value: big.NewInt(value)}
+		}
+		return providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](resolved, nil)
+	}
+
+	// Each connection handles its messages in order, after which the venue returns errors.
+	var (
+		mtx   sync.Mutex
+		queue []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]
+	)
+	handleMessage := func([]byte) (providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], []handlers.WebsocketEncodedMessage, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if len(queue) == 0 {
+			return providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{}, nil, fmt.Errorf("no more messages")
+		}
+
+		resp := queue[0]
+		queue = queue[1:]
+		return resp, nil, nil
+	}
+
+	connHandler := handlermocks.NewWebSocketConnHandler(t)
+	connHandler.On("Dial").Return(nil).Twice()
+	connHandler.On("Write", testMessage).Return(nil).Twice()
+	connHandler.On("Read").Return(testMessage, nil).Maybe()
+	connHandler.On("Close").Return(nil).Twice()
+
+	dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+	dataHandler.On("CreateMessages", mock.Anything).Return([]handlers.WebsocketEncodedMessage{testMessage}, nil).Twice()
+	dataHandler.On("HandleMessage", mock.Anything).Return(handleMessage).Maybe()
+
+	m := mockmetrics.NewWebSocketMetrics(t)
+	m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketDataHandlerStatus", name, metrics.CreateMessageSuccess).Return().Maybe()
+	m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageSuccess).Return().Maybe()
+	m.On("AddWebSocketDataHandlerStatus", name, metrics.HandleMessageErr).Return().Maybe()
+	m.On("AddWebSocketDataHandlerStatus", name, metrics.Deduplicated).Return().Times(3)
+	m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
+	m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+	m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
+
+	handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+		logger,
+		dedupeCfg,
+		dataHandler,
+		connHandler,
+		m,
+	)
+	require.NoError(t, err)
+
+	ids := []slinkytypes.CurrencyPair{btcusd, ethusd}
+
+	// connect handles the given messages on a new connection, and returns the resolved values
+	// of the responses that are sent.
+	connect := func(messages ...map[slinkytypes.CurrencyPair]int64) []map[slinkytypes.CurrencyPair]int64 {
+		mtx.Lock()
+		for _, values := range messages {
+			queue = append(queue, newResponse(values))
+		}
+		mtx.Unlock()
+
+		responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], dedupeCfg.MaxBufferSize)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		require.Error(t, handler.Start(ctx, ids, responseCh))
+		close(responseCh)
+
+		var sent []map[slinkytypes.CurrencyPair]int64
+		for resp := range responseCh {
+			values := make(map[slinkytypes.CurrencyPair]int64)
+			for cp, result := range resp.Resolved {
+				values[cp] = result.Value.Int64()
+			}
+			sent = append(sent, values)
+		}
+		return sent
+	}
+
+	// Identical values on the same connection are dropped.
+	sent := connect(
+		map[slinkytypes.CurrencyPair]int64{btcusd: 1, ethusd: 5},
+		map[slinkytypes.CurrencyPair]int64{btcusd: 1},
+		map[slinkytypes.CurrencyPair]int64{btcusd: 2},
+	)
+	require.Equal(t, []map[slinkytypes.CurrencyPair]int64{
+		{btcusd: 1, ethusd: 5},
+		{},
+		{btcusd: 2},
+	}, sent)
+
+	// Values that are replayed after a reconnect are dropped.
+	sent = connect(
+		map[slinkytypes.CurrencyPair]int64{btcusd: 2, ethusd: 5},
+		map[slinkytypes.CurrencyPair]int64{ethusd: 6},
+	)
+	require.Equal(t, []map[slinkytypes.CurrencyPair]int64{
+		{},
+		{ethusd: 6},
+	}, sent)
+
+	t.Run("negative dedupe window is rejected", func(t *testing.T) {
+		invalidCfg := cfg
+		invalidCfg.DedupeWindow = -time.Second
+		require.Error(t, invalidCfg.ValidateBasic())
+	})
+}
+
+func TestWebSocketQueryHandlerMessageAge(t *testing.T) {
+	eventTimestamp := time.Now().Add(-time.Minute)
+
+	// The first message carries an exchange timestamp, the second does not.
+	var (
+		mtx   sync.Mutex
+		queue = []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+			providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+				map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					btcusd: providertypes.NewResultWithEventTimestamp(big.NewInt(1), time.Now(), eventTimestamp),
+				},
+				nil,
+			),
+			providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+				map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+					ethusd: providertypes.NewResult(big.NewInt(2), time.Now()),
+				},
+				nil,
+			),
+		}
+	)
+	handleMessage := func([]byte) (providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], []handlers.WebsocketEncodedMessage, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		if len(queue) == 0 {
+			return providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{}, nil, fmt.Errorf("no more messages")
+		}
+
+		resp := queue[0]
+		queue = queue[1:]
+		return resp, nil, nil
+	}
+
+	connHandler := handlermocks.NewWebSocketConnHandler(t)
+	connHandler.On("Dial").Return(nil).Once()
+	connHandler.On("Write", testMessage).Return(nil).Once()
+	connHandler.On("Read").Return(testMessage, nil).Maybe()
+	connHandler.On("Close").Return(nil).Once()
+
+	dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+	dataHandler.On("CreateMessages", mock.Anything).Return([]handlers.WebsocketEncodedMessage{testMessage}, nil).Once()
+	dataHandler.On("HandleMessage", mock.Anything).Return(handleMessage).Maybe()
+
+	m := mockmetrics.NewWebSocketMetrics(t)
+	m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+	m.On("AddWebSocketDataHandlerStatus", name, mock.Anything).Return().Maybe()
+	m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
+	m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+	m.On(
+		"ObserveWebSocketMessageAge",
+		name,
+		metrics.MessageAgeSourceExchange,
+		mock.MatchedBy(func(age time.Duration) bool { return age >= time.Minute }),
+	).Return().Once()
+	m.On(
+		"ObserveWebSocketMessageAge",
+		name,
+		metrics.MessageAgeSourceInterarrival,
+		mock.MatchedBy(func(age time.Duration) bool { return age < time.Minute }),
+	).Return().Once()
+
+	handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+		logger,
+		cfg,
+		dataHandler,
+		connHandler,
+		m,
+	)
+	require.NoError(t, err)
+
+	responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], cfg.MaxBufferSize)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	require.Error(t, handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd, ethusd}, responseCh))
+}
+
+func TestWebSocketQueryHandlerFieldOverrides(t *testing.T) {
+	overriddenCfg := cfg
+	overriddenCfg.FieldOverrides = config.FieldOverrides{"lastPrice": "last"}
+
+	testCases := []struct {
+		name     string
+		message  []byte
+		expected []byte
+	}{
+		{
+			name:     "overridden fields are renamed",
+			message:  []byte(`{"last":"100","symbol":"BTCUSD"}`),
+			expected: []byte(`{"lastPrice":"100","symbol":"BTCUSD"}`),
+		},
+		{
+			name:     "message that is not json is passed through",
+			message:  heartbeat,
+			expected: heartbeat,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			connHandler := handlermocks.NewWebSocketConnHandler(t)
+			connHandler.On("Dial").Return(nil).Once()
+			connHandler.On("Write", testMessage).Return(nil).Once()
+			connHandler.On("Read").Return(tc.message, nil).Maybe()
+			connHandler.On("Close").Return(nil).Once()
+
+			dataHandler := handlermocks.NewWebSocketDataHandler[slinkytypes.CurrencyPair, *big.Int](t)
+			dataHandler.On("CreateMessages", mock.Anything).Return([]handlers.WebsocketEncodedMessage{testMessage}, nil).Once()
+			dataHandler.On("HandleMessage", tc.expected).Return(
+				providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](nil, nil),
+				nil,
+				nil,
+			)
+
+			m := mockmetrics.NewWebSocketMetrics(t)
+			m.On("AddWebSocketConnectionStateTransition", name, mock.Anything, mock.Anything).Return().Maybe()
+			m.On("AddWebSocketConnectionStatus", name, mock.Anything).Return().Maybe()
+			m.On("AddWebSocketDataHandlerStatus", name, mock.Anything).Return().Maybe()
+			m.On("ObserveWebSocketBufferSize", name, mock.Anything).Return().Maybe()
+			m.On("ObserveWebSocketLatency", name, mock.Anything).Return().Maybe()
+			m.On("ObserveWebSocketMessageAge", name, mock.Anything, mock.Anything).Return().Maybe()
+
+			handler, err := handlers.NewWebSocketQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+				logger,
+				overriddenCfg,
+				dataHandler,
+				connHandler,
+				m,
+			)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], overriddenCfg.MaxBufferSize)
+			require.Error(t, handler.Start(ctx, []slinkytypes.CurrencyPair{btcusd}, responseCh))
+		})
+	}
+
+	t.Run("invalid field overrides are rejected", func(t *testing.T) {
+		invalidCfg := cfg
+		invalidCfg.FieldOverrides = config.FieldOverrides{"lastPrice": ""}
+		require.Error(t, invalidCfg.ValidateBasic())
+	})
+}
@@ -15,6 +15,11 @@ type WebSocketMetrics struct {
 	mock.Mock
 }
 
+// AddWebSocketConnectionStateTransition provides a mock function with given fields: provider, from, to
+func (_m *WebSocketMetrics) AddWebSocketConnectionStateTransition(provider string, from string, to string) {
+	_m.Called(provider, from, to)
+}
+
 // AddWebSocketConnectionStatus provides a mock function with given fields: provider, status
 func (_m *WebSocketMetrics) AddWebSocketConnectionStatus(provider string, status metrics.ConnectionStatus) {
 	_m.Called(provider, status)
@@ -25,11 +30,21 @@ func (_m *WebSocketMetrics) AddWebSocketDataHandlerStatus(provider string, statu
 	_m.Called(provider, status)
 }
 
+// ObserveWebSocketBufferSize provides a mock function with given fields: provider, size
+func (_m *WebSocketMetrics) ObserveWebSocketBufferSize(provider string, size int) {
+	_m.Called(provider, size)
+}
+
 // ObserveWebSocketLatency provides a mock function with given fields: provider, duration
 func (_m *WebSocketMetrics) ObserveWebSocketLatency(provider string, duration time.Duration) {
 	_m.Called(provider, duration)
 }
 
+// ObserveWebSocketMessageAge provides a mock function with given fields: provider, source, age
+func (_m *WebSocketMetrics) ObserveWebSocketMessageAge(provider string, source string, age time.Duration) {
+	_m.Called(provider, source, age)
+}
+
 // NewWebSocketMetrics creates a new instance of WebSocketMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketMetrics(t interface {
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,6 +14,19 @@ import (
 const (
 	// StatusLabel is the label used for the status of a provider response.
 	StatusLabel = "status"
+	// FromStateLabel is the label used for the state a connection transitioned from.
+	FromStateLabel = "from"
+	// ToStateLabel is the label used for the state a connection transitioned to.
+	ToStateLabel = "to"
+	// SourceLabel is the label used for the source of a message age observation.
+	SourceLabel = "source"
+
+	// MessageAgeSourceExchange indicates that the message age was measured from the timestamp
+	// reported by the exchange.
+	MessageAgeSourceExchange = "exchange"
+	// MessageAgeSourceInterarrival indicates that the exchange did not report a timestamp, and
+	// the message age was measured as the time since the previous message was received.
+	MessageAgeSourceInterarrival = "interarrival"
 )
 
 // WebSocketMetrics is an interface that defines the API for metrics collection for providers
@@ -31,6 +45,18 @@ type WebSocketMetrics interface {
 	// ObserveWebSocketLatency adds a latency observation to the metrics collector for the
 	// given provider.
 	ObserveWebSocketLatency(provider string, duration time.Duration)
+
+	// ObserveWebSocketBufferSize records the number of messages currently buffered for the
+	// given provider. The metrics collector exposes the highest observed buffer size.
+	ObserveWebSocketBufferSize(provider string, size int)
+
+	// AddWebSocketConnectionStateTransition records a transition of one of the given provider's
+	// connections between states e.g. from connected to failed.
+	AddWebSocketConnectionStateTransition(provider, from, to string)
+
+	// ObserveWebSocketMessageAge records the age of a price update received by the given
+	// provider. The source indicates how the age was measured.
+	ObserveWebSocketMessageAge(provider, source string, age time.Duration)
 }
 
 // WebSocketMetricsImpl contains metrics exposed by this package.
@@ -43,6 +69,18 @@ type WebSocketMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	responseTimePerProvider *prometheus.HistogramVec
+
+	// Highest number of buffered messages observed per provider.
+	bufferHighWaterMarkPerProvider *prometheus.GaugeVec
+
+	// Number of connection state transitions.
+	connectionStateTransitionsPerProvider *prometheus.CounterVec
+
+	// Histogram paginated by provider and source, measuring the age of price updates on receipt.
+	messageAgePerProvider *prometheus.HistogramVec
+
+	mtx                 sync.Mutex
+	bufferHighWaterMark map[string]int
 }
 
 // NewWebSocketMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -72,12 +110,32 @@ func NewWebSocketMetrics() WebSocketMetrics {
 			Help:      "Response time per web socket provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel}),
+		bufferHighWaterMarkPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_buffer_high_water_mark",
+			Help:      "Highest number of buffered web socket messages observed per provider.",
+		}, []string{providermetrics.ProviderLabel}),
+		connectionStateTransitionsPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_connection_state_transitions",
+			Help:      "Transitions of the underlying web socket connections between states.",
+		}, []string{providermetrics.ProviderLabel, FromStateLabel, ToStateLabel}),
+		messageAgePerProvider: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "provider_ws_message_age_seconds",
+			Help:      "Age of web socket price updates on receipt, per provider and source.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{providermetrics.ProviderLabel, SourceLabel}),
+		bufferHighWaterMark: make(map[string]int),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.connectionStatusPerProvider)
 	prometheus.MustRegister(m.dataHandlerStatusPerProvider)
 	prometheus.MustRegister(m.responseTimePerProvider)
+	prometheus.MustRegister(m.bufferHighWaterMarkPerProvider)
+	prometheus.MustRegister(m.connectionStateTransitionsPerProvider)
+	prometheus.MustRegister(m.messageAgePerProvider)
 
 	return m
 }
@@ -98,6 +156,15 @@ func (m *noOpWebSocketMetricsImpl) AddWebSocketDataHandlerStatus(_ string, _ Han
 func (m *noOpWebSocketMetricsImpl) ObserveWebSocketLatency(_ string, _ time.Duration) {
 }
 
+func (m *noOpWebSocketMetricsImpl) ObserveWebSocketBufferSize(_ string, _ int) {
+}
+
+func (m *noOpWebSocketMetricsImpl) AddWebSocketConnectionStateTransition(_, _, _ string) {
+}
+
+func (m *noOpWebSocketMetricsImpl) ObserveWebSocketMessageAge(_, _ string, _ time.Duration) {
+}
+
 // AddWebSocketConnectionStatus adds a method / status response to the metrics collector for the
 // given provider. Specifically, this tracks various connection related errors.
 func (m *WebSocketMetricsImpl) AddWebSocketConnectionStatus(provider string, status ConnectionStatus) {
@@ -125,3 +192,41 @@ func (m *WebSocketMetricsImpl) ObserveWebSocketLatency(provider string, duration
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// ObserveWebSocketBufferSize records the number of buffered messages for the given provider and
+// updates the high water mark if the size exceeds the highest size observed so far.
+func (m *WebSocketMetricsImpl) ObserveWebSocketBufferSize(provider string, size int) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if size <= m.bufferHighWaterMark[provider] {
+		return
+	}
+
+	m.bufferHighWaterMark[provider] = size
+	m.bufferHighWaterMarkPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+	},
+	).Set(float64(size))
+}
+
+// AddWebSocketConnectionStateTransition records a transition of one of the given provider's
+// connections between states.
+func (m *WebSocketMetricsImpl) AddWebSocketConnectionStateTransition(provider, from, to string) {
+	m.connectionStateTransitionsPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		FromStateLabel:                from,
+		ToStateLabel:                  to,
+	},
+	).Add(1)
+}
+
+// ObserveWebSocketMessageAge records the age of a price update received by the given provider,
+// in seconds.
+func (m *WebSocketMetricsImpl) ObserveWebSocketMessageAge(provider, source string, age time.Duration) {
+	m.messageAgePerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		SourceLabel:                   source,
+	},
+	).Observe(age.Seconds())
+}
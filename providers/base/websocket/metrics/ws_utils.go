@@ -52,6 +52,12 @@ const (
 	HeartBeatErr
 	// Unknown indicates that the provider encountered an unknown error.
 	Unknown
+	// BufferDropped indicates that the provider dropped a message because the response
+	// buffer was full.
+	BufferDropped
+	// Deduplicated indicates that the provider dropped a value because it was identical to
+	// the last value processed for the same ID within the dedupe window.
+	Deduplicated
 )
 
 // String returns a string representation of the connection status.
@@ -97,6 +103,10 @@ func (s HandlerStatus) String() string {
 		return "heartbeat_success"
 	case HeartBeatErr:
 		return "heartbeat_err"
+	case BufferDropped:
+		return "buffer_dropped"
+	case Deduplicated:
+		return "deduplicated"
 	default:
 		return "unknown_err"
 	}
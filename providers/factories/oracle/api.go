@@ -12,10 +12,13 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
+	slinkyhttp "github.com/skip-mev/slinky/pkg/http"
 	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/binancefutures"
 	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
 	"github.com/skip-mev/slinky/providers/apis/coingecko"
 	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
+	"github.com/skip-mev/slinky/providers/apis/fx"
 	"github.com/skip-mev/slinky/providers/apis/geckoterminal"
 	"github.com/skip-mev/slinky/providers/apis/kraken"
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
@@ -46,8 +49,9 @@ func APIQueryHandlerFactory(
 		Transport: &http.Transport{
 			MaxConnsPerHost: cfg.API.MaxQueries,
 			Proxy:           http.ProxyFromEnvironment,
+			DialContext:     slinkyhttp.NewDialContextWithLocalAddress(cfg.API.LocalAddress),
 		},
-		Timeout: cfg.API.Timeout,
+		Timeout: cfg.API.GetMaxTimeout(),
 	}
 
 	var (
@@ -63,10 +67,14 @@ func APIQueryHandlerFactory(
 	switch providerName := cfg.Name; {
 	case providerName == binance.Name:
 		apiDataHandler, err = binance.NewAPIHandler(cfg.API)
+	case providerName == binancefutures.Name:
+		apiDataHandler, err = binancefutures.NewAPIHandler(cfg.API)
 	case providerName == coinbaseapi.Name:
 		apiDataHandler, err = coinbaseapi.NewAPIHandler(cfg.API)
 	case providerName == coingecko.Name:
 		apiDataHandler, err = coingecko.NewAPIHandler(cfg.API)
+	case providerName == fx.Name:
+		apiDataHandler, err = fx.NewAPIHandler(cfg.API)
 	case providerName == geckoterminal.Name:
 		apiDataHandler, err = geckoterminal.NewAPIHandler(cfg.API)
 	case providerName == kraken.Name:
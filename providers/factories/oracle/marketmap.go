@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/skip-mev/slinky/oracle/config"
+	slinkyhttp "github.com/skip-mev/slinky/pkg/http"
 	"github.com/skip-mev/slinky/providers/apis/dydx"
 	"github.com/skip-mev/slinky/providers/apis/marketmap"
 	"github.com/skip-mev/slinky/providers/base"
@@ -34,8 +35,9 @@ func MarketMapProviderFactory(
 		Transport: &http.Transport{
 			MaxConnsPerHost: cfg.API.MaxQueries,
 			Proxy:           http.ProxyFromEnvironment,
+			DialContext:     slinkyhttp.NewDialContextWithLocalAddress(cfg.API.LocalAddress),
 		},
-		Timeout: cfg.API.Timeout,
+		Timeout: cfg.API.GetMaxTimeout(),
 	}
 
 	var (
@@ -0,0 +1,76 @@
+package oracle
+
+import (
+	"sort"
+
+	"github.com/skip-mev/slinky/oracle/constants"
+	"github.com/skip-mev/slinky/oracle/types"
+	"github.com/skip-mev/slinky/providers/apis/binance"
+	"github.com/skip-mev/slinky/providers/apis/binancefutures"
+	coinbaseapi "github.com/skip-mev/slinky/providers/apis/coinbase"
+	"github.com/skip-mev/slinky/providers/apis/coingecko"
+	"github.com/skip-mev/slinky/providers/apis/defi/uniswapv3"
+	"github.com/skip-mev/slinky/providers/apis/fx"
+	"github.com/skip-mev/slinky/providers/apis/geckoterminal"
+	krakenapi "github.com/skip-mev/slinky/providers/apis/kraken"
+	"github.com/skip-mev/slinky/providers/websockets/bitfinex"
+	"github.com/skip-mev/slinky/providers/websockets/bitstamp"
+	"github.com/skip-mev/slinky/providers/websockets/bybit"
+	coinbasews "github.com/skip-mev/slinky/providers/websockets/coinbase"
+	"github.com/skip-mev/slinky/providers/websockets/cryptodotcom"
+	"github.com/skip-mev/slinky/providers/websockets/gate"
+	"github.com/skip-mev/slinky/providers/websockets/huobi"
+	krakenws "github.com/skip-mev/slinky/providers/websockets/kraken"
+	"github.com/skip-mev/slinky/providers/websockets/kucoin"
+	"github.com/skip-mev/slinky/providers/websockets/mexc"
+	"github.com/skip-mev/slinky/providers/websockets/okx"
+)
+
+// ProviderQuoteCurrencies returns the quote currencies supported by each price provider, indexed by
+// provider name, as declared by the provider's default market config(s). These are utilized by the
+// aggregator to automatically route conversions for markets that enable auto routing.
+func ProviderQuoteCurrencies() map[string][]string {
+	markets := map[string][]types.CurrencyPairsToProviderTickers{
+		// API providers.
+		binance.Name:        {binance.DefaultUSMarketConfig, binance.DefaultNonUSMarketConfig},
+		binancefutures.Name: {binancefutures.DefaultMarketConfig},
+		coinbaseapi.Name:    {coinbaseapi.DefaultMarketConfig},
+		coingecko.Name:      {coingecko.DefaultMarketConfig},
+		fx.Name:             {fx.DefaultMarketConfig},
+		geckoterminal.Name:  {geckoterminal.DefaultETHMarketConfig},
+		krakenapi.Name:      {krakenapi.DefaultMarketConfig},
+		// WebSocket providers.
+		bitfinex.Name:     {bitfinex.DefaultMarketConfig},
+		bitstamp.Name:     {bitstamp.DefaultMarketConfig},
+		bybit.Name:        {bybit.DefaultMarketConfig},
+		coinbasews.Name:   {coinbasews.DefaultMarketConfig},
+		cryptodotcom.Name: {cryptodotcom.DefaultMarketConfig},
+		gate.Name:         {gate.DefaultMarketConfig},
+		huobi.Name:        {huobi.DefaultMarketConfig},
+		krakenws.Name:     {krakenws.DefaultMarketConfig},
+		kucoin.Name:       {kucoin.DefaultMarketConfig},
+		mexc.Name:         {mexc.DefaultMarketConfig},
+		okx.Name:          {okx.DefaultMarketConfig},
+		// DeFi providers.
+		uniswapv3.ProviderNames[constants.ETHEREUM]: {uniswapv3.DefaultETHMarketConfig},
+	}
+
+	quotes := make(map[string][]string, len(markets))
+	for provider, configs := range markets {
+		seen := make(map[string]struct{})
+		for _, cfg := range configs {
+			for _, quote := range cfg.QuoteCurrencies() {
+				if _, ok := seen[quote]; ok {
+					continue
+				}
+
+				seen[quote] = struct{}{}
+				quotes[provider] = append(quotes[provider], quote)
+			}
+		}
+
+		sort.Strings(quotes[provider])
+	}
+
+	return quotes
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/oracle/types"
+	slinkyhttp "github.com/skip-mev/slinky/pkg/http"
 	apihandlers "github.com/skip-mev/slinky/providers/base/api/handlers"
 	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	wsmetrics "github.com/skip-mev/slinky/providers/base/websocket/metrics"
@@ -45,8 +46,9 @@ func WebSocketQueryHandlerFactory(
 		Transport: &http.Transport{
 			MaxConnsPerHost: cfg.API.MaxQueries,
 			Proxy:           http.ProxyFromEnvironment,
+			DialContext:     slinkyhttp.NewDialContextWithLocalAddress(cfg.WebSocket.LocalAddress),
 		},
-		Timeout: cfg.API.Timeout,
+		Timeout: cfg.API.GetMaxTimeout(),
 	}
 
 	var (
@@ -2,6 +2,8 @@ package types
 
 import (
 	"errors"
+
+	"github.com/skip-mev/slinky/pkg/math"
 )
 
 // ErrorCode is a type alias for an int error code.
@@ -25,6 +27,11 @@ const (
 	ErrorWebSocketGeneral      ErrorCode = 14
 	ErrorGRPCGeneral           ErrorCode = 15
 	ErrorNoExistingPrice       ErrorCode = 16
+	ErrorNotReturned           ErrorCode = 17
+	ErrorResponseTooLarge      ErrorCode = 18
+	ErrorInvalidPrice          ErrorCode = 19
+	ErrorStalePrice            ErrorCode = 20
+	ErrorAssertionFailed       ErrorCode = 21
 )
 
 // Error returns the error representation of the ErrorCode.
@@ -62,6 +69,16 @@ func (e ErrorCode) Error() error {
 		return errors.New("general grpc error")
 	case ErrorNoExistingPrice:
 		return errors.New("no existing price")
+	case ErrorNotReturned:
+		return errors.New("not returned by provider")
+	case ErrorResponseTooLarge:
+		return errors.New("response too large")
+	case ErrorInvalidPrice:
+		return errors.New("invalid price (NaN, infinite or overflowing)")
+	case ErrorStalePrice:
+		return errors.New("stale price")
+	case ErrorAssertionFailed:
+		return errors.New("response assertion failed")
 	case ErrorUnknown:
 		fallthrough
 	default:
@@ -90,3 +107,14 @@ func NewErrorWithCode(err error, ec ErrorCode) ErrorWithCode {
 		internalErr: err,
 	}
 }
+
+// NewParsePriceError returns an ErrorWithCode for a price that could not be parsed. Prices that
+// parse to a NaN, infinite, or overflowing value are reported with ErrorInvalidPrice so that they
+// can be told apart from malformed responses in the provider metrics.
+func NewParsePriceError(err error) ErrorWithCode {
+	if errors.Is(err, math.ErrNaN) || errors.Is(err, math.ErrInf) || errors.Is(err, math.ErrOverflow) {
+		return NewErrorWithCode(err, ErrorInvalidPrice)
+	}
+
+	return NewErrorWithCode(err, ErrorFailedToParsePrice)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -51,11 +52,17 @@ type GetResponse[K ResponseKey, V ResponseValue] struct {
 type ResolvedResult[V ResponseValue] struct {
 	// Value is the value of the requested ID.
 	Value V
-	// Timestamp is the timestamp of the value.
+	// Timestamp is the timestamp of the value i.e. the time at which it was received.
 	Timestamp time.Time
+	// EventTimestamp is the optional time at which the value was produced by the provider's
+	// exchange. This is the zero time if the provider does not report an event time.
+	EventTimestamp time.Time
 	// ResponseCode is an optional code that can be attached to responses to provide
 	// additional context.
 	ResponseCode ResponseCode
+	// Volume is the optional trading volume reported alongside the value. This is nil
+	// if the provider does not report volume.
+	Volume *big.Float
 }
 
 // UnresolvedResult is an unresolved (failed) result of a single requested ID.
@@ -122,6 +129,35 @@ func NewResultWithCode[V ResponseValue](value V, timestamp time.Time, code Respo
 	}
 }
 
+// NewResultWithVolume creates a new ResolvedResult with the given volume.
+func NewResultWithVolume[V ResponseValue](value V, timestamp time.Time, volume *big.Float) ResolvedResult[V] {
+	return ResolvedResult[V]{
+		Value:     value,
+		Timestamp: timestamp,
+		Volume:    volume,
+	}
+}
+
+// NewResultWithEventTimestamp creates a new ResolvedResult with the given exchange event timestamp.
+func NewResultWithEventTimestamp[V ResponseValue](value V, timestamp, eventTimestamp time.Time) ResolvedResult[V] {
+	return ResolvedResult[V]{
+		Value:          value,
+		Timestamp:      timestamp,
+		EventTimestamp: eventTimestamp,
+	}
+}
+
+// DataTimestamp returns the time that the age of the value should be measured from. This is the
+// event timestamp if one was reported, and the receipt timestamp otherwise. Event timestamps that
+// are after the receipt timestamp (i.e. due to clock skew) are capped at the receipt timestamp.
+func (r ResolvedResult[V]) DataTimestamp() time.Time {
+	if r.EventTimestamp.IsZero() || r.EventTimestamp.After(r.Timestamp) {
+		return r.Timestamp
+	}
+
+	return r.EventTimestamp
+}
+
 // String returns a string representation of the ResolvedResult. This is mostly used for logging
 // and testing purposes.
 func (r ResolvedResult[V]) String() string {
@@ -45,7 +45,7 @@ func (h *WebSocketHandler) parseTickerMessage(
 	price, err := math.Float64StringToBigFloat(msg.Data.PriceStr)
 	if err != nil {
 		unResolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(err),
 		}
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
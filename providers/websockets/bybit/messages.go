@@ -56,35 +56,32 @@ type SubscriptionRequest struct {
 	Args []string `json:"args"`
 }
 
-// NewSubscriptionRequestMessage creates subscription messages corresponding to the provided tickers.
-// If the number of tickers is greater than 10, the requests will be broken into 10-ticker messages.
-func NewSubscriptionRequestMessage(tickers []string) ([]handlers.WebsocketEncodedMessage, error) {
-	numTickers := len(tickers)
-	if numTickers == 0 {
-		return nil, fmt.Errorf("tickers cannot be empty")
+// NewSubscriptionRequestMessage creates a subscription message that subscribes to the given topics
+// under the given request ID. ByBit rejects requests with more than MaxArgsPerRequest topics, so
+// larger sets of topics must be split across several messages.
+func NewSubscriptionRequestMessage(reqID string, topics []string) (handlers.WebsocketEncodedMessage, error) {
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("topics cannot be empty")
 	}
 
-	messages := make([]handlers.WebsocketEncodedMessage, len(tickers))
-
-	for i, ticker := range tickers {
+	if len(topics) > MaxArgsPerRequest {
+		return nil, fmt.Errorf("cannot subscribe to more than %d topics in a single request; got %d", MaxArgsPerRequest, len(topics))
+	}
 
-		bz, err := json.Marshal(
-			SubscriptionRequest{
-				BaseRequest: BaseRequest{
-					Op: string(OperationSubscribe),
-				},
-				Args: []string{ticker},
+	bz, err := json.Marshal(
+		SubscriptionRequest{
+			BaseRequest: BaseRequest{
+				ReqID: reqID,
+				Op:    string(OperationSubscribe),
 			},
-		)
-		if err != nil {
-			return messages, fmt.Errorf("unable to to marshal message: %w", err)
-		}
-
-		messages[i] = bz
-
+			Args: topics,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to to marshal message: %w", err)
 	}
 
-	return messages, nil
+	return bz, nil
 }
 
 // HeartbeatPing is the ping sent to the server.
@@ -173,6 +170,8 @@ type SubscriptionResponse struct {
 type TickerUpdateMessage struct {
 	Topic string           `json:"topic"`
 	Data  TickerUpdateData `json:"data"`
+	// Timestamp is the time at which the update was generated, in unix milliseconds.
+	Timestamp int64 `json:"ts"`
 }
 
 // TickerUpdateData is the data stored inside a ticker update message.
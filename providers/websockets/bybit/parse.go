@@ -15,22 +15,47 @@ import (
 )
 
 // parseSubscriptionResponse parses a subscribe response message. The format of the message
-// is defined in the messages.go file. There are two cases that are handled:
+// is defined in the messages.go file. The response is matched to the topics of the subscribe
+// message with the same request ID. There are two cases that are handled:
 //
-// 1. Successfully subscribed to the channel. In this case, no further action is required.
-// 2. Error message. In this case, we attempt to re-subscribe to the channel.
+// 1. Successfully subscribed to the topics. In this case, the topics are marked as subscribed.
+// 2. Error message. In this case, we attempt to re-subscribe to the topics.
 func (h *WebSocketHandler) parseSubscriptionResponse(resp SubscriptionResponse) ([]handlers.WebsocketEncodedMessage, error) {
-	// A response with an event type of subscribe means that we have successfully subscribed to the channel.
-	if t := Operation(resp.Op); t == OperationSubscribe && resp.Success {
-		h.logger.Debug("successfully subscribed to channel", zap.String("connection", resp.ConnID))
-		return nil, nil
+	if t := Operation(resp.Op); t != OperationSubscribe {
+		return nil, fmt.Errorf("unable to parse message")
 	}
 
-	if t := Operation(resp.Op); t == OperationSubscribe && !resp.Success {
+	topics, ok := h.pending[resp.ReqID]
+	delete(h.pending, resp.ReqID)
+
+	// A failed subscription that cannot be matched to its topics cannot be retried.
+	if !resp.Success && !ok {
 		return nil, fmt.Errorf("received error message: %s", resp.RetMsg)
 	}
 
-	return nil, fmt.Errorf("unable to parse message")
+	if !resp.Success {
+		h.logger.Debug(
+			"could not successfully subscribe to topics; attempting to resubscribe",
+			zap.Strings("topics", topics),
+			zap.String("error", resp.RetMsg),
+		)
+
+		return h.subscribe(topics)
+	}
+
+	for _, topic := range topics {
+		h.subscribed[topic] = struct{}{}
+	}
+
+	h.logger.Debug(
+		"successfully subscribed to topics",
+		zap.String("connection", resp.ConnID),
+		zap.Strings("topics", topics),
+		zap.Int("subscribed", len(h.subscribed)),
+		zap.Int("pending", len(h.pending)),
+	)
+
+	return nil, nil
 }
 
 // parseTickerUpdate parses a ticker update message. The format of the message is defined
@@ -61,11 +86,18 @@ func (h *WebSocketHandler) parseTickerUpdate(
 	if err != nil {
 		wErr := fmt.Errorf("failed to convert price to big.Float: %w", err)
 		unresolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(wErr),
 		}
 		return types.NewPriceResponse(resolved, unresolved), nil
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// If the update does not carry a timestamp, the age of the price is measured from when it
+	// was received.
+	var eventTime time.Time
+	if resp.Timestamp > 0 {
+		eventTime = time.UnixMilli(resp.Timestamp).UTC()
+	}
+
+	resolved[ticker] = types.NewPriceResultWithEventTimestamp(price, time.Now().UTC(), eventTime)
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
@@ -2,6 +2,7 @@ package bybit_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -105,6 +106,31 @@ func TestHandlerMessage(t *testing.T) {
 		},
 		{
 			name: "successful subscription",
+			msg: func() []byte {
+				msg := bybit.SubscriptionResponse{
+					BaseResponse: bybit.BaseResponse{
+						Success: true,
+						RetMsg:  string(bybit.OperationSubscribe),
+						ConnID:  "90190u1309",
+						Op:      string(bybit.OperationSubscribe),
+					},
+					ReqID: "1",
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{},
+			),
+			updateMsg: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:    false,
+		},
+		{
+			name: "successful subscription of an unknown request",
 			msg: func() []byte {
 				msg := bybit.SubscriptionResponse{
 					BaseResponse: bybit.BaseResponse{
@@ -129,7 +155,37 @@ func TestHandlerMessage(t *testing.T) {
 			expErr:    false,
 		},
 		{
-			name: "subscription error",
+			name: "subscription error resubscribes to the topics of the request",
+			msg: func() []byte {
+				msg := bybit.SubscriptionResponse{
+					BaseResponse: bybit.BaseResponse{
+						Success: false,
+						RetMsg:  string(bybit.OperationSubscribe),
+						ConnID:  "90190u1309",
+						Op:      string(bybit.OperationSubscribe),
+					},
+					ReqID: "1",
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{},
+			),
+			updateMsg: func() []handlers.WebsocketEncodedMessage {
+				msg, err := bybit.NewSubscriptionRequestMessage("2", []string{"tickers.BTCUSDT", "tickers.ETHUSDT"})
+				require.NoError(t, err)
+
+				return []handlers.WebsocketEncodedMessage{msg}
+			},
+			expErr: false,
+		},
+		{
+			name: "subscription error of an unknown request",
 			msg: func() []byte {
 				msg := bybit.SubscriptionResponse{
 					BaseResponse: bybit.BaseResponse{
@@ -213,7 +269,8 @@ func TestCreateMessage(t *testing.T) {
 			expected: func() [][]byte {
 				msg := bybit.SubscriptionRequest{
 					BaseRequest: bybit.BaseRequest{
-						Op: string(bybit.OperationSubscribe),
+						ReqID: "1",
+						Op:    string(bybit.OperationSubscribe),
 					},
 					Args: []string{"tickers.BTCUSDT"},
 				}
@@ -232,17 +289,50 @@ func TestCreateMessage(t *testing.T) {
 				ethusdt,
 			},
 			expected: func() [][]byte {
-				msgs := make([][]byte, 2)
-				for i, ticker := range []string{"tickers.BTCUSDT", "tickers.ETHUSDT"} {
+				msg := bybit.SubscriptionRequest{
+					BaseRequest: bybit.BaseRequest{
+						ReqID: "1",
+						Op:    string(bybit.OperationSubscribe),
+					},
+					Args: []string{"tickers.BTCUSDT", "tickers.ETHUSDT"},
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return [][]byte{bz}
+			},
+			expectedErr: false,
+		},
+		{
+			name: "currency pairs are split into batches of the max args per request",
+			cps: func() []types.ProviderTicker {
+				cps := make([]types.ProviderTicker, bybit.MaxArgsPerRequest+2)
+				for i := range cps {
+					cps[i] = types.NewProviderTicker(fmt.Sprintf("TICKER%dUSDT", i), "{}")
+				}
+
+				return cps
+			}(),
+			expected: func() [][]byte {
+				topics := make([]string, bybit.MaxArgsPerRequest+2)
+				for i := range topics {
+					topics[i] = fmt.Sprintf("tickers.TICKER%dUSDT", i)
+				}
+
+				msgs := make([][]byte, 0, 2)
+				for i, batch := range [][]string{topics[:bybit.MaxArgsPerRequest], topics[bybit.MaxArgsPerRequest:]} {
 					msg := bybit.SubscriptionRequest{
 						BaseRequest: bybit.BaseRequest{
-							Op: string(bybit.OperationSubscribe),
+							ReqID: fmt.Sprint(i + 1),
+							Op:    string(bybit.OperationSubscribe),
 						},
-						Args: []string{ticker},
+						Args: batch,
 					}
+
 					bz, err := json.Marshal(msg)
 					require.NoError(t, err)
-					msgs[i] = bz
+					msgs = append(msgs, bz)
 				}
 
 				return msgs
@@ -3,6 +3,7 @@ package bybit
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -23,6 +24,14 @@ type WebSocketHandler struct {
 	ws config.WebSocketConfig
 	// cache maintains the latest set of tickers seen by the handler.
 	cache types.ProviderTickers
+
+	// nextReqID is the request ID of the last subscribe message sent by the handler.
+	nextReqID uint64
+	// pending maintains the topics of each subscribe message whose subscription has not been
+	// confirmed yet, indexed by request ID.
+	pending map[string][]string
+	// subscribed maintains the set of topics whose subscription has been confirmed.
+	subscribed map[string]struct{}
 }
 
 // NewWebSocketDataHandler returns a new ByBit PriceWebSocketDataHandler.
@@ -43,9 +52,11 @@ func NewWebSocketDataHandler(
 	}
 
 	return &WebSocketHandler{
-		logger: logger,
-		ws:     ws,
-		cache:  types.NewProviderTickers(),
+		logger:     logger,
+		ws:         ws,
+		cache:      types.NewProviderTickers(),
+		pending:    make(map[string][]string),
+		subscribed: make(map[string]struct{}),
 	}, nil
 }
 
@@ -110,20 +121,47 @@ func (h *WebSocketHandler) HandleMessage(
 	}
 }
 
-// CreateMessages is used to create an initial subscription message to send to the data provider.
+// CreateMessages is used to create the initial subscription messages to send to the data provider.
 // Only the tickers that are specified in the config are subscribed to. The only channel that is
 // subscribed to is the index tickers channel - which supports spot markets.
 func (h *WebSocketHandler) CreateMessages(
 	tickers []types.ProviderTicker,
 ) ([]handlers.WebsocketEncodedMessage, error) {
-	pairs := make([]string, 0)
+	topics := make([]string, 0, len(tickers))
 
 	for _, ticker := range tickers {
-		pairs = append(pairs, string(TickerChannel)+"."+ticker.GetOffChainTicker())
+		topics = append(topics, string(TickerChannel)+"."+ticker.GetOffChainTicker())
 		h.cache.Add(ticker)
 	}
 
-	return NewSubscriptionRequestMessage(pairs)
+	return h.subscribe(topics)
+}
+
+// subscribe returns the subscribe messages for the given topics. The topics are split into batches
+// of at most MaxArgsPerRequest topics, each of which is sent in its own subscribe message under a
+// unique request ID. The topics of each batch are tracked as pending until ByBit confirms the
+// subscription of the batch.
+func (h *WebSocketHandler) subscribe(topics []string) ([]handlers.WebsocketEncodedMessage, error) {
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("tickers cannot be empty")
+	}
+
+	msgs := make([]handlers.WebsocketEncodedMessage, 0, (len(topics)+MaxArgsPerRequest-1)/MaxArgsPerRequest)
+	for start := 0; start < len(topics); start += MaxArgsPerRequest {
+		batch := topics[start:min(start+MaxArgsPerRequest, len(topics))]
+
+		h.nextReqID++
+		reqID := strconv.FormatUint(h.nextReqID, 10)
+		msg, err := NewSubscriptionRequestMessage(reqID, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		h.pending[reqID] = batch
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
 }
 
 // HeartBeatMessages is used to construct heartbeat messages to be sent to the data provider. Note that
@@ -144,8 +182,10 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger: h.logger,
-		ws:     h.ws,
-		cache:  types.NewProviderTickers(),
+		logger:     h.logger,
+		ws:         h.ws,
+		cache:      types.NewProviderTickers(),
+		pending:    make(map[string][]string),
+		subscribed: make(map[string]struct{}),
 	}
 }
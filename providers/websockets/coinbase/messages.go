@@ -230,6 +230,9 @@ type TickerResponseMessage struct {
 
 	// TradeID is the trade ID of the ticker.
 	TradeID int64 `json:"trade_id"`
+
+	// Time is the time of the trade in RFC 3339 format.
+	Time string `json:"time"`
 }
 
 // HeartbeatResponseMessage represents a heartbeat response message.
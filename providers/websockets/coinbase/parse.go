@@ -43,7 +43,7 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	price, err := math.Float64StringToBigFloat(msg.Price)
 	if err != nil {
 		unResolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(err),
 		}
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
@@ -51,8 +51,14 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	// Update the trade ID.
 	h.tradeIDs[ticker] = msg.TradeID
 
-	// Convert the time to a time object and resolve the price into the response.
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// Convert the time to a time object and resolve the price into the response. If the time
+	// cannot be parsed, the age of the price is measured from when it was received.
+	var eventTime time.Time
+	if t, err := time.Parse(time.RFC3339Nano, msg.Time); err == nil {
+		eventTime = t.UTC()
+	}
+
+	resolved[ticker] = types.NewPriceResultWithEventTimestamp(price, time.Now().UTC(), eventTime)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
 
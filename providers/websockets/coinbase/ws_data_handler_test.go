@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	providertypes "github.com/skip-mev/slinky/providers/types"
 
@@ -66,6 +67,33 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "ticker message with a trade time",
+			msg: func() []byte {
+				msg := coinbase.TickerResponseMessage{
+					Type:     string(coinbase.TickerMessage),
+					Ticker:   "BTC-USD",
+					Price:    "10000.00",
+					Sequence: 1,
+					Time:     "2022-10-19T23:28:22.061769Z",
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusd: {
+						Value:          big.NewFloat(10000.00),
+						EventTimestamp: time.Date(2022, 10, 19, 23, 28, 22, 61769000, time.UTC),
+					},
+				},
+			},
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "ticker message with invalid ticker",
 			msg: func() []byte {
@@ -308,6 +336,7 @@ func TestHandleMessage(t *testing.T) {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
 				require.Equal(t, result.ResponseCode, resp.Resolved[cp].ResponseCode)
+				require.Equal(t, result.EventTimestamp, resp.Resolved[cp].EventTimestamp)
 			}
 
 			for cp := range tc.resp.UnResolved {
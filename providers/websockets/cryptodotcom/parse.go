@@ -43,7 +43,7 @@ func (h *WebSocketHandler) parseInstrumentMessage(
 		if price, err := math.Float64StringToBigFloat(instrument.LatestTradePrice); err != nil {
 			wErr := fmt.Errorf("failed to parse price %s:"+" %w", instrument.LatestTradePrice, err)
 			unresolved[ticker] = providertypes.UnresolvedResult{
-				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+				ErrorWithCode: providertypes.NewParsePriceError(wErr),
 			}
 		} else {
 			resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
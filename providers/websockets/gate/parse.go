@@ -55,7 +55,7 @@ func (h *WebSocketHandler) parseTickerStream(
 	if err != nil {
 		wErr := fmt.Errorf("failed to parse price %s: %w", priceStr, err)
 		unresolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(wErr),
 		}
 		return types.NewPriceResponse(resolved, unresolved), unresolved[ticker]
 	}
@@ -44,6 +44,18 @@ const (
 	//
 	// https://docs.kraken.com/websockets/#message-subscribe
 	SubscribeEvent Event = "subscribe"
+
+	// PingEvent is the event name that is used to ping the server to ensure that
+	// the connection is still alive.
+	//
+	// https://docs.kraken.com/websockets/#message-ping
+	PingEvent Event = "ping"
+
+	// PongEvent is the event name that is sent to the client in response to a
+	// ping.
+	//
+	// https://docs.kraken.com/websockets/#message-pong
+	PongEvent Event = "pong"
 )
 
 const (
@@ -123,6 +135,44 @@ type HeartbeatResponseMessage struct {
 	Event string `json:"event"`
 }
 
+// PingRequestMessage is the message that is sent to the server to ensure that
+// the connection is still alive.
+//
+//	{
+//			"event": "ping"
+//	}
+//
+// ref: https://docs.kraken.com/websockets/#message-ping
+type PingRequestMessage struct {
+	// Event is the event name that is sent to the server to ping it.
+	Event string `json:"event"`
+}
+
+// NewPingRequestMessage returns a new PingRequestMessage.
+func NewPingRequestMessage() ([]handlers.WebsocketEncodedMessage, error) {
+	bz, err := json.Marshal(PingRequestMessage{
+		Event: string(PingEvent),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []handlers.WebsocketEncodedMessage{bz}, nil
+}
+
+// PongResponseMessage is the message that is sent to the client in response to
+// a ping.
+//
+//	{
+//			"event": "pong"
+//	}
+//
+// ref: https://docs.kraken.com/websockets/#message-pong
+type PongResponseMessage struct {
+	// Event is the event name that is sent to the client in response to a ping.
+	Event string `json:"event"`
+}
+
 // SubscribeRequestMessage is the message that is sent to the server to subscribe
 // to a channel.
 //
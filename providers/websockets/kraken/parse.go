@@ -15,7 +15,7 @@ import (
 )
 
 // parseBaseMessage will parse message responses from the Kraken websocket API that are
-// not related to price updates. There are three types of messages that are handled by
+// not related to price updates. There are four types of messages that are handled by
 // this function:
 //  1. System status response messages. This is used to check if the Kraken system is online.
 //     Usually this is the first message that is received after connecting to the websocket.
@@ -24,6 +24,8 @@ import (
 //  3. Subscription status response messages. This is used to check if the subscription request
 //     was successful. If the subscription request was not successful, the handler will attempt
 //     to resubscribe to the market.
+//  4. Pong response messages. This is used by the Kraken websocket server to respond to the
+//     pings sent by the handler.
 func (h *WebSocketHandler) parseBaseMessage(message []byte, event Event) ([]handlers.WebsocketEncodedMessage, error) {
 	switch event {
 	case SystemStatusEvent:
@@ -44,6 +46,9 @@ func (h *WebSocketHandler) parseBaseMessage(message []byte, event Event) ([]hand
 	case HeartbeatEvent:
 		h.logger.Debug("received heartbeat response message")
 		return nil, nil
+	case PongEvent:
+		h.logger.Debug("received pong response message")
+		return nil, nil
 	case SubscriptionStatusEvent:
 		h.logger.Debug("received subscription status response message")
 
@@ -111,7 +116,7 @@ func (h *WebSocketHandler) parseTickerMessage(
 	if err != nil {
 		wErr := fmt.Errorf("failed to parse price %s: %w", priceStr, err)
 		unResolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(wErr),
 		}
 		return types.NewPriceResponse(resolved, unResolved), unResolved[ticker]
 	}
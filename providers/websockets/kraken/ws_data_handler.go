@@ -103,9 +103,10 @@ func (h *WebSocketHandler) CreateMessages(
 	return NewSubscribeRequestMessage(instruments)
 }
 
-// HeartBeatMessages is not used for Kraken.
+// HeartBeatMessages is used by the Kraken handler to ping the server. This is used to keep the
+// connection alive when no messages are being sent from the data provider.
 func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessage, error) {
-	return nil, nil
+	return NewPingRequestMessage()
 }
 
 // Copy is used to create a copy of the WebSocketHandler.
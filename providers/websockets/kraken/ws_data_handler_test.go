@@ -134,6 +134,17 @@ func TestHandleMessage(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "pong response message",
+			msg: func() []byte {
+				return []byte(`{"event": "pong"}`)
+			},
+			resp: types.PriceResponse{},
+			updateMsg: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expectedErr: false,
+		},
 		{
 			name: "subscription status response message (subscribed)",
 			msg: func() []byte {
@@ -308,6 +319,19 @@ func TestCreateMessage(t *testing.T) {
 	}
 }
 
+func TestHeartBeatMessages(t *testing.T) {
+	wsHandler, err := kraken.NewWebSocketDataHandler(logger, kraken.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	expected := []handlers.WebsocketEncodedMessage{
+		[]byte(`{"event":"ping"}`),
+	}
+
+	msgs, err := wsHandler.HeartBeatMessages()
+	require.NoError(t, err)
+	require.Equal(t, expected, msgs)
+}
+
 func TestDecodeTickerResponseMessage(t *testing.T) {
 	testCases := []struct {
 		name     string
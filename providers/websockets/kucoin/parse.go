@@ -75,7 +75,7 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	if err != nil {
 		wErr := fmt.Errorf("failed to parse price %w", err)
 		unResolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(wErr),
 		}
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
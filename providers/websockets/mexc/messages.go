@@ -209,4 +209,7 @@ type TickerData struct {
 
 	// Price is the latest price for the currency pair i.e. market.
 	Price string `json:"p"`
+
+	// Volume is the 24h volume for the currency pair i.e. market.
+	Volume string `json:"v"`
 }
@@ -2,6 +2,7 @@ package mexc
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -40,11 +41,19 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 	price, err := math.Float64StringToBigFloat(msg.Data.Price)
 	if err != nil {
 		unResolved[ticker] = providertypes.UnresolvedResult{
-			ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToParsePrice),
+			ErrorWithCode: providertypes.NewParsePriceError(err),
 		}
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// Attach the volume if it was reported.
+	var volume *big.Float
+	if len(msg.Data.Volume) > 0 {
+		if volume, err = math.Float64StringToBigFloat(msg.Data.Volume); err != nil {
+			volume = nil
+		}
+	}
+
+	resolved[ticker] = types.NewPriceResultWithVolume(price, time.Now().UTC(), volume)
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
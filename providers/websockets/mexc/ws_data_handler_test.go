@@ -81,6 +81,25 @@ func TestHandleMessage(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "price update message with volume",
+			msg: func() []byte {
+				msg := `{"c":"spot@public.miniTicker.v3.api@BTCUSDT@UTC+8","d":{"s":"BTCUSDT","p":"10000.00","v":"250.5"}}`
+				return []byte(msg)
+			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(10000.00),
+						Volume: big.NewFloat(250.5),
+					},
+				},
+			},
+			updateMessage: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expErr: false,
+		},
 		{
 			name: "unsupported market price update",
 			msg: func() []byte {
@@ -160,6 +179,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume == nil {
+					require.Nil(t, resp.Resolved[cp].Volume)
+				} else {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -196,4 +196,7 @@ type IndexTicker struct {
 
 	// IndexPrice is the index price.
 	IndexPrice string `json:"idxPx" validate:"required"`
+
+	// Timestamp is the time at which the index price was produced, in unix milliseconds.
+	Timestamp string `json:"ts"`
 }
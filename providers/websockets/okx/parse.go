@@ -3,6 +3,7 @@ package okx
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -95,12 +96,19 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 		if err != nil {
 			wErr := fmt.Errorf("failed to convert price to big.Float: %w", err)
 			unresolved[ticker] = providertypes.UnresolvedResult{
-				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+				ErrorWithCode: providertypes.NewParsePriceError(wErr),
 			}
 			continue
 		}
 
-		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+		// If the timestamp cannot be parsed, the age of the price is measured from when it was
+		// received.
+		var eventTime time.Time
+		if ms, err := strconv.ParseInt(instrument.Timestamp, 10, 64); err == nil {
+			eventTime = time.UnixMilli(ms).UTC()
+		}
+
+		resolved[ticker] = types.NewPriceResultWithEventTimestamp(price, time.Now().UTC(), eventTime)
 	}
 
 	return types.NewPriceResponse(resolved, unresolved), nil
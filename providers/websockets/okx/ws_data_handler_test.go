@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -86,6 +87,40 @@ func TestHandleMessage(t *testing.T) {
 			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
 			expErr:        false,
 		},
+		{
+			name: "instrument price update with a timestamp",
+			msg: func() []byte {
+				msg := okx.IndexTickersResponseMessage{
+					Arguments: okx.SubscriptionTopic{
+						Channel:      string(okx.IndexTickersChannel),
+						InstrumentID: "BTC-USDT",
+					},
+					Data: []okx.IndexTicker{
+						{
+							ID:         "BTC-USDT",
+							IndexPrice: "1",
+							Timestamp:  "1597026383085",
+						},
+					},
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return bz
+			},
+			resp: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusdt: {
+						Value:          big.NewFloat(1.0),
+						EventTimestamp: time.UnixMilli(1597026383085).UTC(),
+					},
+				},
+				types.UnResolvedPrices{},
+			),
+			updateMessage: func() []handlers.WebsocketEncodedMessage { return nil },
+			expErr:        false,
+		},
 		{
 			name: "multiple instruments included in the response",
 			msg: func() []byte {
@@ -311,6 +346,7 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				require.Equal(t, result.EventTimestamp, resp.Resolved[cp].EventTimestamp)
 			}
 
 			for cp := range tc.resp.UnResolved {
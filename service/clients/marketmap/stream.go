@@ -0,0 +1,99 @@
+package marketmap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+// ErrStreamingUnsupported is returned when the market map source does not implement the
+// market map Stream service.
+var ErrStreamingUnsupported = errors.New("market map source does not support streaming")
+
+// StreamClient subscribes to market map updates from a market map source that implements
+// the market map Stream service.
+type StreamClient struct {
+	logger *zap.Logger
+	client mmtypes.StreamClient
+}
+
+// NewStreamClient returns a new StreamClient that connects to the gRPC endpoint configured
+// in the given API config.
+func NewStreamClient(logger *zap.Logger, api config.APIConfig) (*StreamClient, error) {
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	conn, err := grpc.NewClient(
+		api.URL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStreamClientWithClient(logger, mmtypes.NewStreamClient(conn))
+}
+
+// NewStreamClientWithClient returns a new StreamClient that uses the given client.
+func NewStreamClientWithClient(logger *zap.Logger, client mmtypes.StreamClient) (*StreamClient, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	return &StreamClient{
+		logger: logger.With(zap.String("client", "market_map_stream")),
+		client: client,
+	}, nil
+}
+
+// StreamMarketMap subscribes to market map updates and invokes onUpdate with every valid market
+// map received. This blocks until the context is cancelled or the stream fails. If the market map
+// source does not support streaming, ErrStreamingUnsupported is returned.
+func (c *StreamClient) StreamMarketMap(ctx context.Context, onUpdate func(mmtypes.MarketMap)) error {
+	stream, err := c.client.MarketMapUpdates(ctx, &mmtypes.MarketMapUpdatesRequest{})
+	if err != nil {
+		return streamError(err)
+	}
+
+	c.logger.Info("subscribed to market map updates")
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return streamError(err)
+		}
+
+		if err := resp.MarketMap.ValidateBasic(); err != nil {
+			c.logger.Error("received invalid market map from stream", zap.Error(err))
+			continue
+		}
+
+		onUpdate(resp.MarketMap)
+	}
+}
+
+// streamError maps an Unimplemented status to ErrStreamingUnsupported.
+func streamError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return ErrStreamingUnsupported
+	}
+
+	return fmt.Errorf("market map stream failed: %w", err)
+}
@@ -0,0 +1,104 @@
+package marketmap_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	"github.com/skip-mev/slinky/providers/apis/marketmap"
+	mmclient "github.com/skip-mev/slinky/service/clients/marketmap"
+	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+type streamServer struct {
+	mmtypes.UnimplementedStreamServer
+
+	maps []mmtypes.MarketMap
+}
+
+func (s *streamServer) MarketMapUpdates(_ *mmtypes.MarketMapUpdatesRequest, stream mmtypes.Stream_MarketMapUpdatesServer) error {
+	for _, mm := range s.maps {
+		if err := stream.Send(&mmtypes.MarketMapResponse{MarketMap: mm}); err != nil {
+			return err
+		}
+	}
+
+	<-stream.Context().Done()
+	return nil
+}
+
+func startServer(t *testing.T, srv mmtypes.StreamServer) config.APIConfig {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	grpcSrv := grpc.NewServer()
+	if srv != nil {
+		mmtypes.RegisterStreamServer(grpcSrv, srv)
+	}
+	go grpcSrv.Serve(lis)
+	t.Cleanup(grpcSrv.Stop)
+
+	return config.APIConfig{
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Name:             marketmap.Name,
+		URL:              lis.Addr().String(),
+	}
+}
+
+func TestStreamMarketMap(t *testing.T) {
+	t.Run("streams valid market maps", func(t *testing.T) {
+		invalid := mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				"invalid": {},
+			},
+		}
+		valid := mmtypes.MarketMap{}
+
+		api := startServer(t, &streamServer{maps: []mmtypes.MarketMap{invalid, valid}})
+		client, err := mmclient.NewStreamClient(zap.NewNop(), api)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		updates := make(chan mmtypes.MarketMap, 2)
+		done := make(chan error, 1)
+		go func() {
+			done <- client.StreamMarketMap(ctx, func(mm mmtypes.MarketMap) {
+				updates <- mm
+			})
+		}()
+
+		select {
+		case mm := <-updates:
+			require.True(t, valid.Equal(mm))
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for market map")
+		}
+
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+		require.Empty(t, updates)
+	})
+
+	t.Run("returns an error if streaming is not supported", func(t *testing.T) {
+		api := startServer(t, nil)
+		client, err := mmclient.NewStreamClient(zap.NewNop(), api)
+		require.NoError(t, err)
+
+		err = client.StreamMarketMap(context.Background(), func(mmtypes.MarketMap) {})
+		require.ErrorIs(t, err, mmclient.ErrStreamingUnsupported)
+	})
+}
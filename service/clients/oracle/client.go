@@ -2,14 +2,22 @@ package oracle
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"cosmossdk.io/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/service/metrics"
@@ -18,6 +26,22 @@ import (
 
 var _ OracleClient = (*GRPCClient)(nil)
 
+const (
+	// minReconnectInterval is the delay before the client retries to connect to the server after a
+	// failed connection attempt. The delay doubles on every consecutive failure.
+	minReconnectInterval = 100 * time.Millisecond
+	// maxReconnectInterval is the maximum delay between attempts to connect to the server.
+	maxReconnectInterval = 5 * time.Second
+)
+
+var (
+	// ErrClientNotStarted is returned by the client when it is called before it was started.
+	ErrClientNotStarted = errors.New("oracle client not started")
+	// ErrClientNotConnected is returned by the client when it is called while the connection to the
+	// remote oracle service is not established, e.g. because the oracle service has not started yet.
+	ErrClientNotConnected = errors.New("oracle client not yet connected")
+)
+
 // GRPCClient defines an implementation of a gRPC oracle client. This client can
 // be used in ABCI++ calls where the application wants the oracle process to be
 // run out-of-process. The client must be started upon app construction and
@@ -38,6 +62,23 @@ type GRPCClient struct {
 	metrics metrics.Metrics
 	// blockingDial is a parameter which determines whether the client should block on dialing the server
 	blockingDial bool
+	// dialTimeout bounds each attempt to connect to the server, as well as a blocking dial. A value of 0
+	// uses the gRPC default.
+	dialTimeout time.Duration
+	// maxRetries is the number of consecutive failed connection attempts after which the client stops
+	// reconnecting to the server. A value of 0 retries indefinitely.
+	maxRetries int
+	// stopReconnect stops the background reconnection loop of the client.
+	stopReconnect context.CancelFunc
+	// compression determines whether requests and responses are compressed with gzip
+	compression bool
+	// tlsConfig is the TLS configuration used to connect to the server. If nil, the client connects
+	// without TLS.
+	tlsConfig *tls.Config
+	// maxPriceAge is the maximum age of the prices returned by Prices. A value of 0 disables the check.
+	maxPriceAge time.Duration
+	// stalePricePolicy determines how prices older than maxPriceAge are handled.
+	stalePricePolicy StalePricePolicy
 }
 
 // NewClientFromConfig creates a new grpc client of the oracle service with the given
@@ -64,6 +105,10 @@ func NewClientFromConfig(
 		return nil, fmt.Errorf("metrics cannot be nil")
 	}
 
+	if cfg.EnableCompression {
+		opts = append(opts, WithCompression())
+	}
+
 	return NewClient(logger, cfg.OracleAddress, cfg.ClientTimeout, metrics, opts...)
 }
 
@@ -103,50 +148,62 @@ func NewClient(
 	return client, nil
 }
 
-// Start starts the GRPC client. This method dials the remote oracle-service
-// and errors if the connection fails. This method may block (depending on the blockingDial option).
+// Start starts the GRPC client. This method creates the connection to the remote oracle-service without
+// waiting for it to be established, such that the client can be started before the oracle-service. The
+// connection is established, and re-established whenever it is lost, in the background. Calls made while
+// the connection is not established return ErrClientNotConnected. If the blockingDial option is set, this
+// method instead waits for the connection to be established, bounded by ctx and the dial timeout.
 func (c *GRPCClient) Start(ctx context.Context) error {
 	c.logger.Info("starting oracle client", "addr", c.addr)
 
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	creds := insecure.NewCredentials()
+	if c.tlsConfig != nil {
+		creds = credentials.NewTLS(c.tlsConfig)
 	}
 
-	// dial the client, but defer to context closure, if necessary
-	var (
-		conn *grpc.ClientConn
-		err  error
-		done = make(chan struct{})
-	)
-	go func() {
-		defer close(done)
-		conn, err = grpc.NewClient(c.addr, opts...)
-
-		// attempt to connect + wait for change in connection state
-		if c.blockingDial {
-			// connect
-			conn.Connect()
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		// the connection is kept open while unused, such that it is only idle before it is first established
+		grpc.WithIdleTimeout(0),
+	}
 
-			if err == nil {
-				conn.WaitForStateChange(ctx, connectivity.Ready)
-			}
-		}
-	}()
+	if c.compression {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	}
 
-	// wait for either the context to close or the dial to complete
-	select {
-	case <-ctx.Done():
-		err = fmt.Errorf("context closed before oracle client could start: %w", ctx.Err())
-	case <-done:
+	if c.dialTimeout > 0 {
+		opts = append(opts, grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           backoff.DefaultConfig,
+			MinConnectTimeout: c.dialTimeout,
+		}))
 	}
+
+	conn, err := grpc.NewClient(c.addr, opts...)
 	if err != nil {
 		c.logger.Error("failed to dial oracle gRPC server", "err", err)
 		return fmt.Errorf("failed to dial oracle gRPC server: %w", err)
 	}
 
+	// start connecting in the background
+	conn.Connect()
+
+	if c.blockingDial {
+		if err := c.waitForReady(ctx, conn); err != nil {
+			conn.Close()
+
+			c.logger.Error("failed to dial oracle gRPC server", "err", err)
+			return fmt.Errorf("failed to dial oracle gRPC server: %w", err)
+		}
+	}
+
+	// the reconnection loop outlives ctx, and is stopped with the client
+	reconnectCtx, stopReconnect := context.WithCancel(context.Background())
+	go c.reconnect(reconnectCtx, conn)
+
 	c.mutex.Lock()
 	c.client = types.NewOracleClient(conn)
 	c.conn = conn
+	c.stopReconnect = stopReconnect
 	c.mutex.Unlock()
 
 	c.logger.Info("oracle client started")
@@ -154,6 +211,84 @@ func (c *GRPCClient) Start(ctx context.Context) error {
 	return nil
 }
 
+// waitForReady blocks until the given connection is established, ctx is cancelled, or the dial timeout
+// of the client elapses.
+func (c *GRPCClient) waitForReady(ctx context.Context, conn *grpc.ClientConn) error {
+	if c.dialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.dialTimeout)
+		defer cancel()
+	}
+
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("oracle client failed to connect: %w", ctx.Err())
+		}
+	}
+}
+
+// reconnect watches the state of the given connection until ctx is cancelled, and reconnects to the
+// remote oracle-service with an exponential backoff whenever the connection fails. If the client is
+// configured with a max number of retries, the connection is closed once that many consecutive
+// connection attempts have failed.
+func (c *GRPCClient) reconnect(ctx context.Context, conn *grpc.ClientConn) {
+	var (
+		failures      = 0
+		retryInterval = minReconnectInterval
+	)
+	for {
+		state := conn.GetState()
+		switch state {
+		case connectivity.Ready:
+			if failures > 0 {
+				c.logger.Info("oracle client connected", "addr", c.addr, "failed_attempts", failures)
+			}
+			failures = 0
+			retryInterval = minReconnectInterval
+		case connectivity.Idle:
+			// idle connections are only established on demand, so connect eagerly
+			conn.Connect()
+		case connectivity.TransientFailure:
+			failures++
+			if c.maxRetries > 0 && failures > c.maxRetries {
+				c.logger.Error("oracle client exceeded max connection retries; giving up", "addr", c.addr, "max_retries", c.maxRetries)
+				conn.Close()
+				return
+			}
+
+			c.logger.Warn("oracle client failed to connect; retrying", "addr", c.addr, "failed_attempts", failures, "retry_interval", retryInterval)
+
+			// the connection remains in a transient failure until it is established, so retry
+			// immediately once the retry interval elapses without a change in state
+			waitCtx, cancel := context.WithTimeout(ctx, retryInterval)
+			changed := conn.WaitForStateChange(waitCtx, state)
+			cancel()
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if !changed {
+				conn.ResetConnectBackoff()
+				retryInterval = min(2*retryInterval, maxReconnectInterval)
+			}
+
+			continue
+		case connectivity.Shutdown:
+			return
+		}
+
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+	}
+}
+
 // Stop stops the GRPC client. This method closes the connection to the remote.
 func (c *GRPCClient) Stop() error {
 	c.mutex.Lock()
@@ -164,19 +299,118 @@ func (c *GRPCClient) Stop() error {
 		return nil
 	}
 
+	c.stopReconnect()
 	err := c.conn.Close()
 	c.logger.Info("oracle client stopped", "err", err)
 
 	return err
 }
 
+// checkConnected returns an error if the client has not been started, or if the connection to the remote
+// oracle service is not established. Calls fail fast in the latter case, rather than blocking until the
+// connection is established.
+func (c *GRPCClient) checkConnected() error {
+	if c.client == nil {
+		return ErrClientNotStarted
+	}
+
+	if state := c.conn.GetState(); state != connectivity.Ready {
+		return fmt.Errorf("%w: connection is %s", ErrClientNotConnected, strings.ToLower(state.String()))
+	}
+
+	return nil
+}
+
+// connectedClient returns the underlying oracle client if the connection to the remote oracle service is
+// established. The client's mutex is only held while the connection is checked, so that the returned client
+// can be called concurrently with other calls.
+func (c *GRPCClient) connectedClient() (types.OracleClient, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.checkConnected(); err != nil {
+		return nil, err
+	}
+
+	return c.client, nil
+}
+
 // Prices returns the prices from the remote oracle service. This method blocks for the timeout duration configured on the client,
-// otherwise it returns the response from the remote oracle.
+// otherwise it returns the response from the remote oracle. If the client is configured with a max price age, prices older than
+// the max age are handled according to the client's stale price policy.
 func (c *GRPCClient) Prices(
 	ctx context.Context,
 	req *types.QueryPricesRequest,
 	_ ...grpc.CallOption,
+) (*types.QueryPricesResponse, error) {
+	return c.prices(ctx, req, c.timeout)
+}
+
+// PricesWithTimeout returns the prices from the remote oracle service. This is identical to Prices, except that the call
+// blocks for the given timeout rather than the timeout configured on the client.
+func (c *GRPCClient) PricesWithTimeout(
+	ctx context.Context,
+	req *types.QueryPricesRequest,
+	timeout time.Duration,
+) (*types.QueryPricesResponse, error) {
+	if timeout <= 0 {
+		return nil, fmt.Errorf("timeout must be positive; got %s", timeout)
+	}
+
+	return c.prices(ctx, req, timeout)
+}
+
+// prices returns the prices from the remote oracle service, blocking for at most the given timeout. The
+// request is not serialized with other calls, so that a short timeout is not delayed by a long call in flight.
+func (c *GRPCClient) prices(
+	ctx context.Context,
+	req *types.QueryPricesRequest,
+	timeout time.Duration,
 ) (resp *types.QueryPricesResponse, err error) {
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		latency := time.Since(start)
+		c.metrics.ObserveOracleResponseLatency(latency)
+		c.metrics.ObserveOraclePricesLatency(latency)
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+
+		if isDeadlineExceeded(err) {
+			c.metrics.AddOraclePricesDeadlineExceeded()
+		}
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := c.connectedClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = client.Prices(ctx, req, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.enforceMaxPriceAge(resp)
+}
+
+// isDeadlineExceeded returns true if the given error indicates that a request exceeded its deadline
+// before the remote oracle service responded.
+func isDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded
+}
+
+// PriceEnvelopes returns the prices from the remote oracle service, each wrapped in a PriceEnvelope packed
+// into an Any. This method blocks for the timeout duration configured on the client, otherwise it returns
+// the response from the remote oracle.
+func (c *GRPCClient) PriceEnvelopes(
+	ctx context.Context,
+	req *types.QueryPriceEnvelopesRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryPriceEnvelopesResponse, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
@@ -191,9 +425,152 @@ func (c *GRPCClient) Prices(
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	if c.client == nil {
-		return nil, fmt.Errorf("oracle client not started")
+	if err := c.checkConnected(); err != nil {
+		return nil, err
+	}
+
+	return c.client.PriceEnvelopes(ctx, req, grpc.WaitForReady(true))
+}
+
+// ProviderHealth returns the health of each provider of the remote oracle service. This method blocks for
+// the timeout duration configured on the client, otherwise it returns the response from the remote oracle.
+func (c *GRPCClient) ProviderHealth(
+	ctx context.Context,
+	req *types.QueryProviderHealthRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryProviderHealthResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.checkConnected(); err != nil {
+		return nil, err
+	}
+
+	return c.client.ProviderHealth(ctx, req, grpc.WaitForReady(true))
+}
+
+// Config returns the effective, redacted config of the remote oracle service. This method blocks for
+// the timeout duration configured on the client, otherwise it returns the response from the remote oracle.
+func (c *GRPCClient) Config(
+	ctx context.Context,
+	req *types.QueryConfigRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryConfigResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.checkConnected(); err != nil {
+		return nil, err
+	}
+
+	return c.client.Config(ctx, req, grpc.WaitForReady(true))
+}
+
+// RefreshPrices immediately refreshes the prices of the given pairs on the remote oracle service and
+// returns the refreshed prices. This method blocks for the timeout duration configured on the client,
+// otherwise it returns the response from the remote oracle.
+func (c *GRPCClient) RefreshPrices(
+	ctx context.Context,
+	req *types.RefreshPricesRequest,
+	_ ...grpc.CallOption,
+) (resp *types.RefreshPricesResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.checkConnected(); err != nil {
+		return nil, err
+	}
+
+	return c.client.RefreshPrices(ctx, req, grpc.WaitForReady(true))
+}
+
+// SetProviderKilled kills or revives a provider on the remote oracle service and returns the providers
+// that are currently killed. This method blocks for the timeout duration configured on the client,
+// otherwise it returns the response from the remote oracle.
+func (c *GRPCClient) SetProviderKilled(
+	ctx context.Context,
+	req *types.SetProviderKilledRequest,
+	_ ...grpc.CallOption,
+) (resp *types.SetProviderKilledResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.checkConnected(); err != nil {
+		return nil, err
+	}
+
+	return c.client.SetProviderKilled(ctx, req, grpc.WaitForReady(true))
+}
+
+// AggregationAudit returns the audit of the latest aggregation of the requested pairs on the remote oracle
+// service. This method blocks for the timeout duration configured on the client, otherwise it returns the
+// response from the remote oracle.
+func (c *GRPCClient) AggregationAudit(
+	ctx context.Context,
+	req *types.QueryAggregationAuditRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryAggregationAuditResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if err := c.checkConnected(); err != nil {
+		return nil, err
 	}
 
-	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
+	return c.client.AggregationAudit(ctx, req, grpc.WaitForReady(true))
 }
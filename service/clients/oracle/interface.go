@@ -46,3 +46,66 @@ func (NoOpClient) Prices(
 ) (*types.QueryPricesResponse, error) {
 	return nil, nil
 }
+
+// PriceEnvelopes is a no-op.
+func (NoOpClient) PriceEnvelopes(
+	_ context.Context,
+	_ *types.QueryPriceEnvelopesRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceEnvelopesResponse, error) {
+	return nil, nil
+}
+
+// ProviderHealth is a no-op.
+func (NoOpClient) ProviderHealth(
+	_ context.Context,
+	_ *types.QueryProviderHealthRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryProviderHealthResponse, error) {
+	return nil, nil
+}
+
+// Config is a no-op.
+func (NoOpClient) Config(
+	_ context.Context,
+	_ *types.QueryConfigRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryConfigResponse, error) {
+	return nil, nil
+}
+
+// RefreshPrices is a no-op.
+func (NoOpClient) RefreshPrices(
+	_ context.Context,
+	_ *types.RefreshPricesRequest,
+	_ ...grpc.CallOption,
+) (*types.RefreshPricesResponse, error) {
+	return nil, nil
+}
+
+// SetProviderKilled is a no-op.
+func (NoOpClient) SetProviderKilled(
+	_ context.Context,
+	_ *types.SetProviderKilledRequest,
+	_ ...grpc.CallOption,
+) (*types.SetProviderKilledResponse, error) {
+	return nil, nil
+}
+
+// AggregationAudit is a no-op.
+func (NoOpClient) AggregationAudit(
+	_ context.Context,
+	_ *types.QueryAggregationAuditRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryAggregationAuditResponse, error) {
+	return nil, nil
+}
+
+// StreamPrices is a no-op.
+func (NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, nil
+}
@@ -17,6 +17,117 @@ type OracleClient struct {
 	mock.Mock
 }
 
+// AggregationAudit provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) AggregationAudit(ctx context.Context, in *types.QueryAggregationAuditRequest, opts ...grpc.CallOption) (*types.QueryAggregationAuditResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregationAudit")
+	}
+
+	var r0 *types.QueryAggregationAuditResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAggregationAuditRequest, ...grpc.CallOption) (*types.QueryAggregationAuditResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAggregationAuditRequest, ...grpc.CallOption) *types.QueryAggregationAuditResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAggregationAuditResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAggregationAuditRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Config provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) Config(ctx context.Context, in *types.QueryConfigRequest, opts ...grpc.CallOption) (*types.QueryConfigResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *types.QueryConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfigRequest, ...grpc.CallOption) (*types.QueryConfigResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfigRequest, ...grpc.CallOption) *types.QueryConfigResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceEnvelopes provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) PriceEnvelopes(ctx context.Context, in *types.QueryPriceEnvelopesRequest, opts ...grpc.CallOption) (*types.QueryPriceEnvelopesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceEnvelopes")
+	}
+
+	var r0 *types.QueryPriceEnvelopesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceEnvelopesRequest, ...grpc.CallOption) (*types.QueryPriceEnvelopesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceEnvelopesRequest, ...grpc.CallOption) *types.QueryPriceEnvelopesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceEnvelopesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceEnvelopesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Prices provides a mock function with given fields: ctx, in, opts
 func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest, opts ...grpc.CallOption) (*types.QueryPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -54,6 +165,117 @@ func (_m *OracleClient) Prices(ctx context.Context, in *types.QueryPricesRequest
 	return r0, r1
 }
 
+// ProviderHealth provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) ProviderHealth(ctx context.Context, in *types.QueryProviderHealthRequest, opts ...grpc.CallOption) (*types.QueryProviderHealthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderHealth")
+	}
+
+	var r0 *types.QueryProviderHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) (*types.QueryProviderHealthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) *types.QueryProviderHealthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderHealthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderHealthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) RefreshPrices(ctx context.Context, in *types.RefreshPricesRequest, opts ...grpc.CallOption) (*types.RefreshPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPrices")
+	}
+
+	var r0 *types.RefreshPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RefreshPricesRequest, ...grpc.CallOption) (*types.RefreshPricesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RefreshPricesRequest, ...grpc.CallOption) *types.RefreshPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RefreshPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.RefreshPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetProviderKilled provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) SetProviderKilled(ctx context.Context, in *types.SetProviderKilledRequest, opts ...grpc.CallOption) (*types.SetProviderKilledResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProviderKilled")
+	}
+
+	var r0 *types.SetProviderKilledResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetProviderKilledRequest, ...grpc.CallOption) (*types.SetProviderKilledResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetProviderKilledRequest, ...grpc.CallOption) *types.SetProviderKilledResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SetProviderKilledResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SetProviderKilledRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleClient) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -90,6 +312,43 @@ func (_m *OracleClient) Stop() error {
 	return r0
 }
 
+// StreamPrices provides a mock function with given fields: ctx, in, opts
+func (_m *OracleClient) StreamPrices(ctx context.Context, in *types.StreamPricesRequest, opts ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 types.Oracle_StreamPricesClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) (types.Oracle_StreamPricesClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) types.Oracle_StreamPricesClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Oracle_StreamPricesClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.StreamPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewOracleClient creates a new instance of OracleClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleClient(t interface {
@@ -1,5 +1,12 @@
 package oracle
 
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/skip-mev/slinky/service/metrics"
+)
+
 // Option enables consumers to configure the behavior of an OracleClient on initialization.
 type Option func(OracleClient)
 
@@ -16,3 +23,118 @@ func WithBlockingDial() Option {
 		client.blockingDial = true
 	}
 }
+
+// WithDialTimeout configures the OracleClient to bound each attempt to connect to the remote oracle server
+// by the given timeout. If the client is also configured with WithBlockingDial, Start fails if the
+// connection is not established within the timeout.
+func WithDialTimeout(timeout time.Duration) Option {
+	if timeout <= 0 {
+		panic("dial timeout must be positive")
+	}
+
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.dialTimeout = timeout
+	}
+}
+
+// WithMaxRetries configures the OracleClient to stop reconnecting to the remote oracle server after the
+// given number of consecutive failed connection attempts. Once the client gives up, every call returns
+// ErrClientNotConnected until the client is restarted. By default, the client retries indefinitely.
+func WithMaxRetries(maxRetries int) Option {
+	if maxRetries <= 0 {
+		panic("max retries must be positive")
+	}
+
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.maxRetries = maxRetries
+	}
+}
+
+// WithTLS configures the OracleClient to connect to the remote oracle server over TLS using the given
+// configuration. The certificate of the server is verified against the root CAs of the configuration, or
+// the system roots if unset, and the connection fails if the certificate does not match the server. By
+// default, the client connects without TLS, which is only recommended if the oracle server runs on the
+// same host.
+func WithTLS(config *tls.Config) Option {
+	if config == nil {
+		panic("tls config cannot be nil")
+	}
+
+	if config.InsecureSkipVerify {
+		panic("tls config must verify the server certificate")
+	}
+
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.tlsConfig = config
+	}
+}
+
+// WithMetrics configures the OracleClient to record the latency and outcome of its requests with the given
+// metrics, e.g. the latency of Prices requests and how often they exceed their deadline, overriding the
+// metrics the client was constructed with.
+func WithMetrics(m metrics.Metrics) Option {
+	if m == nil {
+		panic("metrics cannot be nil")
+	}
+
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.metrics = m
+	}
+}
+
+// WithCompression configures the OracleClient to compress its requests with gzip. The oracle server
+// compresses its responses to clients that compress their requests, which reduces bandwidth when the
+// oracle server runs on a remote machine.
+func WithCompression() Option {
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.compression = true
+	}
+}
+
+// WithMaxPriceAge configures the OracleClient to enforce a maximum age on the prices returned by Prices,
+// independently of the oracle server's configuration. Prices older than maxAge are either rejected with
+// an error wrapping ErrStalePrices or filtered out of the response, depending on the given policy.
+func WithMaxPriceAge(maxAge time.Duration, policy StalePricePolicy) Option {
+	if maxAge <= 0 {
+		panic("max price age must be positive")
+	}
+
+	if policy != RejectStalePrices && policy != FilterStalePrices {
+		panic("unknown stale price policy")
+	}
+
+	return func(c OracleClient) {
+		client, ok := c.(*GRPCClient)
+		if !ok {
+			return
+		}
+
+		client.maxPriceAge = maxAge
+		client.stalePricePolicy = policy
+	}
+}
@@ -0,0 +1,75 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+// ErrStalePrices is returned by the client when the prices returned by the oracle are older than
+// the client's configured max price age, and the client is configured to reject stale prices.
+var ErrStalePrices = errors.New("oracle prices are stale")
+
+// StalePricePolicy determines how the client handles prices that are older than its configured
+// max price age.
+type StalePricePolicy int
+
+const (
+	// RejectStalePrices returns an error wrapping ErrStalePrices instead of the response.
+	RejectStalePrices StalePricePolicy = iota
+	// FilterStalePrices removes the stale prices from the response and reports their currency
+	// pairs as failing.
+	FilterStalePrices
+)
+
+// String returns the string representation of the policy.
+func (p StalePricePolicy) String() string {
+	switch p {
+	case RejectStalePrices:
+		return "reject"
+	case FilterStalePrices:
+		return "filter"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(p))
+	}
+}
+
+// enforceMaxPriceAge applies the client's stale price policy to the given response. All prices in
+// a response share the time at which the oracle last updated its prices, so either all or none of
+// the prices are stale.
+func (c *GRPCClient) enforceMaxPriceAge(resp *types.QueryPricesResponse) (*types.QueryPricesResponse, error) {
+	if c.maxPriceAge == 0 || resp == nil || len(resp.Prices) == 0 {
+		return resp, nil
+	}
+
+	age := time.Since(resp.Timestamp)
+	if age <= c.maxPriceAge {
+		return resp, nil
+	}
+
+	c.logger.Warn(
+		"oracle prices are stale",
+		"age", age,
+		"max_price_age", c.maxPriceAge,
+		"policy", c.stalePricePolicy.String(),
+	)
+
+	if c.stalePricePolicy == RejectStalePrices {
+		return nil, fmt.Errorf("%w: prices are %s old; max price age is %s", ErrStalePrices, age, c.maxPriceAge)
+	}
+
+	failing := append([]string(nil), resp.Failing...)
+	for ticker := range resp.Prices {
+		failing = append(failing, ticker)
+	}
+	sort.Strings(failing)
+
+	resp.Prices = make(map[string]string)
+	resp.Twaps = make(map[string]string)
+	resp.Failing = failing
+
+	return resp, nil
+}
@@ -0,0 +1,144 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+const (
+	// minStreamRetryInterval is the delay before the first attempt to reopen a price stream that
+	// failed. The delay doubles on every consecutive failure.
+	minStreamRetryInterval = 100 * time.Millisecond
+	// maxStreamRetryInterval is the maximum delay between attempts to reopen a price stream.
+	maxStreamRetryInterval = 5 * time.Second
+)
+
+// StreamPrices opens a stream of the prices of the remote oracle service, which sends the prices of
+// each aggregation cycle of the oracle. Unlike the unary methods, the stream is not bound by the
+// timeout configured on the client, and lasts until ctx is cancelled. Use SubscribePrices for a
+// stream that is reopened on transient failures.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	c.mutex.Lock()
+	client := c.client
+	c.mutex.Unlock()
+
+	if client == nil {
+		return nil, ErrClientNotStarted
+	}
+
+	return client.StreamPrices(ctx, req, grpc.WaitForReady(true))
+}
+
+// SubscribePrices returns a channel that receives the prices of each aggregation cycle of the remote
+// oracle service, as an alternative to polling Prices. The stream is reopened with an exponential
+// backoff whenever it fails transiently, e.g. if the oracle restarts. The channel is closed once ctx is
+// cancelled, or if the stream fails permanently, e.g. because the oracle does not support streaming.
+// If the client is configured with a max price age, each response is subject to the client's stale
+// price policy, and responses that are rejected are not sent. The channel is unbuffered, so the prices
+// of cycles that are not consumed in time are delayed rather than dropped.
+func (c *GRPCClient) SubscribePrices(ctx context.Context) (<-chan *types.QueryPricesResponse, error) {
+	c.mutex.Lock()
+	started := c.client != nil
+	c.mutex.Unlock()
+
+	if !started {
+		return nil, ErrClientNotStarted
+	}
+
+	ch := make(chan *types.QueryPricesResponse)
+	go func() {
+		defer close(ch)
+
+		retryInterval := minStreamRetryInterval
+		for {
+			received, err := c.receivePrices(ctx, ch)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if !isTransientStreamErr(err) {
+				c.logger.Error("oracle price stream failed", "err", err)
+				return
+			}
+
+			// the backoff is reset once the stream delivered prices again
+			if received {
+				retryInterval = minStreamRetryInterval
+			}
+
+			c.logger.Warn("oracle price stream interrupted; reconnecting", "err", err, "retry_interval", retryInterval)
+
+			timer := time.NewTimer(retryInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			retryInterval = min(2*retryInterval, maxStreamRetryInterval)
+		}
+	}()
+
+	return ch, nil
+}
+
+// receivePrices opens a price stream and forwards its responses to the given channel until the stream
+// fails or ctx is cancelled. It returns whether any response was received, along with the error that
+// ended the stream.
+func (c *GRPCClient) receivePrices(ctx context.Context, ch chan<- *types.QueryPricesResponse) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.StreamPrices(ctx, &types.StreamPricesRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		resp, err = c.enforceMaxPriceAge(resp)
+		if err != nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return received, ctx.Err()
+		case ch <- resp:
+		}
+	}
+}
+
+// isTransientStreamErr returns true if a price stream that ended with the given error should be
+// reopened. Streams that the oracle closed, e.g. on shutdown, are reopened as well.
+func isTransientStreamErr(err error) bool {
+	if errors.Is(err, io.EOF) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -18,6 +18,13 @@ type Metrics interface {
 	// AddOracleResponse increments the number of oracle responses, this can represent a liveness counter. This metric is paginated by status.
 	AddOracleResponse(status Labeller)
 
+	// ObserveOraclePricesLatency records the time it took for the oracle to respond to a Prices request, including requests that
+	// exceeded their deadline (this is a histogram)
+	ObserveOraclePricesLatency(duration time.Duration)
+
+	// AddOraclePricesDeadlineExceeded increments the number of Prices requests that exceeded their deadline before the oracle responded.
+	AddOraclePricesDeadlineExceeded()
+
 	// ObserveABCIMethodLatency reports the given latency (as a duration), for the given ABCIMethod, and updates the ABCIMethodLatency histogram w/ that value.
 	ObserveABCIMethodLatency(method ABCIMethod, duration time.Duration)
 
@@ -48,6 +55,8 @@ func NewNopMetrics() Metrics {
 
 func (m *nopMetricsImpl) ObserveOracleResponseLatency(_ time.Duration)                {}
 func (m *nopMetricsImpl) AddOracleResponse(_ Labeller)                                {}
+func (m *nopMetricsImpl) ObserveOraclePricesLatency(_ time.Duration)                  {}
+func (m *nopMetricsImpl) AddOraclePricesDeadlineExceeded()                            {}
 func (m *nopMetricsImpl) ObserveABCIMethodLatency(_ ABCIMethod, _ time.Duration)      {}
 func (m *nopMetricsImpl) AddABCIRequest(_ ABCIMethod, _ Labeller)                     {}
 func (m *nopMetricsImpl) ObserveMessageSize(_ MessageType, _ int)                     {}
@@ -71,6 +80,17 @@ func NewMetrics(chainID string) Metrics {
 			Name:      "oracle_responses",
 			Help:      "The number of oracle responses",
 		}, []string{StatusLabel, ChainIDLabel}),
+		oraclePricesLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: AppNamespace,
+			Name:      "oracle_prices_latency",
+			Help:      "The time it took for the oracle to respond to a prices request (in seconds)",
+			Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{ChainIDLabel}),
+		oraclePricesDeadlineExceeded: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: AppNamespace,
+			Name:      "oracle_prices_deadline_exceeded",
+			Help:      "The number of prices requests that exceeded their deadline before the oracle responded",
+		}, []string{ChainIDLabel}),
 		abciMethodLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: AppNamespace,
 			Name:      "abci_method_latency",
@@ -108,6 +128,8 @@ func NewMetrics(chainID string) Metrics {
 	// register the above metrics
 	prometheus.MustRegister(m.oracleResponseLatency)
 	prometheus.MustRegister(m.oracleResponseCounter)
+	prometheus.MustRegister(m.oraclePricesLatency)
+	prometheus.MustRegister(m.oraclePricesDeadlineExceeded)
 	prometheus.MustRegister(m.abciMethodLatency)
 	prometheus.MustRegister(m.abciRequests)
 	prometheus.MustRegister(m.messageSize)
@@ -121,15 +143,17 @@ func NewMetrics(chainID string) Metrics {
 }
 
 type metricsImpl struct {
-	oracleResponseLatency    *prometheus.HistogramVec
-	oracleResponseCounter    *prometheus.GaugeVec
-	reportsPerValidator      *prometheus.GaugeVec
-	reportStatusPerValidator *prometheus.GaugeVec
-	abciMethodLatency        *prometheus.HistogramVec
-	abciRequests             *prometheus.GaugeVec
-	messageSize              *prometheus.HistogramVec
-	prices                   *prometheus.GaugeVec
-	chainID                  string
+	oracleResponseLatency        *prometheus.HistogramVec
+	oracleResponseCounter        *prometheus.GaugeVec
+	oraclePricesLatency          *prometheus.HistogramVec
+	oraclePricesDeadlineExceeded *prometheus.CounterVec
+	reportsPerValidator          *prometheus.GaugeVec
+	reportStatusPerValidator     *prometheus.GaugeVec
+	abciMethodLatency            *prometheus.HistogramVec
+	abciRequests                 *prometheus.GaugeVec
+	messageSize                  *prometheus.HistogramVec
+	prices                       *prometheus.GaugeVec
+	chainID                      string
 }
 
 func (m *metricsImpl) ObserveABCIMethodLatency(method ABCIMethod, duration time.Duration) {
@@ -152,6 +176,18 @@ func (m *metricsImpl) AddOracleResponse(status Labeller) {
 	}).Inc()
 }
 
+func (m *metricsImpl) ObserveOraclePricesLatency(duration time.Duration) {
+	m.oraclePricesLatency.With(prometheus.Labels{
+		ChainIDLabel: m.chainID,
+	}).Observe(duration.Seconds())
+}
+
+func (m *metricsImpl) AddOraclePricesDeadlineExceeded() {
+	m.oraclePricesDeadlineExceeded.With(prometheus.Labels{
+		ChainIDLabel: m.chainID,
+	}).Inc()
+}
+
 func (m *metricsImpl) AddABCIRequest(method ABCIMethod, status Labeller) {
 	m.abciRequests.With(prometheus.Labels{
 		ABCIMethodLabel: method.String(),
@@ -22,6 +22,11 @@ func (_m *Metrics) AddABCIRequest(method metrics.ABCIMethod, status metrics.Labe
 	_m.Called(method, status)
 }
 
+// AddOraclePricesDeadlineExceeded provides a mock function with given fields:
+func (_m *Metrics) AddOraclePricesDeadlineExceeded() {
+	_m.Called()
+}
+
 // AddOracleResponse provides a mock function with given fields: status
 func (_m *Metrics) AddOracleResponse(status metrics.Labeller) {
 	_m.Called(status)
@@ -47,6 +52,11 @@ func (_m *Metrics) ObserveMessageSize(msg metrics.MessageType, size int) {
 	_m.Called(msg, size)
 }
 
+// ObserveOraclePricesLatency provides a mock function with given fields: duration
+func (_m *Metrics) ObserveOraclePricesLatency(duration time.Duration) {
+	_m.Called(duration)
+}
+
 // ObserveOracleResponseLatency provides a mock function with given fields: duration
 func (_m *Metrics) ObserveOracleResponseLatency(duration time.Duration) {
 	_m.Called(duration)
@@ -0,0 +1,34 @@
+package oracle
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// bearerPrefix is the prefix of the authorization header of requests that present a bearer token.
+const bearerPrefix = "Bearer "
+
+// hasBearerToken returns true if the request presents the given bearer token in its authorization
+// header. The authorization header of HTTP requests is forwarded to the gRPC metadata by the gateway.
+func hasBearerToken(ctx context.Context, token string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, auth := range md.Get("authorization") {
+		if !strings.HasPrefix(auth, bearerPrefix) {
+			continue
+		}
+
+		// compare in constant time so that the token cannot be guessed from the response time
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, bearerPrefix)), []byte(token)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package oracle
+
+import (
+	"sync"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle"
+	oracletypes "github.com/skip-mev/slinky/oracle/types"
+)
+
+var _ oracle.PriceObserver = (*PriceBroadcaster)(nil)
+
+// PriceBroadcaster notifies the price streams of the oracle server each time the oracle completes an
+// aggregation cycle. It must be registered as a price observer of the oracle, and passed to the oracle
+// server via WithPriceBroadcaster.
+type PriceBroadcaster struct {
+	mtx sync.Mutex
+
+	// subscribers is the set of channels that are signalled on every aggregation cycle.
+	subscribers map[chan struct{}]struct{}
+}
+
+// NewPriceBroadcaster returns a new PriceBroadcaster without any subscribers.
+func NewPriceBroadcaster() *PriceBroadcaster {
+	return &PriceBroadcaster{
+		subscribers: make(map[chan struct{}]struct{}),
+	}
+}
+
+// ObservePrices signals every subscriber that the oracle completed an aggregation cycle. This never
+// blocks: a subscriber that has not yet consumed the previous signal is only signalled once, such that
+// slow subscribers skip intermediate cycles rather than stalling the oracle.
+func (b *PriceBroadcaster) ObservePrices(_ oracletypes.Prices, _ time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// subscribe returns a channel that is signalled on every aggregation cycle, along with a function that
+// must be called to unsubscribe once the channel is no longer consumed.
+func (b *PriceBroadcaster) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	b.mtx.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mtx.Unlock()
+
+	return ch, func() {
+		b.mtx.Lock()
+		delete(b.subscribers, ch)
+		b.mtx.Unlock()
+	}
+}
@@ -0,0 +1,76 @@
+package oracle
+
+import (
+	"sync"
+)
+
+// requestTracker tracks the requests in flight on the server, so that they can be drained before
+// the server is stopped.
+type requestTracker struct {
+	mtx sync.Mutex
+	// inFlight is the number of requests currently being served.
+	inFlight int
+	// draining is closed once the server starts draining.
+	draining chan struct{}
+	// drained is closed once the server is draining and no requests are in flight.
+	drained chan struct{}
+}
+
+// newRequestTracker returns a request tracker with no requests in flight.
+func newRequestTracker() *requestTracker {
+	return &requestTracker{
+		draining: make(chan struct{}),
+		drained:  make(chan struct{}),
+	}
+}
+
+// start registers a new request in flight, and returns false if the server is draining, in which
+// case the request must be rejected.
+func (t *requestTracker) start() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if t.isDraining() {
+		return false
+	}
+
+	t.inFlight++
+	return true
+}
+
+// done unregisters a request that was registered by start once it completes.
+func (t *requestTracker) done() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.inFlight--
+	if t.inFlight == 0 && t.isDraining() {
+		close(t.drained)
+	}
+}
+
+// drain stops the tracker from registering new requests, and returns a channel that is closed once
+// the requests in flight have completed.
+func (t *requestTracker) drain() <-chan struct{} {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if !t.isDraining() {
+		close(t.draining)
+		if t.inFlight == 0 {
+			close(t.drained)
+		}
+	}
+
+	return t.drained
+}
+
+// isDraining returns true if the server is draining. The caller must hold the lock.
+func (t *requestTracker) isDraining() bool {
+	select {
+	case <-t.draining:
+		return true
+	default:
+		return false
+	}
+}
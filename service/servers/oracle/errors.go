@@ -6,4 +6,11 @@ var (
 	ErrNilRequest       = errors.New("request cannot be nil")
 	ErrOracleNotRunning = errors.New("oracle is not running")
 	ErrContextCancelled = errors.New("context cancelled")
+	ErrMaxConnections   = errors.New("max connections reached")
+	ErrConfigNotSet     = errors.New("oracle config is not set")
+	ErrKillSwitchNotSet = errors.New("provider kill switch is not enabled")
+	ErrUnauthenticated  = errors.New("missing or invalid bearer token")
+	ErrAuditorNotSet    = errors.New("aggregation audit is not enabled")
+	ErrStreamingNotSet  = errors.New("price streaming is not enabled")
+	ErrServerDraining   = errors.New("oracle server is draining")
 )
@@ -0,0 +1,72 @@
+package oracle
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// pricePaths are the HTTP gateway paths of the endpoints that serve the oracle's prices. Their
+// responses only change once per aggregation cycle, so they are served with an ETag.
+var pricePaths = map[string]struct{}{
+	"/slinky/oracle/v1/prices":          {},
+	"/slinky/oracle/v1/price_envelopes": {},
+}
+
+// serveGateway serves an HTTP request through the grpc-gateway. GET requests for the oracle's
+// prices are served with an ETag that identifies the oracle's latest aggregation cycle, and
+// requests whose If-None-Match header matches the ETag are answered with a 304 Not Modified
+// without a body.
+func (os *OracleServer) serveGateway(w http.ResponseWriter, r *http.Request) {
+	if _, ok := pricePaths[r.URL.Path]; !ok || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+		os.gatewayMux.ServeHTTP(w, r)
+		return
+	}
+
+	// The ETag is computed before the prices are read. If the prices are updated in between, the
+	// client receives the newer prices with the older ETag, and is served the prices again on its
+	// next request, rather than being told that stale prices are unchanged.
+	etag, ok := os.pricesETag()
+	if !ok {
+		os.gatewayMux.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	os.gatewayMux.ServeHTTP(w, r)
+}
+
+// pricesETag returns the ETag of the oracle's current prices, which is derived from the time of
+// the oracle's latest aggregation cycle. This returns false if the oracle is not running or has
+// not yet aggregated any prices.
+func (os *OracleServer) pricesETag() (string, bool) {
+	if !os.o.IsRunning() {
+		return "", false
+	}
+
+	lastSync := os.o.GetLastSyncTime()
+	if lastSync.IsZero() {
+		return "", false
+	}
+
+	return strconv.Quote(strconv.FormatInt(lastSync.UnixNano(), 36)), true
+}
+
+// etagMatches returns true if the given If-None-Match header value matches the ETag. The header
+// may list several ETags separated by commas, or be "*" to match any ETag. Weak ETags are
+// compared by their value, as required for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,7 +1,14 @@
 package oracle
 
 import (
+	"fmt"
+	"sort"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
 	"github.com/skip-mev/slinky/oracle/types"
+	stypes "github.com/skip-mev/slinky/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +21,139 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+// ToReqTWAPs returns the TWAP of each priced pair that maintains a TWAP.
+func ToReqTWAPs(prices types.Prices, info map[string]types.PriceInfo) map[string]string {
+	reqTWAPs := make(map[string]string)
+
+	for cp := range prices {
+		twap := info[cp].TWAP
+		if twap == nil {
+			continue
+		}
+
+		intTWAP, _ := twap.Int(nil)
+		reqTWAPs[cp] = intTWAP.String()
+	}
+
+	return reqTWAPs
+}
+
+// ToReqSpreads returns the spread of each priced pair whose spread is computed.
+func ToReqSpreads(prices types.Prices, info map[string]types.PriceInfo) map[string]string {
+	reqSpreads := make(map[string]string)
+
+	for cp := range prices {
+		spread := info[cp].Spread
+		if spread == nil {
+			continue
+		}
+
+		intSpread, _ := spread.Int(nil)
+		reqSpreads[cp] = intSpread.String()
+	}
+
+	return reqSpreads
+}
+
+// ToPriceEnvelopes wraps each price in a PriceEnvelope packed into an Any. Envelopes are sorted
+// by currency pair.
+func ToPriceEnvelopes(
+	prices types.Prices,
+	info map[string]types.PriceInfo,
+	timestamp time.Time,
+) ([]*codectypes.Any, error) {
+	cps := make([]string, 0, len(prices))
+	for cp := range prices {
+		cps = append(cps, cp)
+	}
+	sort.Strings(cps)
+
+	envelopes := make([]*codectypes.Any, 0, len(cps))
+	for _, cp := range cps {
+		intPrice, _ := prices[cp].Int(nil)
+		envelope := &stypes.PriceEnvelope{
+			CurrencyPair: cp,
+			Price:        intPrice.String(),
+			Timestamp:    timestamp,
+			Decimals:     info[cp].Decimals,
+			Providers:    info[cp].Providers,
+			Source:       info[cp].Source,
+		}
+
+		packed, err := codectypes.NewAnyWithValue(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack price envelope for %s: %w", cp, err)
+		}
+
+		envelopes = append(envelopes, packed)
+	}
+
+	return envelopes, nil
+}
+
+// ToProviderHealth converts the health of each provider of the oracle to its response type.
+func ToProviderHealth(health []types.ProviderHealth) []stypes.ProviderHealth {
+	resp := make([]stypes.ProviderHealth, 0, len(health))
+	for _, provider := range health {
+		connections := make([]string, 0, len(provider.Connections))
+		for _, state := range provider.Connections {
+			connections = append(connections, string(state))
+		}
+
+		var lastErrorTime *time.Time
+		if !provider.LastErrorTime.IsZero() {
+			t := provider.LastErrorTime
+			lastErrorTime = &t
+		}
+
+		resp = append(resp, stypes.ProviderHealth{
+			Name:          provider.Name,
+			Running:       provider.Running,
+			Connections:   connections,
+			LastError:     provider.LastError,
+			LastErrorTime: lastErrorTime,
+		})
+	}
+
+	return resp
+}
+
+// ToAggregationAudits converts the aggregation audit of each of the given pairs to its response type.
+// If no pairs are given, the audit of every pair is converted. Pairs without an audit are omitted.
+func ToAggregationAudits(audits map[string]types.AggregationAudit, pairs []string) map[string]stypes.AggregationAudit {
+	if len(pairs) == 0 {
+		pairs = make([]string, 0, len(audits))
+		for pair := range audits {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	resp := make(map[string]stypes.AggregationAudit, len(pairs))
+	for _, pair := range pairs {
+		audit, ok := audits[pair]
+		if !ok {
+			continue
+		}
+
+		providers := make([]stypes.ProviderAudit, 0, len(audit.Providers))
+		for _, provider := range audit.Providers {
+			providers = append(providers, stypes.ProviderAudit{
+				Provider:       provider.Provider,
+				OffChainTicker: provider.OffChainTicker,
+				Included:       provider.Included,
+				LastGood:       provider.LastGood,
+				Reason:         string(provider.Reason),
+				Detail:         provider.Detail,
+			})
+		}
+
+		resp[pair] = stypes.AggregationAudit{
+			Timestamp:      audit.Timestamp,
+			Providers:      providers,
+			WithheldReason: audit.WithheldReason,
+		}
+	}
+
+	return resp
+}
@@ -3,6 +3,7 @@ package oracle
 import (
 	"context"
 
+	oracletypes "github.com/skip-mev/slinky/oracle/types"
 	"github.com/skip-mev/slinky/service/servers/oracle/types"
 )
 
@@ -15,3 +16,20 @@ type OracleService interface { //nolint
 	Start(context.Context) error
 	Stop(context.Context) error
 }
+
+// ProviderKillSwitch defines the interface used by the oracle server to kill and revive the
+// oracle's providers at runtime.
+type ProviderKillSwitch interface {
+	// SetProviderKilled kills or revives the provider with the given name.
+	SetProviderKilled(name string, killed bool) error
+
+	// GetKilledProviders returns the names of the providers that are currently killed.
+	GetKilledProviders() []string
+}
+
+// AggregationAuditor defines the interface used by the oracle server to retrieve the audit of the
+// oracle's latest aggregation.
+type AggregationAuditor interface {
+	// GetAggregationAudit returns the audit of the latest aggregation of each pair.
+	GetAggregationAudit() map[string]oracletypes.AggregationAudit
+}
@@ -0,0 +1,134 @@
+package oracle
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// rejectedConnLinger is how long a connection accepted past the connection limit is kept open,
+// so that requests made on it can be replied to with a descriptive status, before it is closed.
+// Clients reconnect once the connection is closed, and are served once a slot frees up.
+const rejectedConnLinger = time.Second
+
+// rejectedConnKey is the context key used to mark connections that were accepted past the
+// server's connection limit.
+type rejectedConnKey struct{}
+
+// connLimit tracks the number of open connections across all of the server's listeners.
+type connLimit struct {
+	// maxConns is the maximum number of open connections. A value of 0 disables the limit.
+	maxConns int64
+	// conns is the number of currently open connections.
+	conns atomic.Int64
+	// onChange is invoked with the number of open connections whenever it changes.
+	onChange func(int)
+}
+
+// newConnLimit returns a connection limit that serves at most maxConns connections.
+func newConnLimit(maxConns int, onChange func(int)) *connLimit {
+	return &connLimit{
+		maxConns: int64(maxConns),
+		onChange: onChange,
+	}
+}
+
+// acquire increments the number of open connections, and returns false without incrementing it
+// if the limit is reached.
+func (l *connLimit) acquire() bool {
+	for {
+		count := l.conns.Load()
+		if l.maxConns > 0 && count >= l.maxConns {
+			return false
+		}
+
+		if l.conns.CompareAndSwap(count, count+1) {
+			l.onChange(int(count + 1))
+			return true
+		}
+	}
+}
+
+// release decrements the number of open connections.
+func (l *connLimit) release() {
+	l.onChange(int(l.conns.Add(-1)))
+}
+
+// limitListener is a net.Listener that tracks the number of open connections against a shared
+// connection limit. Connections accepted once the limit is reached do not count towards the limit
+// and are marked as rejected, so that the server can reply with a descriptive status, and are
+// closed after rejectedConnLinger.
+type limitListener struct {
+	net.Listener
+
+	limit *connLimit
+}
+
+// newLimitListener wraps the given listener so that its connections count towards the limit.
+func newLimitListener(l net.Listener, limit *connLimit) *limitListener {
+	return &limitListener{
+		Listener: l,
+		limit:    limit,
+	}
+}
+
+// Accept waits for and returns the next connection to the listener.
+func (l *limitListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	lc := &limitConn{
+		Conn:     c,
+		limit:    l.limit,
+		rejected: !l.limit.acquire(),
+	}
+	if lc.rejected {
+		time.AfterFunc(rejectedConnLinger, func() { lc.Close() })
+	}
+
+	return lc, nil
+}
+
+// limitConn is a net.Conn that releases its slot in the connection limit when closed.
+type limitConn struct {
+	net.Conn
+
+	limit     *connLimit
+	rejected  bool
+	closeOnce sync.Once
+}
+
+// Close closes the connection and releases its slot in the connection limit, if it holds one.
+func (c *limitConn) Close() error {
+	err := c.Conn.Close()
+	if !c.rejected {
+		c.closeOnce.Do(c.limit.release)
+	}
+	return err
+}
+
+// connContext marks the context of connections that were accepted past the connection limit.
+func connContext(ctx context.Context, c net.Conn) context.Context {
+	// TLS connections wrap the connection accepted by the limit listener
+	if tc, ok := c.(*tls.Conn); ok {
+		c = tc.NetConn()
+	}
+
+	if lc, ok := c.(*limitConn); ok && lc.rejected {
+		return context.WithValue(ctx, rejectedConnKey{}, true)
+	}
+
+	return ctx
+}
+
+// isRejectedConn returns true if the request context belongs to a connection that was accepted
+// past the connection limit.
+func isRejectedConn(ctx context.Context) bool {
+	rejected, _ := ctx.Value(rejectedConnKey{}).(bool)
+	return rejected
+}
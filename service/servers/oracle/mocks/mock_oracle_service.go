@@ -15,6 +15,96 @@ type OracleService struct {
 	mock.Mock
 }
 
+// AggregationAudit provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) AggregationAudit(_a0 context.Context, _a1 *types.QueryAggregationAuditRequest) (*types.QueryAggregationAuditResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AggregationAudit")
+	}
+
+	var r0 *types.QueryAggregationAuditResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAggregationAuditRequest) (*types.QueryAggregationAuditResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAggregationAuditRequest) *types.QueryAggregationAuditResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAggregationAuditResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAggregationAuditRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Config provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) Config(_a0 context.Context, _a1 *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *types.QueryConfigResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfigRequest) (*types.QueryConfigResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfigRequest) *types.QueryConfigResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceEnvelopes provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) PriceEnvelopes(_a0 context.Context, _a1 *types.QueryPriceEnvelopesRequest) (*types.QueryPriceEnvelopesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceEnvelopes")
+	}
+
+	var r0 *types.QueryPriceEnvelopesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceEnvelopesRequest) (*types.QueryPriceEnvelopesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceEnvelopesRequest) *types.QueryPriceEnvelopesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceEnvelopesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceEnvelopesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Prices provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Prices(_a0 context.Context, _a1 *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -45,6 +135,96 @@ func (_m *OracleService) Prices(_a0 context.Context, _a1 *types.QueryPricesReque
 	return r0, r1
 }
 
+// ProviderHealth provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) ProviderHealth(_a0 context.Context, _a1 *types.QueryProviderHealthRequest) (*types.QueryProviderHealthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderHealth")
+	}
+
+	var r0 *types.QueryProviderHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest) (*types.QueryProviderHealthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryProviderHealthRequest) *types.QueryProviderHealthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryProviderHealthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryProviderHealthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) RefreshPrices(_a0 context.Context, _a1 *types.RefreshPricesRequest) (*types.RefreshPricesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPrices")
+	}
+
+	var r0 *types.RefreshPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RefreshPricesRequest) (*types.RefreshPricesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RefreshPricesRequest) *types.RefreshPricesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RefreshPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.RefreshPricesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetProviderKilled provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) SetProviderKilled(_a0 context.Context, _a1 *types.SetProviderKilledRequest) (*types.SetProviderKilledResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetProviderKilled")
+	}
+
+	var r0 *types.SetProviderKilledResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetProviderKilledRequest) (*types.SetProviderKilledResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetProviderKilledRequest) *types.SetProviderKilledResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SetProviderKilledResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SetProviderKilledRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *OracleService) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -81,6 +261,24 @@ func (_m *OracleService) Stop(_a0 context.Context) error {
 	return r0
 }
 
+// StreamPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) StreamPrices(_a0 *types.StreamPricesRequest, _a1 types.Oracle_StreamPricesServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StreamPricesRequest, types.Oracle_StreamPricesServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewOracleService creates a new instance of OracleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracleService(t interface {
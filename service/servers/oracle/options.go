@@ -0,0 +1,122 @@
+package oracle
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
+)
+
+// Option is a functional option for the oracle server.
+type Option func(*OracleServer)
+
+// WithMaxConnections sets the maximum number of concurrent client connections that the oracle
+// server will serve. Requests on connections accepted past the limit are rejected, and those
+// connections are closed shortly after they are accepted. A value of 0 disables the limit.
+func WithMaxConnections(maxConns int) Option {
+	if maxConns < 0 {
+		panic("max connections cannot be negative")
+	}
+
+	return func(os *OracleServer) {
+		os.maxConns = maxConns
+	}
+}
+
+// WithMetrics sets the metrics that the oracle server will expose.
+func WithMetrics(metrics oraclemetrics.Metrics) Option {
+	if metrics == nil {
+		panic("metrics cannot be nil")
+	}
+
+	return func(os *OracleServer) {
+		os.metrics = metrics
+	}
+}
+
+// WithStartupJitter delays the oracle server from accepting requests after startup by a random
+// duration in [0, maxJitter), so that side-cars restarted at the same time do not all begin
+// serving at the same instant. The oracle itself starts immediately. A value of 0 disables the
+// delay.
+func WithStartupJitter(maxJitter time.Duration) Option {
+	if maxJitter < 0 {
+		panic("startup jitter cannot be negative")
+	}
+
+	return func(os *OracleServer) {
+		os.startupJitter = maxJitter
+	}
+}
+
+// WithConfig sets the effective config of the oracle that the oracle server exposes via the Config
+// endpoint. Secrets are redacted from the config before it is stored on the server.
+func WithConfig(cfg config.OracleConfig) Option {
+	redacted := cfg.Redacted()
+
+	return func(os *OracleServer) {
+		os.config = &redacted
+	}
+}
+
+// WithProviderKillSwitch sets the kill switch that the oracle server uses to kill and revive
+// providers via the SetProviderKilled endpoint, and the bearer token that requests to the endpoint
+// must present in their authorization header. If unset, the endpoint returns an error.
+func WithProviderKillSwitch(killSwitch ProviderKillSwitch, token string) Option {
+	if killSwitch == nil {
+		panic("provider kill switch cannot be nil")
+	}
+
+	if len(token) == 0 {
+		panic("provider kill switch token cannot be empty")
+	}
+
+	return func(os *OracleServer) {
+		os.killSwitch = killSwitch
+		os.killSwitchToken = token
+	}
+}
+
+// WithAggregationAuditor sets the auditor that the oracle server uses to serve the audit of the
+// oracle's latest aggregation via the AggregationAudit endpoint. If unset, the endpoint returns an
+// error.
+func WithAggregationAuditor(auditor AggregationAuditor) Option {
+	if auditor == nil {
+		panic("aggregation auditor cannot be nil")
+	}
+
+	return func(os *OracleServer) {
+		os.auditor = auditor
+	}
+}
+
+// WithPriceBroadcaster sets the broadcaster that notifies the oracle server of each aggregation cycle
+// of the oracle, which is used to push prices via the StreamPrices endpoint. The broadcaster must also
+// be registered as a price observer of the oracle. If unset, the endpoint returns an error.
+func WithPriceBroadcaster(broadcaster *PriceBroadcaster) Option {
+	if broadcaster == nil {
+		panic("price broadcaster cannot be nil")
+	}
+
+	return func(os *OracleServer) {
+		os.broadcaster = broadcaster
+	}
+}
+
+// WithTLS configures the oracle server to serve TLS connections using the given configuration, which
+// must contain the certificate of the server. Client certificates are verified if the configuration
+// requires them. If unset, the server serves plaintext connections, which is only recommended if the
+// oracle runs on the same host as its clients.
+func WithTLS(config *tls.Config) Option {
+	if config == nil {
+		panic("tls config cannot be nil")
+	}
+
+	if len(config.Certificates) == 0 && config.GetCertificate == nil {
+		panic("tls config must contain a certificate")
+	}
+
+	return func(os *OracleServer) {
+		os.tlsConfig = config
+	}
+}
@@ -0,0 +1,175 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/slinky/oracle/types"
+	stypes "github.com/skip-mev/slinky/service/servers/oracle/types"
+)
+
+const (
+	// MaxScaleDecimals is the maximum number of decimals that prices can be rescaled to.
+	MaxScaleDecimals = 36
+
+	// DefaultScaleMinSignificantDigits is the minimum number of significant digits that a rescaled
+	// price must retain if the request does not specify one.
+	DefaultScaleMinSignificantDigits = 6
+)
+
+// ValidatePriceScale validates the price scale of a Prices request. A nil scale is valid.
+func ValidatePriceScale(scale *stypes.PriceScale) error {
+	if scale == nil {
+		return nil
+	}
+
+	if scale.Decimals > MaxScaleDecimals {
+		return fmt.Errorf("scale decimals cannot exceed %d; got %d", MaxScaleDecimals, scale.Decimals)
+	}
+
+	if _, ok := stypes.RoundingMode_name[int32(scale.RoundingMode)]; !ok {
+		return fmt.Errorf("unknown rounding mode %d", scale.RoundingMode)
+	}
+
+	return nil
+}
+
+// ToScaledReqPrices returns a response containing the prices, TWAPs, and spreads of each priced pair
+// rescaled from the decimals of its market to the decimals of the scale. It errors if any price or
+// TWAP cannot be rescaled without losing significant precision. Spreads are rounded with the scale's
+// rounding mode but are not required to retain any significant digits.
+func ToScaledReqPrices(
+	prices types.Prices,
+	info map[string]types.PriceInfo,
+	scale *stypes.PriceScale,
+) (*stypes.QueryPricesResponse, error) {
+	reqPrices := make(map[string]string, len(prices))
+	reqTWAPs := make(map[string]string)
+	reqSpreads := make(map[string]string)
+
+	for cp, price := range prices {
+		priceInfo, ok := info[cp]
+		if !ok {
+			return nil, fmt.Errorf("decimals of %s are unknown", cp)
+		}
+
+		scaled, err := RescalePrice(price, priceInfo.Decimals, scale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rescale price of %s: %w", cp, err)
+		}
+		reqPrices[cp] = scaled.String()
+
+		if priceInfo.Spread != nil {
+			reqSpreads[cp] = rescale(priceInfo.Spread, priceInfo.Decimals, scale).String()
+		}
+
+		if priceInfo.TWAP == nil {
+			continue
+		}
+
+		scaledTWAP, err := RescalePrice(priceInfo.TWAP, priceInfo.Decimals, scale)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rescale twap of %s: %w", cp, err)
+		}
+		reqTWAPs[cp] = scaledTWAP.String()
+	}
+
+	return &stypes.QueryPricesResponse{
+		Prices:  reqPrices,
+		Twaps:   reqTWAPs,
+		Spreads: reqSpreads,
+	}, nil
+}
+
+// RescalePrice rescales a price that is scaled by the given decimals to the decimals of the scale,
+// rounding it to an integer with the scale's rounding mode. The rescaled price must retain at
+// least the scale's minimum number of significant digits, or all of the significant digits of
+// the price if it has fewer.
+func RescalePrice(price *big.Float, decimals uint64, scale *stypes.PriceScale) (*big.Int, error) {
+	if price.IsInf() {
+		return nil, fmt.Errorf("price is infinite")
+	}
+
+	rescaled := rescale(price, decimals, scale)
+
+	minDigits := int(scale.MinSignificantDigits)
+	if minDigits == 0 {
+		minDigits = DefaultScaleMinSignificantDigits
+	}
+
+	original, _ := price.Int(nil)
+	if digits := countDigits(original); digits < minDigits {
+		minDigits = digits
+	}
+
+	if digits := countDigits(rescaled); digits < minDigits {
+		return nil, fmt.Errorf(
+			"rescaling from %d to %d decimals leaves %d significant digits; at least %d are required",
+			decimals,
+			scale.Decimals,
+			digits,
+			minDigits,
+		)
+	}
+
+	return rescaled, nil
+}
+
+// rescale shifts a finite price that is scaled by the given decimals to the decimals of the scale,
+// and rounds it to an integer with the scale's rounding mode.
+func rescale(price *big.Float, decimals uint64, scale *stypes.PriceScale) *big.Int {
+	rat, _ := price.Rat(nil)
+	num, den := new(big.Int).Set(rat.Num()), new(big.Int).Set(rat.Denom())
+
+	// shift the price by the difference in decimals.
+	if scale.Decimals >= decimals {
+		num.Mul(num, pow10(scale.Decimals-decimals))
+	} else {
+		den.Mul(den, pow10(decimals-scale.Decimals))
+	}
+
+	return roundQuo(num, den, scale.RoundingMode)
+}
+
+// roundQuo returns num / den rounded to an integer with the given rounding mode. den must be positive.
+func roundQuo(num, den *big.Int, mode stypes.RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// compare twice the magnitude of the remainder against the denominator to find out whether
+	// the fraction is below, at, or above one half.
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(den)
+
+	var awayFromZero bool
+	switch mode {
+	case stypes.RoundingMode_ROUNDING_MODE_UP:
+		awayFromZero = true
+	case stypes.RoundingMode_ROUNDING_MODE_HALF_UP:
+		awayFromZero = cmp >= 0
+	case stypes.RoundingMode_ROUNDING_MODE_HALF_EVEN:
+		awayFromZero = cmp > 0 || (cmp == 0 && quo.Bit(0) == 1)
+	}
+
+	if awayFromZero {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+
+	return quo
+}
+
+// countDigits returns the number of significant digits of an integer.
+func countDigits(i *big.Int) int {
+	if i.Sign() == 0 {
+		return 0
+	}
+
+	return len(new(big.Int).Abs(i).String())
+}
+
+func pow10(exp uint64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(exp), nil)
+}
@@ -2,27 +2,45 @@ package oracle
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
+	"net"
 	"net/http"
+	stdos "os"
 	"strings"
 	"time"
 
 	gateway "github.com/cosmos/gogogateway"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/encoding/gzip" // register the gzip compressor for compressed client requests
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetrics "github.com/skip-mev/slinky/oracle/metrics"
 	"github.com/skip-mev/slinky/pkg/sync"
+	"github.com/skip-mev/slinky/pkg/tracing"
 	"github.com/skip-mev/slinky/service/servers/oracle/types"
 )
 
 const DefaultServerShutdownTimeout = 3 * time.Second
 
+// DefaultGracefulStopTimeout is the default for how long the server waits for requests in flight to
+// complete when it is stopped gracefully.
+const DefaultGracefulStopTimeout = 10 * time.Second
+
 // OracleServer is the base implementation of the service.OracleServer interface, this is meant to
 // serve requests from a remote OracleClient.
 type OracleServer struct { //nolint
@@ -45,15 +63,57 @@ type OracleServer struct { //nolint
 
 	// logger to log incoming requests
 	logger *zap.Logger
+
+	// metrics exposes the number of open client connections
+	metrics oraclemetrics.Metrics
+
+	// maxConns is the maximum number of concurrent client connections that are served. A value
+	// of 0 disables the limit.
+	maxConns int
+
+	// startupJitter is the maximum random delay before the server begins accepting requests.
+	startupJitter time.Duration
+
+	// config is the effective config of the oracle, with secrets redacted. If nil, the Config
+	// endpoint returns an error.
+	config *config.OracleConfig
+
+	// killSwitch kills and revives the oracle's providers at runtime. If nil, the SetProviderKilled
+	// endpoint returns an error.
+	killSwitch ProviderKillSwitch
+
+	// killSwitchToken is the bearer token that requests to the SetProviderKilled endpoint must
+	// present in their authorization header.
+	killSwitchToken string
+
+	// auditor returns the audit of the oracle's latest aggregation. If nil, the AggregationAudit
+	// endpoint returns an error.
+	auditor AggregationAuditor
+
+	// broadcaster notifies the server of each aggregation cycle of the oracle. If nil, the
+	// StreamPrices endpoint returns an error.
+	broadcaster *PriceBroadcaster
+
+	// tlsConfig is the TLS configuration of the server. If nil, the server serves plaintext
+	// connections.
+	tlsConfig *tls.Config
+
+	// requests tracks the requests in flight, so that they can be drained by GracefulStop.
+	requests *requestTracker
 }
 
 // NewOracleServer returns a new instance of the OracleServer, given an implementation of the Oracle interface.
-func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
+func NewOracleServer(o oracle.Oracle, logger *zap.Logger, opts ...Option) *OracleServer {
 	logger = logger.With(zap.String("server", "oracle"))
 
 	os := &OracleServer{
-		o:      o,
-		logger: logger,
+		o:        o,
+		logger:   logger,
+		metrics:  oraclemetrics.NewNopMetrics(),
+		requests: newRequestTracker(),
+	}
+	for _, opt := range opts {
+		opt(os)
 	}
 	os.Closer = sync.NewCloser().WithCallback(func() {
 		// if the server has been started, close it
@@ -70,23 +130,72 @@ func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
 
 // routeRequest determines if the incoming http request is a grpc or http request and routes to the proper handler.
 func (os *OracleServer) routeRequest(w http.ResponseWriter, r *http.Request) {
-	if r.ProtoMajor == 2 && strings.HasPrefix(
-		r.Header.Get("Content-Type"), "application/grpc") {
+	isGRPC := r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc")
+
+	if isRejectedConn(r.Context()) {
+		os.logger.Warn("rejecting request; max connections reached", zap.Int("max_connections", os.maxConns))
+		os.rejectRequest(w, isGRPC, codes.ResourceExhausted, ErrMaxConnections)
+		return
+	}
 
+	if !os.requests.start() {
+		os.logger.Debug("rejecting request; server is draining")
+		os.rejectRequest(w, isGRPC, codes.Unavailable, ErrServerDraining)
+		return
+	}
+	defer os.requests.done()
+
+	if isGRPC {
 		os.grpcSrv.ServeHTTP(w, r)
 	} else {
-		os.gatewayMux.ServeHTTP(w, r)
+		os.serveGateway(w, r)
 	}
 }
 
+// rejectRequest replies to a request that the server does not serve, e.g. because it was made on a
+// connection that was accepted past the connection limit. gRPC requests are rejected with the given
+// status code, all other requests with a 503.
+func (os *OracleServer) rejectRequest(w http.ResponseWriter, isGRPC bool, code codes.Code, err error) {
+	if isGRPC {
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Grpc-Status", fmt.Sprintf("%d", code))
+		w.Header().Set("Grpc-Message", err.Error())
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Connection", "close")
+	http.Error(w, err.Error(), http.StatusServiceUnavailable)
+}
+
 // StartServer starts the oracle gRPC server on the given host and port. The server is killed on any errors from the listener, or if ctx is cancelled.
 // This method returns an error via any failure from the listener. This is a blocking call, i.e. until the server is closed or the server errors,
 // this method will block.
 func (os *OracleServer) StartServer(ctx context.Context, host, port string) error {
-	serverEndpoint := fmt.Sprintf("%s:%s", host, port)
+	return os.StartServerOnAddresses(ctx, fmt.Sprintf("%s:%s", host, port))
+}
+
+// StartServerOnAddresses starts the oracle gRPC server on each of the given listen addresses, serving the same oracle on all of them. Each
+// address is either a unix socket path prefixed with unix:// or a TCP host:port (see config.ParseListenAddress). The server is killed on any
+// errors from any of the listeners, or if ctx is cancelled. This is a blocking call, i.e. until the server is closed or the server errors,
+// this method will block.
+func (os *OracleServer) StartServerOnAddresses(ctx context.Context, addrs ...string) error {
+	if len(addrs) == 0 {
+		return fmt.Errorf("[grpc server]: at least one listen address is required")
+	}
+
+	for _, addr := range addrs {
+		if _, _, err := config.ParseListenAddress(addr); err != nil {
+			return fmt.Errorf("[grpc server]: %w", err)
+		}
+	}
+
 	os.httpSrv = &http.Server{
-		Addr:              serverEndpoint,
 		ReadHeaderTimeout: DefaultServerShutdownTimeout,
+		ConnContext:       connContext,
+	}
+	if os.tlsConfig != nil {
+		os.httpSrv.TLSConfig = os.tlsConfig.Clone()
 	}
 	// create grpc server
 	os.grpcSrv = grpc.NewServer()
@@ -94,7 +203,7 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	types.RegisterOracleServer(os.grpcSrv, os)
 
 	// register the grpc-gateway
-	// it handles the http request and dials the server endpoint with the grpc request
+	// it handles the http request and forwards the grpc request to the oracle server
 	os.gatewayMux = runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
 			EmitDefaults: true,
@@ -102,8 +211,9 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 			OrigName:     true,
 		}),
 	)
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithNoProxy()}
-	err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, serverEndpoint, opts)
+	// the gateway calls the oracle server in-process rather than dialing the server endpoint, so that
+	// it does not hold a client connection that counts towards the connection limit
+	err := types.RegisterOracleHandlerServer(ctx, os.gatewayMux, os)
 	if err != nil {
 		return err
 	}
@@ -134,16 +244,35 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 
 	// start the server
 	eg.Go(func() error {
-		// serve, and return any errors
-		os.logger.Info(
-			"starting grpc server",
-			zap.String("host", host),
-			zap.String("port", port),
-		)
+		// stagger the start of the server, return if ctx is cancelled while waiting
+		if !os.waitStartupJitter(ctx) {
+			return nil
+		}
 
-		err = os.httpSrv.ListenAndServe()
+		listeners, err := listen(addrs)
 		if err != nil {
-			return fmt.Errorf("[grpc server]: error serving: %w", err)
+			return fmt.Errorf("[grpc server]: error listening: %w", err)
+		}
+
+		// serve on every listener, and return any errors. The connection limit is shared across
+		// all listeners.
+		limit := newConnLimit(os.maxConns, os.metrics.SetServerConnections)
+		for i, ln := range listeners {
+			os.logger.Info("starting grpc server", zap.String("address", addrs[i]))
+
+			eg.Go(func() error {
+				if err := os.serve(newLimitListener(ln, limit)); err != nil {
+					// the listeners are closed as soon as the server starts draining, keep the oracle
+					// running until the requests in flight have completed
+					if errors.Is(err, http.ErrServerClosed) {
+						<-os.Done()
+					}
+
+					return fmt.Errorf("[grpc server]: error serving on %s: %w", addrs[i], err)
+				}
+
+				return nil
+			})
 		}
 
 		return nil
@@ -153,11 +282,97 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	return eg.Wait()
 }
 
+// serve serves the HTTP server backing the gRPC server on the given listener, over TLS if the server
+// is configured with a TLS configuration.
+func (os *OracleServer) serve(ln net.Listener) error {
+	if os.tlsConfig != nil {
+		// the certificates are served from the TLS configuration of the HTTP server
+		return os.httpSrv.ServeTLS(ln, "", "")
+	}
+
+	return os.httpSrv.Serve(ln)
+}
+
+// listen opens a listener on each of the given listen addresses. If any of the addresses cannot be
+// listened on, the listeners that were opened are closed and an error is returned. Stale unix
+// sockets, e.g. left behind by a previous process that did not shut down cleanly, are replaced.
+func listen(addrs []string) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(addrs))
+	for _, addr := range addrs {
+		network, address, err := config.ParseListenAddress(addr)
+		if err == nil && network == "unix" {
+			err = removeStaleSocket(address)
+		}
+
+		var ln net.Listener
+		if err == nil {
+			ln, err = net.Listen(network, address)
+		}
+
+		if err != nil {
+			for _, opened := range listeners {
+				_ = opened.Close()
+			}
+
+			return nil, fmt.Errorf("%s: %w", addr, err)
+		}
+
+		listeners = append(listeners, ln)
+	}
+
+	return listeners, nil
+}
+
+// removeStaleSocket removes the unix socket at the given path, if any. Files at the path that are
+// not sockets are left in place, in which case listening on the path fails.
+func removeStaleSocket(path string) error {
+	info, err := stdos.Lstat(path)
+	if err != nil || info.Mode()&stdos.ModeSocket == 0 {
+		return nil
+	}
+
+	return stdos.Remove(path)
+}
+
+// waitStartupJitter blocks for a random duration bounded by the configured startup jitter. It
+// returns false if ctx is cancelled before the delay elapses.
+func (os *OracleServer) waitStartupJitter(ctx context.Context) bool {
+	if os.startupJitter <= 0 {
+		return true
+	}
+
+	delay := time.Duration(rand.Int63n(int64(os.startupJitter))) //nolint:gosec
+	os.logger.Info(
+		"delaying grpc server startup",
+		zap.Duration("delay", delay),
+		zap.Duration("max_jitter", os.startupJitter),
+	)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
 // Prices calls the underlying oracle's implementation of GetPrices. It defers to the ctx in the request, and errors if the context is cancelled
-// for any reason, or if the oracle errors.
+// for any reason, or if the oracle errors. If the request specifies a scale, every price is rescaled to its decimals, and the request errors
+// if any price would lose significant precision.
 func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	ctx, span := tracing.Tracer().Start(
+		tracing.ExtractIncomingContext(ctx),
+		"OracleServer.Prices",
+		trace.WithSpanKind(trace.SpanKindServer),
+	)
+	defer span.End()
+
 	// check that the request is non-nil
 	if req == nil {
+		span.SetStatus(otelcodes.Error, ErrNilRequest.Error())
 		return nil, ErrNilRequest
 	}
 
@@ -166,10 +381,21 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	// check that oracle is running
 	if !os.o.IsRunning() {
 		os.logger.Error("oracle not running")
+		span.SetStatus(otelcodes.Error, ErrOracleNotRunning.Error())
 		return nil, ErrOracleNotRunning
 	}
 
-	resCh := make(chan *types.QueryPricesResponse)
+	// check that the requested scale is valid
+	if err := ValidatePriceScale(req.Scale); err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	type result struct {
+		resp *types.QueryPricesResponse
+		err  error
+	}
+	resCh := make(chan result, 1)
 
 	// run the request in a goroutine, to unblock server + ctx cancellation
 	go func() {
@@ -179,9 +405,119 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 		// get the latest timestamp of the latest update from the oracle
 		timestamp := os.o.GetLastSyncTime()
 
-		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
-			Timestamp: timestamp,
+		// get the pairs that are currently missing a price
+		warmingUp, failing := os.o.GetMissingPrices()
+
+		// rescale the prices if the request specifies a scale, otherwise serve each price scaled
+		// by the decimals of its market
+		info := os.o.GetPriceInfo()
+		resp := &types.QueryPricesResponse{}
+		if req.Scale != nil {
+			var err error
+			if resp, err = ToScaledReqPrices(prices, info, req.Scale); err != nil {
+				resCh <- result{err: status.Error(codes.InvalidArgument, err.Error())}
+				return
+			}
+		} else {
+			resp.Prices = ToReqPrices(prices)
+			resp.Twaps = ToReqTWAPs(prices, info)
+			resp.Spreads = ToReqSpreads(prices, info)
+		}
+
+		resp.Timestamp = timestamp
+		resp.WarmingUp = warmingUp
+		resp.Failing = failing
+		resCh <- result{resp: resp}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		span.SetStatus(otelcodes.Error, context.Canceled.Error())
+		return nil, context.Canceled
+	case res := <-resCh:
+		if res.err != nil {
+			os.logger.Error("failed to get prices", zap.Error(res.err))
+			span.SetStatus(otelcodes.Error, res.err.Error())
+			return nil, res.err
+		}
+
+		resp := res.resp
+		span.SetAttributes(
+			attribute.Int("slinky.num_prices", len(resp.Prices)),
+			attribute.Int("slinky.num_failing", len(resp.Failing)),
+			attribute.String("slinky.last_sync", resp.Timestamp.UTC().Format(time.RFC3339Nano)),
+		)
+		return resp, nil
+	}
+}
+
+// PriceEnvelopes returns the latest prices of the underlying oracle, each wrapped in a PriceEnvelope that is
+// packed into an Any. Each envelope contains the decimals of the price and the providers that contributed to
+// it. Like Prices, it defers to the ctx in the request, and errors if the context is cancelled for any reason.
+func (os *OracleServer) PriceEnvelopes(
+	ctx context.Context,
+	req *types.QueryPriceEnvelopesRequest,
+) (*types.QueryPriceEnvelopesResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for price envelopes")
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	type result struct {
+		resp *types.QueryPriceEnvelopesResponse
+		err  error
+	}
+	resCh := make(chan result, 1)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		envelopes, err := ToPriceEnvelopes(os.o.GetPrices(), os.o.GetPriceInfo(), os.o.GetLastSyncTime())
+		resCh <- result{
+			resp: &types.QueryPriceEnvelopesResponse{Envelopes: envelopes},
+			err:  err,
+		}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		return nil, context.Canceled
+	case res := <-resCh:
+		return res.resp, res.err
+	}
+}
+
+// ProviderHealth returns the health of each provider of the underlying oracle, including the state of each of
+// its websocket connections. Unlike Prices, it does not require the oracle to be running so that unhealthy
+// providers can be diagnosed while the oracle is stopped.
+func (os *OracleServer) ProviderHealth(
+	ctx context.Context,
+	req *types.QueryProviderHealthRequest,
+) (*types.QueryProviderHealthResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for provider health")
+
+	resCh := make(chan *types.QueryProviderHealthResponse, 1)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		resCh <- &types.QueryProviderHealthResponse{
+			Providers: ToProviderHealth(os.o.GetProviderHealth()),
 		}
 	}()
 
@@ -195,6 +531,93 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// Config returns the effective config of the underlying oracle, with secrets redacted, encoded as JSON.
+// This allows operators to verify the config that the oracle is running with after environment variable
+// overrides and legacy config fallbacks are applied.
+func (os *OracleServer) Config(
+	_ context.Context,
+	req *types.QueryConfigRequest,
+) (*types.QueryConfigResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for config")
+
+	if os.config == nil {
+		return nil, ErrConfigNotSet
+	}
+
+	bz, err := json.Marshal(os.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return &types.QueryConfigResponse{
+		Config: string(bz),
+	}, nil
+}
+
+// RefreshPrices immediately fetches and aggregates the prices of the requested pairs on the underlying oracle,
+// rather than waiting for its next update, and returns the refreshed prices. Refreshes that are not enabled on
+// the oracle, or that exceed its rate limit, are rejected. Like Prices, it defers to the ctx in the request, and
+// errors if the context is cancelled for any reason.
+func (os *OracleServer) RefreshPrices(
+	ctx context.Context,
+	req *types.RefreshPricesRequest,
+) (*types.RefreshPricesResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request to refresh prices", zap.Strings("pairs", req.Pairs))
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	type result struct {
+		resp *types.RefreshPricesResponse
+		err  error
+	}
+	resCh := make(chan result, 1)
+
+	// run the request in a goroutine, to unblock server + ctx cancellation
+	go func() {
+		prices, err := os.o.RefreshPrices(ctx, req.Pairs)
+		switch {
+		case errors.Is(err, oracle.ErrRefreshDisabled):
+			err = status.Error(codes.Unimplemented, err.Error())
+		case errors.Is(err, oracle.ErrRefreshRateLimited):
+			err = status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if err != nil {
+			resCh <- result{err: err}
+			return
+		}
+
+		resCh <- result{
+			resp: &types.RefreshPricesResponse{
+				Prices:    ToReqPrices(prices),
+				Timestamp: os.o.GetLastSyncTime(),
+			},
+		}
+	}()
+
+	// defer to context closure
+	select {
+	case <-ctx.Done():
+		os.logger.Error("context cancelled")
+		return nil, context.Canceled
+	case res := <-resCh:
+		return res.resp, res.err
+	}
+}
+
 // Close closes the underlying oracle server, and blocks until all open requests have been satisfied.
 func (os *OracleServer) Close() error {
 	// close + close server if necessary
@@ -202,7 +625,163 @@ func (os *OracleServer) Close() error {
 	return nil
 }
 
+// GracefulStop stops the oracle server once the requests in flight have completed. New requests are
+// rejected with an UNAVAILABLE status (or a 503 for HTTP requests), and open price streams are ended with
+// the same status, so that clients retry against another server. If ctx is done before the requests in
+// flight complete, the server is closed regardless, aborting the remaining requests, and the error of ctx
+// is returned.
+func (os *OracleServer) GracefulStop(ctx context.Context) error {
+	os.logger.Info("draining oracle server")
+	drained := os.requests.drain()
+
+	// stop accepting new connections
+	if os.httpSrv != nil {
+		if err := os.httpSrv.Shutdown(ctx); err != nil {
+			os.logger.Warn("failed to shut down http server gracefully", zap.Error(err))
+		}
+	}
+
+	var err error
+	select {
+	case <-drained:
+		os.logger.Info("drained oracle server")
+	case <-ctx.Done():
+		err = ctx.Err()
+		os.logger.Warn("timed out draining oracle server; closing with requests in flight", zap.Error(err))
+	}
+
+	_ = os.Close()
+	return err
+}
+
 // Done returns a channel that is closed when the oracle server is closed.
 func (os *OracleServer) Done() <-chan struct{} {
 	return os.Closer.Done()
 }
+
+// SetProviderKilled kills or revives a provider of the underlying oracle at runtime, and returns the providers
+// that are currently killed. Killed providers are stopped and their prices are excluded from the aggregation
+// until they are revived. Requests are rejected unless the oracle server is configured with a kill switch, and
+// must present the kill switch's bearer token in their authorization header.
+func (os *OracleServer) SetProviderKilled(
+	ctx context.Context,
+	req *types.SetProviderKilledRequest,
+) (*types.SetProviderKilledResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Info(
+		"received request to set provider killed",
+		zap.String("provider", req.Provider),
+		zap.Bool("killed", req.Killed),
+	)
+
+	if os.killSwitch == nil {
+		return nil, status.Error(codes.Unimplemented, ErrKillSwitchNotSet.Error())
+	}
+
+	if !hasBearerToken(ctx, os.killSwitchToken) {
+		os.logger.Warn("rejected unauthenticated request to set provider killed", zap.String("provider", req.Provider))
+		return nil, status.Error(codes.Unauthenticated, ErrUnauthenticated.Error())
+	}
+
+	if err := os.killSwitch.SetProviderKilled(req.Provider, req.Killed); err != nil {
+		os.logger.Error("failed to set provider killed", zap.String("provider", req.Provider), zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.SetProviderKilledResponse{
+		KilledProviders: os.killSwitch.GetKilledProviders(),
+	}, nil
+}
+
+// AggregationAudit returns, for each requested pair, whether each of its providers was included in the latest
+// aggregated price of the pair and, if not, why it was excluded. If no pairs are requested, the audit of every
+// pair is returned. Requests are rejected unless the oracle server is configured with an auditor.
+func (os *OracleServer) AggregationAudit(
+	_ context.Context,
+	req *types.QueryAggregationAuditRequest,
+) (*types.QueryAggregationAuditResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for aggregation audit", zap.Strings("pairs", req.Pairs))
+
+	if os.auditor == nil {
+		return nil, status.Error(codes.Unimplemented, ErrAuditorNotSet.Error())
+	}
+
+	return &types.QueryAggregationAuditResponse{
+		Audits: ToAggregationAudits(os.auditor.GetAggregationAudit(), req.Pairs),
+	}, nil
+}
+
+// StreamPrices streams the latest prices of the underlying oracle. The current prices are sent as soon as
+// the stream is opened, followed by the prices of each subsequent aggregation cycle of the oracle. Each
+// response is identical to the response of Prices for the same scale. Streams that fall behind skip
+// intermediate cycles, and always receive the latest prices. Requests are rejected unless the oracle
+// server is configured with a price broadcaster.
+func (os *OracleServer) StreamPrices(
+	req *types.StreamPricesRequest,
+	stream types.Oracle_StreamPricesServer,
+) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Debug("received request to stream prices")
+
+	if os.broadcaster == nil {
+		return status.Error(codes.Unimplemented, ErrStreamingNotSet.Error())
+	}
+
+	// check that the requested scale is valid
+	if err := ValidatePriceScale(req.Scale); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// subscribe before the current prices are sent, so that no aggregation cycle is missed
+	updates, unsubscribe := os.broadcaster.subscribe()
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	pricesReq := &types.QueryPricesRequest{Scale: req.Scale}
+	send := func() error {
+		// the oracle may not have started yet, in which case there are no prices to send
+		if !os.o.IsRunning() {
+			return nil
+		}
+
+		resp, err := os.Prices(ctx, pricesReq)
+		if err != nil {
+			return err
+		}
+
+		return stream.Send(resp)
+	}
+
+	if err := send(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Debug("price stream closed")
+			return ctx.Err()
+		case <-os.requests.draining:
+			os.logger.Debug("closing price stream; server is draining")
+			return status.Error(codes.Unavailable, ErrServerDraining.Error())
+		case <-updates:
+			if err := send(); err != nil {
+				os.logger.Error("failed to stream prices", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
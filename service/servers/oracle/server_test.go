@@ -2,24 +2,47 @@ package oracle_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
+	"net"
 	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	grpcstats "google.golang.org/grpc/stats"
 	"google.golang.org/grpc/status"
 
+	"github.com/skip-mev/slinky/oracle"
+	"github.com/skip-mev/slinky/oracle/config"
+	oraclemetricsmocks "github.com/skip-mev/slinky/oracle/metrics/mocks"
 	"github.com/skip-mev/slinky/oracle/mocks"
 	"github.com/skip-mev/slinky/oracle/types"
 	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	wshandlers "github.com/skip-mev/slinky/providers/base/websocket/handlers"
 	client "github.com/skip-mev/slinky/service/clients/oracle"
 	"github.com/skip-mev/slinky/service/metrics"
+	metricmocks "github.com/skip-mev/slinky/service/metrics/mocks"
 	server "github.com/skip-mev/slinky/service/servers/oracle"
 	stypes "github.com/skip-mev/slinky/service/servers/oracle/types"
 	mmtypes "github.com/skip-mev/slinky/x/marketmap/types"
@@ -122,6 +145,107 @@ func (s *ServerTestSuite) TestOracleServerTimeout() {
 	s.Require().Equal(err.Error(), status.FromContextError(context.DeadlineExceeded).Err().Error())
 }
 
+func (s *ServerTestSuite) TestOracleServerPricesWithTimeout() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+	}).After(2 * timeout)
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now()).Maybe()
+	s.mockOracle.On("GetMissingPrices").Return(nil, nil).Maybe()
+	s.mockOracle.On("GetPriceInfo").Return(nil).Maybe()
+
+	grpcClient, ok := s.client.(*client.GRPCClient)
+	s.Require().True(ok)
+
+	// the client's default timeout elapses before the oracle responds
+	_, err := grpcClient.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().Equal(status.FromContextError(context.DeadlineExceeded).Err().Error(), err.Error())
+
+	// a longer per-call timeout overrides the default
+	resp, err := grpcClient.PricesWithTimeout(context.Background(), &stypes.QueryPricesRequest{}, 4*timeout)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{"BTC/USD": "100"}, resp.Prices)
+
+	// a shorter per-call timeout overrides the default
+	start := time.Now()
+	_, err = grpcClient.PricesWithTimeout(context.Background(), &stypes.QueryPricesRequest{}, timeout/4)
+	s.Require().Equal(status.FromContextError(context.DeadlineExceeded).Err().Error(), err.Error())
+	s.Require().Less(time.Since(start), timeout)
+
+	// the timeout must be positive
+	_, err = grpcClient.PricesWithTimeout(context.Background(), &stypes.QueryPricesRequest{}, 0)
+	s.Require().Error(err)
+}
+
+func (s *ServerTestSuite) TestOracleServerConcurrentPricesWithTimeout() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+	}).After(2 * timeout)
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now()).Maybe()
+	s.mockOracle.On("GetMissingPrices").Return(nil, nil).Maybe()
+	s.mockOracle.On("GetPriceInfo").Return(nil).Maybe()
+
+	grpcClient, ok := s.client.(*client.GRPCClient)
+	s.Require().True(ok)
+
+	// a long call is in flight
+	done := make(chan error, 1)
+	go func() {
+		_, err := grpcClient.PricesWithTimeout(context.Background(), &stypes.QueryPricesRequest{}, 4*timeout)
+		done <- err
+	}()
+	time.Sleep(timeout / 4)
+
+	// a call with the client's shorter default timeout is not delayed by the call in flight
+	start := time.Now()
+	_, err := grpcClient.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+	s.Require().Less(time.Since(start), timeout+timeout/2)
+
+	s.Require().NoError(<-done)
+}
+
+func (s *ServerTestSuite) TestOracleServerPricesDeadlineMetrics() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD": big.NewFloat(100),
+	}).After(2 * timeout)
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now()).Maybe()
+	s.mockOracle.On("GetMissingPrices").Return(nil, nil).Maybe()
+	s.mockOracle.On("GetPriceInfo").Return(nil).Maybe()
+
+	clientMetrics := metricmocks.NewMetrics(s.T())
+	clientMetrics.On("ObserveOracleResponseLatency", mock.Anything).Return().Twice()
+	clientMetrics.On("ObserveOraclePricesLatency", mock.Anything).Return().Twice()
+	clientMetrics.On("AddOracleResponse", metrics.Failure{}).Return().Once()
+	clientMetrics.On("AddOracleResponse", metrics.Success{}).Return().Once()
+	clientMetrics.On("AddOraclePricesDeadlineExceeded").Return().Once()
+
+	c, err := client.NewClient(
+		log.NewTestLogger(s.T()),
+		localhost+":"+port,
+		timeout,
+		metrics.NewNopMetrics(),
+		client.WithBlockingDial(),
+		client.WithMetrics(clientMetrics),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(c.Start(context.Background()))
+	defer c.Stop()
+
+	grpcClient, ok := c.(*client.GRPCClient)
+	s.Require().True(ok)
+
+	// requests that exceed their deadline are counted
+	_, err = grpcClient.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+
+	// requests that complete within their deadline are not
+	_, err = grpcClient.PricesWithTimeout(context.Background(), &stypes.QueryPricesRequest{}, 4*timeout)
+	s.Require().NoError(err)
+}
+
 func (s *ServerTestSuite) TestOracleServerPrices() {
 	// set the mock oracle to return price-data
 	s.mockOracle.On("IsRunning").Return(true)
@@ -147,6 +271,12 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	})
 	ts := time.Now()
 	s.mockOracle.On("GetLastSyncTime").Return(ts)
+	s.mockOracle.On("GetMissingPrices").Return([]string{"SOL/USD"}, []string{"ATOM/USD"})
+	s.mockOracle.On("GetPriceInfo").Return(map[string]types.PriceInfo{
+		cp1.String(): {Decimals: 8, TWAP: big.NewFloat(99.9), Spread: big.NewFloat(2)},
+		cp2.String(): {Decimals: 8},
+		"SOL/USD":    {Decimals: 8, TWAP: big.NewFloat(10)},
+	})
 
 	// call from grpc client
 	resp, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
@@ -159,6 +289,16 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 
 	s.Require().Equal(resp.Timestamp, ts.UTC())
 
+	// check missing prices
+	s.Require().Equal([]string{"SOL/USD"}, resp.WarmingUp)
+	s.Require().Equal([]string{"ATOM/USD"}, resp.Failing)
+
+	// check twaps are only returned for priced pairs that maintain a twap
+	s.Require().Equal(map[string]string{cp1.String(): big.NewInt(99).String()}, resp.Twaps)
+
+	// check spreads are only returned for priced pairs whose spread is computed
+	s.Require().Equal(map[string]string{cp1.String(): big.NewInt(2).String()}, resp.Spreads)
+
 	// call from http client
 	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/prices", localhost, port))
 	s.Require().NoError(err)
@@ -170,6 +310,474 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerScaledPrices() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD": big.NewFloat(12345678.5),
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now())
+	s.mockOracle.On("GetMissingPrices").Return(nil, nil)
+	s.mockOracle.On("GetPriceInfo").Return(map[string]types.PriceInfo{
+		"BTC/USD": {Decimals: 2, TWAP: big.NewFloat(12345600), Spread: big.NewFloat(250)},
+	})
+
+	// prices are rescaled towards zero by default
+	resp, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{
+		Scale: &stypes.PriceScale{Decimals: 0},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{"BTC/USD": "123456"}, resp.Prices)
+	s.Require().Equal(map[string]string{"BTC/USD": "123456"}, resp.Twaps)
+	s.Require().Equal(map[string]string{"BTC/USD": "2"}, resp.Spreads)
+
+	// prices are rounded with the requested rounding mode
+	resp, err = s.client.Prices(context.Background(), &stypes.QueryPricesRequest{
+		Scale: &stypes.PriceScale{Decimals: 0, RoundingMode: stypes.RoundingMode_ROUNDING_MODE_HALF_EVEN},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{"BTC/USD": "123457"}, resp.Prices)
+
+	// increasing the decimals retains the fractional part of the price
+	resp, err = s.client.Prices(context.Background(), &stypes.QueryPricesRequest{
+		Scale: &stypes.PriceScale{Decimals: 4},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{"BTC/USD": "1234567850"}, resp.Prices)
+
+	// rescaling that loses significant precision is rejected
+	_, err = s.client.Prices(context.Background(), &stypes.QueryPricesRequest{
+		Scale: &stypes.PriceScale{Decimals: 0, MinSignificantDigits: 8},
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// the decimals are capped
+	_, err = s.client.Prices(context.Background(), &stypes.QueryPricesRequest{
+		Scale: &stypes.PriceScale{Decimals: server.MaxScaleDecimals + 1},
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// the scale can be set via query parameters
+	httpResp, err := s.httpClient.Get(fmt.Sprintf(
+		"http://%s:%s/slinky/oracle/v1/prices?scale.decimals=4&scale.rounding_mode=2",
+		localhost,
+		port,
+	))
+	s.Require().NoError(err)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode, string(respBz))
+	s.Require().Contains(string(respBz), `{"prices":{"BTC/USD":"1234567850"}`)
+}
+
+func (s *ServerTestSuite) TestOracleServerStalePrices() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"BTC/USD": big.NewFloat(100.1),
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(time.Now().Add(-time.Minute))
+	s.mockOracle.On("GetMissingPrices").Return([]string{}, []string{"ATOM/USD"})
+	s.mockOracle.On("GetPriceInfo").Return(map[string]types.PriceInfo{
+		"BTC/USD": {Decimals: 8, TWAP: big.NewFloat(100)},
+	})
+
+	newClient := func(policy client.StalePricePolicy) client.OracleClient {
+		c, err := client.NewClient(
+			log.NewTestLogger(s.T()),
+			localhost+":"+port,
+			timeout,
+			metrics.NewNopMetrics(),
+			client.WithBlockingDial(),
+			client.WithMaxPriceAge(time.Second, policy),
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(c.Start(context.Background()))
+		s.T().Cleanup(func() { _ = c.Stop() })
+
+		return c
+	}
+
+	// prices within the server's max age are returned by a client without a max price age
+	resp, err := s.client.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Prices, 1)
+
+	// stale prices are rejected
+	_, err = newClient(client.RejectStalePrices).Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().ErrorIs(err, client.ErrStalePrices)
+
+	// stale prices are filtered and reported as failing
+	resp, err = newClient(client.FilterStalePrices).Prices(context.Background(), &stypes.QueryPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Prices)
+	s.Require().Empty(resp.Twaps)
+	s.Require().Equal([]string{"ATOM/USD", "BTC/USD"}, resp.Failing)
+}
+
+func TestWithMaxPriceAge(t *testing.T) {
+	require.Panics(t, func() { client.WithMaxPriceAge(0, client.RejectStalePrices) })
+	require.Panics(t, func() { client.WithMaxPriceAge(time.Second, client.StalePricePolicy(2)) })
+}
+
+func (s *ServerTestSuite) TestOracleServerPriceEnvelopes() {
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		"ETH/USD": big.NewFloat(200.1),
+		"BTC/USD": big.NewFloat(100.1),
+	})
+	s.mockOracle.On("GetPriceInfo").Return(map[string]types.PriceInfo{
+		"BTC/USD": {Decimals: 8, Providers: []string{"binance", "coinbase"}, Source: "coinbase"},
+		"ETH/USD": {Decimals: 18, Providers: []string{"kraken"}},
+	})
+	ts := time.Now()
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	// call from grpc client
+	resp, err := s.client.PriceEnvelopes(context.Background(), &stypes.QueryPriceEnvelopesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Envelopes, 2)
+
+	expected := []stypes.PriceEnvelope{
+		{CurrencyPair: "BTC/USD", Price: "100", Timestamp: ts.UTC(), Decimals: 8, Providers: []string{"binance", "coinbase"}, Source: "coinbase"},
+		{CurrencyPair: "ETH/USD", Price: "200", Timestamp: ts.UTC(), Decimals: 18, Providers: []string{"kraken"}},
+	}
+	for i, packed := range resp.Envelopes {
+		s.Require().Equal("/slinky.service.v1.PriceEnvelope", packed.TypeUrl)
+
+		var envelope stypes.PriceEnvelope
+		s.Require().NoError(envelope.Unmarshal(packed.Value))
+		s.Require().Equal(expected[i].CurrencyPair, envelope.CurrencyPair)
+		s.Require().Equal(expected[i].Price, envelope.Price)
+		s.Require().True(expected[i].Timestamp.Equal(envelope.Timestamp))
+		s.Require().Equal(expected[i].Decimals, envelope.Decimals)
+		s.Require().Equal(expected[i].Providers, envelope.Providers)
+		s.Require().Equal(expected[i].Source, envelope.Source)
+	}
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/price_envelopes", localhost, port))
+	s.Require().NoError(err)
+
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"@type":"/slinky.service.v1.PriceEnvelope","currency_pair":"BTC/USD","price":"100"`)
+}
+
+func (s *ServerTestSuite) TestOracleServerProviderHealth() {
+	lastErrorTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.mockOracle.On("GetProviderHealth").Return([]types.ProviderHealth{
+		{
+			Name:          "binance_api",
+			Running:       true,
+			LastError:     "failed to fetch BTC/USD (rate limit exceeded): too many requests",
+			LastErrorTime: lastErrorTime,
+		},
+		{
+			Name:        "okx_ws",
+			Running:     true,
+			Connections: []wshandlers.ConnectionState{wshandlers.ConnectionStateConnected, wshandlers.ConnectionStateFailed},
+		},
+	})
+
+	// call from grpc client
+	resp, err := s.client.ProviderHealth(context.Background(), &stypes.QueryProviderHealthRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]stypes.ProviderHealth{
+		{
+			Name:          "binance_api",
+			Running:       true,
+			LastError:     "failed to fetch BTC/USD (rate limit exceeded): too many requests",
+			LastErrorTime: &lastErrorTime,
+		},
+		{Name: "okx_ws", Running: true, Connections: []string{"connected", "failed"}},
+	}, resp.Providers)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/provider_health", localhost, port))
+	s.Require().NoError(err)
+
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"last_error":"failed to fetch BTC/USD (rate limit exceeded): too many requests","last_error_time":"2024-01-02T03:04:05Z"`)
+	s.Require().Contains(string(respBz), `{"name":"okx_ws","running":true,"connections":["connected","failed"],"last_error":"","last_error_time":null}`)
+}
+
+func (s *ServerTestSuite) TestOracleServerRefreshPrices() {
+	s.mockOracle.On("IsRunning").Return(true)
+	ts := time.Now()
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+	s.mockOracle.On("RefreshPrices", mock.Anything, []string{"BTC/USD"}).Return(types.Prices{
+		"BTC/USD": big.NewFloat(100.1),
+	}, nil).Twice()
+	s.mockOracle.On("RefreshPrices", mock.Anything, []string{"BTC/USD"}).Return(nil, oracle.ErrRefreshRateLimited).Once()
+
+	// call from grpc client
+	resp, err := s.client.RefreshPrices(context.Background(), &stypes.RefreshPricesRequest{Pairs: []string{"BTC/USD"}})
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{"BTC/USD": "100"}, resp.Prices)
+	s.Require().Equal(ts.UTC(), resp.Timestamp)
+
+	// call from http client
+	httpResp, err := s.httpClient.Post(
+		fmt.Sprintf("http://%s:%s/slinky/oracle/v1/refresh_prices", localhost, port),
+		"application/json",
+		strings.NewReader(`{"pairs":["BTC/USD"]}`),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `{"prices":{"BTC/USD":"100"},"timestamp":`)
+
+	// rate limited refreshes are rejected
+	_, err = s.client.RefreshPrices(context.Background(), &stypes.RefreshPricesRequest{Pairs: []string{"BTC/USD"}})
+	s.Require().Equal(codes.ResourceExhausted, status.Code(err))
+}
+
+func TestOracleServerConfig(t *testing.T) {
+	t.Run("returns the redacted config", func(t *testing.T) {
+		cfg := config.OracleConfig{
+			UpdateInterval: time.Second,
+			Providers: []config.ProviderConfig{
+				{
+					Name: "coinmarketcap_api",
+					API: config.APIConfig{
+						Name: "coinmarketcap_api",
+						Endpoints: []config.Endpoint{
+							{
+								URL: "https://pro-api.coinmarketcap.com",
+								Authentication: config.Authentication{
+									APIKey:       "secret",
+									APIKeyHeader: "X-CMC_PRO_API_KEY",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithConfig(cfg))
+		resp, err := srv.Config(context.Background(), &stypes.QueryConfigRequest{})
+		require.NoError(t, err)
+
+		var got config.OracleConfig
+		require.NoError(t, json.Unmarshal([]byte(resp.Config), &got))
+		require.Equal(t, cfg.Redacted(), got)
+		require.NotContains(t, resp.Config, "secret")
+		require.Equal(t, "secret", cfg.Providers[0].API.Endpoints[0].Authentication.APIKey)
+	})
+
+	t.Run("returns an error if the config is not set", func(t *testing.T) {
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop())
+		_, err := srv.Config(context.Background(), &stypes.QueryConfigRequest{})
+		require.ErrorIs(t, err, server.ErrConfigNotSet)
+	})
+}
+
+type killSwitch struct {
+	providers map[string]bool
+}
+
+func (k *killSwitch) SetProviderKilled(name string, killed bool) error {
+	if _, ok := k.providers[name]; !ok {
+		return fmt.Errorf("provider %s is not configured", name)
+	}
+
+	k.providers[name] = killed
+	return nil
+}
+
+func (k *killSwitch) GetKilledProviders() []string {
+	killed := make([]string, 0)
+	for name, ok := range k.providers {
+		if ok {
+			killed = append(killed, name)
+		}
+	}
+
+	return killed
+}
+
+func TestOracleServerSetProviderKilled(t *testing.T) {
+	const token = "token"
+
+	// authenticated returns a request context that presents the given bearer token.
+	authenticated := func(token string) context.Context {
+		return metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("authorization", "Bearer "+token),
+		)
+	}
+
+	t.Run("kills and revives providers", func(t *testing.T) {
+		ks := &killSwitch{providers: map[string]bool{"binance_api": false}}
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithProviderKillSwitch(ks, token))
+
+		resp, err := srv.SetProviderKilled(authenticated(token), &stypes.SetProviderKilledRequest{
+			Provider: "binance_api",
+			Killed:   true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"binance_api"}, resp.KilledProviders)
+
+		resp, err = srv.SetProviderKilled(authenticated(token), &stypes.SetProviderKilledRequest{
+			Provider: "binance_api",
+			Killed:   false,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.KilledProviders)
+	})
+
+	t.Run("returns an error for an unknown provider", func(t *testing.T) {
+		ks := &killSwitch{providers: map[string]bool{}}
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithProviderKillSwitch(ks, token))
+
+		_, err := srv.SetProviderKilled(authenticated(token), &stypes.SetProviderKilledRequest{
+			Provider: "binance_api",
+			Killed:   true,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("rejects requests without the bearer token", func(t *testing.T) {
+		ks := &killSwitch{providers: map[string]bool{"binance_api": false}}
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithProviderKillSwitch(ks, token))
+
+		for _, ctx := range []context.Context{
+			context.Background(),
+			authenticated("wrong"),
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token)),
+		} {
+			_, err := srv.SetProviderKilled(ctx, &stypes.SetProviderKilledRequest{
+				Provider: "binance_api",
+				Killed:   true,
+			})
+			require.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+		require.Empty(t, ks.GetKilledProviders())
+	})
+
+	t.Run("authenticates HTTP requests with the authorization header", func(t *testing.T) {
+		const killSwitchPort = "8094"
+
+		mockOracle := mocks.NewOracle(t)
+		mockOracle.On("Start", mock.Anything).Return(nil)
+		mockOracle.On("IsRunning").Return(true).Maybe()
+
+		ks := &killSwitch{providers: map[string]bool{"binance_api": false}}
+		srv := server.NewOracleServer(mockOracle, zap.NewNop(), server.WithProviderKillSwitch(ks, token))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go srv.StartServer(ctx, localhost, killSwitchPort)
+
+		post := func(auth string) (int, error) {
+			req, err := http.NewRequest(
+				http.MethodPost,
+				fmt.Sprintf("http://%s:%s/slinky/oracle/v1/provider_kill_switch", localhost, killSwitchPort),
+				strings.NewReader(`{"provider":"binance_api","killed":true}`),
+			)
+			require.NoError(t, err)
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return 0, err
+			}
+			defer resp.Body.Close()
+
+			return resp.StatusCode, nil
+		}
+
+		require.Eventually(t, func() bool {
+			code, err := post("")
+			return err == nil && code == http.StatusUnauthorized
+		}, 5*time.Second, 100*time.Millisecond)
+		require.Empty(t, ks.GetKilledProviders())
+
+		code, err := post("Bearer " + token)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []string{"binance_api"}, ks.GetKilledProviders())
+
+		cancel()
+		select {
+		case <-srv.Done():
+		case <-time.After(2 * time.Second):
+			t.Fatal("server failed to stop")
+		}
+	})
+
+	t.Run("returns an error if the kill switch is not set", func(t *testing.T) {
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop())
+		_, err := srv.SetProviderKilled(context.Background(), &stypes.SetProviderKilledRequest{
+			Provider: "binance_api",
+			Killed:   true,
+		})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+type auditor map[string]types.AggregationAudit
+
+func (a auditor) GetAggregationAudit() map[string]types.AggregationAudit {
+	return a
+}
+
+func TestOracleServerAggregationAudit(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	audits := auditor{
+		"BTC/USD": {
+			Timestamp: timestamp,
+			Providers: []types.ProviderAudit{
+				{Provider: "binance_api", OffChainTicker: "BTCUSDT", Included: true},
+				{Provider: "kraken_api", OffChainTicker: "XXBTZUSD", Reason: types.ExclusionStale},
+			},
+		},
+		"ETH/USD": {
+			Timestamp:      timestamp,
+			WithheldReason: "insufficient converted prices",
+		},
+	}
+
+	t.Run("returns the audit of every pair", func(t *testing.T) {
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithAggregationAuditor(audits))
+
+		resp, err := srv.AggregationAudit(context.Background(), &stypes.QueryAggregationAuditRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Audits, 2)
+		require.Equal(t, stypes.AggregationAudit{
+			Timestamp: timestamp,
+			Providers: []stypes.ProviderAudit{
+				{Provider: "binance_api", OffChainTicker: "BTCUSDT", Included: true},
+				{Provider: "kraken_api", OffChainTicker: "XXBTZUSD", Reason: "stale"},
+			},
+		}, resp.Audits["BTC/USD"])
+		require.Equal(t, "insufficient converted prices", resp.Audits["ETH/USD"].WithheldReason)
+	})
+
+	t.Run("returns the audit of the requested pairs", func(t *testing.T) {
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop(), server.WithAggregationAuditor(audits))
+
+		resp, err := srv.AggregationAudit(context.Background(), &stypes.QueryAggregationAuditRequest{
+			Pairs: []string{"ETH/USD", "SOL/USD"},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Audits, 1)
+		require.Contains(t, resp.Audits, "ETH/USD")
+	})
+
+	t.Run("returns an error if the auditor is not set", func(t *testing.T) {
+		srv := server.NewOracleServer(mocks.NewOracle(t), zap.NewNop())
+		_, err := srv.AggregationAudit(context.Background(), &stypes.QueryAggregationAuditRequest{})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
 // test that the oracle server closes when expected.
 func (s *ServerTestSuite) TestOracleServerClose() {
 	// close the server, and check that no requests are received
@@ -207,3 +815,889 @@ func TestOracleFailureStopsServer(t *testing.T) {
 		t.Fatal("server failed to stop")
 	}
 }
+
+func TestOracleServerMaxConnections(t *testing.T) {
+	const limitedPort = "8081"
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{})
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	// connections past the limit never count towards the number of open connections
+	serverMetrics := oraclemetricsmocks.NewMetrics(t)
+	serverMetrics.On("SetServerConnections", mock.MatchedBy(func(conns int) bool {
+		return conns >= 0 && conns <= 1
+	})).Return()
+
+	srv := server.NewOracleServer(
+		mockOracle,
+		zap.NewNop(),
+		server.WithMaxConnections(1),
+		server.WithMetrics(serverMetrics),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, limitedPort)
+
+	newClient := func() client.OracleClient {
+		c, err := client.NewClient(
+			log.NewTestLogger(t),
+			localhost+":"+limitedPort,
+			timeout,
+			metrics.NewNopMetrics(),
+			client.WithBlockingDial(),
+		)
+		require.NoError(t, err)
+
+		dialCtx, dialCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer dialCancel()
+		require.NoError(t, c.Start(dialCtx))
+
+		return c
+	}
+
+	// the first connection is served
+	first := newClient()
+
+	_, err := first.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.NoError(t, err)
+
+	// connections past the limit are rejected
+	second := newClient()
+	_, err = second.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	httpResp, err := http.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/prices", localhost, limitedPort))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, httpResp.StatusCode)
+	require.NoError(t, httpResp.Body.Close())
+
+	// the rejected connection is closed, so the rejected client reconnects and is served once
+	// a slot frees up
+	require.NoError(t, first.Stop())
+	require.Eventually(t, func() bool {
+		_, err := second.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	require.NoError(t, second.Stop())
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func TestOracleServerListenAddresses(t *testing.T) {
+	const tcpPort = "8086"
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{})
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop())
+
+	// at least one valid listen address is required
+	require.Error(t, srv.StartServerOnAddresses(context.Background()))
+	require.Error(t, srv.StartServerOnAddresses(context.Background(), "unix://"))
+
+	socket := filepath.Join(t.TempDir(), "slinky.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServerOnAddresses(ctx, net.JoinHostPort(localhost, tcpPort), "unix://"+socket)
+
+	// the same oracle is served on every address
+	for _, addr := range []string{net.JoinHostPort(localhost, tcpPort), "unix://" + socket} {
+		c, err := client.NewClient(
+			log.NewTestLogger(t),
+			addr,
+			timeout,
+			metrics.NewNopMetrics(),
+			client.WithBlockingDial(),
+		)
+		require.NoError(t, err)
+
+		dialCtx, dialCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		require.NoError(t, c.Start(dialCtx))
+		dialCancel()
+
+		require.Eventually(t, func() bool {
+			_, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+			return err == nil
+		}, 5*time.Second, 100*time.Millisecond, addr)
+		require.NoError(t, c.Stop())
+	}
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func TestOracleServerStartupJitter(t *testing.T) {
+	const jitteredPort = "8084"
+
+	require.Panics(t, func() { server.WithStartupJitter(-time.Second) })
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil).Maybe()
+	mockOracle.On("Stop").Return().Maybe()
+
+	// the server does not accept requests while the startup jitter elapses, but can be stopped
+	srv := server.NewOracleServer(mockOracle, zap.NewNop(), server.WithStartupJitter(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, jitteredPort)
+
+	require.Never(t, func() bool {
+		conn, err := net.Dial("tcp", net.JoinHostPort(localhost, jitteredPort))
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, time.Second, 100*time.Millisecond)
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+// payloadSizes records the wire and uncompressed sizes of the payloads received by a client.
+type payloadSizes struct {
+	mu                   sync.Mutex
+	compressed, expanded int
+}
+
+func (p *payloadSizes) TagRPC(ctx context.Context, _ *grpcstats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (p *payloadSizes) HandleRPC(_ context.Context, s grpcstats.RPCStats) {
+	if in, ok := s.(*grpcstats.InPayload); ok {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.compressed += in.CompressedLength
+		p.expanded += in.Length
+	}
+}
+
+func (p *payloadSizes) TagConn(ctx context.Context, _ *grpcstats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (p *payloadSizes) HandleConn(context.Context, grpcstats.ConnStats) {}
+
+func TestOracleServerCompression(t *testing.T) {
+	const compressedPort = "8085"
+
+	prices := make(types.Prices)
+	for i := 0; i < 100; i++ {
+		prices[fmt.Sprintf("TOKEN%d/USD", i)] = big.NewFloat(100.1)
+	}
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(prices)
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, compressedPort)
+
+	// responses are compressed for clients that compress their requests
+	sizes := &payloadSizes{}
+	conn, err := grpc.NewClient(
+		net.JoinHostPort(localhost, compressedPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)),
+		grpc.WithStatsHandler(sizes),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Eventually(t, func() bool {
+		resp, err := stypes.NewOracleClient(conn).Prices(context.Background(), &stypes.QueryPricesRequest{})
+		return err == nil && len(resp.Prices) == len(prices)
+	}, 5*time.Second, 100*time.Millisecond)
+
+	sizes.mu.Lock()
+	require.Less(t, sizes.compressed, sizes.expanded)
+	sizes.mu.Unlock()
+
+	// the oracle client compresses its requests if configured to
+	c, err := client.NewClient(
+		log.NewTestLogger(t),
+		net.JoinHostPort(localhost, compressedPort),
+		timeout,
+		metrics.NewNopMetrics(),
+		client.WithBlockingDial(),
+		client.WithCompression(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.Start(context.Background()))
+	defer c.Stop()
+
+	resp, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Prices, len(prices))
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func TestOracleServerStreamPrices(t *testing.T) {
+	const streamPort = "8088"
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{"BTC/USD": big.NewFloat(100)}).Once()
+	mockOracle.On("GetPrices").Return(types.Prices{"BTC/USD": big.NewFloat(101)})
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	broadcaster := server.NewPriceBroadcaster()
+	srv := server.NewOracleServer(mockOracle, zap.NewNop(), server.WithPriceBroadcaster(broadcaster))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, streamPort)
+
+	c, err := client.NewClient(
+		log.NewTestLogger(t),
+		net.JoinHostPort(localhost, streamPort),
+		timeout,
+		metrics.NewNopMetrics(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.Start(context.Background()))
+	defer c.Stop()
+
+	grpcClient, ok := c.(*client.GRPCClient)
+	require.True(t, ok)
+
+	streamCtx, streamCancel := context.WithCancel(context.Background())
+	prices, err := grpcClient.SubscribePrices(streamCtx)
+	require.NoError(t, err)
+
+	// the current prices are sent as soon as the stream is opened
+	select {
+	case resp := <-prices:
+		require.Equal(t, map[string]string{"BTC/USD": "100"}, resp.Prices)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no prices received")
+	}
+
+	// the prices of each subsequent aggregation cycle are pushed to the stream
+	broadcaster.ObservePrices(types.Prices{"BTC/USD": big.NewFloat(101)}, time.Now())
+	select {
+	case resp := <-prices:
+		require.Equal(t, map[string]string{"BTC/USD": "101"}, resp.Prices)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no prices received")
+	}
+
+	// the channel is closed once the context is cancelled
+	streamCancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-prices
+		return !ok
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesNotEnabled() {
+	grpcClient, ok := s.client.(*client.GRPCClient)
+	s.Require().True(ok)
+
+	// streams are rejected permanently if the server has no price broadcaster
+	stream, err := grpcClient.StreamPrices(s.ctx, &stypes.StreamPricesRequest{})
+	s.Require().NoError(err)
+	_, err = stream.Recv()
+	s.Require().Equal(codes.Unimplemented, status.Code(err))
+
+	prices, err := grpcClient.SubscribePrices(s.ctx)
+	s.Require().NoError(err)
+	select {
+	case _, ok := <-prices:
+		s.Require().False(ok)
+	case <-time.After(2 * time.Second):
+		s.T().Fatal("price stream was not closed")
+	}
+}
+
+func TestOracleServerGracefulStop(t *testing.T) {
+	const drainPort = "8092"
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{"BTC/USD": big.NewFloat(100)}).Once()
+	mockOracle.On("GetPrices").Run(func(_ mock.Arguments) {
+		started <- struct{}{}
+		<-release
+	}).Return(types.Prices{"BTC/USD": big.NewFloat(101)}).Once()
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop(), server.WithPriceBroadcaster(server.NewPriceBroadcaster()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, drainPort)
+
+	// the client serializes its requests, so requests made while another is in flight use their own
+	// client, connected before the server starts draining
+	newClient := func() *client.GRPCClient {
+		c, err := client.NewClient(
+			log.NewTestLogger(t),
+			net.JoinHostPort(localhost, drainPort),
+			5*time.Second,
+			metrics.NewNopMetrics(),
+			client.WithBlockingDial(),
+		)
+		require.NoError(t, err)
+		require.NoError(t, c.Start(context.Background()))
+		t.Cleanup(func() { _ = c.Stop() })
+
+		grpcClient, ok := c.(*client.GRPCClient)
+		require.True(t, ok)
+		return grpcClient
+	}
+	c, grpcClient := newClient(), newClient()
+
+	// open a price stream, which is ended once the server starts draining
+	stream, err := grpcClient.StreamPrices(context.Background(), &stypes.StreamPricesRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	// start a request that is in flight while the server drains
+	type result struct {
+		resp *stypes.QueryPricesResponse
+		err  error
+	}
+	inFlight := make(chan result, 1)
+	go func() {
+		resp, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		inFlight <- result{resp, err}
+	}()
+	<-started
+
+	stopped := make(chan error, 1)
+	go func() {
+		stopCtx, stopCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer stopCancel()
+		stopped <- srv.GracefulStop(stopCtx)
+	}()
+
+	// open streams are ended and new requests are rejected while draining
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = grpcClient.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	select {
+	case <-stopped:
+		t.Fatal("server stopped with requests in flight")
+	default:
+	}
+
+	// the request in flight completes, after which the server stops
+	close(release)
+	res := <-inFlight
+	require.NoError(t, res.err)
+	require.Equal(t, "101", res.resp.Prices["BTC/USD"])
+
+	select {
+	case err := <-stopped:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func TestOracleServerGracefulStopTimeout(t *testing.T) {
+	const drainPort = "8093"
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Run(func(_ mock.Arguments) {
+		started <- struct{}{}
+		<-release
+	}).Return(types.Prices{"BTC/USD": big.NewFloat(100)}).Maybe()
+	mockOracle.On("GetLastSyncTime").Return(time.Now()).Maybe()
+	mockOracle.On("GetMissingPrices").Return(nil, nil).Maybe()
+	mockOracle.On("GetPriceInfo").Return(nil).Maybe()
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, drainPort)
+
+	c, err := client.NewClient(
+		log.NewTestLogger(t),
+		net.JoinHostPort(localhost, drainPort),
+		5*time.Second,
+		metrics.NewNopMetrics(),
+		client.WithBlockingDial(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.Start(context.Background()))
+	defer c.Stop()
+
+	inFlight := make(chan error, 1)
+	go func() {
+		_, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		inFlight <- err
+	}()
+	<-started
+
+	// the server is closed once the timeout elapses, aborting the request in flight
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer stopCancel()
+	require.ErrorIs(t, srv.GracefulStop(stopCtx), context.DeadlineExceeded)
+
+	select {
+	case <-srv.Done():
+	default:
+		t.Fatal("server was not closed")
+	}
+
+	select {
+	case err := <-inFlight:
+		require.Error(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("request in flight was not aborted")
+	}
+}
+
+func TestOracleClientNotConnected(t *testing.T) {
+	const lazyPort = "8089"
+
+	c, err := client.NewClient(
+		log.NewTestLogger(t),
+		net.JoinHostPort(localhost, lazyPort),
+		timeout,
+		metrics.NewNopMetrics(),
+		client.WithDialTimeout(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// calls fail before the client is started
+	_, err = c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.ErrorIs(t, err, client.ErrClientNotStarted)
+
+	// the client starts without blocking, although the server is not up yet
+	require.NoError(t, c.Start(context.Background()))
+	defer c.Stop()
+
+	// calls fail fast while the connection is not established
+	start := time.Now()
+	_, err = c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.ErrorIs(t, err, client.ErrClientNotConnected)
+	require.Less(t, time.Since(start), timeout)
+
+	// the client connects once the server is up
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{"BTC/USD": big.NewFloat(100)})
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, lazyPort)
+
+	require.Eventually(t, func() bool {
+		resp, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		return err == nil && resp.Prices["BTC/USD"] == "100"
+	}, 30*time.Second, 100*time.Millisecond)
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func TestOracleClientMaxRetries(t *testing.T) {
+	const unusedPort = "8090"
+
+	c, err := client.NewClient(
+		log.NewTestLogger(t),
+		net.JoinHostPort(localhost, unusedPort),
+		timeout,
+		metrics.NewNopMetrics(),
+		client.WithBlockingDial(),
+		client.WithDialTimeout(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// a blocking dial fails once the dial timeout elapses
+	require.Error(t, c.Start(context.Background()))
+
+	c, err = client.NewClient(
+		log.NewTestLogger(t),
+		net.JoinHostPort(localhost, unusedPort),
+		timeout,
+		metrics.NewNopMetrics(),
+		client.WithMaxRetries(1),
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.Start(context.Background()))
+	defer c.Stop()
+
+	// the client gives up once the max retries are exceeded
+	require.Eventually(t, func() bool {
+		_, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+		return err != nil && strings.Contains(err.Error(), "shutdown")
+	}, 30*time.Second, 100*time.Millisecond)
+	_, err = c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.ErrorIs(t, err, client.ErrClientNotConnected)
+}
+
+func TestOracleServerTLS(t *testing.T) {
+	const tlsPort = "8091"
+
+	cert, roots := newTestCertificate(t, localhost)
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{"BTC/USD": big.NewFloat(100)})
+	mockOracle.On("GetLastSyncTime").Return(time.Now())
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop(), server.WithTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, tlsPort)
+
+	newClient := func(opts ...client.Option) client.OracleClient {
+		opts = append(opts, client.WithBlockingDial(), client.WithDialTimeout(time.Second))
+		c, err := client.NewClient(
+			log.NewTestLogger(t),
+			net.JoinHostPort(localhost, tlsPort),
+			timeout,
+			metrics.NewNopMetrics(),
+			opts...,
+		)
+		require.NoError(t, err)
+
+		return c
+	}
+
+	// a client that trusts the certificate of the server is served over TLS
+	c := newClient(client.WithTLS(&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}))
+	require.Eventually(t, func() bool {
+		return c.Start(context.Background()) == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	defer c.Stop()
+
+	resp, err := c.Prices(context.Background(), &stypes.QueryPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"BTC/USD": "100"}, resp.Prices)
+
+	// a client that does not trust the certificate of the server fails to connect
+	require.Error(t, newClient(client.WithTLS(&tls.Config{MinVersion: tls.VersionTLS12})).Start(context.Background()))
+
+	// a client that expects a different server name fails to connect
+	require.Error(t, newClient(client.WithTLS(&tls.Config{
+		RootCAs:    roots,
+		ServerName: "oracle.example.com",
+		MinVersion: tls.VersionTLS12,
+	})).Start(context.Background()))
+
+	// a plaintext client fails to connect
+	require.Error(t, newClient().Start(context.Background()))
+
+	// the client must verify the certificate of the server
+	require.Panics(t, func() {
+		client.WithTLS(&tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+	})
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+// newTestCertificate returns a self-signed certificate for the given host, along with a pool
+// containing the certificate.
+func newTestCertificate(t *testing.T, host string) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: host},
+		DNSNames:              []string{host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, roots
+}
+
+func TestOracleServerETag(t *testing.T) {
+	const etagPort = "8087"
+
+	var (
+		mu       sync.Mutex
+		lastSync = time.Now()
+	)
+
+	mockOracle := mocks.NewOracle(t)
+	mockOracle.On("Start", mock.Anything).Return(nil)
+	mockOracle.On("IsRunning").Return(true)
+	mockOracle.On("GetPrices").Return(types.Prices{"BTC/USD": big.NewFloat(100)})
+	mockOracle.On("GetLastSyncTime").Return(func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return lastSync
+	})
+	mockOracle.On("GetMissingPrices").Return(nil, nil)
+	mockOracle.On("GetPriceInfo").Return(nil)
+	mockOracle.On("GetProviderHealth").Return(nil)
+
+	srv := server.NewOracleServer(mockOracle, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go srv.StartServer(ctx, localhost, etagPort)
+
+	get := func(path, ifNoneMatch string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s:%s%s", localhost, etagPort, path), nil)
+		require.NoError(t, err)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+
+		return resp
+	}
+
+	// the prices are served with an etag
+	var resp *http.Response
+	require.Eventually(t, func() bool {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s:%s/slinky/oracle/v1/prices", localhost, etagPort), nil)
+		require.NoError(t, err)
+
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			return false
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+
+		return resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 100*time.Millisecond)
+
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// the prices are not served again until the oracle aggregates new prices
+	resp = get("/slinky/oracle/v1/prices", etag)
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+	require.Equal(t, etag, resp.Header.Get("ETag"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Empty(t, body)
+
+	resp = get("/slinky/oracle/v1/price_envelopes", fmt.Sprintf(`"other", W/%s`, etag))
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	resp = get("/slinky/oracle/v1/prices", `"other"`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// other endpoints are not served with an etag
+	resp = get("/slinky/oracle/v1/provider_health", etag)
+	require.Empty(t, resp.Header.Get("ETag"))
+
+	// once the oracle aggregates new prices, they are served with a new etag
+	mu.Lock()
+	lastSync = lastSync.Add(time.Second)
+	mu.Unlock()
+
+	resp = get("/slinky/oracle/v1/prices", etag)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get("ETag"))
+	require.NotEqual(t, etag, resp.Header.Get("ETag"))
+
+	cancel()
+	select {
+	case <-srv.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("server failed to stop")
+	}
+}
+
+func TestRescalePrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		price    *big.Float
+		decimals uint64
+		scale    *stypes.PriceScale
+		expected string
+		err      bool
+	}{
+		{
+			name:     "increases decimals",
+			price:    big.NewFloat(1234.5),
+			decimals: 2,
+			scale:    &stypes.PriceScale{Decimals: 5},
+			expected: "1234500",
+		},
+		{
+			name:     "rounds down by default",
+			price:    big.NewFloat(125),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 0, MinSignificantDigits: 1},
+			expected: "12",
+		},
+		{
+			name:     "rounds up",
+			price:    big.NewFloat(121),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 0, RoundingMode: stypes.RoundingMode_ROUNDING_MODE_UP, MinSignificantDigits: 1},
+			expected: "13",
+		},
+		{
+			name:     "rounds half up",
+			price:    big.NewFloat(125),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 0, RoundingMode: stypes.RoundingMode_ROUNDING_MODE_HALF_UP, MinSignificantDigits: 1},
+			expected: "13",
+		},
+		{
+			name:     "rounds half even",
+			price:    big.NewFloat(125),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 0, RoundingMode: stypes.RoundingMode_ROUNDING_MODE_HALF_EVEN, MinSignificantDigits: 1},
+			expected: "12",
+		},
+		{
+			name:     "rounds negative prices away from zero",
+			price:    big.NewFloat(-135),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 0, RoundingMode: stypes.RoundingMode_ROUNDING_MODE_HALF_EVEN, MinSignificantDigits: 1},
+			expected: "-14",
+		},
+		{
+			name:     "prices with few significant digits only retain them",
+			price:    big.NewFloat(15),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 1},
+			expected: "15",
+		},
+		{
+			name:     "losing significant digits errors",
+			price:    big.NewFloat(12345678),
+			decimals: 4,
+			scale:    &stypes.PriceScale{Decimals: 0},
+			err:      true,
+		},
+		{
+			name:     "rounding a price to zero errors",
+			price:    big.NewFloat(4),
+			decimals: 1,
+			scale:    &stypes.PriceScale{Decimals: 0},
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := server.RescalePrice(tc.price, tc.decimals, tc.scale)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, price.String())
+		})
+	}
+}
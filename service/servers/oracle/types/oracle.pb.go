@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -34,8 +35,54 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RoundingMode defines how a rescaled price is rounded to an integer.
+type RoundingMode int32
+
+const (
+	// ROUNDING_MODE_UNSPECIFIED rounds towards zero.
+	RoundingMode_ROUNDING_MODE_UNSPECIFIED RoundingMode = 0
+	// ROUNDING_MODE_DOWN rounds towards zero.
+	RoundingMode_ROUNDING_MODE_DOWN RoundingMode = 1
+	// ROUNDING_MODE_UP rounds away from zero.
+	RoundingMode_ROUNDING_MODE_UP RoundingMode = 2
+	// ROUNDING_MODE_HALF_UP rounds to the nearest integer, and ties away from
+	// zero.
+	RoundingMode_ROUNDING_MODE_HALF_UP RoundingMode = 3
+	// ROUNDING_MODE_HALF_EVEN rounds to the nearest integer, and ties to the
+	// nearest even integer.
+	RoundingMode_ROUNDING_MODE_HALF_EVEN RoundingMode = 4
+)
+
+var RoundingMode_name = map[int32]string{
+	0: "ROUNDING_MODE_UNSPECIFIED",
+	1: "ROUNDING_MODE_DOWN",
+	2: "ROUNDING_MODE_UP",
+	3: "ROUNDING_MODE_HALF_UP",
+	4: "ROUNDING_MODE_HALF_EVEN",
+}
+
+var RoundingMode_value = map[string]int32{
+	"ROUNDING_MODE_UNSPECIFIED": 0,
+	"ROUNDING_MODE_DOWN":        1,
+	"ROUNDING_MODE_UP":          2,
+	"ROUNDING_MODE_HALF_UP":     3,
+	"ROUNDING_MODE_HALF_EVEN":   4,
+}
+
+func (x RoundingMode) String() string {
+	return proto.EnumName(RoundingMode_name, int32(x))
+}
+
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{0}
+}
+
 // QueryPricesRequest defines the request type for the the Prices method.
 type QueryPricesRequest struct {
+	// scale optionally rescales every price (and TWAP) in the response to a
+	// fixed number of decimals. If unset, each price is scaled by the decimals
+	// of its market.
+	Scale *PriceScale `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
 }
 
 func (m *QueryPricesRequest) Reset()         { *m = QueryPricesRequest{} }
@@ -71,18 +118,107 @@ func (m *QueryPricesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPricesRequest proto.InternalMessageInfo
 
+func (m *QueryPricesRequest) GetScale() *PriceScale {
+	if m != nil {
+		return m.Scale
+	}
+	return nil
+}
+
+// PriceScale defines the number of decimals that prices are rescaled to, and
+// how they are rounded when the rescaling removes decimals.
+type PriceScale struct {
+	// decimals is the number of decimals that every price is scaled by.
+	Decimals uint64 `protobuf:"varint,1,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// rounding_mode is the rounding mode applied when a rescaled price is not an
+	// integer. Defaults to rounding towards zero.
+	RoundingMode RoundingMode `protobuf:"varint,2,opt,name=rounding_mode,json=roundingMode,proto3,enum=slinky.service.v1.RoundingMode" json:"rounding_mode,omitempty"`
+	// min_significant_digits is the minimum number of significant digits that a
+	// rescaled price must retain. Prices that have fewer significant digits at
+	// the decimals of their market only need to retain those. If zero, the
+	// server's default is used.
+	MinSignificantDigits uint32 `protobuf:"varint,3,opt,name=min_significant_digits,json=minSignificantDigits,proto3" json:"min_significant_digits,omitempty"`
+}
+
+func (m *PriceScale) Reset()         { *m = PriceScale{} }
+func (m *PriceScale) String() string { return proto.CompactTextString(m) }
+func (*PriceScale) ProtoMessage()    {}
+func (*PriceScale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{1}
+}
+func (m *PriceScale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceScale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceScale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceScale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceScale.Merge(m, src)
+}
+func (m *PriceScale) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceScale) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceScale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceScale proto.InternalMessageInfo
+
+func (m *PriceScale) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *PriceScale) GetRoundingMode() RoundingMode {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return RoundingMode_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (m *PriceScale) GetMinSignificantDigits() uint32 {
+	if m != nil {
+		return m.MinSignificantDigits
+	}
+	return 0
+}
+
 // QueryPricesResponse defines the response type for the Prices method.
 type QueryPricesResponse struct {
 	// prices defines the list of prices.
 	Prices    map[string]string `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Timestamp time.Time         `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// warming_up defines the list of pairs that do not yet have a price but are
+	// still within the oracle's no-data grace period.
+	WarmingUp []string `protobuf:"bytes,3,rep,name=warming_up,json=warmingUp,proto3" json:"warming_up,omitempty"`
+	// failing defines the list of pairs that do not have a price and have
+	// exceeded the oracle's no-data grace period.
+	Failing []string `protobuf:"bytes,4,rep,name=failing,proto3" json:"failing,omitempty"`
+	// twaps defines the time-weighted average price of each pair that configures
+	// a TWAP in its ticker metadata, scaled by the same decimals as its price.
+	Twaps map[string]string `protobuf:"bytes,5,rep,name=twaps,proto3" json:"twaps" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// spreads defines the spread between the highest and lowest provider prices
+	// that contributed to the price of each pair, scaled by the same decimals as
+	// its price. Spreads are only returned if the oracle computes them.
+	Spreads map[string]string `protobuf:"bytes,6,rep,name=spreads,proto3" json:"spreads" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
 func (m *QueryPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesResponse) ProtoMessage()    {}
 func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{1}
+	return fileDescriptor_e88883d464f0f25b, []int{2}
 }
 func (m *QueryPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,244 +261,5174 @@ func (m *QueryPricesResponse) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+func (m *QueryPricesResponse) GetWarmingUp() []string {
+	if m != nil {
+		return m.WarmingUp
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
+func (m *QueryPricesResponse) GetFailing() []string {
+	if m != nil {
+		return m.Failing
+	}
+	return nil
+}
 
-var fileDescriptor_e88883d464f0f25b = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6f, 0xa3, 0x30,
-	0x14, 0xc4, 0xc9, 0x2e, 0xda, 0x38, 0x97, 0x5d, 0x6f, 0x0e, 0x2c, 0x5a, 0x11, 0x14, 0x69, 0x57,
-	0xb9, 0xac, 0xad, 0xb0, 0x87, 0x7e, 0x1c, 0x91, 0x7a, 0x6e, 0x82, 0x7a, 0xea, 0xa5, 0x22, 0xc8,
-	0xa5, 0x56, 0x00, 0x53, 0x6c, 0x90, 0xb8, 0xb6, 0x7f, 0x20, 0x52, 0xff, 0x54, 0x8e, 0x91, 0x7a,
-	0xe9, 0xa9, 0xad, 0x92, 0xaa, 0xbf, 0xa3, 0x02, 0x93, 0x7e, 0x4b, 0xed, 0x09, 0x8f, 0xe7, 0xbd,
-	0x79, 0xcc, 0xf3, 0x40, 0x4b, 0x44, 0x2c, 0x99, 0x95, 0x44, 0xd0, 0xac, 0x60, 0x01, 0x25, 0xc5,
-	0x88, 0xf0, 0xcc, 0x0f, 0x22, 0x8a, 0xd3, 0x8c, 0x4b, 0x8e, 0x7e, 0x28, 0x1e, 0x37, 0x3c, 0x2e,
-	0x46, 0x66, 0x2f, 0xe4, 0x21, 0xaf, 0x59, 0x52, 0x9d, 0x54, 0xa1, 0xf9, 0x3b, 0xe4, 0x3c, 0x8c,
-	0x28, 0xf1, 0x53, 0x46, 0xfc, 0x24, 0xe1, 0xd2, 0x97, 0x8c, 0x27, 0xa2, 0x61, 0xfb, 0x0d, 0x5b,
-	0xa3, 0x69, 0x7e, 0x4c, 0x24, 0x8b, 0xa9, 0x90, 0x7e, 0x9c, 0x36, 0x05, 0xbf, 0x02, 0x2e, 0x62,
-	0x2e, 0x8e, 0x94, 0xae, 0x02, 0x8a, 0x1a, 0xf4, 0x20, 0x9a, 0xe4, 0x34, 0x2b, 0xc7, 0x19, 0x0b,
-	0xa8, 0xf0, 0xe8, 0x69, 0x4e, 0x85, 0x1c, 0xdc, 0x03, 0xf8, 0xf3, 0xc5, 0xb5, 0x48, 0x79, 0x22,
-	0x28, 0x1a, 0x43, 0x3d, 0xad, 0x6f, 0x0c, 0x60, 0xb7, 0x87, 0x5d, 0xc7, 0xc1, 0x6f, 0x1c, 0xe0,
-	0x77, 0xfa, 0xb0, 0x82, 0x7b, 0x89, 0xcc, 0x4a, 0xf7, 0xcb, 0xe2, 0xba, 0xaf, 0x79, 0x8d, 0x0e,
-	0x72, 0x61, 0xe7, 0xf1, 0x6f, 0x8d, 0x96, 0x0d, 0x86, 0x5d, 0xc7, 0xc4, 0xca, 0x0f, 0xde, 0xf8,
-	0xc1, 0x07, 0x9b, 0x0a, 0xf7, 0x5b, 0xd5, 0x3c, 0xbf, 0xe9, 0x03, 0xef, 0xa9, 0xcd, 0xdc, 0x81,
-	0xdd, 0x67, 0x03, 0xd0, 0x77, 0xd8, 0x9e, 0xd1, 0xd2, 0x00, 0x36, 0x18, 0x76, 0xbc, 0xea, 0x88,
-	0x7a, 0xf0, 0x6b, 0xe1, 0x47, 0x39, 0xad, 0x07, 0x74, 0x3c, 0x05, 0x76, 0x5b, 0xdb, 0xc0, 0x39,
-	0x07, 0x50, 0xdf, 0xaf, 0x9f, 0x04, 0x95, 0x50, 0x57, 0x2a, 0xe8, 0xcf, 0x47, 0xae, 0xea, 0x25,
-	0x99, 0x7f, 0x3f, 0x67, 0x7e, 0x60, 0x9f, 0x5d, 0xde, 0x5d, 0xb4, 0x4c, 0x64, 0x90, 0x26, 0x0e,
-	0x2a, 0x03, 0x55, 0x1a, 0xd4, 0x12, 0xdc, 0xc9, 0x62, 0x65, 0x81, 0xe5, 0xca, 0x02, 0xb7, 0x2b,
-	0x0b, 0xcc, 0xd7, 0x96, 0xb6, 0x5c, 0x5b, 0xda, 0xd5, 0xda, 0xd2, 0x0e, 0xb7, 0x42, 0x26, 0x4f,
-	0xf2, 0x29, 0x0e, 0x78, 0x4c, 0xc4, 0x8c, 0xa5, 0xff, 0x62, 0x5a, 0x90, 0x57, 0xa9, 0xaa, 0xbe,
-	0x34, 0x13, 0x1b, 0x59, 0x59, 0xa6, 0x54, 0x4c, 0xf5, 0x7a, 0x79, 0xff, 0x1f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x0e, 0xe7, 0x89, 0x62, 0x83, 0x02, 0x00, 0x00,
+func (m *QueryPricesResponse) GetTwaps() map[string]string {
+	if m != nil {
+		return m.Twaps
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPricesResponse) GetSpreads() map[string]string {
+	if m != nil {
+		return m.Spreads
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// PriceEnvelope defines a single aggregated price along with the metadata
+// needed to interpret it. Envelopes are versioned by their fully qualified
+// type URL and are served packed into a google.protobuf.Any so that consumers
+// can route them generically.
+type PriceEnvelope struct {
+	// currency_pair defines the currency pair of the price e.g. BTC/USD.
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// price defines the scaled price of the currency pair.
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// timestamp defines the time at which the oracle last updated its prices.
+	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// decimals defines the number of decimals the price is scaled by.
+	Decimals uint64 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// providers defines the providers that contributed to the price.
+	Providers []string `protobuf:"bytes,5,rep,name=providers,proto3" json:"providers,omitempty"`
+	// source defines the provider that the price is attributed to, if the price
+	// was selected from a single provider's price.
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+}
 
-// OracleClient is the client API for Oracle service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type OracleClient interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+func (m *PriceEnvelope) Reset()         { *m = PriceEnvelope{} }
+func (m *PriceEnvelope) String() string { return proto.CompactTextString(m) }
+func (*PriceEnvelope) ProtoMessage()    {}
+func (*PriceEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{3}
+}
+func (m *PriceEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceEnvelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceEnvelope.Merge(m, src)
+}
+func (m *PriceEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceEnvelope.DiscardUnknown(m)
 }
 
-type oracleClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_PriceEnvelope proto.InternalMessageInfo
+
+func (m *PriceEnvelope) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
 }
 
-func NewOracleClient(cc grpc1.ClientConn) OracleClient {
-	return &oracleClient{cc}
+func (m *PriceEnvelope) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
-	out := new(QueryPricesResponse)
-	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Prices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PriceEnvelope) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return time.Time{}
 }
 
-// OracleServer is the server API for Oracle service.
-type OracleServer interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+func (m *PriceEnvelope) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
 }
 
-// UnimplementedOracleServer can be embedded to have forward compatible implementations.
-type UnimplementedOracleServer struct {
+func (m *PriceEnvelope) GetProviders() []string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
 }
 
-func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+func (m *PriceEnvelope) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
-	s.RegisterService(&_Oracle_serviceDesc, srv)
+// QueryPriceEnvelopesRequest defines the request type for the PriceEnvelopes
+// method.
+type QueryPriceEnvelopesRequest struct {
 }
 
-func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OracleServer).Prices(ctx, in)
+func (m *QueryPriceEnvelopesRequest) Reset()         { *m = QueryPriceEnvelopesRequest{} }
+func (m *QueryPriceEnvelopesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceEnvelopesRequest) ProtoMessage()    {}
+func (*QueryPriceEnvelopesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{4}
+}
+func (m *QueryPriceEnvelopesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceEnvelopesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceEnvelopesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.service.v1.Oracle/Prices",
+}
+func (m *QueryPriceEnvelopesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceEnvelopesRequest.Merge(m, src)
+}
+func (m *QueryPriceEnvelopesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceEnvelopesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceEnvelopesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceEnvelopesRequest proto.InternalMessageInfo
+
+// QueryPriceEnvelopesResponse defines the response type for the
+// PriceEnvelopes method.
+type QueryPriceEnvelopesResponse struct {
+	// envelopes defines the list of prices, each a PriceEnvelope packed into a
+	// google.protobuf.Any.
+	Envelopes []*types.Any `protobuf:"bytes,1,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+}
+
+func (m *QueryPriceEnvelopesResponse) Reset()         { *m = QueryPriceEnvelopesResponse{} }
+func (m *QueryPriceEnvelopesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceEnvelopesResponse) ProtoMessage()    {}
+func (*QueryPriceEnvelopesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{5}
+}
+func (m *QueryPriceEnvelopesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceEnvelopesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceEnvelopesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).Prices(ctx, req.(*QueryPricesRequest))
+}
+func (m *QueryPriceEnvelopesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceEnvelopesResponse.Merge(m, src)
+}
+func (m *QueryPriceEnvelopesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceEnvelopesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceEnvelopesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceEnvelopesResponse proto.InternalMessageInfo
+
+func (m *QueryPriceEnvelopesResponse) GetEnvelopes() []*types.Any {
+	if m != nil {
+		return m.Envelopes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Oracle_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "slinky.service.v1.Oracle",
-	HandlerType: (*OracleServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Prices",
-			Handler:    _Oracle_Prices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "slinky/service/v1/oracle.proto",
+// ProviderHealth defines the health of a single provider.
+type ProviderHealth struct {
+	// name defines the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// running defines whether the provider is running.
+	Running bool `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	// connections defines the state of each of the provider's websocket
+	// connections i.e. connected, reconnecting, failed, or disabled. This is
+	// empty for API providers.
+	Connections []string `protobuf:"bytes,3,rep,name=connections,proto3" json:"connections,omitempty"`
+	// last_error defines the most recent error that the provider encountered,
+	// e.g. a request that timed out or a response that could not be parsed. This
+	// is empty if the provider has not encountered an error.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// last_error_time defines the time at which the provider encountered its
+	// most recent error. This is unset if the provider has not encountered an
+	// error.
+	LastErrorTime *time.Time `protobuf:"bytes,5,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time,omitempty"`
 }
 
-func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderHealth) Reset()         { *m = ProviderHealth{} }
+func (m *ProviderHealth) String() string { return proto.CompactTextString(m) }
+func (*ProviderHealth) ProtoMessage()    {}
+func (*ProviderHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{6}
+}
+func (m *ProviderHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ProviderHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderHealth.Merge(m, src)
+}
+func (m *ProviderHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderHealth.DiscardUnknown(m)
 }
 
-func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProviderHealth proto.InternalMessageInfo
+
+func (m *ProviderHealth) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ProviderHealth) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
 }
 
-func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderHealth) GetConnections() []string {
+	if m != nil {
+		return m.Connections
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
 
-func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+func (m *ProviderHealth) GetLastErrorTime() *time.Time {
+	if m != nil {
+		return m.LastErrorTime
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Prices) > 0 {
-		for k := range m.Prices {
-			v := m.Prices[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QueryProviderHealthRequest defines the request type for the ProviderHealth
+// method.
+type QueryProviderHealthRequest struct {
+}
+
+func (m *QueryProviderHealthRequest) Reset()         { *m = QueryProviderHealthRequest{} }
+func (m *QueryProviderHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderHealthRequest) ProtoMessage()    {}
+func (*QueryProviderHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{7}
+}
+func (m *QueryProviderHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderHealthRequest.Merge(m, src)
+}
+func (m *QueryProviderHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderHealthRequest.DiscardUnknown(m)
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryProviderHealthRequest proto.InternalMessageInfo
+
+// QueryProviderHealthResponse defines the response type for the
+// ProviderHealth method.
+type QueryProviderHealthResponse struct {
+	// providers defines the health of each provider, sorted by name.
+	Providers []ProviderHealth `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
+}
+
+func (m *QueryProviderHealthResponse) Reset()         { *m = QueryProviderHealthResponse{} }
+func (m *QueryProviderHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderHealthResponse) ProtoMessage()    {}
+func (*QueryProviderHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{8}
+}
+func (m *QueryProviderHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderHealthResponse.Merge(m, src)
+}
+func (m *QueryProviderHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderHealthResponse proto.InternalMessageInfo
+
+func (m *QueryProviderHealthResponse) GetProviders() []ProviderHealth {
+	if m != nil {
+		return m.Providers
 	}
-	var l int
-	_ = l
-	return n
+	return nil
+}
+
+// QueryConfigRequest defines the request type for the Config method.
+type QueryConfigRequest struct {
+}
+
+func (m *QueryConfigRequest) Reset()         { *m = QueryConfigRequest{} }
+func (m *QueryConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConfigRequest) ProtoMessage()    {}
+func (*QueryConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{9}
+}
+func (m *QueryConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfigRequest.Merge(m, src)
+}
+func (m *QueryConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConfigRequest proto.InternalMessageInfo
+
+// QueryConfigResponse defines the response type for the Config method.
+type QueryConfigResponse struct {
+	// config defines the effective configuration of the oracle, with secrets
+	// redacted, encoded as JSON.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
+func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConfigResponse) ProtoMessage()    {}
+func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{10}
+}
+func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfigResponse.Merge(m, src)
+}
+func (m *QueryConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConfigResponse proto.InternalMessageInfo
+
+func (m *QueryConfigResponse) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+// RefreshPricesRequest defines the request type for the RefreshPrices method.
+type RefreshPricesRequest struct {
+	// pairs defines the list of pairs to refresh e.g. BTC/USD.
+	Pairs []string `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (m *RefreshPricesRequest) Reset()         { *m = RefreshPricesRequest{} }
+func (m *RefreshPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshPricesRequest) ProtoMessage()    {}
+func (*RefreshPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{11}
+}
+func (m *RefreshPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshPricesRequest.Merge(m, src)
+}
+func (m *RefreshPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshPricesRequest proto.InternalMessageInfo
+
+func (m *RefreshPricesRequest) GetPairs() []string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// RefreshPricesResponse defines the response type for the RefreshPrices
+// method.
+type RefreshPricesResponse struct {
+	// prices defines the refreshed price of each requested pair that resolved a
+	// price.
+	Prices    map[string]string `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timestamp time.Time         `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *RefreshPricesResponse) Reset()         { *m = RefreshPricesResponse{} }
+func (m *RefreshPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshPricesResponse) ProtoMessage()    {}
+func (*RefreshPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{12}
+}
+func (m *RefreshPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshPricesResponse.Merge(m, src)
+}
+func (m *RefreshPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshPricesResponse proto.InternalMessageInfo
+
+func (m *RefreshPricesResponse) GetPrices() map[string]string {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *RefreshPricesResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// SetProviderKilledRequest defines the request type for the SetProviderKilled
+// method.
+type SetProviderKilledRequest struct {
+	// provider defines the name of the provider to kill or revive.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// killed defines whether the provider is killed (true) or revived (false).
+	Killed bool `protobuf:"varint,2,opt,name=killed,proto3" json:"killed,omitempty"`
+}
+
+func (m *SetProviderKilledRequest) Reset()         { *m = SetProviderKilledRequest{} }
+func (m *SetProviderKilledRequest) String() string { return proto.CompactTextString(m) }
+func (*SetProviderKilledRequest) ProtoMessage()    {}
+func (*SetProviderKilledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{13}
+}
+func (m *SetProviderKilledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProviderKilledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProviderKilledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProviderKilledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProviderKilledRequest.Merge(m, src)
+}
+func (m *SetProviderKilledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProviderKilledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProviderKilledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProviderKilledRequest proto.InternalMessageInfo
+
+func (m *SetProviderKilledRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *SetProviderKilledRequest) GetKilled() bool {
+	if m != nil {
+		return m.Killed
+	}
+	return false
+}
+
+// SetProviderKilledResponse defines the response type for the
+// SetProviderKilled method.
+type SetProviderKilledResponse struct {
+	// killed_providers defines the names of the providers that are currently
+	// killed, sorted by name.
+	KilledProviders []string `protobuf:"bytes,1,rep,name=killed_providers,json=killedProviders,proto3" json:"killed_providers,omitempty"`
+}
+
+func (m *SetProviderKilledResponse) Reset()         { *m = SetProviderKilledResponse{} }
+func (m *SetProviderKilledResponse) String() string { return proto.CompactTextString(m) }
+func (*SetProviderKilledResponse) ProtoMessage()    {}
+func (*SetProviderKilledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{14}
+}
+func (m *SetProviderKilledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProviderKilledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProviderKilledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProviderKilledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProviderKilledResponse.Merge(m, src)
+}
+func (m *SetProviderKilledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProviderKilledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProviderKilledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProviderKilledResponse proto.InternalMessageInfo
+
+func (m *SetProviderKilledResponse) GetKilledProviders() []string {
+	if m != nil {
+		return m.KilledProviders
+	}
+	return nil
+}
+
+// ProviderAudit defines whether the price of a single provider of a pair was
+// included in the aggregated price of the pair.
+type ProviderAudit struct {
+	// provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// off_chain_ticker defines the off-chain ticker of the provider's price.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// included defines whether the provider's price was included in the
+	// aggregation.
+	Included bool `protobuf:"varint,3,opt,name=included,proto3" json:"included,omitempty"`
+	// last_good defines whether the included price is the provider's last good
+	// price rather than a fresh price.
+	LastGood bool `protobuf:"varint,4,opt,name=last_good,json=lastGood,proto3" json:"last_good,omitempty"`
+	// reason defines the reason the price was excluded i.e. stale,
+	// below_min_volume, adjustment_failed, no_price, conversion_failed,
+	// zero_weight, or decimal_disagreement. This is empty if the price was
+	// included.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// detail defines a human readable description of why the price was
+	// excluded, if available.
+	Detail string `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (m *ProviderAudit) Reset()         { *m = ProviderAudit{} }
+func (m *ProviderAudit) String() string { return proto.CompactTextString(m) }
+func (*ProviderAudit) ProtoMessage()    {}
+func (*ProviderAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{15}
+}
+func (m *ProviderAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderAudit.Merge(m, src)
+}
+func (m *ProviderAudit) XXX_Size() int {
+	return m.Size()
 }
+func (m *ProviderAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderAudit proto.InternalMessageInfo
+
+func (m *ProviderAudit) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderAudit) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
+}
+
+func (m *ProviderAudit) GetIncluded() bool {
+	if m != nil {
+		return m.Included
+	}
+	return false
+}
+
+func (m *ProviderAudit) GetLastGood() bool {
+	if m != nil {
+		return m.LastGood
+	}
+	return false
+}
+
+func (m *ProviderAudit) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ProviderAudit) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+// AggregationAudit defines the audit of the latest aggregation of a pair.
+type AggregationAudit struct {
+	// timestamp defines the time of the aggregation.
+	Timestamp time.Time `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// providers defines the audit of each of the pair's providers, in the order
+	// of the market's provider configs.
+	Providers []ProviderAudit `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
+	// withheld_reason defines the reason the price of the pair was withheld.
+	// This is empty if the pair was priced.
+	WithheldReason string `protobuf:"bytes,3,opt,name=withheld_reason,json=withheldReason,proto3" json:"withheld_reason,omitempty"`
+}
+
+func (m *AggregationAudit) Reset()         { *m = AggregationAudit{} }
+func (m *AggregationAudit) String() string { return proto.CompactTextString(m) }
+func (*AggregationAudit) ProtoMessage()    {}
+func (*AggregationAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{16}
+}
+func (m *AggregationAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregationAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregationAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregationAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregationAudit.Merge(m, src)
+}
+func (m *AggregationAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregationAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregationAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregationAudit proto.InternalMessageInfo
+
+func (m *AggregationAudit) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *AggregationAudit) GetProviders() []ProviderAudit {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *AggregationAudit) GetWithheldReason() string {
+	if m != nil {
+		return m.WithheldReason
+	}
+	return ""
+}
+
+// QueryAggregationAuditRequest defines the request type for the
+// AggregationAudit method.
+type QueryAggregationAuditRequest struct {
+	// pairs defines the list of pairs to return the audit of e.g. BTC/USD. If
+	// empty, the audit of every pair is returned.
+	Pairs []string `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (m *QueryAggregationAuditRequest) Reset()         { *m = QueryAggregationAuditRequest{} }
+func (m *QueryAggregationAuditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregationAuditRequest) ProtoMessage()    {}
+func (*QueryAggregationAuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{17}
+}
+func (m *QueryAggregationAuditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregationAuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregationAuditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregationAuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregationAuditRequest.Merge(m, src)
+}
+func (m *QueryAggregationAuditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregationAuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregationAuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregationAuditRequest proto.InternalMessageInfo
+
+func (m *QueryAggregationAuditRequest) GetPairs() []string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// QueryAggregationAuditResponse defines the response type for the
+// AggregationAudit method.
+type QueryAggregationAuditResponse struct {
+	// audits defines the audit of each requested pair, indexed by pair.
+	Audits map[string]AggregationAudit `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *QueryAggregationAuditResponse) Reset()         { *m = QueryAggregationAuditResponse{} }
+func (m *QueryAggregationAuditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregationAuditResponse) ProtoMessage()    {}
+func (*QueryAggregationAuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{18}
+}
+func (m *QueryAggregationAuditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregationAuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregationAuditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregationAuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregationAuditResponse.Merge(m, src)
+}
+func (m *QueryAggregationAuditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregationAuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregationAuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregationAuditResponse proto.InternalMessageInfo
+
+func (m *QueryAggregationAuditResponse) GetAudits() map[string]AggregationAudit {
+	if m != nil {
+		return m.Audits
+	}
+	return nil
+}
+
+// StreamPricesRequest defines the request type for the StreamPrices method.
+type StreamPricesRequest struct {
+	// scale optionally rescales every price (and TWAP) in each response to a
+	// fixed number of decimals. If unset, each price is scaled by the decimals
+	// of its market.
+	Scale *PriceScale `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (m *StreamPricesRequest) Reset()         { *m = StreamPricesRequest{} }
+func (m *StreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPricesRequest) ProtoMessage()    {}
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{19}
+}
+func (m *StreamPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPricesRequest.Merge(m, src)
+}
+func (m *StreamPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPricesRequest proto.InternalMessageInfo
+
+func (m *StreamPricesRequest) GetScale() *PriceScale {
+	if m != nil {
+		return m.Scale
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("slinky.service.v1.RoundingMode", RoundingMode_name, RoundingMode_value)
+	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
+	proto.RegisterType((*PriceScale)(nil), "slinky.service.v1.PriceScale")
+	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.SpreadsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.TwapsEntry")
+	proto.RegisterType((*PriceEnvelope)(nil), "slinky.service.v1.PriceEnvelope")
+	proto.RegisterType((*QueryPriceEnvelopesRequest)(nil), "slinky.service.v1.QueryPriceEnvelopesRequest")
+	proto.RegisterType((*QueryPriceEnvelopesResponse)(nil), "slinky.service.v1.QueryPriceEnvelopesResponse")
+	proto.RegisterType((*ProviderHealth)(nil), "slinky.service.v1.ProviderHealth")
+	proto.RegisterType((*QueryProviderHealthRequest)(nil), "slinky.service.v1.QueryProviderHealthRequest")
+	proto.RegisterType((*QueryProviderHealthResponse)(nil), "slinky.service.v1.QueryProviderHealthResponse")
+	proto.RegisterType((*QueryConfigRequest)(nil), "slinky.service.v1.QueryConfigRequest")
+	proto.RegisterType((*QueryConfigResponse)(nil), "slinky.service.v1.QueryConfigResponse")
+	proto.RegisterType((*RefreshPricesRequest)(nil), "slinky.service.v1.RefreshPricesRequest")
+	proto.RegisterType((*RefreshPricesResponse)(nil), "slinky.service.v1.RefreshPricesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.RefreshPricesResponse.PricesEntry")
+	proto.RegisterType((*SetProviderKilledRequest)(nil), "slinky.service.v1.SetProviderKilledRequest")
+	proto.RegisterType((*SetProviderKilledResponse)(nil), "slinky.service.v1.SetProviderKilledResponse")
+	proto.RegisterType((*ProviderAudit)(nil), "slinky.service.v1.ProviderAudit")
+	proto.RegisterType((*AggregationAudit)(nil), "slinky.service.v1.AggregationAudit")
+	proto.RegisterType((*QueryAggregationAuditRequest)(nil), "slinky.service.v1.QueryAggregationAuditRequest")
+	proto.RegisterType((*QueryAggregationAuditResponse)(nil), "slinky.service.v1.QueryAggregationAuditResponse")
+	proto.RegisterMapType((map[string]AggregationAudit)(nil), "slinky.service.v1.QueryAggregationAuditResponse.AuditsEntry")
+	proto.RegisterType((*StreamPricesRequest)(nil), "slinky.service.v1.StreamPricesRequest")
+}
+
+func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
+
+var fileDescriptor_e88883d464f0f25b = []byte{
+	// 1458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x13, 0xd7,
+	0x17, 0xcf, 0xc4, 0x8e, 0xb1, 0x4f, 0x12, 0x30, 0x97, 0x90, 0xbf, 0x33, 0x79, 0x99, 0x89, 0x80,
+	0xf0, 0xb2, 0x89, 0x41, 0xfa, 0x03, 0xea, 0x26, 0x21, 0x06, 0xd2, 0x96, 0x24, 0x4c, 0xa0, 0x95,
+	0x58, 0x74, 0x3a, 0xcc, 0x5c, 0xdb, 0x57, 0x19, 0xcf, 0x75, 0xef, 0x8c, 0x13, 0x79, 0xdb, 0x2f,
+	0x50, 0x24, 0x76, 0xdd, 0xb5, 0xea, 0x97, 0xe8, 0xa6, 0xbb, 0x4a, 0x74, 0x87, 0xd4, 0x4d, 0xa5,
+	0x4a, 0x2d, 0x82, 0xae, 0xba, 0xef, 0xbe, 0xba, 0x8f, 0x89, 0xc7, 0xf6, 0x24, 0x31, 0xa0, 0xae,
+	0xe2, 0x73, 0xce, 0x3d, 0xe7, 0xfe, 0xce, 0xfb, 0x4e, 0x60, 0x21, 0xf0, 0x88, 0xbf, 0xdb, 0x29,
+	0x07, 0x98, 0xed, 0x11, 0x07, 0x97, 0xf7, 0x56, 0xca, 0x94, 0xd9, 0x8e, 0x87, 0x4b, 0x2d, 0x46,
+	0x43, 0x8a, 0x4e, 0x4b, 0x79, 0x49, 0xc9, 0x4b, 0x7b, 0x2b, 0xfa, 0x54, 0x9d, 0xd6, 0xa9, 0x90,
+	0x96, 0xf9, 0x2f, 0x79, 0x50, 0x9f, 0xab, 0x53, 0x5a, 0xf7, 0x70, 0xd9, 0x6e, 0x91, 0xb2, 0xed,
+	0xfb, 0x34, 0xb4, 0x43, 0x42, 0xfd, 0x40, 0x49, 0x67, 0x94, 0x54, 0x50, 0xcf, 0xda, 0xb5, 0xb2,
+	0xed, 0x77, 0x94, 0x68, 0xb1, 0x5f, 0x14, 0x92, 0x26, 0x0e, 0x42, 0xbb, 0xd9, 0x8a, 0x74, 0x1d,
+	0x1a, 0x34, 0x69, 0x60, 0xc9, 0x2b, 0x25, 0x21, 0x45, 0xc6, 0x06, 0xa0, 0x47, 0x6d, 0xcc, 0x3a,
+	0xdb, 0x8c, 0x38, 0x38, 0x30, 0xf1, 0x57, 0x6d, 0x1c, 0x84, 0xe8, 0x06, 0x8c, 0x05, 0x8e, 0xed,
+	0xe1, 0x82, 0x56, 0xd4, 0x96, 0xc7, 0x2b, 0xf3, 0xa5, 0x01, 0x1f, 0x4a, 0x42, 0x61, 0x87, 0x1f,
+	0x32, 0xe5, 0x59, 0xe3, 0x07, 0x0d, 0xa0, 0xcb, 0x45, 0x3a, 0x64, 0x5d, 0xec, 0x90, 0xa6, 0xed,
+	0x05, 0xc2, 0x4c, 0xda, 0x3c, 0xa0, 0xd1, 0x3a, 0x4c, 0x32, 0xda, 0xf6, 0x5d, 0xe2, 0xd7, 0xad,
+	0x26, 0x75, 0x71, 0x61, 0xb4, 0xa8, 0x2d, 0x9f, 0xac, 0x2c, 0x26, 0xdc, 0x63, 0xaa, 0x73, 0x0f,
+	0xa9, 0x8b, 0xcd, 0x09, 0x16, 0xa3, 0xd0, 0x4d, 0x98, 0x6e, 0x12, 0xdf, 0x0a, 0x48, 0xdd, 0x27,
+	0x35, 0xe2, 0xd8, 0x7e, 0x68, 0xb9, 0xa4, 0x4e, 0xc2, 0xa0, 0x90, 0x2a, 0x6a, 0xcb, 0x93, 0xe6,
+	0x54, 0x93, 0xf8, 0x3b, 0x5d, 0xe1, 0xba, 0x90, 0x19, 0x3f, 0xa6, 0xe1, 0x4c, 0x8f, 0xcb, 0x41,
+	0x8b, 0xfa, 0x01, 0x46, 0xdb, 0x90, 0x69, 0x09, 0x4e, 0x41, 0x2b, 0xa6, 0x96, 0xc7, 0x2b, 0x95,
+	0x04, 0x30, 0x09, 0x7a, 0x32, 0x10, 0x41, 0xd5, 0x0f, 0x59, 0x67, 0x2d, 0xfd, 0xf2, 0x8f, 0xc5,
+	0x11, 0x53, 0xd9, 0x41, 0x6b, 0x90, 0x3b, 0xc8, 0x84, 0xf0, 0x70, 0xbc, 0xa2, 0x97, 0x64, 0xae,
+	0x4a, 0x51, 0xae, 0x4a, 0x8f, 0xa3, 0x13, 0x6b, 0x59, 0xae, 0xfc, 0xfc, 0xcf, 0x45, 0xcd, 0xec,
+	0xaa, 0xa1, 0x79, 0x80, 0x7d, 0x9b, 0x35, 0x79, 0xa0, 0xda, 0xad, 0x42, 0xaa, 0x98, 0x5a, 0xce,
+	0x99, 0x39, 0xc5, 0x79, 0xd2, 0x42, 0x05, 0x38, 0x51, 0xb3, 0x89, 0x47, 0xfc, 0x7a, 0x21, 0x2d,
+	0x64, 0x11, 0x89, 0x1e, 0xc2, 0x58, 0xb8, 0x6f, 0xb7, 0x82, 0xc2, 0x98, 0xf0, 0x66, 0x65, 0x48,
+	0x6f, 0x1e, 0x73, 0x9d, 0xb8, 0x33, 0xd2, 0x0a, 0xda, 0x81, 0x13, 0x41, 0x8b, 0x61, 0xdb, 0x0d,
+	0x0a, 0x19, 0x61, 0xf0, 0xc6, 0x90, 0x06, 0x77, 0xa4, 0x56, 0xdc, 0x64, 0x64, 0x49, 0xbf, 0x0d,
+	0xe3, 0xb1, 0xe8, 0xa1, 0x3c, 0xa4, 0x76, 0x71, 0x47, 0x14, 0x4b, 0xce, 0xe4, 0x3f, 0xd1, 0x14,
+	0x8c, 0xed, 0xd9, 0x5e, 0x5b, 0xd6, 0x47, 0xce, 0x94, 0xc4, 0x9d, 0xd1, 0x5b, 0x9a, 0x7e, 0x0b,
+	0xa0, 0x0b, 0xf5, 0x9d, 0x34, 0xef, 0xc0, 0x44, 0x1c, 0xd3, 0xbb, 0xe8, 0x1a, 0xaf, 0x35, 0x98,
+	0x14, 0x88, 0xab, 0xfe, 0x1e, 0xf6, 0x68, 0x0b, 0xa3, 0x25, 0x98, 0x74, 0xda, 0x8c, 0x61, 0xdf,
+	0xe9, 0x58, 0x2d, 0x9b, 0x30, 0x65, 0x67, 0x22, 0x62, 0x6e, 0xdb, 0x84, 0x71, 0x83, 0xa2, 0x24,
+	0x22, 0x83, 0x82, 0xe8, 0x2d, 0x8f, 0xd4, 0xfb, 0x95, 0x47, 0xbc, 0xc9, 0xd2, 0x7d, 0x4d, 0x36,
+	0x07, 0xb9, 0x16, 0xa3, 0x7b, 0xc4, 0xc5, 0x4c, 0x56, 0x41, 0xce, 0xec, 0x32, 0xd0, 0x34, 0x64,
+	0x02, 0xda, 0x66, 0x0e, 0x2e, 0x64, 0x04, 0x28, 0x45, 0x19, 0x73, 0xa0, 0x77, 0xd3, 0x18, 0xb9,
+	0x19, 0x0d, 0x06, 0xe3, 0x11, 0xcc, 0x26, 0x4a, 0x55, 0x0f, 0x55, 0x20, 0x87, 0x23, 0xa6, 0x6a,
+	0xa3, 0xa9, 0x01, 0x97, 0x56, 0xfd, 0x8e, 0xd9, 0x3d, 0x66, 0xfc, 0xa2, 0xc1, 0xc9, 0x6d, 0x05,
+	0xeb, 0x01, 0xb6, 0xbd, 0xb0, 0x81, 0x10, 0xa4, 0x7d, 0xbb, 0x89, 0x55, 0x2c, 0xc5, 0x6f, 0x5e,
+	0xe9, 0xac, 0xed, 0xfb, 0xbc, 0xd2, 0x79, 0x14, 0xb3, 0x66, 0x44, 0xa2, 0x22, 0x8c, 0x3b, 0xd4,
+	0xf7, 0xb1, 0x23, 0xc6, 0xa5, 0xea, 0x91, 0x38, 0x8b, 0x37, 0x91, 0x67, 0x07, 0xa1, 0x85, 0x19,
+	0xa3, 0x4c, 0xc4, 0x29, 0x67, 0xe6, 0x38, 0xa7, 0xca, 0x19, 0xe8, 0x01, 0x9c, 0xea, 0x8a, 0x2d,
+	0x1e, 0xdc, 0xc2, 0xd8, 0xb1, 0xe9, 0x48, 0x8b, 0x54, 0x4c, 0x1e, 0x58, 0xe1, 0x92, 0x58, 0xf0,
+	0xe2, 0xfe, 0x44, 0xc1, 0x73, 0x61, 0x36, 0x51, 0xaa, 0x82, 0x57, 0x8d, 0xe7, 0x4b, 0x06, 0xef,
+	0x5c, 0xe2, 0xe0, 0x8d, 0x6b, 0xab, 0x96, 0xea, 0x6a, 0x1a, 0x53, 0x6a, 0xa2, 0xdf, 0xa5, 0x7e,
+	0x8d, 0xd4, 0xa3, 0xbb, 0xaf, 0xc1, 0x99, 0x1e, 0xae, 0xba, 0x73, 0x1a, 0x32, 0x8e, 0xe0, 0xa8,
+	0x58, 0x2b, 0xca, 0xb8, 0x0a, 0x53, 0x26, 0xae, 0x31, 0x1c, 0x34, 0x7a, 0x17, 0x03, 0xaf, 0x64,
+	0x9b, 0x28, 0x7c, 0x39, 0x53, 0x12, 0xc6, 0xdf, 0x1a, 0x9c, 0xed, 0x3b, 0xae, 0xec, 0x9b, 0x7d,
+	0x43, 0xf5, 0x66, 0xd2, 0x84, 0x4f, 0xd2, 0xfc, 0x6f, 0xc7, 0xea, 0x07, 0x4c, 0x1e, 0x63, 0x13,
+	0x0a, 0x3b, 0x38, 0x8c, 0xb2, 0xf0, 0x09, 0xf1, 0x3c, 0xec, 0x46, 0xe1, 0xd1, 0x21, 0x1b, 0x25,
+	0x42, 0x19, 0x3b, 0xa0, 0x79, 0xa8, 0x77, 0xc5, 0x61, 0x55, 0xbf, 0x8a, 0x32, 0xee, 0xc1, 0x4c,
+	0x82, 0x3d, 0x15, 0xbf, 0x4b, 0x90, 0x97, 0xc7, 0xac, 0xde, 0xd2, 0xc8, 0x99, 0xa7, 0x24, 0x7f,
+	0xfb, 0x20, 0xef, 0x3f, 0x89, 0xd9, 0x24, 0xa9, 0xd5, 0xb6, 0x4b, 0x8e, 0x46, 0xb3, 0x0c, 0x79,
+	0x5a, 0xab, 0x59, 0x4e, 0xc3, 0x26, 0xbe, 0x15, 0x12, 0x67, 0x17, 0x33, 0xe5, 0xea, 0x49, 0x5a,
+	0xab, 0xdd, 0xe5, 0xec, 0xc7, 0x82, 0xcb, 0xad, 0x10, 0xdf, 0xf1, 0xda, 0x2e, 0x76, 0xc5, 0x94,
+	0xca, 0x9a, 0x07, 0x34, 0x9a, 0x05, 0xd1, 0x46, 0x56, 0x9d, 0x52, 0x57, 0xf4, 0x55, 0xd6, 0xcc,
+	0x72, 0xc6, 0x7d, 0x4a, 0x5d, 0xee, 0x30, 0xc3, 0x76, 0x40, 0x7d, 0xd1, 0x4d, 0x39, 0x53, 0x51,
+	0x9c, 0xef, 0xe2, 0xd0, 0x26, 0x5e, 0x34, 0x79, 0x24, 0x65, 0xfc, 0xac, 0x41, 0x7e, 0xb5, 0x5e,
+	0x67, 0xb8, 0x2e, 0x1e, 0x3e, 0xd2, 0x87, 0x9e, 0x64, 0x6b, 0xef, 0x37, 0x24, 0xd7, 0xe3, 0x8d,
+	0x35, 0x2a, 0xea, 0xb0, 0x78, 0x44, 0x63, 0x89, 0x8b, 0x07, 0xfa, 0x0a, 0x5d, 0x84, 0x53, 0xfb,
+	0x24, 0x6c, 0x34, 0xb0, 0xe7, 0x5a, 0xca, 0xaf, 0x94, 0x0c, 0x58, 0xc4, 0x36, 0x05, 0xd7, 0xb8,
+	0x09, 0x73, 0xa2, 0xd5, 0xfa, 0x7d, 0x39, 0xba, 0x87, 0x7e, 0xd7, 0x60, 0xfe, 0x10, 0x35, 0x55,
+	0x0b, 0x4f, 0x21, 0x63, 0x73, 0x46, 0xd4, 0x4b, 0x1f, 0x1d, 0xb6, 0x81, 0x0f, 0xb3, 0x50, 0x12,
+	0x54, 0x6f, 0x4f, 0x49, 0x8b, 0xfa, 0x17, 0x30, 0x1e, 0x13, 0x26, 0xf4, 0xc3, 0xed, 0x78, 0x3f,
+	0x8c, 0x57, 0x96, 0x12, 0xee, 0x1e, 0xb8, 0x36, 0xd6, 0x34, 0x1f, 0xc3, 0x99, 0x9d, 0x90, 0x61,
+	0xbb, 0xf9, 0xe1, 0xef, 0xcc, 0xcb, 0x2f, 0x34, 0x98, 0x88, 0xbf, 0x0a, 0xd1, 0x3c, 0xcc, 0x98,
+	0x5b, 0x4f, 0x36, 0xd7, 0x37, 0x36, 0xef, 0x5b, 0x0f, 0xb7, 0xd6, 0xab, 0xd6, 0x93, 0xcd, 0x9d,
+	0xed, 0xea, 0xdd, 0x8d, 0x7b, 0x1b, 0xd5, 0xf5, 0xfc, 0x08, 0x9a, 0x06, 0xd4, 0x2b, 0x5e, 0xdf,
+	0xfa, 0x7c, 0x33, 0xaf, 0xa1, 0x29, 0xc8, 0xf7, 0xa9, 0x6d, 0xe7, 0x47, 0xd1, 0x0c, 0x9c, 0xed,
+	0xe5, 0x3e, 0x58, 0xfd, 0xf4, 0x1e, 0x17, 0xa5, 0xd0, 0x2c, 0xfc, 0x2f, 0x41, 0x54, 0xfd, 0xac,
+	0xba, 0x99, 0x4f, 0x57, 0xfe, 0xc9, 0x42, 0x66, 0x4b, 0xbc, 0xfb, 0x51, 0x07, 0x32, 0xd2, 0x4d,
+	0x74, 0xfe, 0xb8, 0x47, 0x92, 0x08, 0x83, 0x7e, 0x61, 0xb8, 0xb7, 0x94, 0x51, 0xfc, 0xfa, 0xd7,
+	0xbf, 0x5e, 0x8c, 0xea, 0xa8, 0x50, 0x56, 0xdf, 0x1c, 0xf2, 0x43, 0x83, 0x7f, 0x72, 0xa8, 0xd9,
+	0xf8, 0xad, 0x58, 0xa6, 0xf1, 0xdd, 0x8c, 0xae, 0x1d, 0x69, 0xbc, 0x7f, 0xc3, 0xeb, 0xa5, 0x61,
+	0x8f, 0x2b, 0x4c, 0x97, 0x04, 0xa6, 0x25, 0x74, 0xee, 0x10, 0x4c, 0xd6, 0xc1, 0xa6, 0x57, 0xe0,
+	0x7a, 0x36, 0xfd, 0x11, 0xe0, 0x12, 0x36, 0xa8, 0x5e, 0x1a, 0xf6, 0xf8, 0x30, 0xe0, 0xa4, 0x86,
+	0xd5, 0x90, 0x48, 0x3a, 0x90, 0x91, 0xbb, 0xf1, 0xf0, 0xa4, 0xf5, 0x6c, 0x54, 0xfd, 0xc2, 0x71,
+	0xc7, 0x8e, 0x4f, 0x9a, 0x5c, 0xb6, 0xe8, 0x1b, 0x0d, 0x26, 0x7b, 0x96, 0x20, 0xba, 0x78, 0xfc,
+	0x9a, 0x94, 0x20, 0x96, 0x87, 0xdd, 0xa7, 0xc6, 0x15, 0x01, 0xe3, 0xfc, 0x1d, 0xed, 0xb2, 0x51,
+	0x1c, 0x44, 0xc2, 0xa4, 0x8e, 0xa5, 0xca, 0xe8, 0x3b, 0x0d, 0x4e, 0x0f, 0x2c, 0x25, 0x74, 0x25,
+	0xe1, 0xb2, 0xc3, 0x56, 0xa1, 0x7e, 0x75, 0xb8, 0xc3, 0x0a, 0xdd, 0x8a, 0x40, 0x77, 0x85, 0xa3,
+	0xbb, 0x70, 0x44, 0xae, 0xf8, 0xce, 0xb3, 0x82, 0x7d, 0x12, 0x3a, 0x0d, 0xf4, 0x7d, 0xd2, 0xba,
+	0x28, 0x0f, 0x3f, 0x13, 0x25, 0xcc, 0xeb, 0xef, 0x3a, 0x44, 0xa3, 0x40, 0xa2, 0xa5, 0x41, 0x9c,
+	0x76, 0x57, 0xc7, 0x12, 0x83, 0x15, 0x7d, 0x09, 0x13, 0xf1, 0xb9, 0x87, 0x92, 0x8a, 0x26, 0x61,
+	0x30, 0x0e, 0x3b, 0x11, 0xae, 0x6b, 0x6b, 0x8f, 0x5e, 0xbe, 0x59, 0xd0, 0x5e, 0xbd, 0x59, 0xd0,
+	0x5e, 0xbf, 0x59, 0xd0, 0x9e, 0xbf, 0x5d, 0x18, 0x79, 0xf5, 0x76, 0x61, 0xe4, 0xb7, 0xb7, 0x0b,
+	0x23, 0x4f, 0xff, 0x5f, 0x27, 0x61, 0xa3, 0xfd, 0xac, 0xe4, 0xd0, 0x66, 0x39, 0xd8, 0x25, 0xad,
+	0x6b, 0x4d, 0xbc, 0x57, 0xee, 0xfb, 0x67, 0x05, 0xff, 0x8b, 0x59, 0x10, 0xf9, 0x10, 0x76, 0x5a,
+	0x38, 0x78, 0x96, 0x11, 0x8b, 0xf5, 0xc6, 0xbf, 0x03, 0x00, 0x1f, 0x1a, 0x9c, 0x8f, 0xda, 0x10,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OracleClient is the client API for Oracle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OracleClient interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// PriceEnvelopes defines a method for fetching the latest prices, each
+	// wrapped in a PriceEnvelope packed into a google.protobuf.Any.
+	PriceEnvelopes(ctx context.Context, in *QueryPriceEnvelopesRequest, opts ...grpc.CallOption) (*QueryPriceEnvelopesResponse, error)
+	// ProviderHealth defines a method for fetching the health of each provider,
+	// including the state of each of its websocket connections.
+	ProviderHealth(ctx context.Context, in *QueryProviderHealthRequest, opts ...grpc.CallOption) (*QueryProviderHealthResponse, error)
+	// Config defines a method for fetching the effective configuration of the
+	// oracle, with secrets such as API keys redacted.
+	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// RefreshPrices defines a method for immediately fetching and aggregating
+	// the prices of the given pairs, rather than waiting for the next update of
+	// the oracle. Refreshes are rate-limited.
+	RefreshPrices(ctx context.Context, in *RefreshPricesRequest, opts ...grpc.CallOption) (*RefreshPricesResponse, error)
+	// SetProviderKilled defines a method for killing or reviving a provider at
+	// runtime. A killed provider is stopped and its prices are excluded from
+	// the aggregation until it is revived.
+	SetProviderKilled(ctx context.Context, in *SetProviderKilledRequest, opts ...grpc.CallOption) (*SetProviderKilledResponse, error)
+	// AggregationAudit defines a method for fetching, for each pair, whether
+	// each of its providers was included in the latest aggregated price and, if
+	// not, why it was excluded. This is only available if the oracle's
+	// aggregation audit mode is enabled.
+	AggregationAudit(ctx context.Context, in *QueryAggregationAuditRequest, opts ...grpc.CallOption) (*QueryAggregationAuditResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A new
+	// response is sent each time the oracle completes an aggregation cycle, such
+	// that consumers do not need to poll the Prices method.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+}
+
+type oracleClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewOracleClient(cc grpc1.ClientConn) OracleClient {
+	return &oracleClient{cc}
+}
+
+func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error) {
+	out := new(QueryPricesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Prices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) PriceEnvelopes(ctx context.Context, in *QueryPriceEnvelopesRequest, opts ...grpc.CallOption) (*QueryPriceEnvelopesResponse, error) {
+	out := new(QueryPriceEnvelopesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/PriceEnvelopes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) ProviderHealth(ctx context.Context, in *QueryProviderHealthRequest, opts ...grpc.CallOption) (*QueryProviderHealthResponse, error) {
+	out := new(QueryProviderHealthResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/ProviderHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
+	out := new(QueryConfigResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Config", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) RefreshPrices(ctx context.Context, in *RefreshPricesRequest, opts ...grpc.CallOption) (*RefreshPricesResponse, error) {
+	out := new(RefreshPricesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/RefreshPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) SetProviderKilled(ctx context.Context, in *SetProviderKilledRequest, opts ...grpc.CallOption) (*SetProviderKilledResponse, error) {
+	out := new(SetProviderKilledResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/SetProviderKilled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) AggregationAudit(ctx context.Context, in *QueryAggregationAuditRequest, opts ...grpc.CallOption) (*QueryAggregationAuditResponse, error) {
+	out := new(QueryAggregationAuditResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/AggregationAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/slinky.service.v1.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// OracleServer is the server API for Oracle service.
+type OracleServer interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// PriceEnvelopes defines a method for fetching the latest prices, each
+	// wrapped in a PriceEnvelope packed into a google.protobuf.Any.
+	PriceEnvelopes(context.Context, *QueryPriceEnvelopesRequest) (*QueryPriceEnvelopesResponse, error)
+	// ProviderHealth defines a method for fetching the health of each provider,
+	// including the state of each of its websocket connections.
+	ProviderHealth(context.Context, *QueryProviderHealthRequest) (*QueryProviderHealthResponse, error)
+	// Config defines a method for fetching the effective configuration of the
+	// oracle, with secrets such as API keys redacted.
+	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// RefreshPrices defines a method for immediately fetching and aggregating
+	// the prices of the given pairs, rather than waiting for the next update of
+	// the oracle. Refreshes are rate-limited.
+	RefreshPrices(context.Context, *RefreshPricesRequest) (*RefreshPricesResponse, error)
+	// SetProviderKilled defines a method for killing or reviving a provider at
+	// runtime. A killed provider is stopped and its prices are excluded from
+	// the aggregation until it is revived.
+	SetProviderKilled(context.Context, *SetProviderKilledRequest) (*SetProviderKilledResponse, error)
+	// AggregationAudit defines a method for fetching, for each pair, whether
+	// each of its providers was included in the latest aggregated price and, if
+	// not, why it was excluded. This is only available if the oracle's
+	// aggregation audit mode is enabled.
+	AggregationAudit(context.Context, *QueryAggregationAuditRequest) (*QueryAggregationAuditResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A new
+	// response is sent each time the oracle completes an aggregation cycle, such
+	// that consumers do not need to poll the Prices method.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
+}
+
+// UnimplementedOracleServer can be embedded to have forward compatible implementations.
+type UnimplementedOracleServer struct {
+}
+
+func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
+}
+func (*UnimplementedOracleServer) PriceEnvelopes(ctx context.Context, req *QueryPriceEnvelopesRequest) (*QueryPriceEnvelopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceEnvelopes not implemented")
+}
+func (*UnimplementedOracleServer) ProviderHealth(ctx context.Context, req *QueryProviderHealthRequest) (*QueryProviderHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderHealth not implemented")
+}
+func (*UnimplementedOracleServer) Config(ctx context.Context, req *QueryConfigRequest) (*QueryConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
+}
+func (*UnimplementedOracleServer) RefreshPrices(ctx context.Context, req *RefreshPricesRequest) (*RefreshPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPrices not implemented")
+}
+func (*UnimplementedOracleServer) SetProviderKilled(ctx context.Context, req *SetProviderKilledRequest) (*SetProviderKilledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderKilled not implemented")
+}
+func (*UnimplementedOracleServer) AggregationAudit(ctx context.Context, req *QueryAggregationAuditRequest) (*QueryAggregationAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregationAudit not implemented")
+}
+func (*UnimplementedOracleServer) StreamPrices(req *StreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+
+func RegisterOracleServer(s grpc1.Server, srv OracleServer) {
+	s.RegisterService(&_Oracle_serviceDesc, srv)
+}
+
+func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Prices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/Prices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Prices(ctx, req.(*QueryPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_PriceEnvelopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceEnvelopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).PriceEnvelopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/PriceEnvelopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).PriceEnvelopes(ctx, req.(*QueryPriceEnvelopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_ProviderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).ProviderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/ProviderHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).ProviderHealth(ctx, req.(*QueryProviderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_Config_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Config(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/Config",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Config(ctx, req.(*QueryConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_RefreshPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).RefreshPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/RefreshPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).RefreshPrices(ctx, req.(*RefreshPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_SetProviderKilled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProviderKilledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).SetProviderKilled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/SetProviderKilled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).SetProviderKilled(ctx, req.(*SetProviderKilledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_AggregationAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregationAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).AggregationAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/AggregationAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).AggregationAudit(ctx, req.(*QueryAggregationAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Oracle_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.service.v1.Oracle",
+	HandlerType: (*OracleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prices",
+			Handler:    _Oracle_Prices_Handler,
+		},
+		{
+			MethodName: "PriceEnvelopes",
+			Handler:    _Oracle_PriceEnvelopes_Handler,
+		},
+		{
+			MethodName: "ProviderHealth",
+			Handler:    _Oracle_ProviderHealth_Handler,
+		},
+		{
+			MethodName: "Config",
+			Handler:    _Oracle_Config_Handler,
+		},
+		{
+			MethodName: "RefreshPrices",
+			Handler:    _Oracle_RefreshPrices_Handler,
+		},
+		{
+			MethodName: "SetProviderKilled",
+			Handler:    _Oracle_SetProviderKilled_Handler,
+		},
+		{
+			MethodName: "AggregationAudit",
+			Handler:    _Oracle_AggregationAudit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "slinky/service/v1/oracle.proto",
+}
+
+func (m *QueryPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scale != nil {
+		{
+			size, err := m.Scale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinSignificantDigits != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinSignificantDigits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RoundingMode != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RoundingMode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spreads) > 0 {
+		for k := range m.Spreads {
+			v := m.Spreads[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Twaps) > 0 {
+		for k := range m.Twaps {
+			v := m.Twaps[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Failing) > 0 {
+		for iNdEx := len(m.Failing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failing[iNdEx])
+			copy(dAtA[i:], m.Failing[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Failing[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WarmingUp) > 0 {
+		for iNdEx := len(m.WarmingUp) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WarmingUp[iNdEx])
+			copy(dAtA[i:], m.WarmingUp[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.WarmingUp[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Prices) > 0 {
+		for k := range m.Prices {
+			v := m.Prices[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
+			copy(dAtA[i:], m.Providers[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Providers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceEnvelopesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceEnvelopesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceEnvelopesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceEnvelopesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceEnvelopesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceEnvelopesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Envelopes) > 0 {
+		for iNdEx := len(m.Envelopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Envelopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastErrorTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastErrorTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintOracle(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Connections) > 0 {
+		for iNdEx := len(m.Connections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Connections[iNdEx])
+			copy(dAtA[i:], m.Connections[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Connections[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Running {
+		i--
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pairs[iNdEx])
+			copy(dAtA[i:], m.Pairs[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Pairs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOracle(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Prices) > 0 {
+		for k := range m.Prices {
+			v := m.Prices[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintOracle(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProviderKilledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProviderKilledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProviderKilledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Killed {
+		i--
+		if m.Killed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProviderKilledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProviderKilledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProviderKilledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KilledProviders) > 0 {
+		for iNdEx := len(m.KilledProviders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KilledProviders[iNdEx])
+			copy(dAtA[i:], m.KilledProviders[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.KilledProviders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastGood {
+		i--
+		if m.LastGood {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Included {
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregationAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregationAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregationAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithheldReason) > 0 {
+		i -= len(m.WithheldReason)
+		copy(dAtA[i:], m.WithheldReason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.WithheldReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintOracle(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregationAuditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregationAuditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregationAuditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pairs[iNdEx])
+			copy(dAtA[i:], m.Pairs[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.Pairs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregationAuditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregationAuditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregationAuditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Audits) > 0 {
+		for k := range m.Audits {
+			v := m.Audits[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scale != nil {
+		{
+			size, err := m.Scale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scale != nil {
+		l = m.Scale.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceScale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		n += 1 + sovOracle(uint64(m.Decimals))
+	}
+	if m.RoundingMode != 0 {
+		n += 1 + sovOracle(uint64(m.RoundingMode))
+	}
+	if m.MinSignificantDigits != 0 {
+		n += 1 + sovOracle(uint64(m.MinSignificantDigits))
+	}
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for k, v := range m.Prices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.WarmingUp) > 0 {
+		for _, s := range m.WarmingUp {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Failing) > 0 {
+		for _, s := range m.Failing {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.Twaps) > 0 {
+		for k, v := range m.Twaps {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Spreads) > 0 {
+		for k, v := range m.Spreads {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PriceEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovOracle(uint64(m.Decimals))
+	}
+	if len(m.Providers) > 0 {
+		for _, s := range m.Providers {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceEnvelopesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceEnvelopesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Envelopes) > 0 {
+		for _, e := range m.Envelopes {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Running {
+		n += 2
+	}
+	if len(m.Connections) > 0 {
+		for _, s := range m.Connections {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastErrorTime)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, s := range m.Pairs {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RefreshPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for k, v := range m.Prices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *SetProviderKilledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Killed {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetProviderKilledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KilledProviders) > 0 {
+		for _, s := range m.KilledProviders {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Included {
+		n += 2
+	}
+	if m.LastGood {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregationAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.WithheldReason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregationAuditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, s := range m.Pairs {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregationAuditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Audits) > 0 {
+		for k, v := range m.Audits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *StreamPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scale != nil {
+		l = m.Scale.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scale == nil {
+				m.Scale = &PriceScale{}
+			}
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceScale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceScale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceScale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingMode", wireType)
+			}
+			m.RoundingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundingMode |= RoundingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignificantDigits", wireType)
+			}
+			m.MinSignificantDigits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSignificantDigits |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Prices[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarmingUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WarmingUp = append(m.WarmingUp, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failing = append(m.Failing, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Twaps == nil {
+				m.Twaps = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Twaps[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spreads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spreads == nil {
+				m.Spreads = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Spreads[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceEnvelopesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceEnvelopesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceEnvelopesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceEnvelopesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceEnvelopesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceEnvelopesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Envelopes = append(m.Envelopes, &types.Any{})
+			if err := m.Envelopes[len(m.Envelopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Running = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderHealth{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Prices[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProviderKilledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProviderKilledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProviderKilledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Killed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Killed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProviderKilledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProviderKilledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProviderKilledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KilledProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KilledProviders = append(m.KilledProviders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Included = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGood", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastGood = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for k, v := range m.Prices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+	return nil
+}
+func (m *AggregationAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregationAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregationAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderAudit{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithheldReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithheldReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregationAuditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -385,12 +5451,44 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregationAuditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregationAuditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -412,7 +5510,7 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregationAuditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -435,15 +5533,15 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregationAuditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregationAuditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Audits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -470,11 +5568,11 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = make(map[string]string)
+			if m.Audits == nil {
+				m.Audits = make(map[string]AggregationAudit)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &AggregationAudit{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -523,7 +5621,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowOracle
@@ -533,24 +5631,26 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthOracle
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthOracle
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &AggregationAudit{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipOracle(dAtA[iNdEx:])
@@ -566,11 +5666,61 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Prices[mapkey] = mapvalue
+			m.Audits[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -597,7 +5747,10 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Scale == nil {
+				m.Scale = &PriceScale{}
+			}
+			if err := m.Scale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
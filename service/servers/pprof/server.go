@@ -0,0 +1,201 @@
+package pprof
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/netutil"
+
+	"github.com/skip-mev/slinky/pkg/sync"
+)
+
+const (
+	// DefaultReadTimeout is the default maximum duration for reading an entire request.
+	DefaultReadTimeout = 10 * time.Second
+	// DefaultWriteTimeout is the default maximum duration before timing out writes of a response.
+	// This must exceed the duration of the profiles that are collected, e.g. CPU profiles are
+	// collected for 30 seconds by default.
+	DefaultWriteTimeout = 60 * time.Second
+	// DefaultIdleTimeout is the default maximum amount of time to wait for the next request on a
+	// keep-alive connection.
+	DefaultIdleTimeout = 120 * time.Second
+	// DefaultMaxConnections is the default maximum number of concurrent connections.
+	DefaultMaxConnections = 10
+
+	// loopbackHost is the host that the server binds to if it is restricted to loopback.
+	loopbackHost = "127.0.0.1"
+	// readHeaderTimeout is the maximum duration for reading the request headers.
+	readHeaderTimeout = 10 * time.Second
+)
+
+// PprofServer is a server that exposes the runtime profiling data of the process in the format
+// expected by the pprof visualization tool. The server will be started in a go-routine, and is
+// stopped on close.
+type PprofServer struct { //nolint
+	srv  *http.Server
+	done chan struct{}
+	*sync.Closer
+	logger *zap.Logger
+
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+	idleTimeout  time.Duration
+
+	// maxConns is the maximum number of concurrent connections. Connections past the limit wait
+	// until an existing connection is closed. A value of 0 disables the limit.
+	maxConns int
+	// loopback restricts the server to the loopback interface, regardless of the configured host.
+	loopback bool
+}
+
+// Option is a functional option for the pprof server.
+type Option func(*PprofServer)
+
+// WithReadTimeout sets the maximum duration for reading an entire request.
+func WithReadTimeout(timeout time.Duration) Option {
+	if timeout <= 0 {
+		panic("read timeout must be positive")
+	}
+
+	return func(ps *PprofServer) {
+		ps.readTimeout = timeout
+	}
+}
+
+// WithWriteTimeout sets the maximum duration before timing out writes of a response. Profiles
+// that are collected for longer than the write timeout cannot be served.
+func WithWriteTimeout(timeout time.Duration) Option {
+	if timeout <= 0 {
+		panic("write timeout must be positive")
+	}
+
+	return func(ps *PprofServer) {
+		ps.writeTimeout = timeout
+	}
+}
+
+// WithIdleTimeout sets the maximum amount of time to wait for the next request on a keep-alive
+// connection.
+func WithIdleTimeout(timeout time.Duration) Option {
+	if timeout <= 0 {
+		panic("idle timeout must be positive")
+	}
+
+	return func(ps *PprofServer) {
+		ps.idleTimeout = timeout
+	}
+}
+
+// WithMaxConnections sets the maximum number of concurrent connections. A value of 0 disables
+// the limit.
+func WithMaxConnections(maxConns int) Option {
+	if maxConns < 0 {
+		panic("max connections cannot be negative")
+	}
+
+	return func(ps *PprofServer) {
+		ps.maxConns = maxConns
+	}
+}
+
+// WithLoopbackOnly restricts the server to the loopback interface, regardless of the host it is
+// configured with, so that the profiling data is not exposed to the network.
+func WithLoopbackOnly() Option {
+	return func(ps *PprofServer) {
+		ps.loopback = true
+	}
+}
+
+// NewPprofServer creates a pprof server that listens on the given address. Notice, this method
+// does not start the server.
+func NewPprofServer(address string, logger *zap.Logger, opts ...Option) (*PprofServer, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || port == "" {
+		return nil, fmt.Errorf("invalid pprof server address: %s", address)
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	ps := &PprofServer{
+		done:         make(chan struct{}),
+		logger:       logger.With(zap.String("server", "pprof")),
+		readTimeout:  DefaultReadTimeout,
+		writeTimeout: DefaultWriteTimeout,
+		idleTimeout:  DefaultIdleTimeout,
+		maxConns:     DefaultMaxConnections,
+	}
+
+	for _, opt := range opts {
+		opt(ps)
+	}
+
+	if ps.loopback {
+		host = loopbackHost
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	ps.srv = &http.Server{
+		Addr:              net.JoinHostPort(host, port),
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       ps.readTimeout,
+		WriteTimeout:      ps.writeTimeout,
+		IdleTimeout:       ps.idleTimeout,
+	}
+
+	ps.Closer = sync.NewCloser().WithCallback(func() {
+		// close the server
+		if err := ps.srv.Close(); err != nil {
+			ps.logger.Info("pprof server close error", zap.Error(err))
+		}
+		// wait for the server to close
+		<-ps.done
+	})
+
+	return ps, nil
+}
+
+// Addr returns the address that the server listens on.
+func (ps *PprofServer) Addr() string {
+	return ps.srv.Addr
+}
+
+// Start will spawn a http server that serves the profiling data under /debug/pprof/.
+func (ps *PprofServer) Start() {
+	defer close(ps.done)
+
+	ps.logger.Info(
+		"starting pprof server",
+		zap.String("address", ps.srv.Addr),
+		zap.Int("max_connections", ps.maxConns),
+	)
+
+	ln, err := net.Listen("tcp", ps.srv.Addr)
+	if err != nil {
+		ps.logger.Error("pprof server failed to listen", zap.Error(err))
+		return
+	}
+
+	if ps.maxConns > 0 {
+		ln = netutil.LimitListener(ln, ps.maxConns)
+	}
+
+	if err := ps.srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+		ps.logger.Error("pprof server error", zap.Error(err))
+	} else {
+		ps.logger.Info("pprof server closed")
+	}
+}
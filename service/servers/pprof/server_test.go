@@ -0,0 +1,81 @@
+package pprof_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/slinky/service/servers/pprof"
+)
+
+func TestNewPprofServer(t *testing.T) {
+	t.Run("fails with incorrect address", func(t *testing.T) {
+		ps, err := pprof.NewPprofServer("localhost", zap.NewNop())
+		require.Nil(t, ps)
+		require.Error(t, err)
+	})
+
+	t.Run("fails without a logger", func(t *testing.T) {
+		ps, err := pprof.NewPprofServer("localhost:8091", nil)
+		require.Nil(t, ps)
+		require.Error(t, err)
+	})
+
+	t.Run("binds to the configured host by default", func(t *testing.T) {
+		ps, err := pprof.NewPprofServer("0.0.0.0:8091", zap.NewNop())
+		require.NoError(t, err)
+		require.Equal(t, "0.0.0.0:8091", ps.Addr())
+	})
+
+	t.Run("binds to loopback if restricted", func(t *testing.T) {
+		ps, err := pprof.NewPprofServer("0.0.0.0:8091", zap.NewNop(), pprof.WithLoopbackOnly())
+		require.NoError(t, err)
+		require.Equal(t, "127.0.0.1:8091", ps.Addr())
+	})
+}
+
+func TestStart(t *testing.T) {
+	ps, err := pprof.NewPprofServer(
+		"localhost:8092",
+		zap.NewNop(),
+		pprof.WithLoopbackOnly(),
+		pprof.WithReadTimeout(time.Second),
+		pprof.WithWriteTimeout(5*time.Second),
+		pprof.WithIdleTimeout(time.Second),
+		pprof.WithMaxConnections(1),
+	)
+	require.NoError(t, err)
+
+	// start the server
+	go ps.Start()
+
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://127.0.0.1:8092/debug/pprof/")
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+
+		return resp.StatusCode == http.StatusOK
+	}, 3*time.Second, 50*time.Millisecond)
+
+	// close the server
+	ps.Close()
+
+	// expect the server to be closed within 3 seconds
+	select {
+	case <-ps.Done():
+	case <-time.After(3 * time.Second):
+		t.Fatal("pprof server did not close")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	require.Panics(t, func() { pprof.WithReadTimeout(0) })
+	require.Panics(t, func() { pprof.WithWriteTimeout(-time.Second) })
+	require.Panics(t, func() { pprof.WithIdleTimeout(0) })
+	require.Panics(t, func() { pprof.WithMaxConnections(-1) })
+}
@@ -1,6 +1,7 @@
 package prometheus
 
 import (
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"net"
@@ -28,33 +29,80 @@ type PrometheusServer struct { //nolint
 	done chan struct{}
 	*sync.Closer
 	logger *zap.Logger
+
+	// certFile and keyFile are the TLS certificate and key served by the server. If unset, the
+	// server serves plaintext HTTP.
+	certFile string
+	keyFile  string
+
+	// username and password are the basic authentication credentials required to scrape
+	// metrics. If unset, no authentication is required.
+	username string
+	password string
+}
+
+// Option is a functional option for the prometheus server.
+type Option func(*PrometheusServer)
+
+// WithTLS configures the server to serve metrics over HTTPS using the given PEM encoded
+// certificate and key files.
+func WithTLS(certFile, keyFile string) Option {
+	if certFile == "" || keyFile == "" {
+		panic("tls cert file and key file cannot be empty")
+	}
+
+	return func(ps *PrometheusServer) {
+		ps.certFile = certFile
+		ps.keyFile = keyFile
+	}
+}
+
+// WithBasicAuth configures the server to require the given basic authentication credentials
+// to scrape metrics.
+func WithBasicAuth(username, password string) Option {
+	if username == "" || password == "" {
+		panic("basic auth username and password cannot be empty")
+	}
+
+	return func(ps *PrometheusServer) {
+		ps.username = username
+		ps.password = password
+	}
 }
 
 // NewPrometheusServer creates a prometheus server if the metrics are enabled and
 // address is set, and valid. Notice, this method does not start the server.
-func NewPrometheusServer(prometheusAddress string, logger *zap.Logger) (*PrometheusServer, error) {
+func NewPrometheusServer(prometheusAddress string, logger *zap.Logger, opts ...Option) (*PrometheusServer, error) {
 	// get the prometheus server address
 	if prometheusAddress == "" || !isValidAddress(prometheusAddress) {
 		return nil, fmt.Errorf("invalid prometheus server address: %s", prometheusAddress)
 	}
-	srv := &http.Server{
-		Addr: prometheusAddress,
-		Handler: promhttp.InstrumentMetricHandler(
-			prometheus.DefaultRegisterer, promhttp.HandlerFor(
-				prometheus.DefaultGatherer,
-				promhttp.HandlerOpts{MaxRequestsInFlight: maxOpenConnections},
-			),
-		),
-		ReadHeaderTimeout: readHeaderTimeout,
-	}
-
 	logger = logger.With(zap.String("server", "prometheus"))
 	ps := &PrometheusServer{
-		srv:    srv,
 		done:   make(chan struct{}),
 		logger: logger,
 	}
 
+	for _, opt := range opts {
+		opt(ps)
+	}
+
+	var handler http.Handler = promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer, promhttp.HandlerFor(
+			prometheus.DefaultGatherer,
+			promhttp.HandlerOpts{MaxRequestsInFlight: maxOpenConnections},
+		),
+	)
+	if ps.username != "" {
+		handler = ps.basicAuth(handler)
+	}
+
+	ps.srv = &http.Server{
+		Addr:              prometheusAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
 	ps.Closer = sync.NewCloser().WithCallback(func() {
 		// close the server
 		if err := ps.srv.Close(); err != nil {
@@ -70,7 +118,14 @@ func NewPrometheusServer(prometheusAddress string, logger *zap.Logger) (*Prometh
 // Start will spawn a http server that will handle requests to /metrics
 // and serves the metrics registered in the DefaultRegisterer.
 func (ps *PrometheusServer) Start() {
-	if err := ps.srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+	var err error
+	if ps.certFile != "" {
+		err = ps.srv.ListenAndServeTLS(ps.certFile, ps.keyFile)
+	} else {
+		err = ps.srv.ListenAndServe()
+	}
+
+	if !errors.Is(err, http.ErrServerClosed) {
 		ps.logger.Info("prometheus server error", zap.Error(err))
 	} else {
 		ps.logger.Info("prometheus server closed")
@@ -80,6 +135,23 @@ func (ps *PrometheusServer) Start() {
 	close(ps.done)
 }
 
+// basicAuth wraps the given handler, rejecting requests that do not supply the configured
+// basic authentication credentials.
+func (ps *PrometheusServer) basicAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(username), []byte(ps.username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(ps.password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func isValidAddress(address string) bool {
 	host, port, err := net.SplitHostPort(address)
 	if err != nil {
@@ -45,6 +45,40 @@ func TestStart(t *testing.T) {
 		case <-time.After(3 * time.Second):
 		}
 	})
+
+	t.Run("Start succeeds with basic auth", func(t *testing.T) {
+		address := "0.0.0.0:8082"
+
+		ps, err := prometheus.NewPrometheusServer(address, zap.NewNop(), prometheus.WithBasicAuth("user", "pass"))
+		require.NotNil(t, ps)
+		require.NoError(t, err)
+
+		// start the server
+		go ps.Start()
+
+		time.Sleep(1 * time.Second)
+
+		// requests without the correct credentials are rejected
+		require.False(t, pingServer("http://"+address))
+		require.False(t, pingServer("http://user:wrong@"+address))
+
+		// requests with the correct credentials succeed
+		require.True(t, pingServer("http://user:pass@"+address))
+
+		// close the server
+		ps.Close()
+
+		// expect the server to be closed within 3 seconds
+		select {
+		case <-ps.Done():
+		case <-time.After(3 * time.Second):
+		}
+	})
+}
+
+func TestOptions(t *testing.T) {
+	require.Panics(t, func() { prometheus.WithTLS("", "key.pem") })
+	require.Panics(t, func() { prometheus.WithBasicAuth("user", "") })
 }
 
 func pingServer(address string) bool {
@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
+
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 )
 
 // ValidateBasic validates the market map configuration and its expected configuration.
@@ -35,6 +38,48 @@ func (mm *MarketMap) ValidateBasic() error {
 	return nil
 }
 
+// Canonicalize returns a copy of the market map in which every currency pair is upper-cased and
+// every market is keyed by its ticker. This allows market maps with inconsistent casing, e.g.
+// hand-written configs that mix btc/usd and BTC/USD, to resolve to the same markets. An error is
+// returned if a market is keyed by a different currency pair than its ticker, or if two markets
+// resolve to the same currency pair.
+func (mm MarketMap) Canonicalize() (MarketMap, error) {
+	if mm.Markets == nil {
+		return mm, nil
+	}
+
+	markets := make(map[string]Market, len(mm.Markets))
+	for key, market := range mm.Markets {
+		market.Ticker.CurrencyPair = canonicalCurrencyPair(market.Ticker.CurrencyPair)
+
+		providerConfigs := make([]ProviderConfig, len(market.ProviderConfigs))
+		for i, providerConfig := range market.ProviderConfigs {
+			if providerConfig.NormalizeByPair != nil {
+				normalizeByPair := canonicalCurrencyPair(*providerConfig.NormalizeByPair)
+				providerConfig.NormalizeByPair = &normalizeByPair
+			}
+
+			providerConfigs[i] = providerConfig
+		}
+		if market.ProviderConfigs != nil {
+			market.ProviderConfigs = providerConfigs
+		}
+
+		ticker := market.Ticker.String()
+		if strings.ToUpper(key) != ticker {
+			return MarketMap{}, fmt.Errorf("market map key %s does not match its ticker %s", key, ticker)
+		}
+
+		if _, ok := markets[ticker]; ok {
+			return MarketMap{}, fmt.Errorf("market %s resolves to duplicate market %s", key, ticker)
+		}
+
+		markets[ticker] = market
+	}
+
+	return MarketMap{Markets: markets}, nil
+}
+
 // String returns the string representation of the market map.
 func (mm *MarketMap) String() string {
 	return fmt.Sprintf(
@@ -119,3 +164,11 @@ func (m *Market) Equal(other Market) bool {
 
 	return true
 }
+
+// canonicalCurrencyPair returns the currency pair with an upper-cased base and quote.
+func canonicalCurrencyPair(cp slinkytypes.CurrencyPair) slinkytypes.CurrencyPair {
+	return slinkytypes.CurrencyPair{
+		Base:  strings.ToUpper(cp.Base),
+		Quote: strings.ToUpper(cp.Quote),
+	}
+}
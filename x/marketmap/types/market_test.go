@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -332,6 +333,81 @@ func TestMarketMapValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMarketMapCanonicalize(t *testing.T) {
+	lower := func(market types.Market) types.Market {
+		market.Ticker.CurrencyPair = slinkytypes.CurrencyPair{
+			Base:  strings.ToLower(market.Ticker.CurrencyPair.Base),
+			Quote: market.Ticker.CurrencyPair.Quote,
+		}
+		return market
+	}
+
+	mixedBTCUSD := lower(btcusd)
+	mixedBTCUSD.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &slinkytypes.CurrencyPair{Base: "usdt", Quote: "Usd"},
+		},
+	}
+
+	t.Run("mixed case markets resolve to the same markets", func(t *testing.T) {
+		mixed := types.MarketMap{
+			Markets: map[string]types.Market{
+				"btc/usd":               mixedBTCUSD,
+				"Usdt/Usd":              usdtusd,
+				btcusdt.Ticker.String(): lower(btcusdt),
+			},
+		}
+
+		canonical, err := mixed.Canonicalize()
+		require.NoError(t, err)
+		require.NoError(t, canonical.ValidateBasic())
+		require.Equal(t, types.MarketMap{
+			Markets: map[string]types.Market{
+				btcusd.Ticker.String():  btcusd,
+				usdtusd.Ticker.String(): usdtusd,
+				btcusdt.Ticker.String(): btcusdt,
+			},
+		}, canonical)
+
+		// The original market map is not modified.
+		require.Equal(t, "usdt", mixed.Markets["btc/usd"].ProviderConfigs[0].NormalizeByPair.Base)
+	})
+
+	t.Run("markets that resolve to the same currency pair are rejected", func(t *testing.T) {
+		duplicate := types.MarketMap{
+			Markets: map[string]types.Market{
+				"btc/usd":              lower(btcusd),
+				btcusd.Ticker.String(): btcusd,
+			},
+		}
+
+		_, err := duplicate.Canonicalize()
+		require.Error(t, err)
+	})
+
+	t.Run("markets keyed by a different currency pair than their ticker are rejected", func(t *testing.T) {
+		mismatched := types.MarketMap{
+			Markets: map[string]types.Market{
+				btcusd.Ticker.String(): btcusdt,
+			},
+		}
+
+		_, err := mismatched.Canonicalize()
+		require.Error(t, err)
+
+		// the market map module does not require markets to be keyed by their ticker
+		require.NoError(t, mismatched.ValidateBasic())
+	})
+
+	t.Run("empty market map", func(t *testing.T) {
+		canonical, err := types.MarketMap{}.Canonicalize()
+		require.NoError(t, err)
+		require.Equal(t, types.MarketMap{}, canonical)
+	})
+}
+
 func TestMarketMapEqual(t *testing.T) {
 	cases := []struct {
 		name      string
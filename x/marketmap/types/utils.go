@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+
+	slinkyjson "github.com/skip-mev/slinky/pkg/json"
 )
 
 // ReadMarketMapFromFile reads a market map configuration from a file at the given path.
@@ -17,11 +19,18 @@ func ReadMarketMapFromFile(path string) (MarketMap, error) {
 		return config, fmt.Errorf("error reading config file: %w", err)
 	}
 
-	// Unmarshal the JSON data into the config struct
-	if err := json.Unmarshal(data, &config); err != nil {
+	// Unmarshal the JSON data into the config struct, ignoring any leading BOM or
+	// surrounding whitespace introduced by editors or tooling.
+	if err := json.Unmarshal(slinkyjson.Sanitize(data), &config); err != nil {
 		return config, fmt.Errorf("error unmarshalling config JSON: %w", err)
 	}
 
+	// Hand-written configs may use inconsistent casing for the same currency pair.
+	config, err = config.Canonicalize()
+	if err != nil {
+		return config, fmt.Errorf("error canonicalizing config: %w", err)
+	}
+
 	if err := config.ValidateBasic(); err != nil {
 		return config, fmt.Errorf("error validating config: %w", err)
 	}
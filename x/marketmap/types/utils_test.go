@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/slinky/x/marketmap/types"
+)
+
+func TestReadMarketMapFromFile(t *testing.T) {
+	marketMap := types.MarketMap{
+		Markets: map[string]types.Market{
+			btcusdt.Ticker.String(): btcusdt,
+		},
+	}
+
+	bz, err := json.Marshal(marketMap)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		bz   []byte
+	}{
+		{
+			name: "plain JSON",
+			bz:   bz,
+		},
+		{
+			name: "JSON with a leading BOM",
+			bz:   append([]byte{0xEF, 0xBB, 0xBF}, bz...),
+		},
+		{
+			name: "JSON with a leading BOM and surrounding whitespace",
+			bz:   append(append([]byte("\xEF\xBB\xBF\n\t "), bz...), []byte(" \r\n")...),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "market.json")
+			require.NoError(t, os.WriteFile(path, tc.bz, 0o600))
+
+			mm, err := types.ReadMarketMapFromFile(path)
+			require.NoError(t, err)
+			require.Equal(t, marketMap, mm)
+		})
+	}
+}